@@ -19,12 +19,16 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,10 +37,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	"github.com/argoproj/argo-cd/v2/applicationset/controllers/template"
 	"github.com/argoproj/argo-cd/v2/applicationset/generators"
 	"github.com/argoproj/argo-cd/v2/applicationset/utils"
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/glob"
 
 	argov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
@@ -69,6 +75,13 @@ type ApplicationSetReconciler struct {
 	KubeClientset    kubernetes.Interface
 	utils.Policy
 	utils.Renderer
+
+	EnableProgressiveSyncs bool
+	EnablePolicyOverride   bool
+	// ArgoCDNamespace is the namespace of the Argo CD control plane, where projects and clusters are defined
+	ArgoCDNamespace string
+	// ApplicationSetNamespaces are the additional namespaces in which ApplicationSets are reconciled
+	ApplicationSetNamespaces []string
 }
 
 // +kubebuilder:rbac:groups=argoproj.io,resources=applicationsets,verbs=get;list;watch;create;update;patch;delete
@@ -92,6 +105,31 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, nil
 	}
 
+	// Only reconcile ApplicationSets in the control plane's namespace, or in a namespace that is enabled.
+	if !r.isNamespaceAllowed(applicationSetInfo.Namespace) {
+		logCtx.Warnf("ApplicationSet is in namespace %q, which is not allowed to contain ApplicationSets", applicationSetInfo.Namespace)
+		return ctrl.Result{}, nil
+	}
+
+	policy := utils.DefaultPolicy(applicationSetInfo.Spec.SyncPolicy, r.Policy, r.EnablePolicyOverride)
+
+	// A RollingSync triggers the syncs by updating the existing Applications, which a policy that does not allow
+	// updates would prevent, leaving the rollout waiting forever.
+	if r.EnableProgressiveSyncs && progressiveSyncsStrategyEnabled(&applicationSetInfo, argov1alpha1.ApplicationSetStrategyRollingSync) && !policy.Update() {
+		message := "the RollingSync strategy requires a policy that allows updates of the Applications"
+		logCtx.Error(message)
+		_ = r.setApplicationSetStatusCondition(ctx,
+			&applicationSetInfo,
+			argov1alpha1.ApplicationSetCondition{
+				Type:    argov1alpha1.ApplicationSetConditionErrorOccurred,
+				Message: message,
+				Reason:  argov1alpha1.ApplicationSetReasonErrorOccurred,
+				Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+			}, parametersGenerated,
+		)
+		return ctrl.Result{}, nil
+	}
+
 	// Log a warning if there are unrecognized generators
 	_ = utils.CheckInvalidGenerators(&applicationSetInfo)
 	// desiredApplications is the main list of all expected Applications from all generators in this appset.
@@ -111,7 +149,7 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 	parametersGenerated = true
 
-	validateErrors, err := r.validateGeneratedApplications(ctx, desiredApplications, applicationSetInfo, req.Namespace)
+	validateErrors, err := r.validateGeneratedApplications(ctx, desiredApplications, applicationSetInfo)
 	if err != nil {
 		// While some generators may return an error that requires user intervention,
 		// other generators reference external resources that may change to cause
@@ -134,6 +172,29 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{RequeueAfter: ReconcileRequeueOnValidationError}, nil
 	}
 
+	// appMap is a name->app collection of Applications in this ApplicationSet.
+	appMap := map[string]argov1alpha1.Application{}
+	// appSyncMap tracks which apps will be synced during this reconciliation.
+	appSyncMap := map[string]bool{}
+
+	applications, err := r.getCurrentApplications(ctx, applicationSetInfo)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get current applications for application set: %w", err)
+	}
+
+	for _, app := range applications {
+		appMap[app.Name] = app
+	}
+
+	if r.EnableProgressiveSyncs && progressiveSyncsStrategyEnabled(&applicationSetInfo, argov1alpha1.ApplicationSetStrategyRollingSync) {
+		appSyncMap, err = r.performProgressiveSyncs(ctx, &applicationSetInfo, applications, desiredApplications, appMap)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to perform progressive sync reconciliation for application set: %w", err)
+		}
+	} else if err := r.updateApplicationSetApplicationStatus(ctx, &applicationSetInfo, applications, map[string]int{}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update applicationset app status: %w", err)
+	}
+
 	var validApps []argov1alpha1.Application
 	for i := range desiredApplications {
 		if validateErrors[i] == nil {
@@ -162,7 +223,12 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		)
 	}
 
-	if r.Policy.Update() {
+	if r.EnableProgressiveSyncs && progressiveSyncsStrategyEnabled(&applicationSetInfo, argov1alpha1.ApplicationSetStrategyRollingSync) {
+		// trigger the syncs of the Applications which are allowed to progress in the current step
+		validApps = r.syncValidApplications(&applicationSetInfo, appSyncMap, appMap, validApps)
+	}
+
+	if policy.Update() {
 		err = r.createOrUpdateInCluster(ctx, applicationSetInfo, validApps)
 		if err != nil {
 			_ = r.setApplicationSetStatusCondition(ctx,
@@ -192,7 +258,7 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
-	if r.Policy.Delete() {
+	if policy.Delete() {
 		err = r.deleteInCluster(ctx, applicationSetInfo, desiredApplications)
 		if err != nil {
 			_ = r.setApplicationSetStatusCondition(ctx,
@@ -352,10 +418,12 @@ func (r *ApplicationSetReconciler) setApplicationSetStatusCondition(ctx context.
 
 // validateGeneratedApplications uses the Argo CD validation functions to verify the correctness of the
 // generated applications.
-func (r *ApplicationSetReconciler) validateGeneratedApplications(ctx context.Context, desiredApplications []argov1alpha1.Application, applicationSetInfo argov1alpha1.ApplicationSet, namespace string) (map[int]error, error) {
+func (r *ApplicationSetReconciler) validateGeneratedApplications(ctx context.Context, desiredApplications []argov1alpha1.Application, applicationSetInfo argov1alpha1.ApplicationSet) (map[int]error, error) {
 	errorsByIndex := map[int]error{}
 	namesSet := map[string]bool{}
 	for i, app := range desiredApplications {
+		// The Applications are always created in the namespace of the ApplicationSet
+		app.Namespace = applicationSetInfo.Namespace
 
 		if !namesSet[app.Name] {
 			namesSet[app.Name] = true
@@ -364,7 +432,7 @@ func (r *ApplicationSetReconciler) validateGeneratedApplications(ctx context.Con
 			continue
 		}
 
-		proj, err := r.ArgoAppClientset.ArgoprojV1alpha1().AppProjects(namespace).Get(ctx, app.Spec.GetProject(), metav1.GetOptions{})
+		proj, err := r.ArgoAppClientset.ArgoprojV1alpha1().AppProjects(r.ArgoCDNamespace).Get(ctx, app.Spec.GetProject(), metav1.GetOptions{})
 		if err != nil {
 			if apierr.IsNotFound(err) {
 				errorsByIndex[i] = fmt.Errorf("application references project %s which does not exist", app.Spec.Project)
@@ -373,7 +441,12 @@ func (r *ApplicationSetReconciler) validateGeneratedApplications(ctx context.Con
 			return nil, err
 		}
 
-		if err := utils.ValidateDestination(ctx, &app.Spec.Destination, r.KubeClientset, namespace); err != nil {
+		if !proj.IsAppNamespacePermitted(&app, r.ArgoCDNamespace) {
+			errorsByIndex[i] = fmt.Errorf("application in namespace %s is not allowed to use project %s", app.Namespace, proj.Name)
+			continue
+		}
+
+		if err := utils.ValidateDestination(ctx, &app.Spec.Destination, r.KubeClientset, r.ArgoCDNamespace); err != nil {
 			errorsByIndex[i] = fmt.Errorf("application destination spec is invalid: %s", err.Error())
 			continue
 		}
@@ -392,6 +465,11 @@ func (r *ApplicationSetReconciler) validateGeneratedApplications(ctx context.Con
 	return errorsByIndex, nil
 }
 
+// isNamespaceAllowed returns whether ApplicationSets, and the Applications they generate, may exist in the given namespace
+func (r *ApplicationSetReconciler) isNamespaceAllowed(namespace string) bool {
+	return namespace == r.ArgoCDNamespace || glob.MatchStringInList(r.ApplicationSetNamespaces, namespace, false)
+}
+
 func (r *ApplicationSetReconciler) getMinRequeueAfter(applicationSetInfo *argov1alpha1.ApplicationSet) time.Duration {
 	var res time.Duration
 	for _, requestedGenerator := range applicationSetInfo.Spec.Generators {
@@ -412,60 +490,8 @@ func (r *ApplicationSetReconciler) getMinRequeueAfter(applicationSetInfo *argov1
 	return res
 }
 
-func getTempApplication(applicationSetTemplate argov1alpha1.ApplicationSetTemplate) *argov1alpha1.Application {
-	var tmplApplication argov1alpha1.Application
-	tmplApplication.Annotations = applicationSetTemplate.Annotations
-	tmplApplication.Labels = applicationSetTemplate.Labels
-	tmplApplication.Namespace = applicationSetTemplate.Namespace
-	tmplApplication.Name = applicationSetTemplate.Name
-	tmplApplication.Spec = applicationSetTemplate.Spec
-	tmplApplication.Finalizers = applicationSetTemplate.Finalizers
-
-	return &tmplApplication
-}
-
 func (r *ApplicationSetReconciler) generateApplications(applicationSetInfo argov1alpha1.ApplicationSet) ([]argov1alpha1.Application, argov1alpha1.ApplicationSetReasonType, error) {
-	var res []argov1alpha1.Application
-
-	var firstError error
-	var applicationSetReason argov1alpha1.ApplicationSetReasonType
-
-	for _, requestedGenerator := range applicationSetInfo.Spec.Generators {
-		t, err := generators.Transform(requestedGenerator, r.Generators, applicationSetInfo.Spec.Template, &applicationSetInfo, map[string]interface{}{})
-		if err != nil {
-			log.WithError(err).WithField("generator", requestedGenerator).
-				Error("error generating application from params")
-			if firstError == nil {
-				firstError = err
-				applicationSetReason = argov1alpha1.ApplicationSetReasonApplicationParamsGenerationError
-			}
-			continue
-		}
-
-		for _, a := range t {
-			tmplApplication := getTempApplication(a.Template)
-
-			for _, p := range a.Params {
-				app, err := r.Renderer.RenderTemplateParams(tmplApplication, applicationSetInfo.Spec.SyncPolicy, p, applicationSetInfo.Spec.GoTemplate)
-				if err != nil {
-					log.WithError(err).WithField("params", a.Params).WithField("generator", requestedGenerator).
-						Error("error generating application from params")
-
-					if firstError == nil {
-						firstError = err
-						applicationSetReason = argov1alpha1.ApplicationSetReasonRenderTemplateParamsError
-					}
-					continue
-				}
-				res = append(res, *app)
-			}
-		}
-
-		log.WithField("generator", requestedGenerator).Infof("generated %d applications", len(res))
-		log.WithField("generator", requestedGenerator).Debugf("apps from generator: %+v", res)
-	}
-
-	return res, applicationSetReason, firstError
+	return template.GenerateApplications(log.WithField("applicationset", applicationSetInfo.Name), applicationSetInfo, r.Generators, r.Renderer)
 }
 
 func (r *ApplicationSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
@@ -525,9 +551,21 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 		}
 
 		action, err := utils.CreateOrUpdate(ctx, r.Client, found, func() error {
+			// Keep the fields of the existing Application which the ApplicationSet ignores
+			if found.ResourceVersion != "" {
+				if err := utils.ApplyIgnoreDifferences(applicationSet.Spec.IgnoreApplicationDifferences, found, &generatedApp); err != nil {
+					return fmt.Errorf("failed to apply ignoreApplicationDifferences: %w", err)
+				}
+			}
+
 			// Copy only the Application/ObjectMeta fields that are significant, from the generatedApp
 			found.Spec = generatedApp.Spec
 
+			// allow setting the Operation field to trigger a sync operation on an Application
+			if generatedApp.Operation != nil {
+				found.Operation = generatedApp.Operation
+			}
+
 			// Preserve specially treated argo cd annotations:
 			// * https://github.com/argoproj/applicationset/issues/180
 			// * https://github.com/argoproj/argo-cd/issues/10500
@@ -591,7 +629,7 @@ func (r *ApplicationSetReconciler) createInCluster(ctx context.Context, applicat
 func (r *ApplicationSetReconciler) getCurrentApplications(_ context.Context, applicationSet argov1alpha1.ApplicationSet) ([]argov1alpha1.Application, error) {
 	// TODO: Should this use the context param?
 	var current argov1alpha1.ApplicationList
-	err := r.Client.List(context.Background(), &current, client.MatchingFields{".metadata.controller": applicationSet.Name})
+	err := r.Client.List(context.Background(), &current, client.MatchingFields{".metadata.controller": applicationSet.Name}, client.InNamespace(applicationSet.Namespace))
 
 	if err != nil {
 		return nil, err
@@ -606,7 +644,7 @@ func (r *ApplicationSetReconciler) deleteInCluster(ctx context.Context, applicat
 	// settingsMgr := settings.NewSettingsManager(context.TODO(), r.KubeClientset, applicationSet.Namespace)
 	// argoDB := db.NewDB(applicationSet.Namespace, settingsMgr, r.KubeClientset)
 	// clusterList, err := argoDB.ListClusters(ctx)
-	clusterList, err := utils.ListClusters(ctx, r.KubeClientset, applicationSet.Namespace)
+	clusterList, err := utils.ListClusters(ctx, r.KubeClientset, r.ArgoCDNamespace)
 	if err != nil {
 		return err
 	}
@@ -667,7 +705,7 @@ func (r *ApplicationSetReconciler) removeFinalizerOnInvalidDestination(ctx conte
 	var validDestination bool
 
 	// Detect if the destination is invalid (name doesn't correspond to a matching cluster)
-	if err := utils.ValidateDestination(ctx, &app.Spec.Destination, r.KubeClientset, applicationSet.Namespace); err != nil {
+	if err := utils.ValidateDestination(ctx, &app.Spec.Destination, r.KubeClientset, r.ArgoCDNamespace); err != nil {
 		appLog.Warnf("The destination cluster for %s couldn't be found: %v", app.Name, err)
 		validDestination = false
 	} else {
@@ -726,4 +764,479 @@ func (r *ApplicationSetReconciler) removeFinalizerOnInvalidDestination(ctx conte
 	return nil
 }
 
+// performProgressiveSyncs updates the rollout status of the Applications of an ApplicationSet with a strategy, and
+// returns which Applications are allowed to be synced during this reconciliation.
+func (r *ApplicationSetReconciler) performProgressiveSyncs(ctx context.Context, appset *argov1alpha1.ApplicationSet, applications []argov1alpha1.Application, desiredApplications []argov1alpha1.Application, appMap map[string]argov1alpha1.Application) (map[string]bool, error) {
+	appDependencyList, appStepMap, err := buildAppDependencyList(appset, desiredApplications)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build app dependency list: %w", err)
+	}
+
+	if err := r.updateApplicationSetApplicationStatus(ctx, appset, applications, appStepMap); err != nil {
+		return nil, fmt.Errorf("failed to update applicationset app status: %w", err)
+	}
+
+	for i, step := range appDependencyList {
+		log.WithField("applicationset", appset.Name).Debugf("step %d: %v", i+1, step)
+	}
+
+	appSyncMap := buildAppSyncMap(appset, appDependencyList, appMap)
+
+	if err := r.updateApplicationSetApplicationStatusProgress(ctx, appset, appSyncMap, appStepMap); err != nil {
+		return nil, fmt.Errorf("failed to update applicationset application status progress: %w", err)
+	}
+
+	r.updateApplicationSetApplicationStatusConditions(ctx, appset)
+
+	return appSyncMap, nil
+}
+
+// buildAppDependencyList returns the names of the Applications belonging to each step of the strategy, and a map
+// of each Application name to the index of its step.
+func buildAppDependencyList(applicationSet *argov1alpha1.ApplicationSet, applications []argov1alpha1.Application) ([][]string, map[string]int, error) {
+	if !progressiveSyncsStrategyEnabled(applicationSet, argov1alpha1.ApplicationSetStrategyRollingSync) {
+		return [][]string{}, map[string]int{}, nil
+	}
+
+	steps := applicationSet.Spec.Strategy.RollingSync.Steps
+	selectors := make([]labels.Selector, len(steps))
+	for i, step := range steps {
+		selector, err := rolloutStepSelector(step)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid matchExpressions in step %d: %w", i+1, err)
+		}
+		selectors[i] = selector
+	}
+
+	appDependencyList := make([][]string, len(steps))
+	appStepMap := map[string]int{}
+
+	for _, app := range applications {
+		for i, selector := range selectors {
+			if !selector.Matches(labels.Set(app.Labels)) {
+				continue
+			}
+			appDependencyList[i] = append(appDependencyList[i], app.Name)
+			if step, ok := appStepMap[app.Name]; ok {
+				log.Warnf("ApplicationSet %q selects Application %q in both step %d and step %d", applicationSet.Name, app.Name, step+1, i+1)
+			} else {
+				appStepMap[app.Name] = i
+			}
+		}
+	}
+
+	return appDependencyList, appStepMap, nil
+}
+
+// rolloutStepSelector converts the match expressions of a rollout step to a label selector.
+func rolloutStepSelector(step argov1alpha1.ApplicationSetRolloutStep) (labels.Selector, error) {
+	requirements := make([]metav1.LabelSelectorRequirement, len(step.MatchExpressions))
+	for i, expression := range step.MatchExpressions {
+		requirements[i] = metav1.LabelSelectorRequirement{
+			Key:      expression.Key,
+			Operator: metav1.LabelSelectorOperator(expression.Operator),
+			Values:   expression.Values,
+		}
+	}
+	return metav1.LabelSelectorAsSelector(&metav1.LabelSelector{MatchExpressions: requirements})
+}
+
+// buildAppSyncMap determines which Applications are allowed to be synced: the Applications of all healthy steps and
+// of the first step which is not healthy yet. The Applications of every subsequent step have to wait.
+func buildAppSyncMap(applicationSet *argov1alpha1.ApplicationSet, appDependencyList [][]string, appMap map[string]argov1alpha1.Application) map[string]bool {
+	appSyncMap := map[string]bool{}
+	syncEnabled := true
+
+	for _, step := range appDependencyList {
+		for _, appName := range step {
+			appSyncMap[appName] = syncEnabled
+		}
+
+		// detect if we need to halt before progressing to the next step
+		for _, appName := range step {
+			idx := findApplicationStatusIndex(applicationSet.Status.ApplicationStatus, appName)
+			if idx == -1 {
+				// no status found, most likely because the Application is being created
+				syncEnabled = false
+				break
+			}
+
+			app, ok := appMap[appName]
+			if !ok {
+				// the Application does not exist (yet), or is being deleted
+				syncEnabled = false
+				break
+			}
+
+			appStatus := applicationSet.Status.ApplicationStatus[idx]
+			if !isApplicationHealthy(app) || appStatus.Status != argov1alpha1.ApplicationSetApplicationStatusHealthy {
+				syncEnabled = false
+				break
+			}
+		}
+	}
+
+	return appSyncMap
+}
+
+func progressiveSyncsStrategyEnabled(appset *argov1alpha1.ApplicationSet, strategyType string) bool {
+	if appset.Spec.Strategy == nil || appset.Spec.Strategy.Type != strategyType {
+		return false
+	}
+
+	if strategyType == argov1alpha1.ApplicationSetStrategyRollingSync && appset.Spec.Strategy.RollingSync == nil {
+		return false
+	}
+
+	return true
+}
+
+func isApplicationHealthy(app argov1alpha1.Application) bool {
+	healthStatus, syncStatus, operationPhase := statusStrings(app)
+	return healthStatus == string(health.HealthStatusHealthy) &&
+		syncStatus != string(argov1alpha1.SyncStatusCodeOutOfSync) &&
+		(operationPhase == string(synccommon.OperationSucceeded) || operationPhase == "")
+}
+
+func statusStrings(app argov1alpha1.Application) (string, string, string) {
+	operationPhase := ""
+	if app.Status.OperationState != nil {
+		operationPhase = string(app.Status.OperationState.Phase)
+	}
+	return string(app.Status.Health.Status), string(app.Status.Sync.Status), operationPhase
+}
+
+// updateApplicationSetApplicationStatus records the health and sync status of each Application, and promotes it to
+// the next status if needed. appStepMap contains the rollout step of each Application, if the ApplicationSet uses a
+// RollingSync strategy.
+func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatus(ctx context.Context, applicationSet *argov1alpha1.ApplicationSet, applications []argov1alpha1.Application, appStepMap map[string]int) error {
+	now := metav1.Now()
+	appStatuses := make([]argov1alpha1.ApplicationSetApplicationStatus, 0, len(applications))
+
+	for _, app := range applications {
+		healthStatus, syncStatus, operationPhase := statusStrings(app)
+		step := ""
+		stepIndex, inRollout := appStepMap[app.Name]
+		if inRollout {
+			step = fmt.Sprint(stepIndex + 1)
+		}
+
+		var currentAppStatus argov1alpha1.ApplicationSetApplicationStatus
+		idx := findApplicationStatusIndex(applicationSet.Status.ApplicationStatus, app.Name)
+		if idx == -1 {
+			currentAppStatus = argov1alpha1.ApplicationSetApplicationStatus{
+				Application:        app.Name,
+				LastTransitionTime: &now,
+				Message:            "No Application status found, defaulting status to Waiting.",
+				Status:             argov1alpha1.ApplicationSetApplicationStatusWaiting,
+				Step:               step,
+			}
+		} else {
+			currentAppStatus = applicationSet.Status.ApplicationStatus[idx]
+		}
+
+		appOutdated := syncStatus == string(argov1alpha1.SyncStatusCodeOutOfSync)
+		if !inRollout && operationPhase == string(synccommon.OperationRunning) {
+			// the Application is being synced, which is reported as Progressing below
+			appOutdated = false
+		}
+		if appOutdated && currentAppStatus.Status != argov1alpha1.ApplicationSetApplicationStatusWaiting && currentAppStatus.Status != argov1alpha1.ApplicationSetApplicationStatusPending {
+			log.Infof("Application %v is outdated, updating its ApplicationSet status to Waiting", app.Name)
+			currentAppStatus.LastTransitionTime = &now
+			currentAppStatus.Status = argov1alpha1.ApplicationSetApplicationStatusWaiting
+			currentAppStatus.Message = "Application has pending changes, setting status to Waiting."
+			currentAppStatus.Step = step
+		}
+
+		if currentAppStatus.Status == argov1alpha1.ApplicationSetApplicationStatusPending {
+			// Syncs triggered by the controller may report a start time slightly before the transition to Pending,
+			// so allow for some clock skew when matching the operation to the transition.
+			if operationPhase == string(synccommon.OperationSucceeded) && app.Status.OperationState.StartedAt.Add(10*time.Second).After(currentAppStatus.LastTransitionTime.Time) {
+				log.Infof("Application %v has completed a sync successfully, updating its ApplicationSet status to Progressing", app.Name)
+				currentAppStatus.LastTransitionTime = &now
+				currentAppStatus.Status = argov1alpha1.ApplicationSetApplicationStatusProgressing
+				currentAppStatus.Message = "Application resource completed a sync successfully, updating status from Pending to Progressing."
+				currentAppStatus.Step = step
+			} else if operationPhase == string(synccommon.OperationRunning) || healthStatus == string(health.HealthStatusProgressing) {
+				log.Infof("Application %v has entered Progressing status, updating its ApplicationSet status to Progressing", app.Name)
+				currentAppStatus.LastTransitionTime = &now
+				currentAppStatus.Status = argov1alpha1.ApplicationSetApplicationStatusProgressing
+				currentAppStatus.Message = "Application resource became Progressing, updating status from Pending to Progressing."
+				currentAppStatus.Step = step
+			}
+		}
+
+		// Applications which are not part of a rollout are synced by their own sync policy, or manually
+		if !inRollout && currentAppStatus.Status == argov1alpha1.ApplicationSetApplicationStatusWaiting &&
+			(operationPhase == string(synccommon.OperationRunning) || healthStatus == string(health.HealthStatusProgressing)) {
+			log.Infof("Application %v has entered Progressing status, updating its ApplicationSet status to Progressing", app.Name)
+			currentAppStatus.LastTransitionTime = &now
+			currentAppStatus.Status = argov1alpha1.ApplicationSetApplicationStatusProgressing
+			currentAppStatus.Message = "Application resource became Progressing, updating status from Waiting to Progressing."
+			currentAppStatus.Step = step
+		}
+
+		if currentAppStatus.Status == argov1alpha1.ApplicationSetApplicationStatusWaiting && isApplicationHealthy(app) {
+			log.Infof("Application %v is already synced and healthy, updating its ApplicationSet status to Healthy", app.Name)
+			currentAppStatus.LastTransitionTime = &now
+			currentAppStatus.Status = argov1alpha1.ApplicationSetApplicationStatusHealthy
+			currentAppStatus.Message = "Application resource is already Healthy, updating status from Waiting to Healthy."
+			currentAppStatus.Step = step
+		}
+
+		if currentAppStatus.Status == argov1alpha1.ApplicationSetApplicationStatusProgressing && isApplicationHealthy(app) {
+			log.Infof("Application %v has completed Progressing status, updating its ApplicationSet status to Healthy", app.Name)
+			currentAppStatus.LastTransitionTime = &now
+			currentAppStatus.Status = argov1alpha1.ApplicationSetApplicationStatusHealthy
+			currentAppStatus.Message = "Application resource became Healthy, updating status from Progressing to Healthy."
+			currentAppStatus.Step = step
+		}
+
+		currentAppStatus.Health = app.Status.Health
+		currentAppStatus.Sync = app.Status.Sync.Status
+
+		appStatuses = append(appStatuses, currentAppStatus)
+	}
+
+	return r.setAppSetApplicationStatus(ctx, applicationSet, appStatuses)
+}
+
+// updateApplicationSetApplicationStatusProgress moves the Waiting Applications which are allowed to sync to Pending,
+// as long as the maxUpdate limit of their step is not reached.
+func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatusProgress(ctx context.Context, applicationSet *argov1alpha1.ApplicationSet, appSyncMap map[string]bool, appStepMap map[string]int) error {
+	now := metav1.Now()
+	appStatuses := make([]argov1alpha1.ApplicationSetApplicationStatus, 0, len(applicationSet.Status.ApplicationStatus))
+
+	if progressiveSyncsStrategyEnabled(applicationSet, argov1alpha1.ApplicationSetStrategyRollingSync) {
+		steps := applicationSet.Spec.Strategy.RollingSync.Steps
+		updateCountMap := make([]int, len(steps))
+		totalCountMap := make([]int, len(steps))
+
+		// count the Applications of each step, and those of them which are already updating
+		for _, appStatus := range applicationSet.Status.ApplicationStatus {
+			step, ok := appStepMap[appStatus.Application]
+			if !ok {
+				continue
+			}
+			totalCountMap[step]++
+			if appStatus.Status == argov1alpha1.ApplicationSetApplicationStatusPending || appStatus.Status == argov1alpha1.ApplicationSetApplicationStatusProgressing {
+				updateCountMap[step]++
+			}
+		}
+
+		for _, appStatus := range applicationSet.Status.ApplicationStatus {
+			step, ok := appStepMap[appStatus.Application]
+			if ok && appStatus.Status == argov1alpha1.ApplicationSetApplicationStatusWaiting && appSyncMap[appStatus.Application] &&
+				maxUpdateAllowed(applicationSet, steps[step].MaxUpdate, updateCountMap[step], totalCountMap[step]) {
+
+				log.Infof("Application %v moved to Pending status, watching for the Application to start Progressing", appStatus.Application)
+				appStatus.LastTransitionTime = &now
+				appStatus.Status = argov1alpha1.ApplicationSetApplicationStatusPending
+				appStatus.Message = "Application moved to Pending status, watching for the Application resource to start Progressing."
+				appStatus.Step = fmt.Sprint(step + 1)
+
+				updateCountMap[step]++
+			}
+
+			appStatuses = append(appStatuses, appStatus)
+		}
+	}
+
+	return r.setAppSetApplicationStatus(ctx, applicationSet, appStatuses)
+}
+
+// maxUpdateAllowed checks whether another Application of a step may start updating, given the maxUpdate of the step.
+// All Applications of a step may update at once if maxUpdate is unset.
+func maxUpdateAllowed(applicationSet *argov1alpha1.ApplicationSet, maxUpdate *intstr.IntOrString, updating int, total int) bool {
+	if maxUpdate == nil {
+		return true
+	}
+
+	maxUpdateVal, err := intstr.GetScaledValueFromIntOrPercent(maxUpdate, total, false)
+	if err != nil {
+		log.Warnf("ApplicationSet %q has an invalid maxUpdate value '%v', ignoring maxUpdate for this step: %v", applicationSet.Name, maxUpdate.String(), err)
+		return true
+	}
+
+	// ensure that percentage values greater than 0% always result in at least 1 Application being selected
+	if maxUpdate.Type == intstr.String && maxUpdate.StrVal != "0%" && maxUpdateVal < 1 {
+		maxUpdateVal = 1
+	}
+
+	return updating < maxUpdateVal
+}
+
+// updateApplicationSetApplicationStatusConditions sets the RolloutProgressing condition of the ApplicationSet, based
+// on whether all of its Applications are Healthy.
+func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatusConditions(ctx context.Context, applicationSet *argov1alpha1.ApplicationSet) {
+	appSetProgressing := false
+	for _, appStatus := range applicationSet.Status.ApplicationStatus {
+		if appStatus.Status != argov1alpha1.ApplicationSetApplicationStatusHealthy {
+			appSetProgressing = true
+			break
+		}
+	}
+
+	appSetConditionProgressing := false
+	for _, condition := range applicationSet.Status.Conditions {
+		if condition.Type == argov1alpha1.ApplicationSetConditionRolloutProgressing && condition.Status == argov1alpha1.ApplicationSetConditionStatusTrue {
+			appSetConditionProgressing = true
+			break
+		}
+	}
+
+	if appSetProgressing && !appSetConditionProgressing {
+		_ = r.setApplicationSetRolloutCondition(ctx, applicationSet, argov1alpha1.ApplicationSetCondition{
+			Type:    argov1alpha1.ApplicationSetConditionRolloutProgressing,
+			Message: "ApplicationSet Rollout started",
+			Reason:  argov1alpha1.ApplicationSetReasonApplicationSetModified,
+			Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+		})
+	} else if !appSetProgressing && appSetConditionProgressing {
+		_ = r.setApplicationSetRolloutCondition(ctx, applicationSet, argov1alpha1.ApplicationSetCondition{
+			Type:    argov1alpha1.ApplicationSetConditionRolloutProgressing,
+			Message: "ApplicationSet Rollout complete",
+			Reason:  argov1alpha1.ApplicationSetReasonApplicationSetRolloutComplete,
+			Status:  argov1alpha1.ApplicationSetConditionStatusFalse,
+		})
+	}
+}
+
+// setApplicationSetRolloutCondition sets the RolloutProgressing condition, leaving all other conditions untouched.
+func (r *ApplicationSetReconciler) setApplicationSetRolloutCondition(ctx context.Context, applicationSet *argov1alpha1.ApplicationSet, condition argov1alpha1.ApplicationSetCondition) error {
+	// fetch updated Application Set object before updating it
+	namespacedName := types.NamespacedName{Namespace: applicationSet.Namespace, Name: applicationSet.Name}
+	if err := r.Get(ctx, namespacedName, applicationSet); err != nil {
+		if apierr.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error fetching updated application set: %v", err)
+	}
+
+	applicationSet.Status.SetConditions(
+		[]argov1alpha1.ApplicationSetCondition{condition},
+		map[argov1alpha1.ApplicationSetConditionType]bool{argov1alpha1.ApplicationSetConditionRolloutProgressing: true},
+	)
+
+	err := r.Client.Status().Update(ctx, applicationSet)
+	if err != nil && !apierr.IsNotFound(err) {
+		return fmt.Errorf("unable to set application set condition: %v", err)
+	}
+	return nil
+}
+
+// syncValidApplications triggers a sync of the Applications which are Pending in the current step of the rollout.
+// Automated sync policies are removed from the generated Applications, since the rollout decides when they sync.
+func (r *ApplicationSetReconciler) syncValidApplications(applicationSet *argov1alpha1.ApplicationSet, appSyncMap map[string]bool, appMap map[string]argov1alpha1.Application, validApps []argov1alpha1.Application) []argov1alpha1.Application {
+	rolloutApps := []argov1alpha1.Application{}
+	for i := range validApps {
+		pruneEnabled := false
+
+		if validApps[i].Spec.SyncPolicy != nil && validApps[i].Spec.SyncPolicy.Automated != nil {
+			pruneEnabled = validApps[i].Spec.SyncPolicy.Automated.Prune
+			validApps[i].Spec.SyncPolicy.Automated = nil
+		}
+
+		// only Pending Applications are synced, since maxUpdate is accounted for when moving them to Pending
+		appSetStatusPending := false
+		idx := findApplicationStatusIndex(applicationSet.Status.ApplicationStatus, validApps[i].Name)
+		if idx > -1 && applicationSet.Status.ApplicationStatus[idx].Status == argov1alpha1.ApplicationSetApplicationStatusPending {
+			appSetStatusPending = true
+		}
+
+		if appSyncMap[validApps[i].Name] && appMap[validApps[i].Name].Status.Sync.Status == argov1alpha1.SyncStatusCodeOutOfSync && appSetStatusPending {
+			log.Infof("triggering sync for application: %v, prune enabled: %v", validApps[i].Name, pruneEnabled)
+			validApps[i] = syncApplication(validApps[i], pruneEnabled)
+		}
+		rolloutApps = append(rolloutApps, validApps[i])
+	}
+	return rolloutApps
+}
+
+// syncApplication sets a sync operation on the given Application, which is then picked up by the application
+// controller.
+func syncApplication(application argov1alpha1.Application, prune bool) argov1alpha1.Application {
+	operation := argov1alpha1.Operation{
+		InitiatedBy: argov1alpha1.OperationInitiator{
+			Username:  "applicationset-controller",
+			Automated: true,
+		},
+		Info: []*argov1alpha1.Info{
+			{
+				Name:  "Reason",
+				Value: "ApplicationSet RollingSync triggered a sync of this Application resource.",
+			},
+		},
+		Sync: &argov1alpha1.SyncOperation{
+			Prune: prune,
+		},
+	}
+
+	if application.Spec.SyncPolicy != nil {
+		if application.Spec.SyncPolicy.Retry != nil {
+			operation.Retry = *application.Spec.SyncPolicy.Retry
+		}
+		operation.Sync.SyncOptions = application.Spec.SyncPolicy.SyncOptions
+	}
+	application.Operation = &operation
+
+	return application
+}
+
+// setAppSetApplicationStatus updates the ApplicationSet's status field with any new/changed Application statuses.
+func (r *ApplicationSetReconciler) setAppSetApplicationStatus(ctx context.Context, applicationSet *argov1alpha1.ApplicationSet, applicationStatuses []argov1alpha1.ApplicationSetApplicationStatus) error {
+	needToUpdateStatus := false
+
+	if len(applicationStatuses) != len(applicationSet.Status.ApplicationStatus) {
+		needToUpdateStatus = true
+	} else {
+		for _, appStatus := range applicationStatuses {
+			idx := findApplicationStatusIndex(applicationSet.Status.ApplicationStatus, appStatus.Application)
+			if idx == -1 {
+				needToUpdateStatus = true
+				break
+			}
+			currentStatus := applicationSet.Status.ApplicationStatus[idx]
+			if currentStatus.Message != appStatus.Message || currentStatus.Status != appStatus.Status || currentStatus.Step != appStatus.Step ||
+				currentStatus.Health != appStatus.Health || currentStatus.Sync != appStatus.Sync {
+				needToUpdateStatus = true
+				break
+			}
+		}
+	}
+
+	if !needToUpdateStatus {
+		return nil
+	}
+
+	// rebuild ApplicationStatus from scratch, we don't need any previous status history
+	applicationSet.Status.ApplicationStatus = []argov1alpha1.ApplicationSetApplicationStatus{}
+	for i := range applicationStatuses {
+		applicationSet.Status.SetApplicationStatus(applicationStatuses[i])
+	}
+
+	if err := r.Client.Status().Update(ctx, applicationSet); err != nil {
+		return fmt.Errorf("unable to set application set status: %v", err)
+	}
+
+	namespacedName := types.NamespacedName{Namespace: applicationSet.Namespace, Name: applicationSet.Name}
+	if err := r.Get(ctx, namespacedName, applicationSet); err != nil {
+		if apierr.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error fetching updated application set: %v", err)
+	}
+
+	return nil
+}
+
+func findApplicationStatusIndex(appStatuses []argov1alpha1.ApplicationSetApplicationStatus, application string) int {
+	for i := range appStatuses {
+		if appStatuses[i].Application == application {
+			return i
+		}
+	}
+	return -1
+}
+
 var _ handler.EventHandler = &clusterSecretEventHandler{}
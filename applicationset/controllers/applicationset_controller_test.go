@@ -9,6 +9,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -17,6 +19,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	kubefake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -24,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
+	"github.com/argoproj/argo-cd/v2/applicationset/controllers/template"
 	"github.com/argoproj/argo-cd/v2/applicationset/generators"
 	"github.com/argoproj/argo-cd/v2/applicationset/utils"
 
@@ -70,6 +74,16 @@ func (r *rendererMock) RenderTemplateParams(tmpl *argov1alpha1.Application, sync
 
 }
 
+func (r *rendererMock) Replace(tmpl string, replaceMap map[string]interface{}, useGoTemplate bool) (string, error) {
+	args := r.Called(tmpl, replaceMap, useGoTemplate)
+
+	if args.Error(1) != nil {
+		return "", args.Error(1)
+	}
+
+	return args.Get(0).(string), args.Error(1)
+}
+
 func TestExtractApplications(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := argov1alpha1.AddToScheme(scheme)
@@ -159,10 +173,10 @@ func TestExtractApplications(t *testing.T) {
 				for _, p := range cc.params {
 
 					if cc.rendererError != nil {
-						rendererMock.On("RenderTemplateParams", getTempApplication(cc.template), p, false).
+						rendererMock.On("RenderTemplateParams", template.GetTempApplication(cc.template), p, false).
 							Return(nil, cc.rendererError)
 					} else {
-						rendererMock.On("RenderTemplateParams", getTempApplication(cc.template), p, false).
+						rendererMock.On("RenderTemplateParams", template.GetTempApplication(cc.template), p, false).
 							Return(&app, nil)
 						expectedApps = append(expectedApps, app)
 					}
@@ -279,7 +293,7 @@ func TestMergeTemplateApplications(t *testing.T) {
 
 			rendererMock := rendererMock{}
 
-			rendererMock.On("RenderTemplateParams", getTempApplication(cc.expectedMerged), cc.params[0], false).
+			rendererMock.On("RenderTemplateParams", template.GetTempApplication(cc.expectedMerged), cc.params[0], false).
 				Return(&cc.expectedApps[0], nil)
 
 			r := ApplicationSetReconciler{
@@ -420,6 +434,78 @@ func TestCreateOrUpdateInCluster(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Update an existing app without reverting ignored fields",
+			appSet: argov1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "name",
+					Namespace: "namespace",
+				},
+				Spec: argov1alpha1.ApplicationSetSpec{
+					IgnoreApplicationDifferences: argov1alpha1.ApplicationSetIgnoreDifferences{
+						{JSONPointers: []string{"/spec/source/targetRevision"}},
+						{Name: "app2", JSONPointers: []string{"/spec/source/path"}},
+					},
+				},
+			},
+			existingApps: []argov1alpha1.Application{
+				{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Application",
+						APIVersion: "argoproj.io/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "app1",
+						Namespace:       "namespace",
+						ResourceVersion: "2",
+					},
+					Spec: argov1alpha1.ApplicationSpec{
+						Project: "test",
+						Source: argov1alpha1.ApplicationSource{
+							RepoURL:        "https://github.com/argoproj/argocd-example-apps",
+							Path:           "guestbook",
+							TargetRevision: "hotfix",
+						},
+					},
+				},
+			},
+			desiredApps: []argov1alpha1.Application{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "app1",
+					},
+					Spec: argov1alpha1.ApplicationSpec{
+						Project: "project",
+						Source: argov1alpha1.ApplicationSource{
+							RepoURL:        "https://github.com/argoproj/argocd-example-apps",
+							Path:           "helm-guestbook",
+							TargetRevision: "HEAD",
+						},
+					},
+				},
+			},
+			expected: []argov1alpha1.Application{
+				{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Application",
+						APIVersion: "argoproj.io/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "app1",
+						Namespace:       "namespace",
+						ResourceVersion: "3",
+					},
+					Spec: argov1alpha1.ApplicationSpec{
+						Project: "project",
+						Source: argov1alpha1.ApplicationSource{
+							RepoURL:        "https://github.com/argoproj/argocd-example-apps",
+							Path:           "helm-guestbook",
+							TargetRevision: "hotfix",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "Create a new app and check it doesn't replace the existing app",
 			appSet: argov1alpha1.ApplicationSet{
@@ -1737,11 +1823,12 @@ func TestValidateGeneratedApplications(t *testing.T) {
 				ArgoDB:           &argoDBMock,
 				ArgoAppClientset: appclientset.NewSimpleClientset(argoObjs...),
 				KubeClientset:    kubeclientset,
+				ArgoCDNamespace:  "namespace",
 			}
 
 			appSetInfo := argov1alpha1.ApplicationSet{}
 
-			validationErrors, _ := r.validateGeneratedApplications(context.TODO(), cc.apps, appSetInfo, "namespace")
+			validationErrors, _ := r.validateGeneratedApplications(context.TODO(), cc.apps, appSetInfo)
 			var errorMessages []string
 			for _, v := range validationErrors {
 				errorMessages = append(errorMessages, v.Error())
@@ -1843,6 +1930,7 @@ func TestReconcilerValidationErrorBehaviour(t *testing.T) {
 		ArgoAppClientset: appclientset.NewSimpleClientset(argoObjs...),
 		KubeClientset:    kubeclientset,
 		Policy:           &utils.SyncPolicy{},
+		ArgoCDNamespace:  "argocd",
 	}
 
 	req := ctrl.Request{
@@ -1869,6 +1957,129 @@ func TestReconcilerValidationErrorBehaviour(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestReconcilerApplicationSetNamespaces(t *testing.T) {
+	for _, c := range []struct {
+		name                     string
+		applicationSetNamespaces []string
+		sourceNamespaces         []string
+		expectApp                bool
+		expectedCondition        string
+	}{
+		{
+			name:      "namespace not enabled",
+			expectApp: false,
+		},
+		{
+			name:                     "namespace not allowed by project",
+			applicationSetNamespaces: []string{"tenant-*"},
+			expectApp:                false,
+			expectedCondition:        "application in namespace tenant-a is not allowed to use project default",
+		},
+		{
+			name:                     "namespace enabled and allowed by project",
+			applicationSetNamespaces: []string{"tenant-*"},
+			sourceNamespaces:         []string{"tenant-a"},
+			expectApp:                true,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			err := argov1alpha1.AddToScheme(scheme)
+			assert.Nil(t, err)
+
+			defaultProject := argov1alpha1.AppProject{
+				ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+				Spec: argov1alpha1.AppProjectSpec{
+					SourceRepos:      []string{"*"},
+					Destinations:     []argov1alpha1.ApplicationDestination{{Namespace: "*", Server: "https://good-cluster"}},
+					SourceNamespaces: c.sourceNamespaces,
+				},
+			}
+			appSet := argov1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "name",
+					Namespace: "tenant-a",
+				},
+				Spec: argov1alpha1.ApplicationSetSpec{
+					GoTemplate: true,
+					Generators: []argov1alpha1.ApplicationSetGenerator{
+						{
+							List: &argov1alpha1.ListGenerator{
+								Elements: []apiextensionsv1.JSON{{
+									Raw: []byte(`{"cluster": "good-cluster","url": "https://good-cluster"}`),
+								}},
+							},
+						},
+					},
+					Template: argov1alpha1.ApplicationSetTemplate{
+						ApplicationSetTemplateMeta: argov1alpha1.ApplicationSetTemplateMeta{
+							// the Application is generated in the namespace of the ApplicationSet
+							Name:      "{{.cluster}}",
+							Namespace: "argocd",
+						},
+						Spec: argov1alpha1.ApplicationSpec{
+							Source:      argov1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+							Project:     "default",
+							Destination: argov1alpha1.ApplicationDestination{Server: "{{.url}}"},
+						},
+					},
+				},
+			}
+
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet).Build()
+			goodCluster := argov1alpha1.Cluster{Server: "https://good-cluster", Name: "good-cluster"}
+			argoDBMock := dbmocks.ArgoDB{}
+			argoDBMock.On("GetCluster", mock.Anything, "https://good-cluster").Return(&goodCluster, nil)
+
+			r := ApplicationSetReconciler{
+				Client:   client,
+				Scheme:   scheme,
+				Renderer: &utils.Render{},
+				Recorder: record.NewFakeRecorder(1),
+				Generators: map[string]generators.Generator{
+					"List": generators.NewListGenerator(),
+				},
+				ArgoDB:                   &argoDBMock,
+				ArgoAppClientset:         appclientset.NewSimpleClientset(&defaultProject),
+				KubeClientset:            kubefake.NewSimpleClientset(),
+				Policy:                   &utils.SyncPolicy{},
+				ArgoCDNamespace:          "argocd",
+				ApplicationSetNamespaces: c.applicationSetNamespaces,
+			}
+
+			req := ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: "tenant-a",
+					Name:      "name",
+				},
+			}
+			_, err = r.Reconcile(context.Background(), req)
+			assert.Nil(t, err)
+
+			var app argov1alpha1.Application
+			err = r.Client.Get(context.TODO(), crtclient.ObjectKey{Namespace: "tenant-a", Name: "good-cluster"}, &app)
+			if c.expectApp {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+			err = r.Client.Get(context.TODO(), crtclient.ObjectKey{Namespace: "argocd", Name: "good-cluster"}, &app)
+			assert.Error(t, err)
+
+			if c.expectedCondition != "" {
+				var updated argov1alpha1.ApplicationSet
+				err = r.Client.Get(context.TODO(), crtclient.ObjectKey{Namespace: "tenant-a", Name: "name"}, &updated)
+				assert.NoError(t, err)
+				found := false
+				for _, condition := range updated.Status.Conditions {
+					found = found || strings.Contains(condition.Message, c.expectedCondition)
+				}
+				assert.True(t, found, "expected a condition with message %q, got %v", c.expectedCondition, updated.Status.Conditions)
+			}
+		})
+	}
+}
+
 func TestSetApplicationSetStatusCondition(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := argov1alpha1.AddToScheme(scheme)
@@ -1924,3 +2135,500 @@ func TestSetApplicationSetStatusCondition(t *testing.T) {
 
 	assert.Len(t, appSet.Status.Conditions, 3)
 }
+
+func newRollingSyncAppSet(steps []argov1alpha1.ApplicationSetRolloutStep, appStatuses []argov1alpha1.ApplicationSetApplicationStatus) argov1alpha1.ApplicationSet {
+	return argov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "argocd",
+		},
+		Spec: argov1alpha1.ApplicationSetSpec{
+			Strategy: &argov1alpha1.ApplicationSetStrategy{
+				Type: argov1alpha1.ApplicationSetStrategyRollingSync,
+				RollingSync: &argov1alpha1.ApplicationSetRolloutStrategy{
+					Steps: steps,
+				},
+			},
+		},
+		Status: argov1alpha1.ApplicationSetStatus{
+			ApplicationStatus: appStatuses,
+		},
+	}
+}
+
+func newRolloutApp(name string, env string, healthStatus health.HealthStatusCode, syncStatus argov1alpha1.SyncStatusCode) argov1alpha1.Application {
+	return argov1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "argocd",
+			Labels:    map[string]string{"env": env},
+		},
+		Status: argov1alpha1.ApplicationStatus{
+			Health: argov1alpha1.HealthStatus{Status: healthStatus},
+			Sync:   argov1alpha1.SyncStatus{Status: syncStatus},
+		},
+	}
+}
+
+func newRolloutReconciler(t *testing.T, appSet *argov1alpha1.ApplicationSet) ApplicationSetReconciler {
+	scheme := runtime.NewScheme()
+	err := argov1alpha1.AddToScheme(scheme)
+	assert.Nil(t, err)
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(appSet).Build()
+
+	return ApplicationSetReconciler{
+		Client:                 client,
+		Scheme:                 scheme,
+		Recorder:               record.NewFakeRecorder(1),
+		KubeClientset:          kubefake.NewSimpleClientset(),
+		EnableProgressiveSyncs: true,
+	}
+}
+
+var rolloutSteps = []argov1alpha1.ApplicationSetRolloutStep{
+	{MatchExpressions: []argov1alpha1.ApplicationMatchExpression{{Key: "env", Operator: "In", Values: []string{"dev"}}}},
+	{MatchExpressions: []argov1alpha1.ApplicationMatchExpression{{Key: "env", Operator: "In", Values: []string{"staging"}}}},
+	{MatchExpressions: []argov1alpha1.ApplicationMatchExpression{{Key: "env", Operator: "In", Values: []string{"prod"}}}},
+}
+
+func TestBuildAppDependencyList(t *testing.T) {
+	apps := []argov1alpha1.Application{
+		newRolloutApp("app-dev", "dev", "", ""),
+		newRolloutApp("app-staging", "staging", "", ""),
+		newRolloutApp("app-prod-1", "prod", "", ""),
+		newRolloutApp("app-prod-2", "prod", "", ""),
+		newRolloutApp("app-qa", "qa", "", ""),
+	}
+
+	t.Run("RollingSync steps", func(t *testing.T) {
+		appSet := newRollingSyncAppSet(rolloutSteps, nil)
+		appDependencyList, appStepMap, err := buildAppDependencyList(&appSet, apps)
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"app-dev"}, {"app-staging"}, {"app-prod-1", "app-prod-2"}}, appDependencyList)
+		assert.Equal(t, map[string]int{"app-dev": 0, "app-staging": 1, "app-prod-1": 2, "app-prod-2": 2}, appStepMap)
+	})
+
+	t.Run("NotIn and Exists operators", func(t *testing.T) {
+		appSet := newRollingSyncAppSet([]argov1alpha1.ApplicationSetRolloutStep{
+			{MatchExpressions: []argov1alpha1.ApplicationMatchExpression{{Key: "env", Operator: "NotIn", Values: []string{"prod"}}}},
+			{MatchExpressions: []argov1alpha1.ApplicationMatchExpression{{Key: "env", Operator: "Exists"}}},
+		}, nil)
+		appDependencyList, appStepMap, err := buildAppDependencyList(&appSet, apps)
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"app-dev", "app-staging", "app-qa"}, {"app-dev", "app-staging", "app-prod-1", "app-prod-2", "app-qa"}}, appDependencyList)
+		// Applications are assigned to the first step which selects them
+		assert.Equal(t, 0, appStepMap["app-dev"])
+		assert.Equal(t, 1, appStepMap["app-prod-1"])
+	})
+
+	t.Run("invalid operator", func(t *testing.T) {
+		appSet := newRollingSyncAppSet([]argov1alpha1.ApplicationSetRolloutStep{
+			{MatchExpressions: []argov1alpha1.ApplicationMatchExpression{{Key: "env", Operator: "Matches", Values: []string{"prod"}}}},
+		}, nil)
+		_, _, err := buildAppDependencyList(&appSet, apps)
+		assert.Error(t, err)
+	})
+
+	t.Run("no strategy", func(t *testing.T) {
+		appSet := newRollingSyncAppSet(nil, nil)
+		appSet.Spec.Strategy = nil
+		appDependencyList, appStepMap, err := buildAppDependencyList(&appSet, apps)
+		assert.NoError(t, err)
+		assert.Empty(t, appDependencyList)
+		assert.Empty(t, appStepMap)
+	})
+}
+
+func TestBuildAppSyncMap(t *testing.T) {
+	healthy := func(name string) argov1alpha1.ApplicationSetApplicationStatus {
+		return argov1alpha1.ApplicationSetApplicationStatus{Application: name, Status: argov1alpha1.ApplicationSetApplicationStatusHealthy}
+	}
+	waiting := func(name string) argov1alpha1.ApplicationSetApplicationStatus {
+		return argov1alpha1.ApplicationSetApplicationStatus{Application: name, Status: argov1alpha1.ApplicationSetApplicationStatusWaiting}
+	}
+	appDependencyList := [][]string{{"app-dev"}, {"app-staging"}, {"app-prod"}}
+
+	for _, c := range []struct {
+		name        string
+		apps        []argov1alpha1.Application
+		appStatuses []argov1alpha1.ApplicationSetApplicationStatus
+		expected    map[string]bool
+	}{
+		{
+			name: "only the first step syncs while it is not healthy",
+			apps: []argov1alpha1.Application{
+				newRolloutApp("app-dev", "dev", health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeOutOfSync),
+				newRolloutApp("app-staging", "staging", health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeOutOfSync),
+				newRolloutApp("app-prod", "prod", health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeOutOfSync),
+			},
+			appStatuses: []argov1alpha1.ApplicationSetApplicationStatus{waiting("app-dev"), waiting("app-staging"), waiting("app-prod")},
+			expected:    map[string]bool{"app-dev": true, "app-staging": false, "app-prod": false},
+		},
+		{
+			name: "healthy steps let the next step sync",
+			apps: []argov1alpha1.Application{
+				newRolloutApp("app-dev", "dev", health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeSynced),
+				newRolloutApp("app-staging", "staging", health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeOutOfSync),
+				newRolloutApp("app-prod", "prod", health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeOutOfSync),
+			},
+			appStatuses: []argov1alpha1.ApplicationSetApplicationStatus{healthy("app-dev"), waiting("app-staging"), waiting("app-prod")},
+			expected:    map[string]bool{"app-dev": true, "app-staging": true, "app-prod": false},
+		},
+		{
+			name: "degraded application halts the rollout",
+			apps: []argov1alpha1.Application{
+				newRolloutApp("app-dev", "dev", health.HealthStatusDegraded, argov1alpha1.SyncStatusCodeSynced),
+				newRolloutApp("app-staging", "staging", health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeSynced),
+				newRolloutApp("app-prod", "prod", health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeSynced),
+			},
+			appStatuses: []argov1alpha1.ApplicationSetApplicationStatus{healthy("app-dev"), healthy("app-staging"), healthy("app-prod")},
+			expected:    map[string]bool{"app-dev": true, "app-staging": false, "app-prod": false},
+		},
+		{
+			name: "missing application status halts the rollout",
+			apps: []argov1alpha1.Application{
+				newRolloutApp("app-dev", "dev", health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeSynced),
+			},
+			appStatuses: []argov1alpha1.ApplicationSetApplicationStatus{},
+			expected:    map[string]bool{"app-dev": true, "app-staging": false, "app-prod": false},
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			appSet := newRollingSyncAppSet(rolloutSteps, c.appStatuses)
+			appMap := map[string]argov1alpha1.Application{}
+			for _, app := range c.apps {
+				appMap[app.Name] = app
+			}
+			assert.Equal(t, c.expected, buildAppSyncMap(&appSet, appDependencyList, appMap))
+		})
+	}
+}
+
+func TestUpdateApplicationSetApplicationStatus(t *testing.T) {
+	now := metav1.Now()
+	appStepMap := map[string]int{"app-dev": 0, "app-staging": 1}
+
+	for _, c := range []struct {
+		name        string
+		app         argov1alpha1.Application
+		appStatuses []argov1alpha1.ApplicationSetApplicationStatus
+		expected    string
+	}{
+		{
+			name:     "new out of sync application is Waiting",
+			app:      newRolloutApp("app-dev", "dev", health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeOutOfSync),
+			expected: argov1alpha1.ApplicationSetApplicationStatusWaiting,
+		},
+		{
+			name:     "new synced and healthy application is Healthy",
+			app:      newRolloutApp("app-dev", "dev", health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeSynced),
+			expected: argov1alpha1.ApplicationSetApplicationStatusHealthy,
+		},
+		{
+			name: "healthy application with pending changes goes back to Waiting",
+			app:  newRolloutApp("app-dev", "dev", health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeOutOfSync),
+			appStatuses: []argov1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app-dev", Status: argov1alpha1.ApplicationSetApplicationStatusHealthy, LastTransitionTime: &now},
+			},
+			expected: argov1alpha1.ApplicationSetApplicationStatusWaiting,
+		},
+		{
+			name: "pending application being synced is Progressing",
+			app: func() argov1alpha1.Application {
+				app := newRolloutApp("app-dev", "dev", health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeOutOfSync)
+				app.Status.OperationState = &argov1alpha1.OperationState{Phase: synccommon.OperationRunning}
+				return app
+			}(),
+			appStatuses: []argov1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app-dev", Status: argov1alpha1.ApplicationSetApplicationStatusPending, LastTransitionTime: &now},
+			},
+			expected: argov1alpha1.ApplicationSetApplicationStatusProgressing,
+		},
+		{
+			name: "progressing application which became healthy is Healthy",
+			app:  newRolloutApp("app-dev", "dev", health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeSynced),
+			appStatuses: []argov1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app-dev", Status: argov1alpha1.ApplicationSetApplicationStatusProgressing, LastTransitionTime: &now},
+			},
+			expected: argov1alpha1.ApplicationSetApplicationStatusHealthy,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			appSet := newRollingSyncAppSet(rolloutSteps, c.appStatuses)
+			r := newRolloutReconciler(t, &appSet)
+
+			err := r.updateApplicationSetApplicationStatus(context.TODO(), &appSet, []argov1alpha1.Application{c.app}, appStepMap)
+			assert.NoError(t, err)
+			assert.Len(t, appSet.Status.ApplicationStatus, 1)
+			assert.Equal(t, c.expected, appSet.Status.ApplicationStatus[0].Status)
+			assert.Equal(t, "1", appSet.Status.ApplicationStatus[0].Step)
+		})
+	}
+}
+
+func TestUpdateApplicationSetApplicationStatusProgress(t *testing.T) {
+	waiting := func(name string) argov1alpha1.ApplicationSetApplicationStatus {
+		return argov1alpha1.ApplicationSetApplicationStatus{Application: name, Status: argov1alpha1.ApplicationSetApplicationStatusWaiting}
+	}
+	appStepMap := map[string]int{"app-1": 0, "app-2": 0, "app-3": 0, "app-4": 1}
+	appSyncMap := map[string]bool{"app-1": true, "app-2": true, "app-3": true, "app-4": false}
+
+	for _, c := range []struct {
+		name      string
+		maxUpdate *intstr.IntOrString
+		expected  []string
+	}{
+		{
+			name:     "maxUpdate unset",
+			expected: []string{"Pending", "Pending", "Pending", "Waiting"},
+		},
+		{
+			name:      "maxUpdate as a number",
+			maxUpdate: &intstr.IntOrString{Type: intstr.Int, IntVal: 2},
+			expected:  []string{"Pending", "Pending", "Waiting", "Waiting"},
+		},
+		{
+			name:      "maxUpdate as a percentage rounds up to one application",
+			maxUpdate: &intstr.IntOrString{Type: intstr.String, StrVal: "10%"},
+			expected:  []string{"Pending", "Waiting", "Waiting", "Waiting"},
+		},
+		{
+			name:      "maxUpdate of zero",
+			maxUpdate: &intstr.IntOrString{Type: intstr.String, StrVal: "0%"},
+			expected:  []string{"Waiting", "Waiting", "Waiting", "Waiting"},
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			steps := []argov1alpha1.ApplicationSetRolloutStep{
+				{MatchExpressions: []argov1alpha1.ApplicationMatchExpression{{Key: "env", Operator: "In", Values: []string{"dev"}}}, MaxUpdate: c.maxUpdate},
+				{MatchExpressions: []argov1alpha1.ApplicationMatchExpression{{Key: "env", Operator: "In", Values: []string{"prod"}}}},
+			}
+			appSet := newRollingSyncAppSet(steps, []argov1alpha1.ApplicationSetApplicationStatus{waiting("app-1"), waiting("app-2"), waiting("app-3"), waiting("app-4")})
+			r := newRolloutReconciler(t, &appSet)
+
+			err := r.updateApplicationSetApplicationStatusProgress(context.TODO(), &appSet, appSyncMap, appStepMap)
+			assert.NoError(t, err)
+
+			var statuses []string
+			for _, appStatus := range appSet.Status.ApplicationStatus {
+				statuses = append(statuses, appStatus.Status)
+			}
+			assert.Equal(t, c.expected, statuses)
+		})
+	}
+}
+
+func TestSyncValidApplications(t *testing.T) {
+	appSet := newRollingSyncAppSet(rolloutSteps, []argov1alpha1.ApplicationSetApplicationStatus{
+		{Application: "app-dev", Status: argov1alpha1.ApplicationSetApplicationStatusPending},
+		{Application: "app-staging", Status: argov1alpha1.ApplicationSetApplicationStatusWaiting},
+	})
+	appMap := map[string]argov1alpha1.Application{
+		"app-dev":     newRolloutApp("app-dev", "dev", health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeOutOfSync),
+		"app-staging": newRolloutApp("app-staging", "staging", health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeOutOfSync),
+	}
+	appSyncMap := map[string]bool{"app-dev": true, "app-staging": false}
+
+	validApps := []argov1alpha1.Application{appMap["app-dev"], appMap["app-staging"]}
+	for i := range validApps {
+		validApps[i].Spec.SyncPolicy = &argov1alpha1.SyncPolicy{
+			Automated:   &argov1alpha1.SyncPolicyAutomated{Prune: true},
+			SyncOptions: argov1alpha1.SyncOptions{"CreateNamespace=true"},
+		}
+	}
+
+	r := newRolloutReconciler(t, &appSet)
+	rolloutApps := r.syncValidApplications(&appSet, appSyncMap, appMap, validApps)
+
+	assert.Len(t, rolloutApps, 2)
+	for _, app := range rolloutApps {
+		assert.Nil(t, app.Spec.SyncPolicy.Automated, "automated sync policy must be removed for %s", app.Name)
+	}
+	assert.NotNil(t, rolloutApps[0].Operation)
+	assert.True(t, rolloutApps[0].Operation.Sync.Prune)
+	assert.Equal(t, argov1alpha1.SyncOptions{"CreateNamespace=true"}, rolloutApps[0].Operation.Sync.SyncOptions)
+	assert.Equal(t, "applicationset-controller", rolloutApps[0].Operation.InitiatedBy.Username)
+	assert.Nil(t, rolloutApps[1].Operation)
+}
+
+func TestUpdateApplicationSetApplicationStatusConditions(t *testing.T) {
+	appSet := newRollingSyncAppSet(rolloutSteps, []argov1alpha1.ApplicationSetApplicationStatus{
+		{Application: "app-dev", Status: argov1alpha1.ApplicationSetApplicationStatusPending},
+	})
+	appSet.Status.Conditions = []argov1alpha1.ApplicationSetCondition{
+		{Type: argov1alpha1.ApplicationSetConditionResourcesUpToDate, Status: argov1alpha1.ApplicationSetConditionStatusTrue},
+	}
+	r := newRolloutReconciler(t, &appSet)
+
+	r.updateApplicationSetApplicationStatusConditions(context.TODO(), &appSet)
+	assert.Len(t, appSet.Status.Conditions, 2)
+	for _, condition := range appSet.Status.Conditions {
+		if condition.Type == argov1alpha1.ApplicationSetConditionRolloutProgressing {
+			assert.Equal(t, argov1alpha1.ApplicationSetConditionStatusTrue, condition.Status)
+		}
+	}
+
+	appSet.Status.ApplicationStatus[0].Status = argov1alpha1.ApplicationSetApplicationStatusHealthy
+	r.updateApplicationSetApplicationStatusConditions(context.TODO(), &appSet)
+	assert.Len(t, appSet.Status.Conditions, 2)
+	for _, condition := range appSet.Status.Conditions {
+		if condition.Type == argov1alpha1.ApplicationSetConditionRolloutProgressing {
+			assert.Equal(t, argov1alpha1.ApplicationSetConditionStatusFalse, condition.Status)
+			assert.Equal(t, argov1alpha1.ApplicationSetReasonApplicationSetRolloutComplete, condition.Reason)
+		}
+	}
+}
+
+func TestReconcileRollingSyncWithoutUpdatePolicy(t *testing.T) {
+	for _, policy := range []argov1alpha1.ApplicationsSyncPolicy{
+		argov1alpha1.ApplicationsSyncPolicyCreateOnly,
+		argov1alpha1.ApplicationsSyncPolicyCreateDelete,
+	} {
+		t.Run(string(policy), func(t *testing.T) {
+			appSet := newRollingSyncAppSet(rolloutSteps, nil)
+			appSet.Spec.SyncPolicy = &argov1alpha1.ApplicationSetSyncPolicy{ApplicationsSync: &policy}
+			r := newRolloutReconciler(t, &appSet)
+			r.Policy = &utils.SyncPolicy{}
+			r.EnablePolicyOverride = true
+			r.ArgoCDNamespace = "argocd"
+
+			req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: "name"}}
+			_, err := r.Reconcile(context.TODO(), req)
+			assert.NoError(t, err)
+
+			var updated argov1alpha1.ApplicationSet
+			err = r.Client.Get(context.TODO(), req.NamespacedName, &updated)
+			assert.NoError(t, err)
+			found := false
+			for _, condition := range updated.Status.Conditions {
+				if condition.Type == argov1alpha1.ApplicationSetConditionErrorOccurred {
+					found = true
+					assert.Equal(t, argov1alpha1.ApplicationSetConditionStatusTrue, condition.Status)
+					assert.Contains(t, condition.Message, "RollingSync")
+				}
+			}
+			assert.True(t, found, "expected an ErrorOccurred condition")
+		})
+	}
+}
+
+func TestSetApplicationSetStatusDeletedAppSet(t *testing.T) {
+	existing := newRollingSyncAppSet(rolloutSteps, nil)
+	r := newRolloutReconciler(t, &existing)
+	deleted := newRollingSyncAppSet(rolloutSteps, nil)
+	deleted.Name = "deleted"
+
+	err := r.setApplicationSetRolloutCondition(context.TODO(), &deleted, argov1alpha1.ApplicationSetCondition{
+		Type:   argov1alpha1.ApplicationSetConditionRolloutProgressing,
+		Reason: argov1alpha1.ApplicationSetReasonApplicationSetRolloutComplete,
+		Status: argov1alpha1.ApplicationSetConditionStatusFalse,
+	})
+	assert.NoError(t, err)
+}
+
+func TestUpdateApplicationSetApplicationStatusWithoutRollout(t *testing.T) {
+	appSet := newRollingSyncAppSet(nil, nil)
+	appSet.Spec.Strategy = nil
+	r := newRolloutReconciler(t, &appSet)
+
+	syncing := newRolloutApp("app-syncing", "dev", health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeOutOfSync)
+	syncing.Status.OperationState = &argov1alpha1.OperationState{Phase: synccommon.OperationRunning}
+	apps := []argov1alpha1.Application{
+		newRolloutApp("app-healthy", "dev", health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeSynced),
+		newRolloutApp("app-outdated", "dev", health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeOutOfSync),
+		syncing,
+		newRolloutApp("app-degraded", "dev", health.HealthStatusDegraded, argov1alpha1.SyncStatusCodeSynced),
+	}
+
+	err := r.updateApplicationSetApplicationStatus(context.TODO(), &appSet, apps, map[string]int{})
+	assert.NoError(t, err)
+
+	expected := []argov1alpha1.ApplicationSetApplicationStatus{
+		{Application: "app-healthy", Status: argov1alpha1.ApplicationSetApplicationStatusHealthy, Health: argov1alpha1.HealthStatus{Status: health.HealthStatusHealthy}, Sync: argov1alpha1.SyncStatusCodeSynced},
+		{Application: "app-outdated", Status: argov1alpha1.ApplicationSetApplicationStatusWaiting, Health: argov1alpha1.HealthStatus{Status: health.HealthStatusHealthy}, Sync: argov1alpha1.SyncStatusCodeOutOfSync},
+		{Application: "app-syncing", Status: argov1alpha1.ApplicationSetApplicationStatusProgressing, Health: argov1alpha1.HealthStatus{Status: health.HealthStatusHealthy}, Sync: argov1alpha1.SyncStatusCodeOutOfSync},
+		{Application: "app-degraded", Status: argov1alpha1.ApplicationSetApplicationStatusWaiting, Health: argov1alpha1.HealthStatus{Status: health.HealthStatusDegraded}, Sync: argov1alpha1.SyncStatusCodeSynced},
+	}
+	assert.Len(t, appSet.Status.ApplicationStatus, len(expected))
+	for i, appStatus := range appSet.Status.ApplicationStatus {
+		assert.Equal(t, expected[i].Application, appStatus.Application)
+		assert.Equal(t, expected[i].Status, appStatus.Status, appStatus.Application)
+		assert.Equal(t, expected[i].Health, appStatus.Health, appStatus.Application)
+		assert.Equal(t, expected[i].Sync, appStatus.Sync, appStatus.Application)
+		assert.Empty(t, appStatus.Step, appStatus.Application)
+	}
+
+	// statuses of Applications which no longer exist are removed
+	err = r.updateApplicationSetApplicationStatus(context.TODO(), &appSet, apps[:1], map[string]int{})
+	assert.NoError(t, err)
+	assert.Len(t, appSet.Status.ApplicationStatus, 1)
+	assert.Equal(t, "app-healthy", appSet.Status.ApplicationStatus[0].Application)
+}
+
+func TestGenerateApplicationsTemplatePatch(t *testing.T) {
+	templatePatch := `
+spec:
+  {{- if eq .env "prod" }}
+  syncPolicy:
+    automated: {}
+  {{- end }}
+`
+	newAppSet := func(goTemplate bool, templatePatch string) argov1alpha1.ApplicationSet {
+		return argov1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"},
+			Spec: argov1alpha1.ApplicationSetSpec{
+				GoTemplate: goTemplate,
+				Generators: []argov1alpha1.ApplicationSetGenerator{{
+					List: &argov1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"cluster": "dev-cluster", "env": "dev"}`)},
+							{Raw: []byte(`{"cluster": "prod-cluster", "env": "prod"}`)},
+						},
+					},
+				}},
+				Template: argov1alpha1.ApplicationSetTemplate{
+					ApplicationSetTemplateMeta: argov1alpha1.ApplicationSetTemplateMeta{
+						Name:      "{{.cluster}}",
+						Namespace: "argocd",
+					},
+					Spec: argov1alpha1.ApplicationSpec{
+						Source:      argov1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+						Project:     "default",
+						Destination: argov1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc"},
+					},
+				},
+				TemplatePatch: &templatePatch,
+			},
+		}
+	}
+
+	r := ApplicationSetReconciler{
+		Renderer: &utils.Render{},
+		Generators: map[string]generators.Generator{
+			"List": generators.NewListGenerator(),
+		},
+	}
+
+	t.Run("patch is applied to each application", func(t *testing.T) {
+		apps, _, err := r.generateApplications(newAppSet(true, templatePatch))
+		assert.NoError(t, err)
+		assert.Len(t, apps, 2)
+		assert.Equal(t, "dev-cluster", apps[0].Name)
+		assert.Nil(t, apps[0].Spec.SyncPolicy)
+		assert.Equal(t, "prod-cluster", apps[1].Name)
+		assert.Equal(t, &argov1alpha1.SyncPolicy{Automated: &argov1alpha1.SyncPolicyAutomated{}}, apps[1].Spec.SyncPolicy)
+	})
+
+	t.Run("patch requires goTemplate", func(t *testing.T) {
+		_, reason, err := r.generateApplications(newAppSet(false, templatePatch))
+		assert.EqualError(t, err, "templatePatch requires goTemplate to be enabled")
+		assert.Equal(t, argov1alpha1.ApplicationSetReasonType(argov1alpha1.ApplicationSetReasonTemplatePatchError), reason)
+	})
+
+	t.Run("invalid patch", func(t *testing.T) {
+		_, reason, err := r.generateApplications(newAppSet(true, `spec: {{ .cluster`))
+		assert.ErrorContains(t, err, "error rendering template patch")
+		assert.Equal(t, argov1alpha1.ApplicationSetReasonType(argov1alpha1.ApplicationSetReasonTemplatePatchError), reason)
+	})
+}
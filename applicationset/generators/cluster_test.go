@@ -258,6 +258,52 @@ func TestGenerateParams(t *testing.T) {
 	}
 }
 
+func TestGenerateParamsIgnoresOtherNamespaces(t *testing.T) {
+	newClusterSecret := func(namespace string, server string) *corev1.Secret {
+		return &corev1.Secret{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Secret",
+				APIVersion: "v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "staging-01",
+				Namespace: namespace,
+				Labels: map[string]string{
+					"argocd.argoproj.io/secret-type": "cluster",
+					"environment":                    "staging",
+				},
+			},
+			Data: map[string][]byte{
+				"config": []byte("{}"),
+				"name":   []byte("staging-01"),
+				"server": []byte(server),
+			},
+			Type: corev1.SecretType("Opaque"),
+		}
+	}
+	cluster := newClusterSecret("namespace", "https://staging-01.example.com")
+	// a Secret created by a tenant in the namespace of its ApplicationSets, with the name of a real cluster
+	tenantSecret := newClusterSecret("tenant", "https://attacker.example.com")
+
+	appClientset := kubefake.NewSimpleClientset(cluster, tenantSecret)
+	fakeClient := fake.NewClientBuilder().WithObjects(cluster, tenantSecret).Build()
+	clusterGenerator := NewClusterGenerator(fakeClient, context.Background(), appClientset, "namespace")
+
+	got, err := clusterGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+		Clusters: &argoprojiov1alpha1.ClusterGenerator{
+			Selector: metav1.LabelSelector{MatchLabels: map[string]string{"environment": "staging"}},
+		},
+	}, &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "set", Namespace: "tenant"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{{
+		"name":                        "staging-01",
+		"nameNormalized":              "staging-01",
+		"server":                      "https://staging-01.example.com",
+		"metadata.labels.environment": "staging",
+		"metadata.labels.argocd.argoproj.io/secret-type": "cluster",
+	}}, got)
+}
+
 func TestGenerateParamsGoTemplate(t *testing.T) {
 	clusters := []client.Object{
 		&corev1.Secret{
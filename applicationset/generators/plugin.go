@@ -0,0 +1,212 @@
+package generators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jeremywohl/flatten"
+	gocache "github.com/patrickmn/go-cache"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/v2/applicationset/services/plugin"
+	"github.com/argoproj/argo-cd/v2/common"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// DefaultPluginRequeueAfterSeconds is the requeue delay used if the plugin generator does not set one
+	DefaultPluginRequeueAfterSeconds = 30 * time.Minute
+
+	pluginBaseURLKey        = "baseUrl"
+	pluginTokenKey          = "token"
+	pluginRequestTimeoutKey = "requestTimeout"
+)
+
+var _ Generator = (*PluginGenerator)(nil)
+
+// PluginGenerator generates parameters by calling an external plugin service, which is configured in a ConfigMap.
+type PluginGenerator struct {
+	ctx       context.Context
+	clientset kubernetes.Interface
+	namespace string // namespace is the Argo CD namespace
+	// cache holds the parameters returned by the plugins, so that they are not called on every reconciliation
+	cache *gocache.Cache
+}
+
+func NewPluginGenerator(ctx context.Context, clientset kubernetes.Interface, namespace string) Generator {
+	g := &PluginGenerator{
+		ctx:       ctx,
+		clientset: clientset,
+		namespace: namespace,
+		cache:     gocache.New(DefaultPluginRequeueAfterSeconds, time.Minute),
+	}
+	return g
+}
+
+func (g *PluginGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+
+	// Return a requeue default of 30 minutes, if no override is specified.
+
+	if appSetGenerator.Plugin.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.Plugin.RequeueAfterSeconds) * time.Second
+	}
+
+	return DefaultPluginRequeueAfterSeconds
+}
+
+func (g *PluginGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.Plugin.Template
+}
+
+func (g *PluginGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet) ([]map[string]interface{}, error) {
+
+	if appSetGenerator == nil {
+		return nil, EmptyAppSetGeneratorError
+	}
+
+	if appSetGenerator.Plugin == nil {
+		return nil, EmptyAppSetGeneratorError
+	}
+
+	providerConfig := appSetGenerator.Plugin
+
+	input, err := json.Marshal(providerConfig.Input)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling plugin input: %w", err)
+	}
+	cacheKey := fmt.Sprintf("%s/%s/%s/%s", providerConfig.ConfigMapRef.Name, appSet.Namespace, appSet.Name, string(input))
+
+	var objectsFound []map[string]interface{}
+	if cached, found := g.cache.Get(cacheKey); found && !appSet.RefreshRequired() {
+		objectsFound = cached.([]map[string]interface{})
+	} else {
+		pluginClient, err := g.getPluginFromGenerator(appSet.Name, providerConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		list, err := pluginClient.List(g.ctx, providerConfig.Input.Parameters)
+		if err != nil {
+			return nil, fmt.Errorf("error listing params from plugin %q: %w", providerConfig.ConfigMapRef.Name, err)
+		}
+		objectsFound = list.Output.Parameters
+		g.cache.Set(cacheKey, objectsFound, g.GetRequeueAfter(appSetGenerator))
+	}
+
+	res, err := g.generateParams(appSetGenerator, appSet, objectsFound)
+	if err != nil {
+		return nil, fmt.Errorf("error generating params from plugin %q: %w", providerConfig.ConfigMapRef.Name, err)
+	}
+
+	return res, nil
+}
+
+func (g *PluginGenerator) getPluginFromGenerator(appSetName string, generatorConfig *argoprojiov1alpha1.PluginGenerator) (*plugin.Service, error) {
+	cm, err := g.clientset.CoreV1().ConfigMaps(g.namespace).Get(g.ctx, generatorConfig.ConfigMapRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching ConfigMap %q: %w", generatorConfig.ConfigMapRef.Name, err)
+	}
+
+	token, err := g.getToken(cm.Data[pluginTokenKey])
+	if err != nil {
+		return nil, fmt.Errorf("error fetching token of plugin %q: %w", generatorConfig.ConfigMapRef.Name, err)
+	}
+
+	var requestTimeout time.Duration
+	if value, ok := cm.Data[pluginRequestTimeoutKey]; ok && value != "" {
+		seconds, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %s of plugin %q: %w", pluginRequestTimeoutKey, generatorConfig.ConfigMapRef.Name, err)
+		}
+		requestTimeout = time.Duration(seconds) * time.Second
+	}
+
+	pluginClient, err := plugin.NewPluginService(appSetName, cm.Data[pluginBaseURLKey], token, requestTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing plugin %q: %w", generatorConfig.ConfigMapRef.Name, err)
+	}
+	return pluginClient, nil
+}
+
+// getToken resolves a token reference from the plugin ConfigMap. "$key" refers to a key of the argocd-secret Secret,
+// while "$secret-name:key" refers to a key of another Secret in the Argo CD namespace.
+func (g *PluginGenerator) getToken(ref string) (string, error) {
+	if !strings.HasPrefix(ref, "$") {
+		return "", fmt.Errorf("token must reference a secret key with '$key' or '$secret-name:key'")
+	}
+
+	secretName := common.ArgoCDSecretName
+	key := strings.TrimPrefix(ref, "$")
+	if parts := strings.SplitN(key, ":", 2); len(parts) == 2 {
+		secretName, key = parts[0], parts[1]
+	}
+
+	secret, err := g.clientset.CoreV1().Secrets(g.namespace).Get(g.ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("error fetching secret %q: %w", secretName, err)
+	}
+	token, ok := secret.Data[key]
+	if !ok {
+		return "", fmt.Errorf("key %q not found in secret %q", key, secretName)
+	}
+	return string(token), nil
+}
+
+func (g *PluginGenerator) generateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, objectsFound []map[string]interface{}) ([]map[string]interface{}, error) {
+	res := []map[string]interface{}{}
+
+	inputParameters, err := appSetGenerator.Plugin.Input.Parameters.ToMap()
+	if err != nil {
+		return nil, err
+	}
+	// The input parameters are exposed to the template as generator.input.parameters
+	generatorParams := map[string]interface{}{
+		"input": map[string]interface{}{
+			"parameters": inputParameters,
+		},
+	}
+
+	for _, objectFound := range objectsFound {
+
+		params := map[string]interface{}{}
+
+		if appSet.Spec.GoTemplate {
+			for k, v := range objectFound {
+				params[k] = v
+			}
+			params["generator"] = generatorParams
+		} else {
+			flat, err := flatten.Flatten(objectFound, "", flatten.DotStyle)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range flat {
+				params[k] = fmt.Sprintf("%v", v)
+			}
+			flatInput, err := flatten.Flatten(map[string]interface{}{"generator": generatorParams}, "", flatten.DotStyle)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range flatInput {
+				params[k] = fmt.Sprintf("%v", v)
+			}
+		}
+
+		err := appendTemplatedValues(appSetGenerator.Plugin.Values, params, appSet)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, params)
+	}
+
+	log.WithField("appset", appSet.Name).Debugf("plugin %q returned %d parameter sets", appSetGenerator.Plugin.ConfigMapRef.Name, len(res))
+
+	return res, nil
+}
@@ -0,0 +1,289 @@
+package generators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestPluginGenerateParams(t *testing.T) {
+	testCases := []struct {
+		name            string
+		configmap       *corev1.ConfigMap
+		secret          *corev1.Secret
+		inputParameters map[string]apiextensionsv1.JSON
+		values          map[string]string
+		gotemplate      bool
+		content         []byte
+		expected        []map[string]interface{}
+		expectedError   string
+	}{
+		{
+			name: "simple case",
+			configmap: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "first-plugin-cm", Namespace: "default"},
+				Data: map[string]string{
+					"token": "$plugin.token",
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: "default"},
+				Data: map[string][]byte{
+					"plugin.token": []byte("my-secret"),
+				},
+			},
+			inputParameters: map[string]apiextensionsv1.JSON{
+				"pkey1": {Raw: []byte(`"val1"`)},
+				"pkey2": {Raw: []byte(`"val2"`)},
+			},
+			content: []byte(`{"output": {"parameters": [{"key1": "val1", "key2": {"key2_1": "val2_1", "key2_2": {"key2_2_1": "val2_2_1"}}, "key3": 123}]}}`),
+			expected: []map[string]interface{}{
+				{
+					"key1":                             "val1",
+					"key2.key2_1":                      "val2_1",
+					"key2.key2_2.key2_2_1":             "val2_2_1",
+					"key3":                             "123",
+					"generator.input.parameters.pkey1": "val1",
+					"generator.input.parameters.pkey2": "val2",
+				},
+			},
+		},
+		{
+			name: "simple case with values and a named secret",
+			configmap: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "first-plugin-cm", Namespace: "default"},
+				Data: map[string]string{
+					"token": "$plugin-secret:token",
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "plugin-secret", Namespace: "default"},
+				Data: map[string][]byte{
+					"token": []byte("my-secret"),
+				},
+			},
+			values: map[string]string{
+				"valuekey1": "valuevalue1",
+				"valuekey2": "templated-{{key1}}",
+			},
+			content: []byte(`{"output": {"parameters": [{"key1": "val1"}]}}`),
+			expected: []map[string]interface{}{
+				{
+					"key1":             "val1",
+					"values.valuekey1": "valuevalue1",
+					"values.valuekey2": "templated-val1",
+				},
+			},
+		},
+		{
+			name: "go text template",
+			configmap: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "first-plugin-cm", Namespace: "default"},
+				Data: map[string]string{
+					"token": "$plugin.token",
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: "default"},
+				Data: map[string][]byte{
+					"plugin.token": []byte("my-secret"),
+				},
+			},
+			inputParameters: map[string]apiextensionsv1.JSON{
+				"pkey1": {Raw: []byte(`"val1"`)},
+				"pkey2": {Raw: []byte(`{"pkey2_1": "val2_1"}`)},
+			},
+			values: map[string]string{
+				"valuekey1": "templated-{{.key1}}",
+			},
+			gotemplate: true,
+			content:    []byte(`{"output": {"parameters": [{"key1": "val1", "key2": {"key2_1": "val2_1"}}]}}`),
+			expected: []map[string]interface{}{
+				{
+					"key1": "val1",
+					"key2": map[string]interface{}{
+						"key2_1": "val2_1",
+					},
+					"generator": map[string]interface{}{
+						"input": map[string]interface{}{
+							"parameters": map[string]interface{}{
+								"pkey1": "val1",
+								"pkey2": map[string]interface{}{
+									"pkey2_1": "val2_1",
+								},
+							},
+						},
+					},
+					"values": map[string]string{
+						"valuekey1": "templated-val1",
+					},
+				},
+			},
+		},
+		{
+			name: "wrong token",
+			configmap: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "first-plugin-cm", Namespace: "default"},
+				Data: map[string]string{
+					"token": "$plugin.token",
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: "default"},
+				Data: map[string][]byte{
+					"plugin.token": []byte("wrong-secret"),
+				},
+			},
+			content:       []byte(`{"output": {"parameters": [{"key1": "val1"}]}}`),
+			expectedError: "error listing params from plugin \"first-plugin-cm\": plugin returned status 401: Unauthorized",
+		},
+		{
+			name: "token which does not reference a secret",
+			configmap: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "first-plugin-cm", Namespace: "default"},
+				Data: map[string]string{
+					"token": "my-secret",
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: "default"},
+			},
+			expectedError: "error fetching token of plugin \"first-plugin-cm\": token must reference a secret key with '$key' or '$secret-name:key'",
+		},
+		{
+			name: "missing configmap",
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: "default"},
+			},
+			expectedError: "error fetching ConfigMap \"first-plugin-cm\": configmaps \"first-plugin-cm\" not found",
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, testCase := range testCases {
+		testCaseCopy := testCase
+
+		t.Run(testCaseCopy.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != "Bearer my-secret" {
+					http.Error(w, "Unauthorized", http.StatusUnauthorized)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, err := w.Write(testCaseCopy.content)
+				if err != nil {
+					assert.NoError(t, fmt.Errorf("Error Write %v", err))
+				}
+			}))
+			defer ts.Close()
+
+			objects := []runtime.Object{testCaseCopy.secret}
+			if testCaseCopy.configmap != nil {
+				testCaseCopy.configmap.Data["baseUrl"] = ts.URL
+				objects = append(objects, testCaseCopy.configmap)
+			}
+			fakeClient := kubefake.NewSimpleClientset(objects...)
+
+			pluginGenerator := NewPluginGenerator(ctx, fakeClient, "default")
+
+			applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "set",
+				},
+				Spec: argoprojiov1alpha1.ApplicationSetSpec{
+					GoTemplate: testCaseCopy.gotemplate,
+				},
+			}
+
+			got, err := pluginGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+				Plugin: &argoprojiov1alpha1.PluginGenerator{
+					ConfigMapRef: argoprojiov1alpha1.PluginConfigMapRef{
+						Name: "first-plugin-cm",
+					},
+					Input: argoprojiov1alpha1.PluginInput{
+						Parameters: testCaseCopy.inputParameters,
+					},
+					Values: testCaseCopy.values,
+				},
+			}, &applicationSetInfo)
+
+			if testCaseCopy.expectedError != "" {
+				assert.EqualError(t, err, testCaseCopy.expectedError)
+			} else {
+				assert.NoError(t, err)
+				expectedJson, err := json.Marshal(testCaseCopy.expected)
+				assert.NoError(t, err)
+				gotJson, err := json.Marshal(got)
+				assert.NoError(t, err)
+				assert.JSONEq(t, string(expectedJson), string(gotJson))
+			}
+		})
+	}
+}
+
+func TestPluginGenerateParamsCache(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"output": {"parameters": [{"key1": "val1"}]}}`))
+	}))
+	defer ts.Close()
+
+	fakeClient := kubefake.NewSimpleClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "plugin-cm", Namespace: "default"},
+			Data:       map[string]string{"baseUrl": ts.URL, "token": "$plugin.token"},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: "default"},
+			Data:       map[string][]byte{"plugin.token": []byte("my-secret")},
+		},
+	)
+	pluginGenerator := NewPluginGenerator(context.Background(), fakeClient, "default")
+
+	appSetGenerator := &argoprojiov1alpha1.ApplicationSetGenerator{
+		Plugin: &argoprojiov1alpha1.PluginGenerator{
+			ConfigMapRef: argoprojiov1alpha1.PluginConfigMapRef{Name: "plugin-cm"},
+		},
+	}
+	appSet := &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "set"}}
+
+	for i := 0; i < 2; i++ {
+		_, err := pluginGenerator.GenerateParams(appSetGenerator, appSet)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, calls)
+
+	// A refresh of the ApplicationSet bypasses the cache
+	appSet.Annotations = map[string]string{"argocd.argoproj.io/application-set-refresh": "true"}
+	_, err := pluginGenerator.GenerateParams(appSetGenerator, appSet)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestPluginGetRequeueAfter(t *testing.T) {
+	pluginGenerator := NewPluginGenerator(context.Background(), kubefake.NewSimpleClientset(), "default")
+
+	requeueAfterSeconds := int64(60)
+	assert.Equal(t, DefaultPluginRequeueAfterSeconds, pluginGenerator.GetRequeueAfter(&argoprojiov1alpha1.ApplicationSetGenerator{
+		Plugin: &argoprojiov1alpha1.PluginGenerator{},
+	}))
+	assert.Equal(t, 60*time.Second, pluginGenerator.GetRequeueAfter(&argoprojiov1alpha1.ApplicationSetGenerator{
+		Plugin: &argoprojiov1alpha1.PluginGenerator{RequeueAfterSeconds: &requeueAfterSeconds},
+	}))
+}
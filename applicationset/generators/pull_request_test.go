@@ -27,9 +27,14 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 					ctx,
 					[]*pullrequest.PullRequest{
 						&pullrequest.PullRequest{
-							Number:  1,
-							Branch:  "branch1",
-							HeadSHA: "089d92cbf9ff857a39e6feccd32798ca700fb958",
+							Number:       1,
+							Branch:       "branch1",
+							HeadSHA:      "089d92cbf9ff857a39e6feccd32798ca700fb958",
+							TargetBranch: "release/v1.2",
+							Title:        "Add a new feature!",
+							Author:       "octocat",
+							Labels:       []string{"preview"},
+							Draft:        true,
 						},
 					},
 					nil,
@@ -37,11 +42,18 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 			},
 			expected: []map[string]interface{}{
 				{
-					"number":         "1",
-					"branch":         "branch1",
-					"branch_slug":    "branch1",
-					"head_sha":       "089d92cbf9ff857a39e6feccd32798ca700fb958",
-					"head_short_sha": "089d92cb",
+					"number":             "1",
+					"branch":             "branch1",
+					"branch_slug":        "branch1",
+					"target_branch":      "release/v1.2",
+					"target_branch_slug": "release-v1-2",
+					"head_sha":           "089d92cbf9ff857a39e6feccd32798ca700fb958",
+					"head_short_sha":     "089d92cb",
+					"head_short_sha_7":   "089d92c",
+					"title":              "Add a new feature!",
+					"title_slug":         "add-a-new-feature",
+					"author":             "octocat",
+					"draft":              "true",
 				},
 			},
 			expectedErr: nil,
@@ -62,11 +74,18 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 			},
 			expected: []map[string]interface{}{
 				{
-					"number":         "2",
-					"branch":         "feat/areally+long_pull_request_name_to_test_argo_slugification_and_branch_name_shortening_feature",
-					"branch_slug":    "feat-areally-long-pull-request-name-to-test-argo",
-					"head_sha":       "9b34ff5bd418e57d58891eb0aa0728043ca1e8be",
-					"head_short_sha": "9b34ff5b",
+					"number":             "2",
+					"branch":             "feat/areally+long_pull_request_name_to_test_argo_slugification_and_branch_name_shortening_feature",
+					"branch_slug":        "feat-areally-long-pull-request-name-to-test-argo",
+					"target_branch":      "",
+					"target_branch_slug": "",
+					"head_sha":           "9b34ff5bd418e57d58891eb0aa0728043ca1e8be",
+					"head_short_sha":     "9b34ff5b",
+					"head_short_sha_7":   "9b34ff5",
+					"title":              "",
+					"title_slug":         "",
+					"author":             "",
+					"draft":              "false",
 				},
 			},
 			expectedErr: nil,
@@ -87,11 +106,18 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 			},
 			expected: []map[string]interface{}{
 				{
-					"number":         "1",
-					"branch":         "a-very-short-sha",
-					"branch_slug":    "a-very-short-sha",
-					"head_sha":       "abcd",
-					"head_short_sha": "abcd",
+					"number":             "1",
+					"branch":             "a-very-short-sha",
+					"branch_slug":        "a-very-short-sha",
+					"target_branch":      "",
+					"target_branch_slug": "",
+					"head_sha":           "abcd",
+					"head_short_sha":     "abcd",
+					"head_short_sha_7":   "abcd",
+					"title":              "",
+					"title_slug":         "",
+					"author":             "",
+					"draft":              "false",
 				},
 			},
 			expectedErr: nil,
@@ -123,6 +149,48 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 	}
 }
 
+func TestPullRequestGenerateParamsGoTemplateLabels(t *testing.T) {
+	ctx := context.Background()
+	gen := PullRequestGenerator{
+		selectServiceProviderFunc: func(context.Context, *argoprojiov1alpha1.PullRequestGenerator, *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error) {
+			return pullrequest.NewFakeService(
+				ctx,
+				[]*pullrequest.PullRequest{
+					{
+						Number:  1,
+						Branch:  "branch1",
+						HeadSHA: "089d92cbf9ff857a39e6feccd32798ca700fb958",
+						Labels:  []string{"preview", "team-a"},
+					},
+					{
+						Number:  2,
+						Branch:  "branch2",
+						HeadSHA: "9b34ff5bd418e57d58891eb0aa0728043ca1e8be",
+					},
+				},
+				nil,
+			)
+		},
+	}
+	generatorConfig := argoprojiov1alpha1.ApplicationSetGenerator{
+		PullRequest: &argoprojiov1alpha1.PullRequestGenerator{},
+	}
+	appSet := &argoprojiov1alpha1.ApplicationSet{
+		Spec: argoprojiov1alpha1.ApplicationSetSpec{GoTemplate: true},
+	}
+
+	got, err := gen.GenerateParams(&generatorConfig, appSet)
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, []string{"preview", "team-a"}, got[0]["labels"])
+	assert.Equal(t, []string{}, got[1]["labels"])
+
+	// Labels are not available without the Go template
+	got, err = gen.GenerateParams(&generatorConfig, &argoprojiov1alpha1.ApplicationSet{})
+	assert.NoError(t, err)
+	assert.NotContains(t, got[0], "labels")
+}
+
 func TestPullRequestGetSecretRef(t *testing.T) {
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{Name: "test-secret", Namespace: "test"},
@@ -0,0 +1,102 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// getParamsPath is the path of the endpoint which plugins must implement, relative to their base URL
+	getParamsPath = "/api/v1/getparams.execute"
+	// DefaultRequestTimeout is used if the plugin ConfigMap does not set requestTimeout
+	DefaultRequestTimeout = 30 * time.Second
+	// maxErrorBodySize limits how much of an error response is included in the returned error
+	maxErrorBodySize = 1024
+)
+
+// ServiceRequest is the body of the request sent to the plugin
+type ServiceRequest struct {
+	ApplicationSetName string                         `json:"applicationSetName"`
+	Input              argoprojiov1alpha1.PluginInput `json:"input"`
+}
+
+// Output contains the parameters returned by the plugin
+type Output struct {
+	Parameters []map[string]interface{} `json:"parameters"`
+}
+
+// ServiceResponse is the body of the response expected from the plugin
+type ServiceResponse struct {
+	Output Output `json:"output"`
+}
+
+// Service calls the getparams endpoint of a plugin
+type Service struct {
+	client     *http.Client
+	baseURL    string
+	token      string
+	appSetName string
+}
+
+// NewPluginService returns a Service for the plugin listening on baseURL, which authenticates with token
+func NewPluginService(appSetName string, baseURL string, token string, requestTimeout time.Duration) (*Service, error) {
+	if baseURL == "" {
+		return nil, fmt.Errorf("plugin baseUrl is empty")
+	}
+	if requestTimeout <= 0 {
+		requestTimeout = DefaultRequestTimeout
+	}
+
+	return &Service{
+		client:     &http.Client{Timeout: requestTimeout},
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		token:      token,
+		appSetName: appSetName,
+	}, nil
+}
+
+// List sends the parameters to the plugin, and returns its response
+func (p *Service) List(ctx context.Context, parameters argoprojiov1alpha1.PluginParameters) (*ServiceResponse, error) {
+	body, err := json.Marshal(ServiceRequest{
+		ApplicationSetName: p.appSetName,
+		Input: argoprojiov1alpha1.PluginInput{
+			Parameters: parameters,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling plugin request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+getParamsPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error creating plugin request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+p.token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error calling plugin: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return nil, fmt.Errorf("plugin returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+
+	var data ServiceResponse
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, fmt.Errorf("error decoding plugin response: %w", err)
+	}
+
+	return &data, nil
+}
@@ -0,0 +1,64 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestPlugin(t *testing.T) {
+	expectedToken := "d2483a7d-b0c0-4a0a-83c7-9bc4e4b9d0b4"
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/getparams.execute", r.URL.Path)
+
+		if r.Header.Get("Authorization") != "Bearer "+expectedToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = io.WriteString(w, "invalid token")
+			return
+		}
+
+		var request ServiceRequest
+		err := json.NewDecoder(r.Body).Decode(&request)
+		assert.NoError(t, err)
+		assert.Equal(t, "my-appset", request.ApplicationSetName)
+		assert.Equal(t, `"bar"`, string(request.Input.Parameters["foo"].Raw))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"output": {"parameters": [{"number": 123, "nested": {"key": "value"}}]}}`)
+	}))
+	defer ts.Close()
+
+	parameters := argoprojiov1alpha1.PluginParameters{"foo": apiextensionsv1.JSON{Raw: []byte(`"bar"`)}}
+
+	t.Run("valid token", func(t *testing.T) {
+		client, err := NewPluginService("my-appset", ts.URL+"/", expectedToken, 0)
+		assert.NoError(t, err)
+
+		data, err := client.List(context.Background(), parameters)
+		assert.NoError(t, err)
+		assert.Equal(t, []map[string]interface{}{{"number": float64(123), "nested": map[string]interface{}{"key": "value"}}}, data.Output.Parameters)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		client, err := NewPluginService("my-appset", ts.URL, "wrong-token", 0)
+		assert.NoError(t, err)
+
+		_, err = client.List(context.Background(), parameters)
+		assert.EqualError(t, err, "plugin returned status 401: invalid token")
+	})
+
+	t.Run("empty base URL", func(t *testing.T) {
+		_, err := NewPluginService("my-appset", "", expectedToken, 0)
+		assert.Error(t, err)
+	})
+}
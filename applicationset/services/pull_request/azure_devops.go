@@ -0,0 +1,174 @@
+package pull_request
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+)
+
+const (
+	AZURE_DEVOPS_DEFAULT_URL = "https://dev.azure.com"
+	// azureDevOpsPageSize is the number of pull requests requested at once
+	azureDevOpsPageSize = 100
+)
+
+type AzureDevOpsClientFactory interface {
+	// Returns an Azure Devops Client interface.
+	GetClient(ctx context.Context) (git.Client, error)
+}
+
+type devopsFactoryImpl struct {
+	connection *azuredevops.Connection
+}
+
+func (factory *devopsFactoryImpl) GetClient(ctx context.Context) (git.Client, error) {
+	gitClient, err := git.NewClient(ctx, factory.connection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get new Azure DevOps git client for pull request generator: %w", err)
+	}
+	return gitClient, nil
+}
+
+type AzureDevOpsService struct {
+	clientFactory AzureDevOpsClientFactory
+	project       string
+	repo          string
+	labels        []string
+}
+
+var _ PullRequestService = (*AzureDevOpsService)(nil)
+var _ AzureDevOpsClientFactory = &devopsFactoryImpl{}
+
+func NewAzureDevOpsService(ctx context.Context, token, url, organization, project, repo string, labels []string) (PullRequestService, error) {
+	organizationUrl := buildURL(url, organization)
+
+	var connection *azuredevops.Connection
+	if token == "" {
+		connection = azuredevops.NewAnonymousConnection(organizationUrl)
+	} else {
+		connection = azuredevops.NewPatConnection(organizationUrl, token)
+	}
+
+	return &AzureDevOpsService{
+		clientFactory: &devopsFactoryImpl{connection: connection},
+		project:       project,
+		repo:          repo,
+		labels:        labels,
+	}, nil
+}
+
+func (a *AzureDevOpsService) List(ctx context.Context) ([]*PullRequest, error) {
+	client, err := a.clientFactory.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Azure DevOps client: %w", err)
+	}
+
+	pullRequests := []*PullRequest{}
+	for skip := 0; ; skip += azureDevOpsPageSize {
+		args := git.GetPullRequestsArgs{
+			Project:      &a.project,
+			RepositoryId: &a.repo,
+			SearchCriteria: &git.GitPullRequestSearchCriteria{
+				Status: &git.PullRequestStatusValues.Active,
+			},
+			Skip: intPtr(skip),
+			Top:  intPtr(azureDevOpsPageSize),
+		}
+
+		azurePullRequests, err := client.GetPullRequests(ctx, args)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pull requests for %s/%s: %w", a.project, a.repo, err)
+		}
+		if azurePullRequests == nil {
+			break
+		}
+
+		for _, pr := range *azurePullRequests {
+			if pr.PullRequestId == nil || pr.SourceRefName == nil || pr.LastMergeSourceCommit == nil || pr.LastMergeSourceCommit.CommitId == nil {
+				continue
+			}
+
+			azureDevOpsLabels := convertLabels(pr.Labels)
+			if !containAzureDevOpsLabels(a.labels, azureDevOpsLabels) {
+				continue
+			}
+
+			pullRequest := &PullRequest{
+				Number:  *pr.PullRequestId,
+				Branch:  strings.TrimPrefix(*pr.SourceRefName, "refs/heads/"),
+				HeadSHA: *pr.LastMergeSourceCommit.CommitId,
+				Labels:  azureDevOpsLabels,
+			}
+			if pr.TargetRefName != nil {
+				pullRequest.TargetBranch = strings.TrimPrefix(*pr.TargetRefName, "refs/heads/")
+			}
+			if pr.Title != nil {
+				pullRequest.Title = *pr.Title
+			}
+			if pr.CreatedBy != nil && pr.CreatedBy.UniqueName != nil {
+				pullRequest.Author = *pr.CreatedBy.UniqueName
+			}
+			if pr.IsDraft != nil {
+				pullRequest.Draft = *pr.IsDraft
+			}
+			pullRequests = append(pullRequests, pullRequest)
+		}
+
+		if len(*azurePullRequests) < azureDevOpsPageSize {
+			break
+		}
+	}
+
+	return pullRequests, nil
+}
+
+// convertLabels converts WebApiTagDefinitions to strings
+func convertLabels(tags *[]core.WebApiTagDefinition) []string {
+	if tags == nil {
+		return []string{}
+	}
+	labelStrings := make([]string, len(*tags))
+	for i, label := range *tags {
+		if label.Name != nil {
+			labelStrings[i] = *label.Name
+		}
+	}
+	return labelStrings
+}
+
+// containAzureDevOpsLabels returns true if gotLabels contains expectedLabels
+func containAzureDevOpsLabels(expectedLabels []string, gotLabels []string) bool {
+	for _, expected := range expectedLabels {
+		found := false
+		for _, got := range gotLabels {
+			if expected == got {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func buildURL(url, organization string) string {
+	if url == "" {
+		url = AZURE_DEVOPS_DEFAULT_URL
+	}
+	separator := ""
+	if !strings.HasSuffix(url, "/") {
+		separator = "/"
+	}
+	devOpsURL := fmt.Sprintf("%s%s%s", url, separator, organization)
+	return devOpsURL
+}
+
+func intPtr(i int) *int {
+	return &i
+}
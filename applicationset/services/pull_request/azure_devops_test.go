@@ -0,0 +1,173 @@
+package pull_request
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	git "github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/webapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	azureMock "github.com/argoproj/argo-cd/v2/applicationset/services/scm_provider/azure_devops/git/mocks"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+type AzureClientFactoryMock struct {
+	mock *mock.Mock
+}
+
+func (m *AzureClientFactoryMock) GetClient(ctx context.Context) (git.Client, error) {
+	args := m.mock.Called(ctx)
+
+	var client git.Client
+	c := args.Get(0)
+	if c != nil {
+		client = c.(git.Client)
+	}
+
+	var err error
+	if len(args) > 1 {
+		if e, ok := args.Get(1).(error); ok {
+			err = e
+		}
+	}
+
+	return client, err
+}
+
+func newAzurePullRequest(id int, branch, sha string, labels ...string) git.GitPullRequest {
+	tags := []core.WebApiTagDefinition{}
+	for i := range labels {
+		tags = append(tags, core.WebApiTagDefinition{Name: &labels[i]})
+	}
+	sourceRefName := "refs/heads/" + branch
+	return git.GitPullRequest{
+		PullRequestId: &id,
+		SourceRefName: &sourceRefName,
+		LastMergeSourceCommit: &git.GitCommitRef{
+			CommitId: &sha,
+		},
+		Labels: &tags,
+	}
+}
+
+func newAzureDevOpsServiceWithClient(gitClient git.Client, labels []string) *AzureDevOpsService {
+	clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+	clientFactoryMock.mock.On("GetClient", mock.Anything).Return(gitClient, nil)
+	return &AzureDevOpsService{
+		clientFactory: clientFactoryMock,
+		project:       "myproject",
+		repo:          "myrepo",
+		labels:        labels,
+	}
+}
+
+func TestListAzureDevOpsPullRequests(t *testing.T) {
+	ctx := context.Background()
+
+	pullRequestMock := []git.GitPullRequest{
+		newAzurePullRequest(1, "feature-1", "cd4973d9d14a08ffe6b641a89a68891d6aac8056", "label1", "label2"),
+		newAzurePullRequest(2, "feature/2", "7b2c3e2a5b4ce5d8f62f2a0dac3b0c7b0c7c1a37", "label1"),
+	}
+
+	gitClientMock := &azureMock.Client{}
+	gitClientMock.On("GetPullRequests", ctx, mock.MatchedBy(func(args git.GetPullRequestsArgs) bool {
+		return *args.Project == "myproject" && *args.RepositoryId == "myrepo" &&
+			*args.SearchCriteria.Status == git.PullRequestStatusValues.Active && *args.Skip == 0
+	})).Return(&pullRequestMock, nil)
+
+	provider := newAzureDevOpsServiceWithClient(gitClientMock, []string{})
+	list, err := provider.List(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []*PullRequest{
+		{Number: 1, Branch: "feature-1", HeadSHA: "cd4973d9d14a08ffe6b641a89a68891d6aac8056", Labels: []string{"label1", "label2"}},
+		{Number: 2, Branch: "feature/2", HeadSHA: "7b2c3e2a5b4ce5d8f62f2a0dac3b0c7b0c7c1a37", Labels: []string{"label1"}},
+	}, list)
+
+	provider = newAzureDevOpsServiceWithClient(gitClientMock, []string{"label2"})
+	list, err = provider.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.Equal(t, 1, list[0].Number)
+
+	title, targetRefName, author, isDraft := "Add feature 3", "refs/heads/release/v1", "user@example.com", true
+	pullRequestWithMetadata := newAzurePullRequest(3, "feature-3", "4bb3d4f4a4d8f5f9c3d2a4a8e6f5c4b3a2d1e0f9")
+	pullRequestWithMetadata.Title = &title
+	pullRequestWithMetadata.TargetRefName = &targetRefName
+	pullRequestWithMetadata.CreatedBy = &webapi.IdentityRef{UniqueName: &author}
+	pullRequestWithMetadata.IsDraft = &isDraft
+	metadataClientMock := &azureMock.Client{}
+	metadataClientMock.On("GetPullRequests", ctx, mock.Anything).Return(&[]git.GitPullRequest{pullRequestWithMetadata}, nil)
+	list, err = newAzureDevOpsServiceWithClient(metadataClientMock, []string{}).List(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []*PullRequest{{
+		Number:       3,
+		Branch:       "feature-3",
+		HeadSHA:      "4bb3d4f4a4d8f5f9c3d2a4a8e6f5c4b3a2d1e0f9",
+		TargetBranch: "release/v1",
+		Title:        "Add feature 3",
+		Author:       "user@example.com",
+		Labels:       []string{},
+		Draft:        true,
+	}}, list)
+
+	regexp := `feature/.*`
+	list, err = ListPullRequests(ctx, newAzureDevOpsServiceWithClient(gitClientMock, []string{}), []v1alpha1.PullRequestGeneratorFilter{{BranchMatch: &regexp}})
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.Equal(t, 2, list[0].Number)
+}
+
+func TestListAzureDevOpsPullRequestsPagination(t *testing.T) {
+	ctx := context.Background()
+
+	firstPage := []git.GitPullRequest{}
+	for i := 0; i < azureDevOpsPageSize; i++ {
+		firstPage = append(firstPage, newAzurePullRequest(i, fmt.Sprintf("feature-%d", i), "cd4973d9d14a08ffe6b641a89a68891d6aac8056"))
+	}
+	secondPage := []git.GitPullRequest{
+		newAzurePullRequest(azureDevOpsPageSize, "last-feature", "7b2c3e2a5b4ce5d8f62f2a0dac3b0c7b0c7c1a37"),
+	}
+
+	gitClientMock := &azureMock.Client{}
+	gitClientMock.On("GetPullRequests", ctx, mock.MatchedBy(func(args git.GetPullRequestsArgs) bool {
+		return *args.Skip == 0
+	})).Return(&firstPage, nil)
+	gitClientMock.On("GetPullRequests", ctx, mock.MatchedBy(func(args git.GetPullRequestsArgs) bool {
+		return *args.Skip == azureDevOpsPageSize
+	})).Return(&secondPage, nil)
+
+	list, err := newAzureDevOpsServiceWithClient(gitClientMock, []string{}).List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, list, azureDevOpsPageSize+1)
+	assert.Equal(t, "last-feature", list[azureDevOpsPageSize].Branch)
+	gitClientMock.AssertNumberOfCalls(t, "GetPullRequests", 2)
+}
+
+func TestListAzureDevOpsPullRequestsErrors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("client factory error", func(t *testing.T) {
+		clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+		clientFactoryMock.mock.On("GetClient", mock.Anything).Return(nil, fmt.Errorf("client factory error"))
+		provider := &AzureDevOpsService{clientFactory: clientFactoryMock, project: "myproject", repo: "myrepo"}
+		_, err := provider.List(ctx)
+		assert.EqualError(t, err, "failed to get Azure DevOps client: client factory error")
+	})
+
+	t.Run("API error", func(t *testing.T) {
+		gitClientMock := &azureMock.Client{}
+		gitClientMock.On("GetPullRequests", ctx, mock.Anything).Return(nil, fmt.Errorf("API error"))
+		_, err := newAzureDevOpsServiceWithClient(gitClientMock, []string{}).List(ctx)
+		assert.EqualError(t, err, "failed to get pull requests for myproject/myrepo: API error")
+	})
+}
+
+func TestBuildURL(t *testing.T) {
+	assert.Equal(t, "https://dev.azure.com/myorg", buildURL("", "myorg"))
+	assert.Equal(t, "https://azure.example.com/myorg", buildURL("https://azure.example.com", "myorg"))
+	assert.Equal(t, "https://azure.example.com/myorg", buildURL("https://azure.example.com/", "myorg"))
+}
@@ -0,0 +1,172 @@
+package pull_request
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const defaultBitbucketCloudAPIURL = "https://api.bitbucket.org/2.0"
+
+// BitbucketCloudService lists the pull requests of a Bitbucket Cloud repository with the 2.0 REST API.
+// The pages are followed with the "next" links of the responses, see
+// https://developer.atlassian.com/cloud/bitbucket/rest/intro/#pagination
+type BitbucketCloudService struct {
+	client         *http.Client
+	baseURL        string
+	authenticate   func(req *http.Request)
+	owner          string
+	repositorySlug string
+}
+
+// BitbucketCloudPullRequestList is a page of Bitbucket Cloud pull requests.
+type BitbucketCloudPullRequestList struct {
+	Values []BitbucketCloudPullRequest `json:"values"`
+	Next   string                      `json:"next"`
+}
+
+// BitbucketCloudPullRequest is the subset of a Bitbucket Cloud pull request which is used by the generator.
+// See https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-get
+type BitbucketCloudPullRequest struct {
+	ID          int                                  `json:"id"`
+	Title       string                               `json:"title"`
+	Draft       bool                                 `json:"draft"`
+	Source      BitbucketCloudPullRequestSource      `json:"source"`
+	Destination BitbucketCloudPullRequestDestination `json:"destination"`
+	Author      BitbucketCloudPullRequestAuthor      `json:"author"`
+}
+
+type BitbucketCloudPullRequestSource struct {
+	Branch BitbucketCloudPullRequestSourceBranch `json:"branch"`
+	Commit BitbucketCloudPullRequestSourceCommit `json:"commit"`
+}
+
+type BitbucketCloudPullRequestDestination struct {
+	Branch BitbucketCloudPullRequestSourceBranch `json:"branch"`
+}
+
+type BitbucketCloudPullRequestAuthor struct {
+	Nickname string `json:"nickname"`
+}
+
+type BitbucketCloudPullRequestSourceBranch struct {
+	Name string `json:"name"`
+}
+
+type BitbucketCloudPullRequestSourceCommit struct {
+	Hash string `json:"hash"`
+}
+
+var _ PullRequestService = (*BitbucketCloudService)(nil)
+
+func NewBitbucketCloudServiceBasicAuth(baseURL, username, password, owner, repositorySlug string) (PullRequestService, error) {
+	return newBitbucketCloudService(baseURL, func(req *http.Request) {
+		req.SetBasicAuth(username, password)
+	}, owner, repositorySlug)
+}
+
+func NewBitbucketCloudServiceBearerToken(baseURL, bearerToken, owner, repositorySlug string) (PullRequestService, error) {
+	return newBitbucketCloudService(baseURL, func(req *http.Request) {
+		req.Header.Set("Authorization", "Bearer "+bearerToken)
+	}, owner, repositorySlug)
+}
+
+func NewBitbucketCloudServiceNoAuth(baseURL, owner, repositorySlug string) (PullRequestService, error) {
+	return newBitbucketCloudService(baseURL, func(req *http.Request) {}, owner, repositorySlug)
+}
+
+func newBitbucketCloudService(baseURL string, authenticate func(req *http.Request), owner, repositorySlug string) (PullRequestService, error) {
+	if baseURL == "" {
+		baseURL = defaultBitbucketCloudAPIURL
+	}
+	if _, err := url.ParseRequestURI(baseURL); err != nil {
+		return nil, fmt.Errorf("error parsing base url of %s for %s/%s: %v", baseURL, owner, repositorySlug, err)
+	}
+
+	return &BitbucketCloudService{
+		client:         &http.Client{},
+		baseURL:        strings.TrimSuffix(baseURL, "/"),
+		authenticate:   authenticate,
+		owner:          owner,
+		repositorySlug: repositorySlug,
+	}, nil
+}
+
+func (b *BitbucketCloudService) List(ctx context.Context) ([]*PullRequest, error) {
+	// Only the open pull requests are returned by default, but be explicit about it
+	pageURL := fmt.Sprintf("%s/repositories/%s/%s/pullrequests?state=OPEN", b.baseURL, url.PathEscape(b.owner), url.PathEscape(b.repositorySlug))
+
+	pullRequests := []*PullRequest{}
+	for pageURL != "" {
+		page, err := b.getPage(ctx, pageURL)
+		if err != nil {
+			return nil, fmt.Errorf("error listing pull requests for %s/%s: %v", b.owner, b.repositorySlug, err)
+		}
+
+		for _, pull := range page.Values {
+			pullRequests = append(pullRequests, &PullRequest{
+				Number:       pull.ID,
+				Branch:       pull.Source.Branch.Name,
+				HeadSHA:      pull.Source.Commit.Hash,
+				TargetBranch: pull.Destination.Branch.Name,
+				Title:        pull.Title,
+				Author:       pull.Author.Nickname,
+				Draft:        pull.Draft,
+			})
+		}
+		if page.Next != "" {
+			// the credentials are sent along with every page request, so the next page must be on the same server
+			if err := b.checkPageURL(page.Next); err != nil {
+				return nil, fmt.Errorf("error listing pull requests for %s/%s: %v", b.owner, b.repositorySlug, err)
+			}
+		}
+		pageURL = page.Next
+	}
+	return pullRequests, nil
+}
+
+// checkPageURL checks that a page URL has the same scheme and host as the base URL
+func (b *BitbucketCloudService) checkPageURL(pageURL string) error {
+	baseURL, err := url.Parse(b.baseURL)
+	if err != nil {
+		return fmt.Errorf("error parsing base url %s: %v", b.baseURL, err)
+	}
+	parsedURL, err := url.Parse(pageURL)
+	if err != nil {
+		return fmt.Errorf("error parsing next page url %s: %v", pageURL, err)
+	}
+	if parsedURL.Scheme != baseURL.Scheme || parsedURL.Host != baseURL.Host {
+		return fmt.Errorf("next page url %s does not match the base url %s", pageURL, b.baseURL)
+	}
+	return nil
+}
+
+func (b *BitbucketCloudService) getPage(ctx context.Context, pageURL string) (*BitbucketCloudPullRequestList, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	b.authenticate(req)
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(message)))
+	}
+
+	var page BitbucketCloudPullRequestList
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, fmt.Errorf("error parsing pull request response: %v", err)
+	}
+	return &page, nil
+}
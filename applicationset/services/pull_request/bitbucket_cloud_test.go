@@ -0,0 +1,234 @@
+package pull_request
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func defaultHandlerCloud(t *testing.T) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var err error
+		switch r.RequestURI {
+		case "/repositories/OWNER/REPO/pullrequests?state=OPEN":
+			_, err = io.WriteString(w, `{
+				"size": 1,
+				"pagelen": 10,
+				"page": 1,
+				"values": [
+					{
+						"id": 101,
+						"title": "feat(foo-bar)",
+						"state": "OPEN",
+						"draft": false,
+						"author": {
+							"type": "user",
+							"display_name": "Gandalf the Grey",
+							"nickname": "Gandalf"
+						},
+						"source": {
+							"branch": {
+								"name": "feature/foo-bar"
+							},
+							"commit": {
+								"type": "commit",
+								"hash": "1a8dd249c04a"
+							}
+						},
+						"destination": {
+							"branch": {
+								"name": "main"
+							}
+						}
+					}
+				]
+			}`)
+		default:
+			t.Fail()
+		}
+		if err != nil {
+			t.Fail()
+		}
+	}
+}
+
+func TestListPullRequestNoAuthCloud(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		defaultHandlerCloud(t)(w, r)
+	}))
+	defer ts.Close()
+	svc, err := NewBitbucketCloudServiceNoAuth(ts.URL, "OWNER", "REPO")
+	assert.NoError(t, err)
+	pullRequests, err := ListPullRequests(context.Background(), svc, []v1alpha1.PullRequestGeneratorFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(pullRequests))
+	assert.Equal(t, 101, pullRequests[0].Number)
+	assert.Equal(t, "feature/foo-bar", pullRequests[0].Branch)
+	assert.Equal(t, "1a8dd249c04a", pullRequests[0].HeadSHA)
+	assert.Equal(t, "main", pullRequests[0].TargetBranch)
+	assert.Equal(t, "feat(foo-bar)", pullRequests[0].Title)
+	assert.Equal(t, "Gandalf", pullRequests[0].Author)
+	assert.False(t, pullRequests[0].Draft)
+}
+
+func TestListPullRequestBasicAuthCloud(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// base64(user:password)
+		assert.Equal(t, "Basic dXNlcjpwYXNzd29yZA==", r.Header.Get("Authorization"))
+		defaultHandlerCloud(t)(w, r)
+	}))
+	defer ts.Close()
+	svc, err := NewBitbucketCloudServiceBasicAuth(ts.URL, "user", "password", "OWNER", "REPO")
+	assert.NoError(t, err)
+	pullRequests, err := ListPullRequests(context.Background(), svc, []v1alpha1.PullRequestGeneratorFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(pullRequests))
+	assert.Equal(t, 101, pullRequests[0].Number)
+}
+
+func TestListPullRequestBearerTokenCloud(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer tolkien", r.Header.Get("Authorization"))
+		defaultHandlerCloud(t)(w, r)
+	}))
+	defer ts.Close()
+	svc, err := NewBitbucketCloudServiceBearerToken(ts.URL, "tolkien", "OWNER", "REPO")
+	assert.NoError(t, err)
+	pullRequests, err := ListPullRequests(context.Background(), svc, []v1alpha1.PullRequestGeneratorFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(pullRequests))
+	assert.Equal(t, "feature/foo-bar", pullRequests[0].Branch)
+}
+
+func TestListPullRequestPaginationCloud(t *testing.T) {
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var err error
+		switch r.RequestURI {
+		case "/repositories/OWNER/REPO/pullrequests?state=OPEN":
+			_, err = io.WriteString(w, fmt.Sprintf(`{
+				"size": 3,
+				"pagelen": 2,
+				"page": 1,
+				"next": "%s/repositories/OWNER/REPO/pullrequests?page=2&state=OPEN",
+				"values": [
+					{
+						"id": 101,
+						"source": {
+							"branch": {"name": "feature-101"},
+							"commit": {"hash": "1a8dd249c04a"}
+						}
+					},
+					{
+						"id": 102,
+						"source": {
+							"branch": {"name": "feature-102"},
+							"commit": {"hash": "4cf807e67a6d"}
+						}
+					}
+				]
+			}`, ts.URL))
+		case "/repositories/OWNER/REPO/pullrequests?page=2&state=OPEN":
+			_, err = io.WriteString(w, `{
+				"size": 3,
+				"pagelen": 2,
+				"page": 2,
+				"values": [
+					{
+						"id": 200,
+						"source": {
+							"branch": {"name": "feature-200"},
+							"commit": {"hash": "6344d9623e3b"}
+						}
+					}
+				]
+			}`)
+		default:
+			t.Fail()
+		}
+		if err != nil {
+			t.Fail()
+		}
+	}))
+	defer ts.Close()
+	svc, err := NewBitbucketCloudServiceNoAuth(ts.URL, "OWNER", "REPO")
+	assert.NoError(t, err)
+	pullRequests, err := ListPullRequests(context.Background(), svc, []v1alpha1.PullRequestGeneratorFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(pullRequests))
+	assert.Equal(t, &PullRequest{
+		Number:  102,
+		Branch:  "feature-102",
+		HeadSHA: "4cf807e67a6d",
+	}, pullRequests[1])
+	assert.Equal(t, &PullRequest{
+		Number:  200,
+		Branch:  "feature-200",
+		HeadSHA: "6344d9623e3b",
+	}, pullRequests[2])
+}
+
+func TestListPullRequestBranchMatchCloud(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defaultHandlerCloud(t)(w, r)
+	}))
+	defer ts.Close()
+	svc, err := NewBitbucketCloudServiceNoAuth(ts.URL, "OWNER", "REPO")
+	assert.NoError(t, err)
+
+	regexp := `feature/.*`
+	pullRequests, err := ListPullRequests(context.Background(), svc, []v1alpha1.PullRequestGeneratorFilter{{BranchMatch: &regexp}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(pullRequests))
+
+	regexp = `release/.*`
+	pullRequests, err = ListPullRequests(context.Background(), svc, []v1alpha1.PullRequestGeneratorFilter{{BranchMatch: &regexp}})
+	assert.NoError(t, err)
+	assert.Empty(t, pullRequests)
+}
+
+func TestListPullRequestErrorCloud(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+	svc, err := NewBitbucketCloudServiceNoAuth(ts.URL, "OWNER", "REPO")
+	assert.NoError(t, err)
+	_, err = ListPullRequests(context.Background(), svc, []v1alpha1.PullRequestGeneratorFilter{})
+	assert.Error(t, err)
+}
+
+func TestListPullRequestPaginationOtherHostCloud(t *testing.T) {
+	otherHostRequested := false
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		otherHostRequested = true
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer other.Close()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := io.WriteString(w, fmt.Sprintf(`{
+			"next": "%s/repositories/OWNER/REPO/pullrequests?page=2&state=OPEN",
+			"values": []
+		}`, other.URL))
+		if err != nil {
+			t.Fail()
+		}
+	}))
+	defer ts.Close()
+	svc, err := NewBitbucketCloudServiceBasicAuth(ts.URL, "user", "password", "OWNER", "REPO")
+	assert.NoError(t, err)
+	_, err = ListPullRequests(context.Background(), svc, []v1alpha1.PullRequestGeneratorFilter{})
+	assert.ErrorContains(t, err, "does not match the base url")
+	assert.False(t, otherHostRequested, "the credentials must not be sent to another host")
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/cookiejar"
 	"os"
+	"strings"
 
 	"code.gitea.io/sdk/gitea"
 )
@@ -53,11 +54,44 @@ func (g *GiteaService) List(ctx context.Context) ([]*PullRequest, error) {
 	}
 	list := []*PullRequest{}
 	for _, pr := range prs {
-		list = append(list, &PullRequest{
+		pullRequest := &PullRequest{
 			Number:  int(pr.Index),
 			Branch:  pr.Head.Ref,
 			HeadSHA: pr.Head.Sha,
-		})
+			Title:   pr.Title,
+			Labels:  getGiteaPRLabelNames(pr.Labels),
+			Draft:   isGiteaWorkInProgress(pr.Title),
+		}
+		if pr.Base != nil {
+			pullRequest.TargetBranch = pr.Base.Ref
+		}
+		if pr.Poster != nil {
+			pullRequest.Author = pr.Poster.UserName
+		}
+		list = append(list, pullRequest)
 	}
 	return list, nil
 }
+
+// giteaWorkInProgressPrefixes are the default title prefixes which mark a Gitea pull request as work in progress
+var giteaWorkInProgressPrefixes = []string{"WIP:", "[WIP]"}
+
+// isGiteaWorkInProgress returns true if the title marks the pull request as work in progress, which is how Gitea
+// represents draft pull requests
+func isGiteaWorkInProgress(title string) bool {
+	for _, prefix := range giteaWorkInProgressPrefixes {
+		if strings.HasPrefix(strings.ToUpper(title), prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// getGiteaPRLabelNames returns the names of the labels
+func getGiteaPRLabelNames(giteaLabels []*gitea.Label) []string {
+	var labelNames []string
+	for _, giteaLabel := range giteaLabels {
+		labelNames = append(labelNames, giteaLabel.Name)
+	}
+	return labelNames
+}
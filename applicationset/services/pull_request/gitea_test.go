@@ -256,4 +256,14 @@ func TestGiteaList(t *testing.T) {
 	assert.Equal(t, prs[0].Number, 1)
 	assert.Equal(t, prs[0].Branch, "test")
 	assert.Equal(t, prs[0].HeadSHA, "7bbaf62d92ddfafd9cc8b340c619abaec32bc09f")
+	assert.Equal(t, prs[0].TargetBranch, "main")
+	assert.Equal(t, prs[0].Title, "add an empty file")
+	assert.Equal(t, prs[0].Author, "graytshirt")
+	assert.False(t, prs[0].Draft)
+}
+
+func TestGiteaWorkInProgress(t *testing.T) {
+	assert.True(t, isGiteaWorkInProgress("WIP: add an empty file"))
+	assert.True(t, isGiteaWorkInProgress("[wip] add an empty file"))
+	assert.False(t, isGiteaWorkInProgress("add an empty file"))
 }
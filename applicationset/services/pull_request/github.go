@@ -65,9 +65,14 @@ func (g *GithubService) List(ctx context.Context) ([]*PullRequest, error) {
 				continue
 			}
 			pullRequests = append(pullRequests, &PullRequest{
-				Number:  *pull.Number,
-				Branch:  *pull.Head.Ref,
-				HeadSHA: *pull.Head.SHA,
+				Number:       *pull.Number,
+				Branch:       *pull.Head.Ref,
+				HeadSHA:      *pull.Head.SHA,
+				TargetBranch: pull.GetBase().GetRef(),
+				Title:        pull.GetTitle(),
+				Author:       pull.GetUser().GetLogin(),
+				Labels:       getGithubPRLabelNames(pull.Labels),
+				Draft:        pull.GetDraft(),
 			})
 		}
 		if resp.NextPage == 0 {
@@ -78,6 +83,15 @@ func (g *GithubService) List(ctx context.Context) ([]*PullRequest, error) {
 	return pullRequests, nil
 }
 
+// getGithubPRLabelNames returns the names of the labels
+func getGithubPRLabelNames(gitHubLabels []*github.Label) []string {
+	var labelNames []string
+	for _, gitHubLabel := range gitHubLabels {
+		labelNames = append(labelNames, gitHubLabel.GetName())
+	}
+	return labelNames
+}
+
 // containLabels returns true if gotLabels contains expectedLabels
 func containLabels(expectedLabels []string, gotLabels []*github.Label) bool {
 	for _, expected := range expectedLabels {
@@ -68,11 +68,19 @@ func (g *GitLabService) List(ctx context.Context) ([]*PullRequest, error) {
 			return nil, fmt.Errorf("error listing merge requests for project '%s': %v", g.project, err)
 		}
 		for _, mr := range mrs {
-			pullRequests = append(pullRequests, &PullRequest{
-				Number:  mr.IID,
-				Branch:  mr.SourceBranch,
-				HeadSHA: mr.SHA,
-			})
+			pullRequest := &PullRequest{
+				Number:       mr.IID,
+				Branch:       mr.SourceBranch,
+				HeadSHA:      mr.SHA,
+				TargetBranch: mr.TargetBranch,
+				Title:        mr.Title,
+				Labels:       mr.Labels,
+				Draft:        mr.Draft || mr.WorkInProgress,
+			}
+			if mr.Author != nil {
+				pullRequest.Author = mr.Author.Username
+			}
+			pullRequests = append(pullRequests, pullRequest)
 		}
 		if resp.NextPage == 0 {
 			break
@@ -12,6 +12,16 @@ type PullRequest struct {
 	Branch string
 	// HeadSHA is the SHA of the HEAD from which the pull request originated.
 	HeadSHA string
+	// TargetBranch is the name of the branch which the pull request targets.
+	TargetBranch string
+	// Title is the title of the pull request.
+	Title string
+	// Author is the login of the user who opened the pull request.
+	Author string
+	// Labels of the pull request.
+	Labels []string
+	// Draft is true if the pull request is a draft, which is not ready for review yet.
+	Draft bool
 }
 
 type PullRequestService interface {
@@ -20,5 +30,9 @@ type PullRequestService interface {
 }
 
 type Filter struct {
-	BranchMatch *regexp.Regexp
+	BranchMatch       *regexp.Regexp
+	TargetBranchMatch *regexp.Regexp
+	TitleMatch        *regexp.Regexp
+	Author            *string
+	ExcludeDrafts     bool
 }
@@ -137,3 +137,97 @@ func TestNoFilters(t *testing.T) {
 	assert.Equal(t, "one", repos[0].Branch)
 	assert.Equal(t, "two", repos[1].Branch)
 }
+
+func newMetadataFakeService() PullRequestService {
+	provider, _ := NewFakeService(
+		context.Background(),
+		[]*PullRequest{
+			{
+				Number:       1,
+				Branch:       "feature-one",
+				TargetBranch: "main",
+				Title:        "feat: add one",
+				Author:       "alice",
+				HeadSHA:      "189d92cbf9ff857a39e6feccd32798ca700fb958",
+			},
+			{
+				Number:       2,
+				Branch:       "feature-two",
+				TargetBranch: "release/v1",
+				Title:        "fix: backport two",
+				Author:       "bob",
+				HeadSHA:      "289d92cbf9ff857a39e6feccd32798ca700fb958",
+			},
+			{
+				Number:       3,
+				Branch:       "feature-three",
+				TargetBranch: "main",
+				Title:        "feat: add three",
+				Author:       "bob",
+				Draft:        true,
+				HeadSHA:      "389d92cbf9ff857a39e6feccd32798ca700fb958",
+			},
+		},
+		nil,
+	)
+	return provider
+}
+
+func TestFilterPullRequestMetadata(t *testing.T) {
+	testCases := []struct {
+		name            string
+		filter          argoprojiov1alpha1.PullRequestGeneratorFilter
+		expectedNumbers []int
+	}{
+		{
+			name:            "target branch",
+			filter:          argoprojiov1alpha1.PullRequestGeneratorFilter{TargetBranchMatch: strp("^main$")},
+			expectedNumbers: []int{1, 3},
+		},
+		{
+			name:            "title",
+			filter:          argoprojiov1alpha1.PullRequestGeneratorFilter{TitleMatch: strp("^feat:")},
+			expectedNumbers: []int{1, 3},
+		},
+		{
+			name:            "author",
+			filter:          argoprojiov1alpha1.PullRequestGeneratorFilter{Author: strp("bob")},
+			expectedNumbers: []int{2, 3},
+		},
+		{
+			name:            "exclude drafts",
+			filter:          argoprojiov1alpha1.PullRequestGeneratorFilter{ExcludeDrafts: true},
+			expectedNumbers: []int{1, 2},
+		},
+		{
+			name: "all conditions of a filter must match",
+			filter: argoprojiov1alpha1.PullRequestGeneratorFilter{
+				TargetBranchMatch: strp("^main$"),
+				Author:            strp("bob"),
+				ExcludeDrafts:     true,
+			},
+			expectedNumbers: []int{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCaseCopy := testCase
+		t.Run(testCaseCopy.name, func(t *testing.T) {
+			pullRequests, err := ListPullRequests(context.Background(), newMetadataFakeService(), []argoprojiov1alpha1.PullRequestGeneratorFilter{testCaseCopy.filter})
+			assert.NoError(t, err)
+			numbers := []int{}
+			for _, pullRequest := range pullRequests {
+				numbers = append(numbers, pullRequest.Number)
+			}
+			assert.Equal(t, testCaseCopy.expectedNumbers, numbers)
+		})
+	}
+}
+
+func TestFilterBadRegexp(t *testing.T) {
+	_, err := ListPullRequests(context.Background(), newMetadataFakeService(), []argoprojiov1alpha1.PullRequestGeneratorFilter{{TargetBranchMatch: strp("(")}})
+	assert.ErrorContains(t, err, "error compiling TargetBranchMatch regexp")
+
+	_, err = ListPullRequests(context.Background(), newMetadataFakeService(), []argoprojiov1alpha1.PullRequestGeneratorFilter{{TitleMatch: strp("(")}})
+	assert.ErrorContains(t, err, "error compiling TitleMatch regexp")
+}
@@ -0,0 +1,135 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// NamespaceScopedCacheBuilder returns a NewCacheFunc for a cache of all the namespaces, except for the given kinds of
+// objects which are only cached in the given namespace. It allows watching ApplicationSets and Applications in any
+// namespace without caching, and requiring the permission to watch, every Secret of the cluster.
+func NamespaceScopedCacheBuilder(namespace string, namespacedObjects ...client.Object) cache.NewCacheFunc {
+	return func(config *rest.Config, opts cache.Options) (cache.Cache, error) {
+		clusterCache, err := cache.New(config, opts)
+		if err != nil {
+			return nil, fmt.Errorf("error creating cluster cache: %w", err)
+		}
+		namespacedOpts := opts
+		namespacedOpts.Namespace = namespace
+		namespacedCache, err := cache.New(config, namespacedOpts)
+		if err != nil {
+			return nil, fmt.Errorf("error creating cache of namespace %s: %w", namespace, err)
+		}
+		return newNamespaceScopedCache(clusterCache, namespacedCache, opts.Scheme, namespacedObjects...)
+	}
+}
+
+func newNamespaceScopedCache(clusterCache cache.Cache, namespacedCache cache.Cache, scheme *runtime.Scheme, namespacedObjects ...client.Object) (*namespaceScopedCache, error) {
+	c := &namespaceScopedCache{
+		clusterCache:    clusterCache,
+		namespacedCache: namespacedCache,
+		scheme:          scheme,
+		namespacedKinds: map[schema.GroupVersionKind]bool{},
+	}
+	for _, obj := range namespacedObjects {
+		gvk, err := apiutil.GVKForObject(obj, scheme)
+		if err != nil {
+			return nil, err
+		}
+		c.namespacedKinds[gvk] = true
+	}
+	return c, nil
+}
+
+// namespaceScopedCache delegates the objects of the namespaced kinds to a cache restricted to a namespace, and the
+// other objects to a cache of all the namespaces
+type namespaceScopedCache struct {
+	clusterCache    cache.Cache
+	namespacedCache cache.Cache
+	scheme          *runtime.Scheme
+	namespacedKinds map[schema.GroupVersionKind]bool
+}
+
+var _ cache.Cache = &namespaceScopedCache{}
+
+func (c *namespaceScopedCache) cacheForKind(gvk schema.GroupVersionKind) cache.Cache {
+	if c.namespacedKinds[gvk] {
+		return c.namespacedCache
+	}
+	return c.clusterCache
+}
+
+func (c *namespaceScopedCache) cacheForObject(obj runtime.Object) (cache.Cache, error) {
+	gvk, err := apiutil.GVKForObject(obj, c.scheme)
+	if err != nil {
+		return nil, err
+	}
+	gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	return c.cacheForKind(gvk), nil
+}
+
+func (c *namespaceScopedCache) Get(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+	objCache, err := c.cacheForObject(obj)
+	if err != nil {
+		return err
+	}
+	return objCache.Get(ctx, key, obj)
+}
+
+func (c *namespaceScopedCache) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listCache, err := c.cacheForObject(list)
+	if err != nil {
+		return err
+	}
+	return listCache.List(ctx, list, opts...)
+}
+
+func (c *namespaceScopedCache) GetInformer(ctx context.Context, obj client.Object) (cache.Informer, error) {
+	objCache, err := c.cacheForObject(obj)
+	if err != nil {
+		return nil, err
+	}
+	return objCache.GetInformer(ctx, obj)
+}
+
+func (c *namespaceScopedCache) GetInformerForKind(ctx context.Context, gvk schema.GroupVersionKind) (cache.Informer, error) {
+	return c.cacheForKind(gvk).GetInformerForKind(ctx, gvk)
+}
+
+func (c *namespaceScopedCache) IndexField(ctx context.Context, obj client.Object, field string, extractValue client.IndexerFunc) error {
+	objCache, err := c.cacheForObject(obj)
+	if err != nil {
+		return err
+	}
+	return objCache.IndexField(ctx, obj, field, extractValue)
+}
+
+// Start runs both caches until the context is closed, or one of them fails.
+func (c *namespaceScopedCache) Start(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	errs := make(chan error, 2)
+	for _, ca := range []cache.Cache{c.clusterCache, c.namespacedCache} {
+		go func(ca cache.Cache) {
+			errs <- ca.Start(ctx)
+		}(ca)
+	}
+	err := <-errs
+	cancel()
+	if secondErr := <-errs; err == nil {
+		err = secondErr
+	}
+	return err
+}
+
+func (c *namespaceScopedCache) WaitForCacheSync(ctx context.Context) bool {
+	return c.clusterCache.WaitForCacheSync(ctx) && c.namespacedCache.WaitForCacheSync(ctx)
+}
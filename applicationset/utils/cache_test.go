@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// recordingCache records the name of the cache each call is delegated to
+type recordingCache struct {
+	cache.Cache
+	name  string
+	calls *[]string
+}
+
+func (c *recordingCache) Get(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+	*c.calls = append(*c.calls, c.name)
+	return nil
+}
+
+func (c *recordingCache) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	*c.calls = append(*c.calls, c.name)
+	return nil
+}
+
+func (c *recordingCache) GetInformer(ctx context.Context, obj client.Object) (cache.Informer, error) {
+	*c.calls = append(*c.calls, c.name)
+	return nil, nil
+}
+
+func (c *recordingCache) GetInformerForKind(ctx context.Context, gvk schema.GroupVersionKind) (cache.Informer, error) {
+	*c.calls = append(*c.calls, c.name)
+	return nil, nil
+}
+
+func TestNamespaceScopedCache(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, argov1alpha1.AddToScheme(scheme))
+
+	var calls []string
+	c, err := newNamespaceScopedCache(
+		&recordingCache{name: "cluster", calls: &calls},
+		&recordingCache{name: "namespaced", calls: &calls},
+		scheme, &corev1.Secret{})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	key := client.ObjectKey{Namespace: "argocd", Name: "name"}
+	require.NoError(t, c.Get(ctx, key, &corev1.Secret{}))
+	require.NoError(t, c.List(ctx, &corev1.SecretList{}))
+	_, err = c.GetInformer(ctx, &corev1.Secret{})
+	require.NoError(t, err)
+	_, err = c.GetInformerForKind(ctx, corev1.SchemeGroupVersion.WithKind("Secret"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"namespaced", "namespaced", "namespaced", "namespaced"}, calls)
+
+	calls = nil
+	require.NoError(t, c.Get(ctx, key, &argov1alpha1.ApplicationSet{}))
+	require.NoError(t, c.List(ctx, &argov1alpha1.ApplicationList{}))
+	_, err = c.GetInformer(ctx, &argov1alpha1.Application{})
+	require.NoError(t, err)
+	require.NoError(t, c.List(ctx, &corev1.ConfigMapList{}))
+	assert.Equal(t, []string{"cluster", "cluster", "cluster", "cluster"}, calls)
+}
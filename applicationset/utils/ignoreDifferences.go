@@ -0,0 +1,158 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// ApplyIgnoreDifferences copies the fields of the live Application which are ignored by the ApplicationSet into the
+// generated Application, so that updating the live Application from the generated one does not revert them.
+func ApplyIgnoreDifferences(ignoreDifferences argov1alpha1.ApplicationSetIgnoreDifferences, found *argov1alpha1.Application, generatedApp *argov1alpha1.Application) error {
+	var pointers []string
+	for _, ignoreDifference := range ignoreDifferences {
+		if ignoreDifference.Name == "" || ignoreDifference.Name == generatedApp.Name {
+			pointers = append(pointers, ignoreDifference.JSONPointers...)
+		}
+	}
+	if len(pointers) == 0 {
+		return nil
+	}
+
+	live, err := toUnstructuredMap(found)
+	if err != nil {
+		return fmt.Errorf("error converting live application: %w", err)
+	}
+	generated, err := toUnstructuredMap(generatedApp)
+	if err != nil {
+		return fmt.Errorf("error converting generated application: %w", err)
+	}
+
+	for _, pointer := range pointers {
+		path, err := parseJSONPointer(pointer)
+		if err != nil {
+			return err
+		}
+		if len(path) == 0 {
+			return fmt.Errorf("invalid JSON pointer %q: the whole application cannot be ignored", pointer)
+		}
+
+		value, exists := getJSONPointerValue(live, path)
+		if exists {
+			generated = setJSONPointerValue(generated, path, value).(map[string]interface{})
+		} else {
+			generated = removeJSONPointerValue(generated, path).(map[string]interface{})
+		}
+	}
+
+	data, err := json.Marshal(generated)
+	if err != nil {
+		return fmt.Errorf("error marshalling generated application: %w", err)
+	}
+	result := argov1alpha1.Application{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return fmt.Errorf("error unmarshalling generated application: %w", err)
+	}
+	*generatedApp = result
+	return nil
+}
+
+func toUnstructuredMap(app *argov1alpha1.Application) (map[string]interface{}, error) {
+	data, err := json.Marshal(app)
+	if err != nil {
+		return nil, err
+	}
+	res := map[string]interface{}{}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// parseJSONPointer splits a JSON pointer (RFC 6901) into its unescaped reference tokens.
+func parseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return []string{}, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q: must start with '/'", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+func getJSONPointerValue(obj interface{}, path []string) (interface{}, bool) {
+	for _, token := range path {
+		switch typed := obj.(type) {
+		case map[string]interface{}:
+			value, ok := typed[token]
+			if !ok {
+				return nil, false
+			}
+			obj = value
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(typed) {
+				return nil, false
+			}
+			obj = typed[index]
+		default:
+			return nil, false
+		}
+	}
+	return obj, true
+}
+
+// setJSONPointerValue sets the value at the given path, creating missing objects along the way. Array elements which
+// do not exist are not created, in which case obj is left unchanged.
+func setJSONPointerValue(obj interface{}, path []string, value interface{}) interface{} {
+	if len(path) == 0 {
+		return value
+	}
+	switch typed := obj.(type) {
+	case map[string]interface{}:
+		typed[path[0]] = setJSONPointerValue(typed[path[0]], path[1:], value)
+		return typed
+	case []interface{}:
+		index, err := strconv.Atoi(path[0])
+		if err != nil || index < 0 || index >= len(typed) {
+			return typed
+		}
+		typed[index] = setJSONPointerValue(typed[index], path[1:], value)
+		return typed
+	case nil:
+		return map[string]interface{}{path[0]: setJSONPointerValue(nil, path[1:], value)}
+	default:
+		return typed
+	}
+}
+
+func removeJSONPointerValue(obj interface{}, path []string) interface{} {
+	switch typed := obj.(type) {
+	case map[string]interface{}:
+		if len(path) == 1 {
+			delete(typed, path[0])
+		} else if value, ok := typed[path[0]]; ok {
+			typed[path[0]] = removeJSONPointerValue(value, path[1:])
+		}
+		return typed
+	case []interface{}:
+		index, err := strconv.Atoi(path[0])
+		if err != nil || index < 0 || index >= len(typed) {
+			return typed
+		}
+		if len(path) == 1 {
+			return append(typed[:index], typed[index+1:]...)
+		}
+		typed[index] = removeJSONPointerValue(typed[index], path[1:])
+		return typed
+	default:
+		return typed
+	}
+}
@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestApplyIgnoreDifferences(t *testing.T) {
+	newApp := func(name string, targetRevision string, labels map[string]string, syncOptions argov1alpha1.SyncOptions) *argov1alpha1.Application {
+		app := &argov1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: labels,
+			},
+			Spec: argov1alpha1.ApplicationSpec{
+				Source: argov1alpha1.ApplicationSource{
+					RepoURL:        "https://github.com/argoproj/argocd-example-apps",
+					TargetRevision: targetRevision,
+				},
+			},
+		}
+		if syncOptions != nil {
+			app.Spec.SyncPolicy = &argov1alpha1.SyncPolicy{SyncOptions: syncOptions}
+		}
+		return app
+	}
+
+	for _, c := range []struct {
+		name              string
+		ignoreDifferences argov1alpha1.ApplicationSetIgnoreDifferences
+		found             *argov1alpha1.Application
+		generated         *argov1alpha1.Application
+		expected          *argov1alpha1.Application
+		expectedErr       string
+	}{
+		{
+			name:      "no ignored differences",
+			found:     newApp("app", "hotfix", nil, nil),
+			generated: newApp("app", "HEAD", nil, nil),
+			expected:  newApp("app", "HEAD", nil, nil),
+		},
+		{
+			name:              "ignored field is kept",
+			ignoreDifferences: argov1alpha1.ApplicationSetIgnoreDifferences{{JSONPointers: []string{"/spec/source/targetRevision"}}},
+			found:             newApp("app", "hotfix", nil, nil),
+			generated:         newApp("app", "HEAD", nil, nil),
+			expected:          newApp("app", "hotfix", nil, nil),
+		},
+		{
+			name:              "ignored field missing from the live application is removed",
+			ignoreDifferences: argov1alpha1.ApplicationSetIgnoreDifferences{{JSONPointers: []string{"/spec/source/targetRevision"}}},
+			found:             newApp("app", "", nil, nil),
+			generated:         newApp("app", "HEAD", nil, nil),
+			expected:          newApp("app", "", nil, nil),
+		},
+		{
+			name:              "ignored field missing from the generated application is added",
+			ignoreDifferences: argov1alpha1.ApplicationSetIgnoreDifferences{{JSONPointers: []string{"/spec/syncPolicy", "/metadata/labels/team~1owner"}}},
+			found:             newApp("app", "HEAD", map[string]string{"team/owner": "ops"}, argov1alpha1.SyncOptions{"Prune=false"}),
+			generated:         newApp("app", "HEAD", nil, nil),
+			expected:          newApp("app", "HEAD", map[string]string{"team/owner": "ops"}, argov1alpha1.SyncOptions{"Prune=false"}),
+		},
+		{
+			name:              "array element",
+			ignoreDifferences: argov1alpha1.ApplicationSetIgnoreDifferences{{JSONPointers: []string{"/spec/syncPolicy/syncOptions/1"}}},
+			found:             newApp("app", "HEAD", nil, argov1alpha1.SyncOptions{"CreateNamespace=true", "Prune=false"}),
+			generated:         newApp("app", "HEAD", nil, argov1alpha1.SyncOptions{"CreateNamespace=true", "Validate=false"}),
+			expected:          newApp("app", "HEAD", nil, argov1alpha1.SyncOptions{"CreateNamespace=true", "Prune=false"}),
+		},
+		{
+			name:              "rule for another application",
+			ignoreDifferences: argov1alpha1.ApplicationSetIgnoreDifferences{{Name: "other-app", JSONPointers: []string{"/spec/source/targetRevision"}}},
+			found:             newApp("app", "hotfix", nil, nil),
+			generated:         newApp("app", "HEAD", nil, nil),
+			expected:          newApp("app", "HEAD", nil, nil),
+		},
+		{
+			name:              "rule for this application",
+			ignoreDifferences: argov1alpha1.ApplicationSetIgnoreDifferences{{Name: "app", JSONPointers: []string{"/spec/source/targetRevision"}}},
+			found:             newApp("app", "hotfix", nil, nil),
+			generated:         newApp("app", "HEAD", nil, nil),
+			expected:          newApp("app", "hotfix", nil, nil),
+		},
+		{
+			name:              "invalid JSON pointer",
+			ignoreDifferences: argov1alpha1.ApplicationSetIgnoreDifferences{{JSONPointers: []string{"spec/source/targetRevision"}}},
+			found:             newApp("app", "hotfix", nil, nil),
+			generated:         newApp("app", "HEAD", nil, nil),
+			expectedErr:       `invalid JSON pointer "spec/source/targetRevision": must start with '/'`,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			err := ApplyIgnoreDifferences(c.ignoreDifferences, c.found, c.generated)
+			if c.expectedErr != "" {
+				assert.EqualError(t, err, c.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, c.generated)
+		})
+	}
+}
@@ -1,5 +1,9 @@
 package utils
 
+import (
+	argov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
 // Policy allows to apply different rules to a set of changes.
 type Policy interface {
 	Update() bool
@@ -11,6 +15,19 @@ var Policies = map[string]Policy{
 	"sync":          &SyncPolicy{},
 	"create-only":   &CreateOnlyPolicy{},
 	"create-update": &CreateUpdatePolicy{},
+	"create-delete": &CreateDeletePolicy{},
+}
+
+// DefaultPolicy returns the policy to apply to the Applications of an ApplicationSet: the ApplicationsSync policy of
+// the ApplicationSet if it sets one and overriding the controller policy is allowed, the controller policy otherwise.
+func DefaultPolicy(appSetSyncPolicy *argov1alpha1.ApplicationSetSyncPolicy, controllerPolicy Policy, allowPolicyOverride bool) Policy {
+	if !allowPolicyOverride || appSetSyncPolicy == nil || appSetSyncPolicy.ApplicationsSync == nil {
+		return controllerPolicy
+	}
+	if policy, exists := Policies[string(*appSetSyncPolicy.ApplicationsSync)]; exists {
+		return policy
+	}
+	return controllerPolicy
 }
 
 type SyncPolicy struct{}
@@ -42,3 +59,13 @@ func (p *CreateOnlyPolicy) Update() bool {
 func (p *CreateOnlyPolicy) Delete() bool {
 	return false
 }
+
+type CreateDeletePolicy struct{}
+
+func (p *CreateDeletePolicy) Update() bool {
+	return false
+}
+
+func (p *CreateDeletePolicy) Delete() bool {
+	return true
+}
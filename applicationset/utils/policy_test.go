@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestDefaultPolicy(t *testing.T) {
+	createOnly := argov1alpha1.ApplicationsSyncPolicyCreateOnly
+	createDelete := argov1alpha1.ApplicationsSyncPolicyCreateDelete
+	unknown := argov1alpha1.ApplicationsSyncPolicy("unknown")
+
+	for _, c := range []struct {
+		name                string
+		syncPolicy          *argov1alpha1.ApplicationSetSyncPolicy
+		allowPolicyOverride bool
+		expectedUpdate      bool
+		expectedDelete      bool
+	}{
+		{
+			name:                "no sync policy",
+			allowPolicyOverride: true,
+			expectedUpdate:      true,
+			expectedDelete:      true,
+		},
+		{
+			name:                "no applicationsSync",
+			syncPolicy:          &argov1alpha1.ApplicationSetSyncPolicy{PreserveResourcesOnDeletion: true},
+			allowPolicyOverride: true,
+			expectedUpdate:      true,
+			expectedDelete:      true,
+		},
+		{
+			name:                "create-only override",
+			syncPolicy:          &argov1alpha1.ApplicationSetSyncPolicy{ApplicationsSync: &createOnly},
+			allowPolicyOverride: true,
+			expectedUpdate:      false,
+			expectedDelete:      false,
+		},
+		{
+			name:                "create-delete override",
+			syncPolicy:          &argov1alpha1.ApplicationSetSyncPolicy{ApplicationsSync: &createDelete},
+			allowPolicyOverride: true,
+			expectedUpdate:      false,
+			expectedDelete:      true,
+		},
+		{
+			name:                "override not allowed",
+			syncPolicy:          &argov1alpha1.ApplicationSetSyncPolicy{ApplicationsSync: &createOnly},
+			allowPolicyOverride: false,
+			expectedUpdate:      true,
+			expectedDelete:      true,
+		},
+		{
+			name:                "unknown policy",
+			syncPolicy:          &argov1alpha1.ApplicationSetSyncPolicy{ApplicationsSync: &unknown},
+			allowPolicyOverride: true,
+			expectedUpdate:      true,
+			expectedDelete:      true,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			policy := DefaultPolicy(c.syncPolicy, &SyncPolicy{}, c.allowPolicyOverride)
+			assert.Equal(t, c.expectedUpdate, policy.Update())
+			assert.Equal(t, c.expectedDelete, policy.Delete())
+		})
+	}
+}
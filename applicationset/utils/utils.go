@@ -14,6 +14,8 @@ import (
 
 	"github.com/Masterminds/sprig"
 	"github.com/valyala/fasttemplate"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"sigs.k8s.io/yaml"
 
 	log "github.com/sirupsen/logrus"
 
@@ -32,6 +34,7 @@ func init() {
 
 type Renderer interface {
 	RenderTemplateParams(tmpl *argoappsv1.Application, syncPolicy *argoappsv1.ApplicationSetSyncPolicy, params map[string]interface{}, useGoTemplate bool) (*argoappsv1.Application, error)
+	Replace(tmpl string, replaceMap map[string]interface{}, useGoTemplate bool) (string, error)
 }
 
 type Render struct {
@@ -204,6 +207,44 @@ func (r *Render) RenderTemplateParams(tmpl *argoappsv1.Application, syncPolicy *
 	return replacedTmpl, nil
 }
 
+// RenderTemplatePatch renders the templatePatch of an ApplicationSet with the Go template engine, and applies the
+// result to the Application as a strategic merge patch. The rendered patch may be YAML or JSON.
+func RenderTemplatePatch(r Renderer, app *argoappsv1.Application, templatePatch string, params map[string]interface{}) (*argoappsv1.Application, error) {
+	replacedTemplatePatch, err := r.Replace(templatePatch, params, true)
+	if err != nil {
+		return nil, fmt.Errorf("error rendering template patch: %w", err)
+	}
+	return ApplyTemplatePatch(app, replacedTemplatePatch)
+}
+
+// ApplyTemplatePatch applies an already rendered templatePatch to the Application as a strategic merge patch
+func ApplyTemplatePatch(app *argoappsv1.Application, templatePatch string) (*argoappsv1.Application, error) {
+	appJSON, err := json.Marshal(app)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling application: %w", err)
+	}
+
+	patchJSON, err := yaml.YAMLToJSON([]byte(templatePatch))
+	if err != nil {
+		return nil, fmt.Errorf("error converting template patch to JSON: %w", err)
+	}
+	// An empty patch, e.g. when all its content is conditional, leaves the Application unchanged
+	if string(patchJSON) == "null" {
+		return app, nil
+	}
+
+	patchedJSON, err := strategicpatch.StrategicMergePatch(appJSON, patchJSON, argoappsv1.Application{})
+	if err != nil {
+		return nil, fmt.Errorf("error applying template patch: %w", err)
+	}
+
+	var patchedApp argoappsv1.Application
+	if err := json.Unmarshal(patchedJSON, &patchedApp); err != nil {
+		return nil, fmt.Errorf("error unmarshaling patched application: %w", err)
+	}
+	return &patchedApp, nil
+}
+
 var isTemplatedRegex = regexp.MustCompile(".*{{.*}}.*")
 
 // Replace executes basic string substitution of a template with replacement values.
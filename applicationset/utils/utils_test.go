@@ -1016,3 +1016,99 @@ func TestNormalizeBitbucketBasePath(t *testing.T) {
 		assert.Equal(t, c.expectedBasePath, result, c.testName)
 	}
 }
+
+func TestRenderTemplatePatch(t *testing.T) {
+	app := &argoappsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "my-cluster-guestbook",
+			Labels: map[string]string{"team": "a"},
+		},
+		Spec: argoappsv1.ApplicationSpec{
+			Source: argoappsv1.ApplicationSource{
+				RepoURL: "https://github.com/argoproj/argocd-example-apps",
+				Path:    "helm-guestbook",
+			},
+			Project: "default",
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		templatePatch string
+		params        map[string]interface{}
+		expectedApp   func() *argoappsv1.Application
+		expectedError string
+	}{
+		{
+			name: "conditional sync policy and value files",
+			templatePatch: `
+spec:
+  {{- if .autoSync }}
+  syncPolicy:
+    automated:
+      prune: {{ .prune }}
+  {{- end }}
+  source:
+    helm:
+      valueFiles:
+      {{- range .valueFiles }}
+      - {{ . }}
+      {{- end }}
+`,
+			params: map[string]interface{}{
+				"autoSync":   true,
+				"prune":      true,
+				"valueFiles": []interface{}{"values.yaml", "values-prod.yaml"},
+			},
+			expectedApp: func() *argoappsv1.Application {
+				expected := app.DeepCopy()
+				expected.Spec.SyncPolicy = &argoappsv1.SyncPolicy{Automated: &argoappsv1.SyncPolicyAutomated{Prune: true}}
+				expected.Spec.Source.Helm = &argoappsv1.ApplicationSourceHelm{ValueFiles: []string{"values.yaml", "values-prod.yaml"}}
+				return expected
+			},
+		},
+		{
+			name:          "patch in JSON which merges the labels",
+			templatePatch: `{"metadata": {"labels": {"env": "{{ .env }}"}}}`,
+			params:        map[string]interface{}{"env": "prod"},
+			expectedApp: func() *argoappsv1.Application {
+				expected := app.DeepCopy()
+				expected.Labels = map[string]string{"team": "a", "env": "prod"}
+				return expected
+			},
+		},
+		{
+			name:          "empty patch",
+			templatePatch: `{{ if .autoSync }}spec: {syncPolicy: {automated: {}}}{{ end }}`,
+			params:        map[string]interface{}{"autoSync": false},
+			expectedApp: func() *argoappsv1.Application {
+				return app.DeepCopy()
+			},
+		},
+		{
+			name:          "invalid template",
+			templatePatch: `{{ .missing`,
+			params:        map[string]interface{}{},
+			expectedError: "error rendering template patch",
+		},
+		{
+			name:          "invalid patch",
+			templatePatch: `spec: [`,
+			params:        map[string]interface{}{},
+			expectedError: "error converting template patch to JSON",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCaseCopy := testCase
+		t.Run(testCaseCopy.name, func(t *testing.T) {
+			got, err := RenderTemplatePatch(&Render{}, app.DeepCopy(), testCaseCopy.templatePatch, testCaseCopy.params)
+			if testCaseCopy.expectedError != "" {
+				assert.ErrorContains(t, err, testCaseCopy.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCaseCopy.expectedApp(), got)
+		})
+	}
+}
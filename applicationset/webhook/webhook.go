@@ -417,6 +417,7 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 		SCMProvider:             g0.SCMProvider,
 		ClusterDecisionResource: g0.ClusterDecisionResource,
 		PullRequest:             g0.PullRequest,
+		Plugin:                  g0.Plugin,
 		Matrix:                  matrixGenerator0,
 		Merge:                   mergeGenerator0,
 	}
@@ -471,6 +472,7 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 		SCMProvider:             g1.SCMProvider,
 		ClusterDecisionResource: g1.ClusterDecisionResource,
 		PullRequest:             g1.PullRequest,
+		Plugin:                  g1.Plugin,
 		Matrix:                  matrixGenerator1,
 		Merge:                   mergeGenerator1,
 	}
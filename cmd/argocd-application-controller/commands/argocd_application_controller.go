@@ -62,6 +62,9 @@ func NewCommand() *cobra.Command {
 		otlpAddress              string
 		applicationNamespaces    []string
 		persistResourceHealth    bool
+		autoSyncRateLimit        float32
+		autoSyncBurst            int
+		autoSyncConcurrency      int
 	)
 	var command = cobra.Command{
 		Use:               cliName,
@@ -152,7 +155,10 @@ func NewCommand() *cobra.Command {
 				kubectlParallelismLimit,
 				persistResourceHealth,
 				clusterFilter,
-				applicationNamespaces)
+				applicationNamespaces,
+				float64(autoSyncRateLimit),
+				autoSyncBurst,
+				autoSyncConcurrency)
 			errors.CheckError(err)
 			cacheutil.CollectMetrics(redisClient, appController.GetMetricsServer())
 
@@ -195,6 +201,9 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringVar(&otlpAddress, "otlp-address", env.StringFromEnv("ARGOCD_APPLICATION_CONTROLLER_OTLP_ADDRESS", ""), "OpenTelemetry collector address to send traces to")
 	command.Flags().StringSliceVar(&applicationNamespaces, "application-namespaces", env.StringsFromEnv("ARGOCD_APPLICATION_NAMESPACES", []string{}, ","), "List of additional namespaces that applications are allowed to be reconciled from")
 	command.Flags().BoolVar(&persistResourceHealth, "persist-resource-health", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_PERSIST_RESOURCE_HEALTH", true), "Enables storing the managed resources health in the Application CRD")
+	command.Flags().Float32Var(&autoSyncRateLimit, "auto-sync-rate-limit", env.ParseFloatFromEnv("ARGOCD_APPLICATION_CONTROLLER_AUTO_SYNC_RATE_LIMIT", 0, 0, math.MaxFloat32), "Maximum number of automated syncs initiated per second. Zero means no limit")
+	command.Flags().IntVar(&autoSyncBurst, "auto-sync-burst", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_AUTO_SYNC_BURST", 10, 1, math.MaxInt32), "Maximum number of automated syncs initiated at once when the automated sync rate limit is enabled")
+	command.Flags().IntVar(&autoSyncConcurrency, "auto-sync-cluster-concurrency", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_AUTO_SYNC_CLUSTER_CONCURRENCY", 0, 0, math.MaxInt32), "Maximum number of concurrent automated syncs per destination cluster. Zero means no limit")
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command, func(client *redis.Client) {
 		redisClient = client
 	})
@@ -401,7 +401,13 @@ func reconcileApplications(
 			return nil, err
 		}
 
-		res := appStateManager.CompareAppState(&app, proj, app.Spec.Source.TargetRevision, app.Spec.Source, false, false, nil)
+		sources := make([]v1alpha1.ApplicationSource, 0)
+		revisions := make([]string, 0)
+		for _, source := range app.Spec.GetSources() {
+			sources = append(sources, source)
+			revisions = append(revisions, source.TargetRevision)
+		}
+		res := appStateManager.CompareAppState(&app, proj, revisions, sources, false, false, nil, app.Spec.HasMultipleSources())
 		items = append(items, appReconcileResult{
 			Name:       app.Name,
 			Conditions: app.Status.Conditions,
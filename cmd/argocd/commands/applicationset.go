@@ -1,24 +1,35 @@
 package commands
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"reflect"
+	"sort"
 	"strings"
 	"text/tabwriter"
+	"time"
 	"unicode/utf8"
 
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/codes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/headless"
 	cmdutil "github.com/argoproj/argo-cd/v2/cmd/util"
 	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/applicationset"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
 	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	arogappsetv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/cli"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/grpc"
@@ -39,6 +50,18 @@ var (
 
 	# Delete an ApplicationSet
 	argocd appset delete APPSETNAME (APPSETNAME...)
+
+	# Edit the spec of an ApplicationSet in an editor
+	argocd appset edit APPSETNAME
+
+	# Patch an ApplicationSet
+	argocd appset patch APPSETNAME --patch '{"spec": {"syncPolicy": {"preserveResourcesOnDeletion": true}}}' --type merge
+
+	# Preview the Applications generated by an ApplicationSet, without creating them
+	argocd appset generate -f <filename or URL>
+
+	# Regenerate the Applications of an ApplicationSet now, and wait until the controller has processed the refresh
+	argocd appset refresh APPSETNAME --wait
 	`)
 )
 
@@ -59,6 +82,10 @@ func NewAppSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewApplicationSetCreateCommand(clientOpts))
 	command.AddCommand(NewApplicationSetListCommand(clientOpts))
 	command.AddCommand(NewApplicationSetDeleteCommand(clientOpts))
+	command.AddCommand(NewApplicationSetEditCommand(clientOpts))
+	command.AddCommand(NewApplicationSetPatchCommand(clientOpts))
+	command.AddCommand(NewApplicationSetGenerateCommand(clientOpts))
+	command.AddCommand(NewApplicationSetRefreshCommand(clientOpts))
 	return command
 }
 
@@ -106,8 +133,8 @@ func NewApplicationSetGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 			acdClient := headless.NewClientOrDie(clientOpts, c)
 			conn, appIf := acdClient.NewApplicationSetClientOrDie()
 			defer argoio.Close(conn)
-			appSetName := args[0]
-			appSet, err := appIf.Get(ctx, &applicationset.ApplicationSetGetQuery{Name: appSetName})
+			appSetName, appSetNs := argo.ParseAppQualifiedName(args[0], "")
+			appSet, err := appIf.Get(ctx, &applicationset.ApplicationSetGetQuery{Name: appSetName, AppsetNamespace: appSetNs})
 			errors.CheckError(err)
 
 			switch output {
@@ -124,6 +151,12 @@ func NewApplicationSetGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 					_ = w.Flush()
 					fmt.Println()
 				}
+				if len(appSet.Status.ApplicationStatus) > 0 {
+					w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+					printAppSetApplicationStatus(w, appSet)
+					_ = w.Flush()
+					fmt.Println()
+				}
 				if showParams {
 					printHelmParams(appSet.Spec.Template.Spec.Source.Helm)
 				}
@@ -174,7 +207,7 @@ func NewApplicationSetCreateCommand(clientOpts *argocdclient.ClientOptions) *cob
 				defer argoio.Close(conn)
 
 				// Get app before creating to see if it is being updated or no change
-				existing, err := appIf.Get(ctx, &applicationset.ApplicationSetGetQuery{Name: appset.Name})
+				existing, err := appIf.Get(ctx, &applicationset.ApplicationSetGetQuery{Name: appset.Name, AppsetNamespace: appset.Namespace})
 				if grpc.UnwrapGRPCStatus(err).Code() != codes.NotFound {
 					errors.CheckError(err)
 				}
@@ -209,6 +242,7 @@ func NewApplicationSetListCommand(clientOpts *argocdclient.ClientOptions) *cobra
 		output   string
 		selector string
 		projects []string
+		appSetNs string
 	)
 	var command = &cobra.Command{
 		Use:   "list",
@@ -222,7 +256,7 @@ func NewApplicationSetListCommand(clientOpts *argocdclient.ClientOptions) *cobra
 
 			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
 			defer argoio.Close(conn)
-			appsets, err := appIf.List(ctx, &applicationset.ApplicationSetListQuery{Selector: selector, Projects: projects})
+			appsets, err := appIf.List(ctx, &applicationset.ApplicationSetListQuery{Selector: selector, Projects: projects, AppsetNamespace: appSetNs})
 			errors.CheckError(err)
 
 			appsetList := appsets.Items
@@ -243,6 +277,7 @@ func NewApplicationSetListCommand(clientOpts *argocdclient.ClientOptions) *cobra
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|name|json|yaml")
 	command.Flags().StringVarP(&selector, "selector", "l", "", "List applicationsets by label")
 	command.Flags().StringArrayVarP(&projects, "project", "p", []string{}, "Filter by project name")
+	command.Flags().StringVarP(&appSetNs, "appset-namespace", "N", "", "Only list ApplicationSets in namespace")
 
 	return command
 }
@@ -275,9 +310,11 @@ func NewApplicationSetDeleteCommand(clientOpts *argocdclient.ClientOptions) *cob
 			if promptFlag.Changed && promptFlag.Value.String() == "true" {
 				noPrompt = true
 			}
-			for _, appsetName := range args {
+			for _, appsetQualifiedName := range args {
+				appsetName, appsetNs := argo.ParseAppQualifiedName(appsetQualifiedName, "")
 				appsetDeleteReq := applicationset.ApplicationSetDeleteRequest{
-					Name: appsetName,
+					Name:            appsetName,
+					AppsetNamespace: appsetNs,
 				}
 
 				if isTerminal && !noPrompt {
@@ -313,6 +350,366 @@ func NewApplicationSetDeleteCommand(clientOpts *argocdclient.ClientOptions) *cob
 	return command
 }
 
+// NewApplicationSetEditCommand returns a new instance of an `argocd appset edit` command
+func NewApplicationSetEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "edit APPSETNAME",
+		Short: "Edit ApplicationSet spec",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appSetName, appSetNs := argo.ParseAppQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
+			defer argoio.Close(conn)
+			appSet, err := appIf.Get(ctx, &applicationset.ApplicationSetGetQuery{Name: appSetName, AppsetNamespace: appSetNs})
+			errors.CheckError(err)
+			appSetData, err := json.Marshal(appSet.Spec)
+			errors.CheckError(err)
+			appSetData, err = yaml.JSONToYAML(appSetData)
+			errors.CheckError(err)
+
+			cli.InteractiveEdit(fmt.Sprintf("%s-*-edit.yaml", appSetName), appSetData, func(input []byte) error {
+				input, err = yaml.YAMLToJSON(input)
+				if err != nil {
+					return err
+				}
+				updatedSpec := arogappsetv1.ApplicationSetSpec{}
+				err = json.Unmarshal(input, &updatedSpec)
+				if err != nil {
+					return err
+				}
+				appSet, err := appIf.Get(ctx, &applicationset.ApplicationSetGetQuery{Name: appSetName, AppsetNamespace: appSetNs})
+				if err != nil {
+					return err
+				}
+				appSet.Spec = updatedSpec
+				_, err = appIf.Update(ctx, &applicationset.ApplicationSetUpdateRequest{Applicationset: appSet})
+				if err != nil {
+					return fmt.Errorf("Failed to update ApplicationSet:\n%v", err)
+				}
+				return err
+			})
+		},
+	}
+	return command
+}
+
+// NewApplicationSetPatchCommand returns a new instance of an `argocd appset patch` command
+func NewApplicationSetPatchCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		patch     string
+		patchType string
+		output    string
+	)
+	var command = &cobra.Command{
+		Use:   "patch APPSETNAME",
+		Short: "Patch an ApplicationSet",
+		Example: templates.Examples(`
+	# Update the path of the template source with a JSON patch
+	argocd appset patch APPSETNAME --patch '[{"op": "replace", "path": "/spec/template/spec/source/path", "value": "guestbook"}]'
+
+	# Update the template project with a merge patch
+	argocd appset patch APPSETNAME --patch '{"spec": {"template": {"spec": {"project": "my-project"}}}}' --type merge
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appSetName, appSetNs := argo.ParseAppQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
+			defer argoio.Close(conn)
+
+			patchedAppSet, err := appIf.Patch(ctx, &applicationset.ApplicationSetPatchRequest{
+				Name:            appSetName,
+				Patch:           patch,
+				PatchType:       patchType,
+				AppsetNamespace: appSetNs,
+			})
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml", "json":
+				err := PrintResource(patchedAppSet, output)
+				errors.CheckError(err)
+			case "wide", "":
+				printAppSetSummaryTable(patchedAppSet)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVar(&patch, "patch", "", "Patch body")
+	command.Flags().StringVar(&patchType, "type", "json", "The type of patch being provided; one of [json merge strategic]")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// NewApplicationSetRefreshCommand returns a new instance of an `argocd appset refresh` command
+func NewApplicationSetRefreshCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		wait    bool
+		timeout uint
+		output  string
+	)
+	var command = &cobra.Command{
+		Use:   "refresh APPSETNAME",
+		Short: "Refresh an ApplicationSet, so that its generators are run and its Applications regenerated immediately",
+		Example: templates.Examples(`
+	# Request the regeneration of the Applications of an ApplicationSet
+	argocd appset refresh APPSETNAME
+
+	# Wait up to 2 minutes until the ApplicationSet controller has regenerated the Applications
+	argocd appset refresh APPSETNAME --wait --timeout 120
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appSetName, appSetNs := argo.ParseAppQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
+			defer argoio.Close(conn)
+
+			if wait && timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+				defer cancel()
+			}
+
+			appSet, err := appIf.Refresh(ctx, &applicationset.ApplicationSetRefreshRequest{
+				Name:            appSetName,
+				Wait:            wait,
+				AppsetNamespace: appSetNs,
+			})
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml", "json":
+				err := PrintResource(appSet, output)
+				errors.CheckError(err)
+			case "wide", "":
+				printAppSetSummaryTable(appSet)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().BoolVar(&wait, "wait", false, "Wait until the ApplicationSet controller has processed the refresh")
+	command.Flags().UintVar(&timeout, "timeout", 0, "Time out after this many seconds when waiting (0 waits indefinitely)")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// NewApplicationSetGenerateCommand returns a new instance of an `argocd appset generate` command
+func NewApplicationSetGenerateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		fileURL  string
+		output   string
+		showDiff bool
+	)
+	var command = &cobra.Command{
+		Use:   "generate",
+		Short: "Generate the Applications of an ApplicationSet, without creating them",
+		Example: templates.Examples(`
+	# Print the Applications generated by an ApplicationSet
+	argocd appset generate -f <filename or URL>
+
+	# Print the generated Applications as YAML
+	argocd appset generate -f <filename or URL> -o yaml
+
+	# Compare the generated Applications with the existing ones
+	argocd appset generate -f <filename or URL> --diff
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if fileURL == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appsets, err := cmdutil.ConstructApplicationSet(fileURL)
+			errors.CheckError(err)
+
+			if len(appsets) != 1 {
+				errors.CheckError(fmt.Errorf("the input file must contain exactly one ApplicationSet, found %d", len(appsets)))
+			}
+			appset := appsets[0]
+			if appset.Name == "" {
+				errors.CheckError(fmt.Errorf("Error generating Applications of ApplicationSet %s. ApplicationSet does not have Name field set", appset))
+			}
+
+			argocdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, appIf := argocdClient.NewApplicationSetClientOrDie()
+			defer argoio.Close(conn)
+
+			res, err := appIf.Generate(ctx, &applicationset.ApplicationSetGenerateRequest{ApplicationSet: appset})
+			errors.CheckError(err)
+
+			generated := make([]argoappv1.Application, len(res.Applications))
+			for i := range res.Applications {
+				generated[i] = *res.Applications[i]
+			}
+
+			if showDiff {
+				appConn, applicationIf := argocdClient.NewApplicationClientOrDie()
+				defer argoio.Close(appConn)
+
+				live, err := getAppSetApplications(ctx, applicationIf, appset.Name, appset.Namespace, generated)
+				errors.CheckError(err)
+				errors.CheckError(printAppSetApplicationsDiff(live, generated))
+				return
+			}
+
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(generated, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printGeneratedApplicationsTable(generated)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&fileURL, "file", "f", "", "Filename or URL of the ApplicationSet to generate Applications for")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().BoolVar(&showDiff, "diff", false, "Show the differences between the generated Applications and the existing ones")
+	return command
+}
+
+// getAppSetApplications returns the existing Applications which either have the name of a generated Application, or
+// are owned by the ApplicationSet, keyed by their name. An empty namespace is the namespace of the control plane.
+func getAppSetApplications(ctx context.Context, appIf applicationpkg.ApplicationServiceClient, appSetName string, appSetNs string, generated []argoappv1.Application) (map[string]*argoappv1.Application, error) {
+	query := &applicationpkg.ApplicationQuery{}
+	if appSetNs != "" {
+		query.AppNamespace = &appSetNs
+	}
+	apps, err := appIf.List(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error listing Applications: %w", err)
+	}
+
+	generatedNames := map[string]bool{}
+	for _, app := range generated {
+		generatedNames[app.Name] = true
+	}
+
+	live := map[string]*argoappv1.Application{}
+	for i := range apps.Items {
+		app := &apps.Items[i]
+		owner := metav1.GetControllerOf(app)
+		isOwned := owner != nil && owner.APIVersion == argoappv1.SchemeGroupVersion.String() && owner.Kind == "ApplicationSet" && owner.Name == appSetName
+		if isOwned || generatedNames[app.Name] {
+			live[app.Name] = app
+		}
+	}
+	return live, nil
+}
+
+// printAppSetApplicationsDiff prints the differences between the existing and the generated Applications. Existing
+// Applications which are not generated anymore are shown as removed.
+func printAppSetApplicationsDiff(live map[string]*argoappv1.Application, generated []argoappv1.Application) error {
+	foundDiffs := false
+	printDiff := func(name string, liveApp *argoappv1.Application, targetApp *argoappv1.Application) error {
+		liveObj, err := applicationForDiff(liveApp)
+		if err != nil {
+			return err
+		}
+		targetObj, err := applicationForDiff(targetApp)
+		if err != nil {
+			return err
+		}
+		if reflect.DeepEqual(liveObj, targetObj) {
+			return nil
+		}
+		foundDiffs = true
+		fmt.Printf("\n===== Application %s ======\n", name)
+		return cli.PrintDiff(name, liveObj, targetObj)
+	}
+
+	for i := range generated {
+		targetApp := &generated[i]
+		if err := printDiff(targetApp.Name, live[targetApp.Name], targetApp); err != nil {
+			return err
+		}
+		delete(live, targetApp.Name)
+	}
+
+	names := make([]string, 0, len(live))
+	for name := range live {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := printDiff(name, live[name], nil); err != nil {
+			return err
+		}
+	}
+
+	if !foundDiffs {
+		fmt.Println("The generated Applications are identical to the existing ones")
+	}
+	return nil
+}
+
+// applicationForDiff returns the fields of an Application which are set by its ApplicationSet
+func applicationForDiff(app *argoappv1.Application) (*unstructured.Unstructured, error) {
+	if app == nil {
+		return nil, nil
+	}
+	obj, err := kube.ToUnstructured(&argoappv1.Application{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       application.ApplicationKind,
+			APIVersion: argoappv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        app.Name,
+			Namespace:   app.Namespace,
+			Labels:      app.Labels,
+			Annotations: app.Annotations,
+			Finalizers:  app.Finalizers,
+		},
+		Spec: app.Spec,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error converting Application %s: %w", app.Name, err)
+	}
+	unstructured.RemoveNestedField(obj.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(obj.Object, "status")
+	return obj, nil
+}
+
+// Print table of generated applications
+func printGeneratedApplicationsTable(apps []argoappv1.Application) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "NAME\tPROJECT\tSERVER\tNAMESPACE\tREPO\tPATH\tTARGET\n")
+	for _, app := range apps {
+		server := app.Spec.Destination.Server
+		if server == "" {
+			server = app.Spec.Destination.Name
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			app.Name,
+			app.Spec.GetProject(),
+			server,
+			app.Spec.Destination.Namespace,
+			app.Spec.Source.RepoURL,
+			app.Spec.Source.Path,
+			app.Spec.Source.TargetRevision,
+		)
+	}
+	_ = w.Flush()
+}
+
 // Print simple list of application names
 func printApplicationSetNames(apps []arogappsetv1.ApplicationSet) {
 	for _, app := range apps {
@@ -401,6 +798,13 @@ func printAppSetConditions(w io.Writer, appSet *arogappsetv1.ApplicationSet) {
 	}
 }
 
+func printAppSetApplicationStatus(w io.Writer, appSet *arogappsetv1.ApplicationSet) {
+	_, _ = fmt.Fprintf(w, "APPLICATION\tHEALTH\tSYNC\tSTATUS\tSTEP\tMESSAGE\tLAST TRANSITION\n")
+	for _, item := range appSet.Status.ApplicationStatus {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", item.Application, item.Health.Status, item.Sync, item.Status, item.Step, item.Message, item.LastTransitionTime)
+	}
+}
+
 func hasAppSetChanged(appReq, appRes *arogappsetv1.ApplicationSet, upsert bool) bool {
 	// upsert==false, no change occurred from create command
 	if !upsert {
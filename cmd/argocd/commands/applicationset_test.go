@@ -1,7 +1,9 @@
 package commands
 
 import (
+	"os"
 	"testing"
+	"text/tabwriter"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	arogappsetv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -68,3 +70,98 @@ func TestPrintApplicationSetTable(t *testing.T) {
 	expectation := "NAME      NAMESPACE  PROJECT  SYNCPOLICY  CONDITIONS\napp-name             default  nil         [{ResourcesUpToDate  <nil> True }]\napp-name             default  nil         [{ResourcesUpToDate  <nil> True }]\n"
 	assert.Equal(t, expectation, output)
 }
+
+func TestPrintAppSetApplicationStatus(t *testing.T) {
+	output, err := captureOutput(func() error {
+		appSet := &arogappsetv1.ApplicationSet{
+			Status: arogappsetv1.ApplicationSetStatus{
+				ApplicationStatus: []arogappsetv1.ApplicationSetApplicationStatus{
+					{
+						Application: "app-dev",
+						Health:      arogappsetv1.HealthStatus{Status: "Healthy"},
+						Sync:        arogappsetv1.SyncStatusCodeSynced,
+						Status:      arogappsetv1.ApplicationSetApplicationStatusHealthy,
+						Step:        "1",
+					},
+					{
+						Application: "app-prod",
+						Health:      arogappsetv1.HealthStatus{Status: "Healthy"},
+						Sync:        arogappsetv1.SyncStatusCodeOutOfSync,
+						Status:      arogappsetv1.ApplicationSetApplicationStatusWaiting,
+						Step:        "2",
+					},
+				},
+			},
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		printAppSetApplicationStatus(w, appSet)
+		return w.Flush()
+	})
+	assert.NoError(t, err)
+	expectation := "APPLICATION  HEALTH   SYNC       STATUS   STEP  MESSAGE  LAST TRANSITION\napp-dev      Healthy  Synced     Healthy  1              <nil>\napp-prod     Healthy  OutOfSync  Waiting  2              <nil>\n"
+	assert.Equal(t, expectation, output)
+}
+
+func TestPrintGeneratedApplicationsTable(t *testing.T) {
+	output, err := captureOutput(func() error {
+		app := v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "engineering-dev-guestbook",
+			},
+			Spec: v1alpha1.ApplicationSpec{
+				Project: "default",
+				Source: v1alpha1.ApplicationSource{
+					RepoURL:        "https://github.com/argoproj/argocd-example-apps.git",
+					Path:           "guestbook",
+					TargetRevision: "HEAD",
+				},
+				Destination: v1alpha1.ApplicationDestination{
+					Name:      "engineering-dev",
+					Namespace: "guestbook",
+				},
+			},
+		}
+		printGeneratedApplicationsTable([]v1alpha1.Application{app})
+		return nil
+	})
+	assert.NoError(t, err)
+	expectation := "NAME                       PROJECT  SERVER           NAMESPACE  REPO                                                 PATH       TARGET\n" +
+		"engineering-dev-guestbook  default  engineering-dev  guestbook  https://github.com/argoproj/argocd-example-apps.git  guestbook  HEAD\n"
+	assert.Equal(t, expectation, output)
+}
+
+func TestApplicationForDiff(t *testing.T) {
+	generated := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: "argocd",
+			Labels:    map[string]string{"env": "dev"},
+		},
+		Spec: v1alpha1.ApplicationSpec{
+			Project: "default",
+		},
+	}
+	live := generated.DeepCopy()
+	live.ResourceVersion = "123"
+	live.CreationTimestamp = metav1.Now()
+	live.Status.Sync.Status = v1alpha1.SyncStatusCodeSynced
+
+	generatedObj, err := applicationForDiff(generated)
+	assert.NoError(t, err)
+	liveObj, err := applicationForDiff(live)
+	assert.NoError(t, err)
+
+	// Fields which are not set by the ApplicationSet are ignored
+	assert.Equal(t, generatedObj, liveObj)
+	assert.Equal(t, "Application", liveObj.GetKind())
+	assert.Equal(t, map[string]string{"env": "dev"}, liveObj.GetLabels())
+
+	live.Spec.Project = "other"
+	liveObj, err = applicationForDiff(live)
+	assert.NoError(t, err)
+	assert.NotEqual(t, generatedObj, liveObj)
+
+	nilObj, err := applicationForDiff(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, nilObj)
+}
@@ -6,13 +6,16 @@ import (
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/headless"
 	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
 	projectpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/project"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/io"
 )
@@ -33,6 +36,7 @@ func NewProjectWindowsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 	roleCommand.AddCommand(NewProjectWindowsDeleteCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsListCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsUpdateCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectWindowsOverrideCommand(clientOpts))
 	return roleCommand
 }
 
@@ -121,6 +125,8 @@ func NewProjectWindowsAddWindowCommand(clientOpts *argocdclient.ClientOptions) *
 		clusters     []string
 		manualSync   bool
 		timeZone     string
+		appSelector  string
+		andOperator  bool
 	)
 	var command = &cobra.Command{
 		Use:   "add PROJECT",
@@ -139,7 +145,13 @@ func NewProjectWindowsAddWindowCommand(clientOpts *argocdclient.ClientOptions) *
 			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
 			errors.CheckError(err)
 
-			err = proj.Spec.AddWindow(kind, schedule, duration, applications, namespaces, clusters, manualSync, timeZone)
+			var selector *metav1.LabelSelector
+			if appSelector != "" {
+				selector, err = metav1.ParseToLabelSelector(appSelector)
+				errors.CheckError(err)
+			}
+
+			err = proj.Spec.AddWindow(kind, schedule, duration, applications, namespaces, clusters, manualSync, timeZone, selector, andOperator)
 			errors.CheckError(err)
 
 			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
@@ -154,6 +166,8 @@ func NewProjectWindowsAddWindowCommand(clientOpts *argocdclient.ClientOptions) *
 	command.Flags().StringSliceVar(&clusters, "clusters", []string{}, "Clusters that the schedule will be applied to. Comma separated, wildcards supported (e.g. --clusters prod,staging)")
 	command.Flags().BoolVar(&manualSync, "manual-sync", false, "Allow manual syncs for both deny and allow windows")
 	command.Flags().StringVar(&timeZone, "time-zone", "UTC", "Time zone of the sync window")
+	command.Flags().StringVar(&appSelector, "application-selector", "", "Label selector of the applications that the schedule will be applied to (e.g. --application-selector tier=critical)")
+	command.Flags().BoolVar(&andOperator, "and-operator", false, "Apply the schedule only to applications matching all of the given applications, namespaces, clusters and application selector")
 
 	return command
 }
@@ -245,6 +259,51 @@ func NewProjectWindowsUpdateCommand(clientOpts *argocdclient.ClientOptions) *cob
 	return command
 }
 
+// NewProjectWindowsOverrideCommand returns a new instance of an `argocd proj windows override` command
+func NewProjectWindowsOverrideCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		duration string
+		reason   string
+	)
+	var command = &cobra.Command{
+		Use:   "override PROJECT APPNAME",
+		Short: "Temporarily allow an application to be synced regardless of the project sync windows",
+		Example: `  # Allow the application "guestbook" to be synced for the next hour
+  argocd proj windows override my-project guestbook --duration 1h --reason "hotfix for incident 42"`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			if reason == "" {
+				errors.CheckError(fmt.Errorf("a reason is required to override sync windows"))
+			}
+			projName := args[0]
+			appName, appNs := argo.ParseAppQualifiedName(args[1], "")
+			dur, err := time.ParseDuration(duration)
+			errors.CheckError(err)
+
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer io.Close(conn)
+
+			_, err = projIf.OverrideSyncWindows(ctx, &projectpkg.SyncWindowsOverrideRequest{
+				Name:         projName,
+				Application:  appName,
+				AppNamespace: appNs,
+				Duration:     int64(dur.Seconds()),
+				Reason:       reason,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Sync windows of application '%s' overridden for %s\n", args[1], dur)
+		},
+	}
+	command.Flags().StringVar(&duration, "duration", "1h", "Duration of the override, at most 24h. (e.g. --duration 30m)")
+	command.Flags().StringVar(&reason, "reason", "", "Reason for overriding the sync windows (required)")
+	return command
+}
+
 // NewProjectWindowsListCommand returns a new instance of an `argocd proj windows list` command
 func NewProjectWindowsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -17,6 +17,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
@@ -41,6 +42,7 @@ type AppOptions struct {
 	valuesFiles                     []string
 	ignoreMissingValueFiles         bool
 	values                          string
+	valuesObject                    string
 	releaseName                     string
 	helmSets                        []string
 	helmSetStrings                  []string
@@ -48,11 +50,14 @@ type AppOptions struct {
 	helmVersion                     string
 	helmPassCredentials             bool
 	helmSkipCrds                    bool
+	helmKubeVersion                 string
+	helmAPIVersions                 []string
 	project                         string
 	syncPolicy                      string
 	syncOptions                     []string
 	autoPrune                       bool
 	selfHeal                        bool
+	retryFailedAfter                time.Duration
 	allowEmpty                      bool
 	namePrefix                      string
 	nameSuffix                      string
@@ -69,6 +74,8 @@ type AppOptions struct {
 	kustomizeCommonAnnotations      []string
 	kustomizeForceCommonLabels      bool
 	kustomizeForceCommonAnnotations bool
+	kustomizeNamespace              string
+	kustomizeReplicas               []string
 	pluginEnvs                      []string
 	Validate                        bool
 	directoryExclude                string
@@ -93,6 +100,7 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().StringArrayVar(&opts.valuesFiles, "values", []string{}, "Helm values file(s) to use")
 	command.Flags().BoolVar(&opts.ignoreMissingValueFiles, "ignore-missing-value-files", false, "Ignore locally missing valueFiles when setting helm template --values")
 	command.Flags().StringVar(&opts.values, "values-literal-file", "", "Filename or URL to import as a literal Helm values block")
+	command.Flags().StringVar(&opts.valuesObject, "values-object-file", "", "Filename or URL to import as the Helm values object, which takes precedence over the values files and literal values block")
 	command.Flags().StringVar(&opts.releaseName, "release-name", "", "Helm release-name")
 	command.Flags().StringVar(&opts.helmVersion, "helm-version", "", "Helm version")
 	command.Flags().BoolVar(&opts.helmPassCredentials, "helm-pass-credentials", false, "Pass credentials to all domain")
@@ -100,11 +108,14 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().StringArrayVar(&opts.helmSetStrings, "helm-set-string", []string{}, "Helm set STRING values on the command line (can be repeated to set several values: --helm-set-string key1=val1 --helm-set-string key2=val2)")
 	command.Flags().StringArrayVar(&opts.helmSetFiles, "helm-set-file", []string{}, "Helm set values from respective files specified via the command line (can be repeated to set several values: --helm-set-file key1=path1 --helm-set-file key2=path2)")
 	command.Flags().BoolVar(&opts.helmSkipCrds, "helm-skip-crds", false, "Skip helm crd installation step")
+	command.Flags().StringVar(&opts.helmKubeVersion, "helm-kube-version", "", "Kubernetes version passed to helm template instead of the version of the destination cluster")
+	command.Flags().StringArrayVar(&opts.helmAPIVersions, "helm-api-versions", []string{}, "Kubernetes API versions passed to helm template instead of the API versions of the destination cluster (can be repeated to set several API versions: --helm-api-versions apps/v1/Deployment --helm-api-versions monitoring.coreos.com/v1/ServiceMonitor)")
 	command.Flags().StringVar(&opts.project, "project", "", "Application project name")
 	command.Flags().StringVar(&opts.syncPolicy, "sync-policy", "", "Set the sync policy (one of: none, automated (aliases of automated: auto, automatic))")
 	command.Flags().StringArrayVar(&opts.syncOptions, "sync-option", []string{}, "Add or remove a sync option, e.g add `Prune=false`. Remove using `!` prefix, e.g. `!Prune=false`")
 	command.Flags().BoolVar(&opts.autoPrune, "auto-prune", false, "Set automatic pruning when sync is automated")
 	command.Flags().BoolVar(&opts.selfHeal, "self-heal", false, "Set self healing when sync is automated")
+	command.Flags().DurationVar(&opts.retryFailedAfter, "retry-failed-after", 0, "Re-attempt a failed automated sync to the same revision after the given duration (e.g. 10m). Zero disables it")
 	command.Flags().BoolVar(&opts.allowEmpty, "allow-empty", false, "Set allow zero live resources when sync is automated")
 	command.Flags().StringVar(&opts.namePrefix, "nameprefix", "", "Kustomize nameprefix")
 	command.Flags().StringVar(&opts.nameSuffix, "namesuffix", "", "Kustomize namesuffix")
@@ -123,6 +134,8 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().StringArrayVar(&opts.kustomizeCommonAnnotations, "kustomize-common-annotation", []string{}, "Set common labels in Kustomize")
 	command.Flags().BoolVar(&opts.kustomizeForceCommonLabels, "kustomize-force-common-label", false, "Force common labels in Kustomize")
 	command.Flags().BoolVar(&opts.kustomizeForceCommonAnnotations, "kustomize-force-common-annotation", false, "Force common annotations in Kustomize")
+	command.Flags().StringVar(&opts.kustomizeNamespace, "kustomize-namespace", "", "Kustomize namespace")
+	command.Flags().StringArrayVar(&opts.kustomizeReplicas, "kustomize-replica", []string{}, "Kustomize replicas (e.g. --kustomize-replica my-deployment=2 --kustomize-replica my-statefulset=4)")
 	command.Flags().StringVar(&opts.directoryExclude, "directory-exclude", "", "Set glob expression used to exclude files from application source path")
 	command.Flags().StringVar(&opts.directoryInclude, "directory-include", "", "Set glob expression used to include files from application source path")
 	command.Flags().Int64Var(&opts.retryLimit, "sync-retry-limit", 0, "Max number of allowed sync retries")
@@ -155,17 +168,13 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 		case "ignore-missing-value-files":
 			setHelmOpt(&spec.Source, helmOpts{ignoreMissingValueFiles: appOpts.ignoreMissingValueFiles})
 		case "values-literal-file":
-			var data []byte
-
-			// read uri
-			parsedURL, err := url.ParseRequestURI(appOpts.values)
-			if err != nil || !(parsedURL.Scheme == "http" || parsedURL.Scheme == "https") {
-				data, err = os.ReadFile(appOpts.values)
-			} else {
-				data, err = config.ReadRemoteFile(appOpts.values)
-			}
+			data, err := readValuesFile(appOpts.values)
 			errors.CheckError(err)
 			setHelmOpt(&spec.Source, helmOpts{values: string(data)})
+		case "values-object-file":
+			data, err := readValuesFile(appOpts.valuesObject)
+			errors.CheckError(err)
+			setHelmOpt(&spec.Source, helmOpts{valuesObject: string(data)})
 		case "release-name":
 			setHelmOpt(&spec.Source, helmOpts{releaseName: appOpts.releaseName})
 		case "helm-version":
@@ -180,6 +189,10 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 			setHelmOpt(&spec.Source, helmOpts{helmSetFiles: appOpts.helmSetFiles})
 		case "helm-skip-crds":
 			setHelmOpt(&spec.Source, helmOpts{skipCrds: appOpts.helmSkipCrds})
+		case "helm-kube-version":
+			setHelmOpt(&spec.Source, helmOpts{kubeVersion: appOpts.helmKubeVersion})
+		case "helm-api-versions":
+			setHelmOpt(&spec.Source, helmOpts{apiVersions: appOpts.helmAPIVersions})
 		case "directory-recurse":
 			if spec.Source.Directory != nil {
 				spec.Source.Directory.Recurse = appOpts.directoryRecurse
@@ -228,6 +241,12 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 			setKustomizeOpt(&spec.Source, kustomizeOpts{forceCommonLabels: appOpts.kustomizeForceCommonLabels})
 		case "kustomize-force-common-annotation":
 			setKustomizeOpt(&spec.Source, kustomizeOpts{forceCommonAnnotations: appOpts.kustomizeForceCommonAnnotations})
+		case "kustomize-namespace":
+			setKustomizeOpt(&spec.Source, kustomizeOpts{namespace: appOpts.kustomizeNamespace})
+		case "kustomize-replica":
+			replicas, err := parseKustomizeReplicas(appOpts.kustomizeReplicas)
+			errors.CheckError(err)
+			setKustomizeOpt(&spec.Source, kustomizeOpts{replicas: replicas})
 		case "jsonnet-tla-str":
 			setJsonnetOpt(&spec.Source, appOpts.jsonnetTlaStr, false)
 		case "jsonnet-tla-code":
@@ -315,6 +334,15 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 		}
 		spec.SyncPolicy.Automated.AllowEmpty = appOpts.allowEmpty
 	}
+	if flags.Changed("retry-failed-after") {
+		if spec.SyncPolicy == nil || spec.SyncPolicy.Automated == nil {
+			log.Fatal("Cannot set --retry-failed-after: application not configured with automatic sync")
+		}
+		spec.SyncPolicy.Automated.RetryFailedAfter = ""
+		if appOpts.retryFailedAfter > 0 {
+			spec.SyncPolicy.Automated.RetryFailedAfter = appOpts.retryFailedAfter.String()
+		}
+	}
 
 	return visited
 }
@@ -328,6 +356,8 @@ type kustomizeOpts struct {
 	commonAnnotations      map[string]string
 	forceCommonLabels      bool
 	forceCommonAnnotations bool
+	namespace              string
+	replicas               argoappv1.KustomizeReplicas
 }
 
 func setKustomizeOpt(src *argoappv1.ApplicationSource, opts kustomizeOpts) {
@@ -355,14 +385,37 @@ func setKustomizeOpt(src *argoappv1.ApplicationSource, opts kustomizeOpts) {
 	if opts.forceCommonAnnotations {
 		src.Kustomize.ForceCommonAnnotations = opts.forceCommonAnnotations
 	}
+	if opts.namespace != "" {
+		src.Kustomize.Namespace = opts.namespace
+	}
 	for _, image := range opts.images {
 		src.Kustomize.MergeImage(argoappv1.KustomizeImage(image))
 	}
+	for _, replica := range opts.replicas {
+		src.Kustomize.MergeReplica(replica)
+	}
 	if src.Kustomize.IsZero() {
 		src.Kustomize = nil
 	}
 }
 
+// parseKustomizeReplicas parses replica count overrides in the form name=count
+func parseKustomizeReplicas(replicas []string) (argoappv1.KustomizeReplicas, error) {
+	var result argoappv1.KustomizeReplicas
+	for _, r := range replicas {
+		parts := strings.SplitN(r, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("expected replica count override of the form name=count. Received: %s", r)
+		}
+		replica := argoappv1.KustomizeReplica{Name: parts[0], Count: intstr.Parse(parts[1])}
+		if _, err := replica.GetIntCount(); err != nil {
+			return nil, err
+		}
+		result = append(result, replica)
+	}
+	return result, nil
+}
+
 func setPluginOptEnvs(src *argoappv1.ApplicationSource, envs []string) {
 	if src.Plugin == nil {
 		src.Plugin = &argoappv1.ApplicationSourcePlugin{}
@@ -381,6 +434,7 @@ type helmOpts struct {
 	valueFiles              []string
 	ignoreMissingValueFiles bool
 	values                  string
+	valuesObject            string
 	releaseName             string
 	version                 string
 	helmSets                []string
@@ -388,6 +442,17 @@ type helmOpts struct {
 	helmSetFiles            []string
 	passCredentials         bool
 	skipCrds                bool
+	kubeVersion             string
+	apiVersions             []string
+}
+
+// readValuesFile reads a Helm values file from a local path or an http(s) URL
+func readValuesFile(path string) ([]byte, error) {
+	parsedURL, err := url.ParseRequestURI(path)
+	if err != nil || !(parsedURL.Scheme == "http" || parsedURL.Scheme == "https") {
+		return os.ReadFile(path)
+	}
+	return config.ReadRemoteFile(path)
 }
 
 func setHelmOpt(src *argoappv1.ApplicationSource, opts helmOpts) {
@@ -403,6 +468,11 @@ func setHelmOpt(src *argoappv1.ApplicationSource, opts helmOpts) {
 	if len(opts.values) > 0 {
 		src.Helm.Values = opts.values
 	}
+	if len(opts.valuesObject) > 0 {
+		if err := src.Helm.SetValuesObject(opts.valuesObject); err != nil {
+			log.Fatal(err)
+		}
+	}
 	if opts.releaseName != "" {
 		src.Helm.ReleaseName = opts.releaseName
 	}
@@ -415,6 +485,12 @@ func setHelmOpt(src *argoappv1.ApplicationSource, opts helmOpts) {
 	if opts.skipCrds {
 		src.Helm.SkipCrds = opts.skipCrds
 	}
+	if opts.kubeVersion != "" {
+		src.Helm.KubeVersion = opts.kubeVersion
+	}
+	if len(opts.apiVersions) > 0 {
+		src.Helm.APIVersions = opts.apiVersions
+	}
 	for _, text := range opts.helmSets {
 		p, err := argoappv1.NewHelmParameter(text, false)
 		if err != nil {
@@ -7,6 +7,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -28,6 +29,11 @@ func Test_setHelmOpt(t *testing.T) {
 		setHelmOpt(&src, helmOpts{ignoreMissingValueFiles: true})
 		assert.Equal(t, true, src.Helm.IgnoreMissingValueFiles)
 	})
+	t.Run("ValuesObject", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setHelmOpt(&src, helmOpts{valuesObject: "replicas: 3\n"})
+		assert.JSONEq(t, `{"replicas": 3}`, string(src.Helm.ValuesObject.Raw))
+	})
 	t.Run("ReleaseName", func(t *testing.T) {
 		src := v1alpha1.ApplicationSource{}
 		setHelmOpt(&src, helmOpts{releaseName: "foo"})
@@ -63,6 +69,16 @@ func Test_setHelmOpt(t *testing.T) {
 		setHelmOpt(&src, helmOpts{skipCrds: true})
 		assert.Equal(t, true, src.Helm.SkipCrds)
 	})
+	t.Run("HelmKubeVersion", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setHelmOpt(&src, helmOpts{kubeVersion: "1.24.0"})
+		assert.Equal(t, "1.24.0", src.Helm.KubeVersion)
+	})
+	t.Run("HelmAPIVersions", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setHelmOpt(&src, helmOpts{apiVersions: []string{"apps/v1/Deployment"}})
+		assert.Equal(t, []string{"apps/v1/Deployment"}, src.Helm.APIVersions)
+	})
 }
 
 func Test_setKustomizeOpt(t *testing.T) {
@@ -101,6 +117,30 @@ func Test_setKustomizeOpt(t *testing.T) {
 		setKustomizeOpt(&src, kustomizeOpts{commonAnnotations: map[string]string{"foo1": "bar1", "foo2": "bar2"}})
 		assert.Equal(t, &v1alpha1.ApplicationSourceKustomize{CommonAnnotations: map[string]string{"foo1": "bar1", "foo2": "bar2"}}, src.Kustomize)
 	})
+	t.Run("Namespace", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setKustomizeOpt(&src, kustomizeOpts{namespace: "custom-namespace"})
+		assert.Equal(t, &v1alpha1.ApplicationSourceKustomize{Namespace: "custom-namespace"}, src.Kustomize)
+	})
+	t.Run("Replicas", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		replicas, err := parseKustomizeReplicas([]string{"my-deployment=2", "my-statefulset=4", "my-deployment=3"})
+		assert.NoError(t, err)
+		setKustomizeOpt(&src, kustomizeOpts{replicas: replicas})
+		assert.Equal(t, &v1alpha1.ApplicationSourceKustomize{Replicas: v1alpha1.KustomizeReplicas{
+			{Name: "my-deployment", Count: intstr.FromInt(3)},
+			{Name: "my-statefulset", Count: intstr.FromInt(4)},
+		}}, src.Kustomize)
+	})
+}
+
+func Test_parseKustomizeReplicas(t *testing.T) {
+	_, err := parseKustomizeReplicas([]string{"my-deployment"})
+	assert.Error(t, err)
+	_, err = parseKustomizeReplicas([]string{"my-deployment=two"})
+	assert.Error(t, err)
+	_, err = parseKustomizeReplicas([]string{"my-deployment=-1"})
+	assert.Error(t, err)
 }
 
 func Test_setJsonnetOpt(t *testing.T) {
@@ -7,6 +7,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/v2/common"
+	repoclient "github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	configUtil "github.com/argoproj/argo-cd/v2/util/config"
 )
 
@@ -21,10 +22,20 @@ type PluginConfig struct {
 }
 
 type PluginConfigSpec struct {
-	Version  string   `json:"version"`
-	Init     Command  `json:"init,omitempty"`
-	Generate Command  `json:"generate"`
-	Discover Discover `json:"discover"`
+	Version    string     `json:"version"`
+	Init       Command    `json:"init,omitempty"`
+	Generate   Command    `json:"generate"`
+	Discover   Discover   `json:"discover"`
+	Parameters Parameters `json:"parameters,omitempty"`
+}
+
+// Parameters holds the parameters announced by the plugin
+type Parameters struct {
+	// Static is a list of parameters announced regardless of the application
+	Static []*repoclient.ParameterAnnouncement `json:"static,omitempty"`
+	// Dynamic is a command run in the application directory, printing a JSON list of parameters announced for the
+	// application
+	Dynamic Command `json:"dynamic,omitempty"`
 }
 
 // Discover holds find and fileName
@@ -74,6 +85,29 @@ func ValidatePluginConfig(config PluginConfig) error {
 	if config.Spec.Discover.Find.Glob == "" && len(config.Spec.Discover.Find.Command.Command) == 0 && config.Spec.Discover.FileName == "" {
 		return fmt.Errorf("invalid plugin configuration file. atleast one of discover.find.command or discover.find.glob or discover.fineName should be non-empty")
 	}
+	if err := validateParameterAnnouncements(config.Spec.Parameters.Static); err != nil {
+		return fmt.Errorf("invalid plugin configuration file. spec.parameters.static: %s", err)
+	}
+	return nil
+}
+
+// validateParameterAnnouncements checks that the announced parameters have a unique name and a supported collection type
+func validateParameterAnnouncements(announcements []*repoclient.ParameterAnnouncement) error {
+	names := map[string]bool{}
+	for _, announcement := range announcements {
+		if announcement == nil || announcement.Name == "" {
+			return fmt.Errorf("parameter name should be non-empty")
+		}
+		if names[announcement.Name] {
+			return fmt.Errorf("parameter %s is announced more than once", announcement.Name)
+		}
+		names[announcement.Name] = true
+		switch announcement.CollectionType {
+		case "", "string", "array", "map":
+		default:
+			return fmt.Errorf("parameter %s has an invalid collectionType %s, it should be one of string, array or map", announcement.Name, announcement.CollectionType)
+		}
+	}
 	return nil
 }
 
@@ -3,6 +3,7 @@ package plugin
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -23,6 +24,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/argoproj/argo-cd/v2/cmpserver/apiclient"
+	repoclient "github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 )
 
 // cmpTimeoutBuffer is the amount of time before the request deadline to timeout server-side work. It makes sure there's
@@ -301,3 +303,80 @@ func (s *Service) matchRepository(ctx context.Context, workdir string, envEntrie
 	}
 	return false, nil
 }
+
+// GetParametersAnnouncement gets parameter announcements for a given Application and repo contents.
+func (s *Service) GetParametersAnnouncement(stream apiclient.ConfigManagementPluginService_GetParametersAnnouncementServer) error {
+	bufferedCtx, cancel := buffered_context.WithEarlierDeadline(stream.Context(), cmpTimeoutBuffer)
+	defer cancel()
+
+	workDir, err := files.CreateTempDir(common.GetCMPWorkDir())
+	if err != nil {
+		return fmt.Errorf("error creating parameters announcement workdir: %s", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(workDir); err != nil {
+			// we panic here as the workDir may contain sensitive information
+			panic(fmt.Sprintf("error removing parameters announcement workdir: %s", err))
+		}
+	}()
+
+	metadata, err := cmp.ReceiveRepoStream(bufferedCtx, stream, workDir)
+	if err != nil {
+		return fmt.Errorf("parameters announcement error receiving stream: %s", err)
+	}
+	appPath := filepath.Clean(filepath.Join(workDir, metadata.AppRelPath))
+	if !strings.HasPrefix(appPath, workDir) {
+		return fmt.Errorf("illegal appPath: out of workDir bound")
+	}
+
+	repoResponse, err := getParametersAnnouncement(bufferedCtx, appPath, s.initConstants.PluginConfig.Spec.Parameters, metadata.GetEnv())
+	if err != nil {
+		return fmt.Errorf("get parameters announcement error: %s", err)
+	}
+
+	err = stream.SendAndClose(repoResponse)
+	if err != nil {
+		return fmt.Errorf("error sending parameters announcement response: %s", err)
+	}
+	return nil
+}
+
+// getParametersAnnouncement returns the static parameters of the plugin, merged with the parameters printed by the
+// dynamic command. A dynamic parameter replaces the static parameter with the same name.
+func getParametersAnnouncement(ctx context.Context, appDir string, parameters Parameters, envEntries []*apiclient.EnvEntry) (*apiclient.ParametersAnnouncementResponse, error) {
+	announcements := append([]*repoclient.ParameterAnnouncement{}, parameters.Static...)
+	if len(parameters.Dynamic.Command) > 0 {
+		env := append(os.Environ(), environ(envEntries)...)
+		stdout, err := runCommand(ctx, parameters.Dynamic, appDir, env)
+		if err != nil {
+			return nil, fmt.Errorf("error executing dynamic parameter output command: %s", err)
+		}
+
+		var dynamicAnnouncements []*repoclient.ParameterAnnouncement
+		err = json.Unmarshal([]byte(stdout), &dynamicAnnouncements)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling dynamic parameter output into ParametersAnnouncementResponse: %s", err)
+		}
+		if err := validateParameterAnnouncements(dynamicAnnouncements); err != nil {
+			return nil, fmt.Errorf("invalid dynamic parameter output: %s", err)
+		}
+
+		for _, dynamicAnnouncement := range dynamicAnnouncements {
+			replaced := false
+			for i := range announcements {
+				if announcements[i].Name == dynamicAnnouncement.Name {
+					announcements[i] = dynamicAnnouncement
+					replaced = true
+					break
+				}
+			}
+			if !replaced {
+				announcements = append(announcements, dynamicAnnouncement)
+			}
+		}
+	}
+
+	return &apiclient.ParametersAnnouncementResponse{
+		ParameterAnnouncements: announcements,
+	}, nil
+}
@@ -11,6 +11,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/v2/cmpserver/apiclient"
+	repoclient "github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/test"
 )
 
@@ -266,3 +267,52 @@ func TestRunCommandContextTimeout(t *testing.T) {
 	assert.Error(t, err) // The command should time out, causing an error.
 	assert.Less(t, after.Sub(before), 1*time.Second)
 }
+
+func TestGetParametersAnnouncement(t *testing.T) {
+	static := []*repoclient.ParameterAnnouncement{
+		{Name: "static-a", CollectionType: "string", String_: "default"},
+		{Name: "static-b", CollectionType: "array", Array: []string{"a", "b"}},
+	}
+	t.Run("static only", func(t *testing.T) {
+		res, err := getParametersAnnouncement(context.Background(), "", Parameters{Static: static}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, static, res.ParameterAnnouncements)
+	})
+	t.Run("dynamic parameters are merged with static parameters", func(t *testing.T) {
+		dynamic := Command{
+			Command: []string{"sh", "-c"},
+			Args:    []string{`echo '[{"name": "static-b", "collectionType": "map", "map": {"key": "'$ENV_VAR'"}}, {"name": "dynamic-a", "required": true}]'`},
+		}
+		res, err := getParametersAnnouncement(context.Background(), "", Parameters{Static: static, Dynamic: dynamic}, []*apiclient.EnvEntry{{Name: "ENV_VAR", Value: "value"}})
+		require.NoError(t, err)
+		assert.Equal(t, []*repoclient.ParameterAnnouncement{
+			{Name: "static-a", CollectionType: "string", String_: "default"},
+			{Name: "static-b", CollectionType: "map", Map: map[string]string{"key": "value"}},
+			{Name: "dynamic-a", Required: true},
+		}, res.ParameterAnnouncements)
+		// the static parameters of the plugin are not modified
+		assert.Equal(t, "array", static[1].CollectionType)
+	})
+	t.Run("invalid dynamic output", func(t *testing.T) {
+		dynamic := Command{Command: []string{"sh", "-c"}, Args: []string{`echo '{"name": "not-a-list"}'`}}
+		_, err := getParametersAnnouncement(context.Background(), "", Parameters{Dynamic: dynamic}, nil)
+		assert.ErrorContains(t, err, "error unmarshaling dynamic parameter output")
+
+		dynamic = Command{Command: []string{"sh", "-c"}, Args: []string{`echo '[{"name": "a", "collectionType": "set"}]'`}}
+		_, err = getParametersAnnouncement(context.Background(), "", Parameters{Dynamic: dynamic}, nil)
+		assert.ErrorContains(t, err, "invalid collectionType")
+	})
+}
+
+func TestValidatePluginConfig_Parameters(t *testing.T) {
+	cic := buildPluginConfig(withDiscover(Discover{FileName: "kustomization.yaml"}))
+	cic.PluginConfig.Spec.Generate = Command{Command: []string{"kustomize", "build"}}
+	cic.PluginConfig.Spec.Parameters.Static = []*repoclient.ParameterAnnouncement{{Name: "a"}, {Name: "b", CollectionType: "map"}}
+	assert.NoError(t, ValidatePluginConfig(cic.PluginConfig))
+
+	cic.PluginConfig.Spec.Parameters.Static = []*repoclient.ParameterAnnouncement{{Name: "a"}, {Name: "a"}}
+	assert.ErrorContains(t, ValidatePluginConfig(cic.PluginConfig), "parameter a is announced more than once")
+
+	cic.PluginConfig.Spec.Parameters.Static = []*repoclient.ParameterAnnouncement{{Title: "no name"}}
+	assert.ErrorContains(t, ValidatePluginConfig(cic.PluginConfig), "parameter name should be non-empty")
+}
@@ -143,6 +143,10 @@ const (
 	// AnnotationCompareOptions is a comma-separated list of options for comparison
 	AnnotationCompareOptions = "argocd.argoproj.io/compare-options"
 
+	// AnnotationSyncWaveTimeout is the maximum time a resource may take to become healthy before the sync operation
+	// proceeds to the next sync wave, after which the sync operation fails. E.g. "300" (seconds) or "5m"
+	AnnotationSyncWaveTimeout = "argocd.argoproj.io/sync-wave-timeout"
+
 	// AnnotationKeyManagedBy is annotation name which indicates that k8s resource is managed by an application.
 	AnnotationKeyManagedBy = "managed-by"
 	// AnnotationValueManagedByArgoCD is a 'managed-by' annotation value for resources managed by Argo CD
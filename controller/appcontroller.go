@@ -114,6 +114,7 @@ type ApplicationController struct {
 	clusterFilter                 func(cluster *appv1.Cluster) bool
 	projByNameCache               sync.Map
 	applicationNamespaces         []string
+	autoSyncLimiter               *autoSyncLimiter
 }
 
 // NewApplicationController creates new instance of ApplicationController.
@@ -135,6 +136,9 @@ func NewApplicationController(
 	persistResourceHealth bool,
 	clusterFilter func(cluster *appv1.Cluster) bool,
 	applicationNamespaces []string,
+	autoSyncRateLimit float64,
+	autoSyncBurst int,
+	autoSyncClusterConcurrency int,
 ) (*ApplicationController, error) {
 	log.Infof("appResyncPeriod=%v, appHardResyncPeriod=%v", appResyncPeriod, appHardResyncPeriod)
 	db := db.NewDB(namespace, settingsMgr, kubeClientset)
@@ -161,6 +165,7 @@ func NewApplicationController(
 		projByNameCache:               sync.Map{},
 		applicationNamespaces:         applicationNamespaces,
 	}
+	ctrl.autoSyncLimiter = newAutoSyncLimiter(autoSyncRateLimit, autoSyncBurst, autoSyncClusterConcurrency, ctrl.getAppByKey)
 	if kubectlParallelismLimit > 0 {
 		ctrl.kubectlSemaphore = semaphore.NewWeighted(kubectlParallelismLimit)
 	}
@@ -1166,6 +1171,51 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		logCtx.Infof("Initialized new operation: %v", *app.Operation)
 	}
 
+	// A scheduled sync is pending until its start time, unless it is terminated meanwhile
+	if syncOp := state.Operation.Sync; syncOp != nil && syncOp.NotBefore != nil && state.SyncResult == nil {
+		if terminating {
+			state.Phase = synccommon.OperationFailed
+			state.Message = "Operation terminated before the scheduled sync started"
+			ctrl.setOperationState(app, state)
+			return
+		}
+		if syncOp.IsScheduled(time.Now()) {
+			startAfter := time.Until(syncOp.NotBefore.Time)
+			logCtx.Infof("Skipping scheduled operation. Starting at: %s", syncOp.NotBefore.Format(time.RFC3339))
+			state.Message = fmt.Sprintf("Sync is scheduled to start at %s", syncOp.NotBefore.Format(time.RFC3339))
+			ctrl.setOperationState(app, state)
+			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &startAfter)
+			return
+		}
+		// the sync windows are evaluated when the scheduled sync starts rather than when it is requested
+		if proj, err := ctrl.getAppProj(app); err == nil && !proj.Spec.SyncWindows.Matches(app).CanSync(true) && !proj.HasSyncWindowOverride(app) {
+			state.Phase = synccommon.OperationFailed
+			state.Message = "Scheduled sync blocked by sync window"
+			ctrl.setOperationState(app, state)
+			return
+		}
+	}
+
+	// A sync is pending until the applications it depends on are synced and healthy
+	if syncOp := state.Operation.Sync; syncOp != nil && len(app.Spec.DependsOn) > 0 && state.SyncResult == nil {
+		message, err := ctrl.waitingForDependencies(app)
+		switch {
+		case err != nil:
+			state.Phase = synccommon.OperationFailed
+			state.Message = fmt.Sprintf("Invalid dependencies: %v", err)
+		case message != "" && terminating:
+			state.Phase = synccommon.OperationFailed
+			state.Message = "Operation terminated while waiting for dependencies"
+		case message != "":
+			logCtx.Infof("Skipping operation: %s", message)
+			state.Message = message
+		}
+		if err != nil || message != "" {
+			ctrl.setOperationState(app, state)
+			return
+		}
+	}
+
 	if err := argo.ValidateDestination(context.Background(), &app.Spec.Destination, ctrl.db); err != nil {
 		state.Phase = synccommon.OperationFailed
 		state.Message = err.Error()
@@ -1389,15 +1439,29 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		localManifests = opState.Operation.Sync.Manifests
 	}
 
-	revision := app.Spec.Source.TargetRevision
-	if comparisonLevel == CompareWithRecent {
-		revision = app.Status.Sync.Revision
+	revisions := make([]string, 0)
+	sources := make([]appv1.ApplicationSource, 0)
+	hasMultipleSources := app.Spec.HasMultipleSources()
+	for i, source := range app.Spec.GetSources() {
+		revision := source.TargetRevision
+		if comparisonLevel == CompareWithRecent {
+			if hasMultipleSources {
+				revision = ""
+				if i < len(app.Status.Sync.Revisions) {
+					revision = app.Status.Sync.Revisions[i]
+				}
+			} else {
+				revision = app.Status.Sync.Revision
+			}
+		}
+		revisions = append(revisions, revision)
+		sources = append(sources, source)
 	}
 
 	now := metav1.Now()
-	compareResult := ctrl.appStateManager.CompareAppState(app, project, revision, app.Spec.Source,
+	compareResult := ctrl.appStateManager.CompareAppState(app, project, revisions, sources,
 		refreshType == appv1.RefreshTypeHard,
-		comparisonLevel == CompareWithLatestForceResolve, localManifests)
+		comparisonLevel == CompareWithLatestForceResolve, localManifests, hasMultipleSources)
 	for k, v := range compareResult.timings {
 		logCtx = logCtx.WithField(k, v.Milliseconds())
 	}
@@ -1411,18 +1475,17 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary()
 	}
 
-	if project.Spec.SyncWindows.Matches(app).CanSync(false) {
+	if project.Spec.SyncWindows.Matches(app).CanSync(false) || project.HasSyncWindowOverride(app) {
 		syncErrCond := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources)
+		autoSyncConditionTypes := map[appv1.ApplicationConditionType]bool{
+			appv1.ApplicationConditionSyncError:              true,
+			appv1.ApplicationConditionAutoSyncQueuedWarning:  true,
+			appv1.ApplicationConditionWaitingForDependencies: true,
+		}
 		if syncErrCond != nil {
-			app.Status.SetConditions(
-				[]appv1.ApplicationCondition{*syncErrCond},
-				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
-			)
+			app.Status.SetConditions([]appv1.ApplicationCondition{*syncErrCond}, autoSyncConditionTypes)
 		} else {
-			app.Status.SetConditions(
-				[]appv1.ApplicationCondition{},
-				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
-			)
+			app.Status.SetConditions([]appv1.ApplicationCondition{}, autoSyncConditionTypes)
 		}
 	} else {
 		logCtx.Info("Sync prevented by sync window")
@@ -1463,9 +1526,12 @@ func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application,
 		// user requested app refresh.
 		refreshType = requestedType
 		reason = fmt.Sprintf("%s refresh requested", refreshType)
-	} else if !app.Spec.Source.Equals(app.Status.Sync.ComparedTo.Source) {
+	} else if !app.Spec.HasMultipleSources() && !app.Spec.Source.Equals(app.Status.Sync.ComparedTo.Source) {
 		reason = "spec.source differs"
 		compareWith = CompareWithLatestForceResolve
+	} else if app.Spec.HasMultipleSources() && !app.Spec.Sources.Equals(app.Status.Sync.ComparedTo.Sources) {
+		reason = "at least one of the spec.sources differs"
+		compareWith = CompareWithLatestForceResolve
 	} else if hardExpired || softExpired {
 		// The commented line below mysteriously crashes if app.Status.ReconciledAt is nil
 		// reason = fmt.Sprintf("comparison expired. reconciledAt: %v, expiry: %v", app.Status.ReconciledAt, statusRefreshTimeout)
@@ -1576,6 +1642,13 @@ func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, new
 
 // autoSync will initiate a sync operation for an application configured with automated sync
 func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *appv1.SyncStatus, resources []appv1.ResourceStatus) *appv1.ApplicationCondition {
+	appKey := ctrl.toAppKey(app.QualifiedName())
+	queued := false
+	defer func() {
+		if !queued {
+			ctrl.dequeueAutoSync(appKey)
+		}
+	}()
 	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil {
 		return nil
 	}
@@ -1611,17 +1684,25 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	}
 
 	desiredCommitSHA := syncStatus.Revision
-	alreadyAttempted, attemptPhase := alreadyAttemptedSync(app, desiredCommitSHA)
+	desiredCommitSHAsMS := syncStatus.Revisions
+	hasMultipleSources := app.Spec.HasMultipleSources()
+	alreadyAttempted, attemptPhase := alreadyAttemptedSync(app, desiredCommitSHA, desiredCommitSHAsMS, hasMultipleSources)
 	selfHeal := app.Spec.SyncPolicy.Automated.SelfHeal
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
-			Revision:    desiredCommitSHA,
 			Prune:       app.Spec.SyncPolicy.Automated.Prune,
 			SyncOptions: app.Spec.SyncPolicy.SyncOptions,
 		},
 		InitiatedBy: appv1.OperationInitiator{Automated: true},
 		Retry:       appv1.RetryStrategy{Limit: 5},
 	}
+	if hasMultipleSources {
+		op.Sync.Revisions = desiredCommitSHAsMS
+		// The revisions of all sources are used in the messages below
+		desiredCommitSHA = strings.Join(desiredCommitSHAsMS, ", ")
+	} else {
+		op.Sync.Revision = desiredCommitSHA
+	}
 	if app.Spec.SyncPolicy.Retry != nil {
 		op.Retry = *app.Spec.SyncPolicy.Retry
 	}
@@ -1631,12 +1712,25 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	// and parameter overrides are different from our most recent sync operation.
 	if alreadyAttempted && (!selfHeal || !attemptPhase.Successful()) {
 		if !attemptPhase.Successful() {
-			logCtx.Warnf("Skipping auto-sync: failed previous sync attempt to %s", desiredCommitSHA)
-			message := fmt.Sprintf("Failed sync attempt to %s: %s", desiredCommitSHA, app.Status.OperationState.Message)
-			return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: message}
+			shouldRetry, retryAfter, err := shouldRetryFailedAutoSync(app, time.Now())
+			if err != nil {
+				logCtx.Warnf("Skipping auto-sync: invalid retryFailedAfter: %v", err)
+				return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: fmt.Sprintf("Invalid automated sync policy: %v", err)}
+			}
+			if !shouldRetry {
+				logCtx.Warnf("Skipping auto-sync: failed previous sync attempt to %s", desiredCommitSHA)
+				message := fmt.Sprintf("Failed sync attempt to %s: %s", desiredCommitSHA, app.Status.OperationState.Message)
+				if retryAfter > 0 {
+					message = fmt.Sprintf("%s (retrying in %v)", message, retryAfter.Round(time.Second))
+					ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &retryAfter)
+				}
+				return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: message}
+			}
+			logCtx.Infof("Re-attempting failed auto-sync to %s", desiredCommitSHA)
+		} else {
+			logCtx.Infof("Skipping auto-sync: most recent sync already to %s", desiredCommitSHA)
+			return nil
 		}
-		logCtx.Infof("Skipping auto-sync: most recent sync already to %s", desiredCommitSHA)
-		return nil
 	} else if alreadyAttempted && selfHeal {
 		if shouldSelfHeal, retryAfter := ctrl.shouldSelfHeal(app); shouldSelfHeal {
 			for _, resource := range resources {
@@ -1670,9 +1764,32 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		}
 	}
 
+	if message, err := ctrl.waitingForDependencies(app); err != nil {
+		logCtx.Warnf("Skipping auto-sync: invalid dependencies: %v", err)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: fmt.Sprintf("Invalid dependencies: %v", err)}
+	} else if message != "" {
+		logCtx.Infof("Skipping auto-sync: %s", message)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionWaitingForDependencies, Message: message}
+	}
+
+	if reason, retryAfter := ctrl.autoSyncLimiter.reserve(appKey, app); reason != "" {
+		queued = true
+		ctrl.metricsServer.IncAutoSyncThrottled(app, reason)
+		ctrl.metricsServer.SetAutoSyncQueued(autoSyncCluster(app), ctrl.autoSyncLimiter.queuedCount(autoSyncCluster(app)))
+		limit := "controller-wide rate limit"
+		if reason == autoSyncThrottledByClusterConcurrency {
+			limit = "concurrency limit of the destination cluster"
+		}
+		message := fmt.Sprintf("Automated sync to %s is queued due to the %s (retrying in %v)", desiredCommitSHA, limit, retryAfter.Round(time.Millisecond))
+		logCtx.Info(message)
+		ctrl.requestAppRefresh(app.QualifiedName(), CompareWithRecent.Pointer(), &retryAfter)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionAutoSyncQueuedWarning, Message: message}
+	}
+
 	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
 	_, err := argo.SetAppOperation(appIf, app.Name, &op)
 	if err != nil {
+		ctrl.autoSyncLimiter.release(appKey, app)
 		logCtx.Errorf("Failed to initiate auto-sync to %s: %v", desiredCommitSHA, err)
 		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: err.Error()}
 	}
@@ -1682,12 +1799,67 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	return nil
 }
 
+// shouldRetryFailedAutoSync returns whether the failed automated sync of the application should be re-attempted at the
+// given time, according to the retryFailedAfter of its automated sync policy. If the sync should be re-attempted later,
+// the remaining time is returned.
+func shouldRetryFailedAutoSync(app *appv1.Application, now time.Time) (bool, time.Duration, error) {
+	retryFailedAfter, err := app.Spec.SyncPolicy.Automated.GetRetryFailedAfter()
+	if err != nil || retryFailedAfter == 0 {
+		return false, 0, err
+	}
+	state := app.Status.OperationState
+	if state == nil || !state.Operation.InitiatedBy.Automated {
+		return false, 0, nil
+	}
+	finishedAt := state.StartedAt.Time
+	if state.FinishedAt != nil {
+		finishedAt = state.FinishedAt.Time
+	}
+	if retryAt := finishedAt.Add(retryFailedAfter); retryAt.After(now) {
+		return false, retryAt.Sub(now), nil
+	}
+	return true, 0, nil
+}
+
+// dequeueAutoSync removes the application with the given key from the queue of throttled automated syncs
+func (ctrl *ApplicationController) dequeueAutoSync(key string) {
+	if cluster, ok := ctrl.autoSyncLimiter.dequeue(key); ok {
+		ctrl.metricsServer.SetAutoSyncQueued(cluster, ctrl.autoSyncLimiter.queuedCount(cluster))
+	}
+}
+
+// getAppByKey returns the application with the given key from the informer cache
+func (ctrl *ApplicationController) getAppByKey(key string) (*appv1.Application, bool) {
+	obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(key)
+	if err != nil || !exists {
+		return nil, false
+	}
+	app, ok := obj.(*appv1.Application)
+	return app, ok
+}
+
 // alreadyAttemptedSync returns whether or not the most recent sync was performed against the
-// commitSHA and with the same app source config which are currently set in the app
-func alreadyAttemptedSync(app *appv1.Application, commitSHA string) (bool, synccommon.OperationPhase) {
+// commitSHA (or the commitSHAs of all sources, for applications with multiple sources) and with
+// the same app source config which are currently set in the app
+func alreadyAttemptedSync(app *appv1.Application, commitSHA string, commitSHAsMS []string, hasMultipleSources bool) (bool, synccommon.OperationPhase) {
 	if app.Status.OperationState == nil || app.Status.OperationState.Operation.Sync == nil || app.Status.OperationState.SyncResult == nil {
 		return false, ""
 	}
+	if hasMultipleSources {
+		if !reflect.DeepEqual(app.Status.OperationState.SyncResult.Revisions, commitSHAsMS) {
+			return false, ""
+		}
+		// Ignore differences in target revisions, since we already just verified commitSHAs are equal
+		specSources := app.Spec.Sources.DeepCopy()
+		for i := range specSources {
+			specSources[i].TargetRevision = ""
+		}
+		syncResSources := app.Status.OperationState.SyncResult.Sources.DeepCopy()
+		for i := range syncResSources {
+			syncResSources[i].TargetRevision = ""
+		}
+		return reflect.DeepEqual(specSources, syncResSources), app.Status.OperationState.Phase
+	}
 	if app.Status.OperationState.SyncResult.Revision != commitSHA {
 		return false, ""
 	}
@@ -1842,6 +2014,9 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 				ctrl.requestAppRefresh(newApp.QualifiedName(), compareWith, nil)
 				ctrl.appOperationQueue.Add(key)
+				if oldOK && newOK && dependencyStatusChanged(oldApp, newApp) {
+					ctrl.requestDependentAppsRefresh(newApp)
+				}
 			},
 			DeleteFunc: func(obj interface{}) {
 				if !ctrl.canProcessApp(obj) {
@@ -1852,6 +2027,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 				if err == nil {
 					ctrl.appRefreshQueue.Add(key)
+					ctrl.dequeueAutoSync(key)
 				}
 			},
 		},
@@ -14,6 +14,7 @@ import (
 	statecache "github.com/argoproj/argo-cd/v2/controller/cache"
 
 	"github.com/argoproj/gitops-engine/pkg/cache/mocks"
+	"github.com/argoproj/gitops-engine/pkg/health"
 	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
@@ -112,6 +113,9 @@ func newFakeController(data *fakeData) *ApplicationController {
 		true,
 		nil,
 		[]string{},
+		0,
+		0,
+		0,
 	)
 	if err != nil {
 		panic(err)
@@ -305,6 +309,32 @@ func TestAutoSync(t *testing.T) {
 	assert.False(t, app.Operation.Sync.Prune)
 }
 
+func TestAutoSyncClusterConcurrency(t *testing.T) {
+	app := newFakeApp()
+	otherApp := newFakeApp()
+	otherApp.Name = "my-other-app"
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, otherApp}})
+	ctrl.autoSyncLimiter = newAutoSyncLimiter(0, 0, 1, ctrl.getAppByKey)
+	syncStatus := argoappv1.SyncStatus{
+		Status:   argoappv1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	resources := []argoappv1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: argoappv1.SyncStatusCodeOutOfSync}}
+
+	cond := ctrl.autoSync(app, &syncStatus, resources)
+	assert.Nil(t, cond)
+
+	cond = ctrl.autoSync(otherApp, &syncStatus, resources)
+	if assert.NotNil(t, cond) {
+		assert.Equal(t, argoappv1.ApplicationConditionAutoSyncQueuedWarning, cond.Type)
+		assert.Contains(t, cond.Message, "concurrency limit of the destination cluster")
+	}
+	otherApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-other-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, otherApp.Operation)
+	assert.Equal(t, 1, ctrl.autoSyncLimiter.queuedCount(otherApp.Spec.Destination.Server))
+}
+
 func TestAutoSyncNotAllowEmpty(t *testing.T) {
 	app := newFakeApp()
 	app.Spec.SyncPolicy.Automated.Prune = true
@@ -473,6 +503,67 @@ func TestAutoSyncIndicateError(t *testing.T) {
 	assert.Nil(t, app.Operation)
 }
 
+// TestAutoSyncRetryFailedAfter verifies we re-attempt a failed automated sync to the same revision after retryFailedAfter
+func TestAutoSyncRetryFailedAfter(t *testing.T) {
+	newFailedApp := func(finishedAt time.Time) *argoappv1.Application {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated.RetryFailedAfter = "10m"
+		app.Status.OperationState = &argoappv1.OperationState{
+			Operation: argoappv1.Operation{
+				Sync:        &argoappv1.SyncOperation{Source: app.Spec.Source.DeepCopy()},
+				InitiatedBy: argoappv1.OperationInitiator{Automated: true},
+			},
+			Phase:      synccommon.OperationFailed,
+			Message:    "admission webhook unavailable",
+			FinishedAt: &metav1.Time{Time: finishedAt},
+			SyncResult: &argoappv1.SyncOperationResult{
+				Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+				Source:   *app.Spec.Source.DeepCopy(),
+			},
+		}
+		return app
+	}
+	syncStatus := argoappv1.SyncStatus{
+		Status:   argoappv1.SyncStatusCodeOutOfSync,
+		Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+	}
+	resources := []argoappv1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: argoappv1.SyncStatusCodeOutOfSync}}
+
+	t.Run("CoolingDown", func(t *testing.T) {
+		app := newFailedApp(time.Now().Add(-5 * time.Minute))
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond := ctrl.autoSync(app, &syncStatus, resources)
+		if assert.NotNil(t, cond) {
+			assert.Equal(t, argoappv1.ApplicationConditionSyncError, cond.Type)
+			assert.Contains(t, cond.Message, "retrying in")
+		}
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+	t.Run("Retried", func(t *testing.T) {
+		app := newFailedApp(time.Now().Add(-11 * time.Minute))
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond := ctrl.autoSync(app, &syncStatus, resources)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		if assert.NotNil(t, app.Operation) {
+			assert.Equal(t, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", app.Operation.Sync.Revision)
+		}
+	})
+	t.Run("ManualSyncFailed", func(t *testing.T) {
+		app := newFailedApp(time.Now().Add(-11 * time.Minute))
+		app.Status.OperationState.Operation.InitiatedBy = argoappv1.OperationInitiator{Username: "admin"}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond := ctrl.autoSync(app, &syncStatus, resources)
+		assert.NotNil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+}
+
 // TestAutoSyncParameterOverrides verifies we auto-sync if revision is same but parameter overrides are different
 func TestAutoSyncParameterOverrides(t *testing.T) {
 	app := newFakeApp()
@@ -1287,6 +1378,127 @@ func TestProcessRequestedAppOperation_HasRetriesTerminated(t *testing.T) {
 	assert.Equal(t, string(synccommon.OperationFailed), phase)
 }
 
+func TestProcessRequestedAppOperation_Scheduled(t *testing.T) {
+	notBefore := metav1.NewTime(time.Now().Add(time.Hour))
+	app := newFakeApp()
+	app.Operation = &argoappv1.Operation{
+		Sync: &argoappv1.SyncOperation{NotBefore: &notBefore},
+	}
+	app.Status.OperationState = nil
+
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}})
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]interface{}{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			assert.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, nil, nil
+	})
+
+	ctrl.processRequestedAppOperation(app)
+
+	phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	assert.Equal(t, string(synccommon.OperationRunning), phase)
+	message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.Equal(t, "Sync is scheduled to start at "+notBefore.Format(time.RFC3339), message)
+	_, ok, _ := unstructured.NestedMap(receivedPatch, "status", "operationState", "syncResult")
+	assert.False(t, ok)
+}
+
+func TestProcessRequestedAppOperation_ScheduledTerminated(t *testing.T) {
+	notBefore := metav1.NewTime(time.Now().Add(time.Hour))
+	app := newFakeApp()
+	app.Operation = &argoappv1.Operation{
+		Sync: &argoappv1.SyncOperation{NotBefore: &notBefore},
+	}
+	app.Status.OperationState = &argoappv1.OperationState{
+		Operation: *app.Operation,
+		Phase:     synccommon.OperationTerminating,
+		StartedAt: metav1.Now(),
+	}
+
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}})
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]interface{}{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			assert.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, nil, nil
+	})
+
+	ctrl.processRequestedAppOperation(app)
+
+	phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	assert.Equal(t, string(synccommon.OperationFailed), phase)
+	message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.Equal(t, "Operation terminated before the scheduled sync started", message)
+}
+
+func TestProcessRequestedAppOperation_ScheduledBlockedBySyncWindow(t *testing.T) {
+	notBefore := metav1.NewTime(time.Now().Add(-time.Minute))
+	app := newFakeApp()
+	app.Operation = &argoappv1.Operation{
+		Sync: &argoappv1.SyncOperation{NotBefore: &notBefore},
+	}
+	app.Status.OperationState = nil
+	proj := defaultProj.DeepCopy()
+	proj.Spec.SyncWindows = argoappv1.SyncWindows{{
+		Kind:         "deny",
+		Schedule:     "* * * * *",
+		Duration:     "1h",
+		Applications: []string{"*"},
+	}}
+
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}})
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]interface{}{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			assert.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, nil, nil
+	})
+
+	ctrl.processRequestedAppOperation(app)
+
+	phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	assert.Equal(t, string(synccommon.OperationFailed), phase)
+	message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.Equal(t, "Scheduled sync blocked by sync window", message)
+}
+
+func TestProcessRequestedAppOperation_WaitingForDependencies(t *testing.T) {
+	dependency := newFakeApp()
+	dependency.Name = "cert-manager"
+	dependency.Status.Sync.Status = argoappv1.SyncStatusCodeSynced
+	dependency.Status.Health.Status = health.HealthStatusProgressing
+	app := newFakeApp()
+	app.Spec.DependsOn = []argoappv1.ApplicationDependency{{Name: "cert-manager"}}
+	app.Operation = &argoappv1.Operation{
+		Sync: &argoappv1.SyncOperation{},
+	}
+	app.Status.OperationState = nil
+
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, dependency, &defaultProj}})
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]interface{}{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			assert.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, nil, nil
+	})
+
+	ctrl.processRequestedAppOperation(app)
+
+	phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	assert.Equal(t, string(synccommon.OperationRunning), phase)
+	message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.Equal(t, "Waiting for dependencies: cert-manager (Synced, Progressing)", message)
+}
+
 func TestGetAppHosts(t *testing.T) {
 	app := newFakeApp()
 	data := &fakeData{
@@ -0,0 +1,182 @@
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// autoSyncThrottledByRateLimit is the reason of automated syncs delayed by the controller-wide rate limit
+	autoSyncThrottledByRateLimit = "rate_limit"
+	// autoSyncThrottledByClusterConcurrency is the reason of automated syncs delayed by the per-cluster concurrency limit
+	autoSyncThrottledByClusterConcurrency = "cluster_concurrency"
+	// autoSyncClusterConcurrencyRetryInterval is the delay before an automated sync delayed by the per-cluster
+	// concurrency limit is retried
+	autoSyncClusterConcurrencyRetryInterval = 10 * time.Second
+	// autoSyncStartTimeout is the time after which an initiated automated sync which the controller has not started
+	// processing yet is no longer considered to be in progress
+	autoSyncStartTimeout = time.Minute
+)
+
+// autoSyncLimiter limits the rate at which the controller initiates automated syncs, and the number of automated syncs
+// running concurrently against each destination cluster. Automated syncs exceeding the limits are queued until they
+// can be initiated.
+type autoSyncLimiter struct {
+	limiter            *rate.Limiter
+	clusterConcurrency int
+	getApp             func(key string) (*appv1.Application, bool)
+
+	lock sync.Mutex
+	// inProgress contains the time at which automated syncs have been initiated, by cluster and application key
+	inProgress map[string]map[string]time.Time
+	// queued contains the destination cluster of the applications which automated sync has been delayed, by
+	// application key
+	queued map[string]string
+}
+
+// newAutoSyncLimiter returns a limiter that initiates at most rateLimit automated syncs per second with bursts of
+// burst syncs, and at most clusterConcurrency concurrent automated syncs per cluster. A rateLimit or a
+// clusterConcurrency of zero disables the respective limit. getApp returns the latest state of an application by key.
+func newAutoSyncLimiter(rateLimit float64, burst int, clusterConcurrency int, getApp func(key string) (*appv1.Application, bool)) *autoSyncLimiter {
+	l := &autoSyncLimiter{
+		clusterConcurrency: clusterConcurrency,
+		getApp:             getApp,
+		inProgress:         map[string]map[string]time.Time{},
+		queued:             map[string]string{},
+	}
+	if rateLimit > 0 {
+		if burst < 1 {
+			burst = 1
+		}
+		l.limiter = rate.NewLimiter(rate.Limit(rateLimit), burst)
+	}
+	return l
+}
+
+// autoSyncCluster returns the cluster the limits of an application apply to
+func autoSyncCluster(app *appv1.Application) string {
+	if app.Spec.Destination.Server != "" {
+		return app.Spec.Destination.Server
+	}
+	return app.Spec.Destination.Name
+}
+
+// isInProgress returns whether the automated sync of the application initiated at the given time is still in progress
+func (l *autoSyncLimiter) isInProgress(key string, initiatedAt time.Time) bool {
+	app, ok := l.getApp(key)
+	if !ok {
+		return false
+	}
+	if app.Operation != nil {
+		return true
+	}
+	state := app.Status.OperationState
+	if state != nil && !state.StartedAt.Time.Before(initiatedAt.Truncate(time.Second)) {
+		return !state.Phase.Completed()
+	}
+	// the controller has not observed the initiated sync yet
+	return time.Since(initiatedAt) < autoSyncStartTimeout
+}
+
+// reserve records an automated sync of the given application if it does not exceed the limits. Otherwise, the
+// application is queued and reserve returns the reason it has been throttled and the delay after which the sync
+// should be retried.
+func (l *autoSyncLimiter) reserve(key string, app *appv1.Application) (string, time.Duration) {
+	cluster := autoSyncCluster(app)
+	if l.clusterConcurrency > 0 {
+		l.forgetCompleted(cluster)
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.clusterConcurrency > 0 {
+		inProgress := l.inProgress[cluster]
+		delete(inProgress, key)
+		if len(inProgress) >= l.clusterConcurrency {
+			l.queued[key] = cluster
+			return autoSyncThrottledByClusterConcurrency, autoSyncClusterConcurrencyRetryInterval
+		}
+	}
+	if l.limiter != nil {
+		r := l.limiter.Reserve()
+		if delay := r.Delay(); delay > 0 {
+			r.Cancel()
+			l.queued[key] = cluster
+			return autoSyncThrottledByRateLimit, delay
+		}
+	}
+	if l.clusterConcurrency > 0 {
+		if _, ok := l.inProgress[cluster]; !ok {
+			l.inProgress[cluster] = map[string]time.Time{}
+		}
+		l.inProgress[cluster][key] = time.Now()
+	}
+	delete(l.queued, key)
+	return "", 0
+}
+
+// forgetCompleted forgets the automated syncs of the given cluster which are no longer in progress. The applications
+// are read without holding the lock, so that reserving the syncs of other clusters is not blocked by the reads.
+func (l *autoSyncLimiter) forgetCompleted(cluster string) {
+	l.lock.Lock()
+	initiated := make(map[string]time.Time, len(l.inProgress[cluster]))
+	for k, initiatedAt := range l.inProgress[cluster] {
+		initiated[k] = initiatedAt
+	}
+	l.lock.Unlock()
+
+	var completed []string
+	for k, initiatedAt := range initiated {
+		if !l.isInProgress(k, initiatedAt) {
+			completed = append(completed, k)
+		}
+	}
+	if len(completed) == 0 {
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	inProgress := l.inProgress[cluster]
+	for _, k := range completed {
+		// the application may have been synced again in the meantime
+		if initiatedAt, ok := inProgress[k]; ok && initiatedAt.Equal(initiated[k]) {
+			delete(inProgress, k)
+		}
+	}
+}
+
+// release forgets the automated sync of the given application recorded by reserve, e.g. because it failed to be
+// initiated
+func (l *autoSyncLimiter) release(key string, app *appv1.Application) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	delete(l.inProgress[autoSyncCluster(app)], key)
+}
+
+// dequeue removes the given application from the queue and returns the cluster it was queued for
+func (l *autoSyncLimiter) dequeue(key string) (string, bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	cluster, ok := l.queued[key]
+	delete(l.queued, key)
+	return cluster, ok
+}
+
+// queuedCount returns the number of applications queued for the given cluster
+func (l *autoSyncLimiter) queuedCount(cluster string) int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	count := 0
+	for _, c := range l.queued {
+		if c == cluster {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func newLimiterTestApp(name string, server string) *argoappv1.Application {
+	return &argoappv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+		Spec:       argoappv1.ApplicationSpec{Destination: argoappv1.ApplicationDestination{Server: server}},
+	}
+}
+
+func TestAutoSyncLimiter_RateLimit(t *testing.T) {
+	l := newAutoSyncLimiter(1, 1, 0, func(key string) (*argoappv1.Application, bool) {
+		return nil, false
+	})
+	app1 := newLimiterTestApp("app1", "https://cluster1")
+	app2 := newLimiterTestApp("app2", "https://cluster1")
+
+	reason, _ := l.reserve("argocd/app1", app1)
+	assert.Empty(t, reason)
+
+	reason, retryAfter := l.reserve("argocd/app2", app2)
+	assert.Equal(t, autoSyncThrottledByRateLimit, reason)
+	assert.True(t, retryAfter > 0)
+	assert.Equal(t, 1, l.queuedCount("https://cluster1"))
+
+	cluster, ok := l.dequeue("argocd/app2")
+	assert.True(t, ok)
+	assert.Equal(t, "https://cluster1", cluster)
+	assert.Equal(t, 0, l.queuedCount("https://cluster1"))
+}
+
+func TestAutoSyncLimiter_ClusterConcurrency(t *testing.T) {
+	apps := map[string]*argoappv1.Application{
+		"argocd/app1": newLimiterTestApp("app1", "https://cluster1"),
+		"argocd/app2": newLimiterTestApp("app2", "https://cluster1"),
+		"argocd/app3": newLimiterTestApp("app3", "https://cluster2"),
+	}
+	l := newAutoSyncLimiter(0, 0, 1, func(key string) (*argoappv1.Application, bool) {
+		app, ok := apps[key]
+		return app, ok
+	})
+
+	reason, _ := l.reserve("argocd/app1", apps["argocd/app1"])
+	assert.Empty(t, reason)
+
+	t.Run("SameCluster", func(t *testing.T) {
+		reason, retryAfter := l.reserve("argocd/app2", apps["argocd/app2"])
+		assert.Equal(t, autoSyncThrottledByClusterConcurrency, reason)
+		assert.Equal(t, autoSyncClusterConcurrencyRetryInterval, retryAfter)
+	})
+	t.Run("OtherCluster", func(t *testing.T) {
+		reason, _ := l.reserve("argocd/app3", apps["argocd/app3"])
+		assert.Empty(t, reason)
+	})
+	t.Run("SyncCompleted", func(t *testing.T) {
+		apps["argocd/app1"].Status.OperationState = &argoappv1.OperationState{
+			Phase:     synccommon.OperationSucceeded,
+			StartedAt: metav1.NewTime(time.Now().Add(time.Second)),
+		}
+		reason, _ := l.reserve("argocd/app2", apps["argocd/app2"])
+		assert.Empty(t, reason)
+		assert.Equal(t, 0, l.queuedCount("https://cluster1"))
+	})
+	t.Run("Released", func(t *testing.T) {
+		l.release("argocd/app2", apps["argocd/app2"])
+		reason, _ := l.reserve("argocd/app1", apps["argocd/app1"])
+		assert.Empty(t, reason)
+	})
+}
+
+func TestAutoSyncLimiter_GetAppWithoutLock(t *testing.T) {
+	apps := map[string]*argoappv1.Application{
+		"argocd/app1": newLimiterTestApp("app1", "https://cluster1"),
+		"argocd/app2": newLimiterTestApp("app2", "https://cluster1"),
+	}
+	var l *autoSyncLimiter
+	l = newAutoSyncLimiter(0, 0, 2, func(key string) (*argoappv1.Application, bool) {
+		// the limiter must not hold its lock while reading applications
+		locked := l.lock.TryLock()
+		if locked {
+			l.lock.Unlock()
+		}
+		assert.True(t, locked, "the lock is held while reading application %s", key)
+		app, ok := apps[key]
+		return app, ok
+	})
+
+	reason, _ := l.reserve("argocd/app1", apps["argocd/app1"])
+	assert.Empty(t, reason)
+	reason, _ = l.reserve("argocd/app2", apps["argocd/app2"])
+	assert.Empty(t, reason)
+	reason, _ = l.reserve("argocd/app1", apps["argocd/app1"])
+	assert.Empty(t, reason)
+}
@@ -0,0 +1,150 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// getAppDependencies returns the applications the given application depends on, among the applications of the same
+// project and namespace, sorted by name. The names of the dependencies which do not exist are returned separately.
+func (ctrl *ApplicationController) getAppDependencies(app *appv1.Application) ([]*appv1.Application, []string, error) {
+	apps, err := ctrl.appLister.Applications(app.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing applications: %w", err)
+	}
+	var dependencies []*appv1.Application
+	var missing []string
+	seen := map[string]bool{}
+	for i := range app.Spec.DependsOn {
+		dependency := &app.Spec.DependsOn[i]
+		if err := dependency.Validate(); err != nil {
+			return nil, nil, err
+		}
+		found := false
+		for _, a := range apps {
+			// an application selecting itself by labels does not depend on itself
+			if a.Spec.GetProject() != app.Spec.GetProject() || (dependency.Selector != nil && a.Name == app.Name) || !dependency.Matches(a) {
+				continue
+			}
+			found = true
+			if !seen[a.Name] {
+				seen[a.Name] = true
+				dependencies = append(dependencies, a)
+			}
+		}
+		if !found && dependency.Name != "" {
+			missing = append(missing, dependency.Name)
+		}
+	}
+	sort.Slice(dependencies, func(i, j int) bool {
+		return dependencies[i].Name < dependencies[j].Name
+	})
+	return dependencies, missing, nil
+}
+
+// findDependencyCycle returns the names of the applications forming a dependency cycle with the given application,
+// starting and ending with the application, or nil if there is no such cycle
+func (ctrl *ApplicationController) findDependencyCycle(app *appv1.Application) []string {
+	visited := map[string]bool{}
+	var path []string
+	var visit func(a *appv1.Application) []string
+	visit = func(a *appv1.Application) []string {
+		dependencies, _, err := ctrl.getAppDependencies(a)
+		if err != nil {
+			// invalid dependencies are reported by the application which declares them
+			return nil
+		}
+		path = append(path, a.Name)
+		for _, dependency := range dependencies {
+			if dependency.Name == app.Name {
+				return append(path, app.Name)
+			}
+			if visited[dependency.Name] {
+				continue
+			}
+			visited[dependency.Name] = true
+			if cycle := visit(dependency); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		return nil
+	}
+	return visit(app)
+}
+
+// waitingForDependencies returns a message listing the dependencies of the application which are not synced and
+// healthy yet, or an empty message if the application can be synced. An error is returned if the dependencies of the
+// application are invalid or form a cycle.
+func (ctrl *ApplicationController) waitingForDependencies(app *appv1.Application) (string, error) {
+	if len(app.Spec.DependsOn) == 0 {
+		return "", nil
+	}
+	dependencies, missing, err := ctrl.getAppDependencies(app)
+	if err != nil {
+		return "", err
+	}
+	if cycle := ctrl.findDependencyCycle(app); cycle != nil {
+		return "", fmt.Errorf("dependency cycle detected: %s", strings.Join(cycle, " -> "))
+	}
+	var waiting []string
+	for _, name := range missing {
+		waiting = append(waiting, fmt.Sprintf("%s (not found)", name))
+	}
+	for _, dependency := range dependencies {
+		syncStatus := dependency.Status.Sync.Status
+		healthStatus := dependency.Status.Health.Status
+		if dependency.Operation != nil {
+			waiting = append(waiting, fmt.Sprintf("%s (operation in progress)", dependency.Name))
+		} else if syncStatus != appv1.SyncStatusCodeSynced || healthStatus != health.HealthStatusHealthy {
+			if syncStatus == "" {
+				syncStatus = appv1.SyncStatusCodeUnknown
+			}
+			if healthStatus == "" {
+				healthStatus = health.HealthStatusUnknown
+			}
+			waiting = append(waiting, fmt.Sprintf("%s (%s, %s)", dependency.Name, syncStatus, healthStatus))
+		}
+	}
+	if len(waiting) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("Waiting for dependencies: %s", strings.Join(waiting, ", ")), nil
+}
+
+// dependencyStatusChanged returns whether the change of an application may allow the applications depending on it
+// to be synced
+func dependencyStatusChanged(oldApp *appv1.Application, newApp *appv1.Application) bool {
+	return oldApp.Status.Sync.Status != newApp.Status.Sync.Status ||
+		oldApp.Status.Health.Status != newApp.Status.Health.Status ||
+		(oldApp.Operation == nil) != (newApp.Operation == nil) ||
+		!labels.Equals(oldApp.Labels, newApp.Labels)
+}
+
+// requestDependentAppsRefresh requests the refresh of the applications depending on the given application, so that
+// their syncs waiting for it are initiated as soon as it is synced and healthy
+func (ctrl *ApplicationController) requestDependentAppsRefresh(app *appv1.Application) {
+	apps, err := ctrl.appLister.Applications(app.Namespace).List(labels.Everything())
+	if err != nil {
+		log.Warnf("Failed to list applications depending on '%s': %v", app.QualifiedName(), err)
+		return
+	}
+	for _, a := range apps {
+		if a.Name == app.Name || a.Spec.GetProject() != app.Spec.GetProject() || !ctrl.canProcessApp(a) {
+			continue
+		}
+		for i := range a.Spec.DependsOn {
+			if a.Spec.DependsOn[i].Matches(app) {
+				ctrl.requestAppRefresh(a.QualifiedName(), nil, nil)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+func newFakeDependencyApp(name string, syncStatus argoappv1.SyncStatusCode, healthStatus health.HealthStatusCode, dependsOn ...argoappv1.ApplicationDependency) *argoappv1.Application {
+	app := newFakeApp()
+	app.Name = name
+	app.Labels = map[string]string{"name": name}
+	app.Spec.DependsOn = dependsOn
+	app.Status.Sync.Status = syncStatus
+	app.Status.Health.Status = healthStatus
+	return app
+}
+
+func TestWaitingForDependencies(t *testing.T) {
+	certManager := newFakeDependencyApp("cert-manager", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+	ingress := newFakeDependencyApp("ingress", argoappv1.SyncStatusCodeOutOfSync, health.HealthStatusProgressing)
+	otherProject := newFakeDependencyApp("other-project", argoappv1.SyncStatusCodeOutOfSync, health.HealthStatusMissing)
+	otherProject.Spec.Project = "other"
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{certManager, ingress, otherProject}})
+
+	t.Run("Ready", func(t *testing.T) {
+		app := newFakeDependencyApp("tenant", "", "", argoappv1.ApplicationDependency{Name: "cert-manager"})
+		message, err := ctrl.waitingForDependencies(app)
+		require.NoError(t, err)
+		assert.Empty(t, message)
+	})
+	t.Run("NotHealthy", func(t *testing.T) {
+		app := newFakeDependencyApp("tenant", "", "", argoappv1.ApplicationDependency{Name: "cert-manager"}, argoappv1.ApplicationDependency{Name: "ingress"})
+		message, err := ctrl.waitingForDependencies(app)
+		require.NoError(t, err)
+		assert.Equal(t, "Waiting for dependencies: ingress (OutOfSync, Progressing)", message)
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		app := newFakeDependencyApp("tenant", "", "", argoappv1.ApplicationDependency{Name: "crds"}, argoappv1.ApplicationDependency{Name: "other-project"})
+		message, err := ctrl.waitingForDependencies(app)
+		require.NoError(t, err)
+		assert.Equal(t, "Waiting for dependencies: crds (not found), other-project (not found)", message)
+	})
+	t.Run("Selector", func(t *testing.T) {
+		app := newFakeDependencyApp("tenant", "", "", argoappv1.ApplicationDependency{Selector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "name", Operator: metav1.LabelSelectorOpExists}},
+		}})
+		message, err := ctrl.waitingForDependencies(app)
+		require.NoError(t, err)
+		assert.Equal(t, "Waiting for dependencies: ingress (OutOfSync, Progressing)", message)
+	})
+	t.Run("InvalidDependency", func(t *testing.T) {
+		app := newFakeDependencyApp("tenant", "", "", argoappv1.ApplicationDependency{})
+		_, err := ctrl.waitingForDependencies(app)
+		assert.EqualError(t, err, "dependency must have either a name or a selector")
+	})
+	t.Run("SelfDependency", func(t *testing.T) {
+		app := newFakeDependencyApp("cert-manager", "", "", argoappv1.ApplicationDependency{Name: "cert-manager"})
+		_, err := ctrl.waitingForDependencies(app)
+		assert.EqualError(t, err, "dependency cycle detected: cert-manager -> cert-manager")
+	})
+}
+
+func TestWaitingForDependencies_Cycle(t *testing.T) {
+	a := newFakeDependencyApp("a", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy, argoappv1.ApplicationDependency{Name: "b"})
+	b := newFakeDependencyApp("b", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy, argoappv1.ApplicationDependency{Name: "c"})
+	c := newFakeDependencyApp("c", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy, argoappv1.ApplicationDependency{Selector: &metav1.LabelSelector{
+		MatchLabels: map[string]string{"name": "a"},
+	}})
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{a, b, c}})
+
+	_, err := ctrl.waitingForDependencies(a)
+	assert.EqualError(t, err, "dependency cycle detected: a -> b -> c -> a")
+}
+
+func TestAutoSyncWaitingForDependencies(t *testing.T) {
+	dependency := newFakeDependencyApp("cert-manager", argoappv1.SyncStatusCodeOutOfSync, health.HealthStatusMissing)
+	app := newFakeApp()
+	app.Spec.DependsOn = []argoappv1.ApplicationDependency{{Name: "cert-manager"}}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, dependency}})
+	syncStatus := argoappv1.SyncStatus{
+		Status:   argoappv1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+
+	cond := ctrl.autoSync(app, &syncStatus, []argoappv1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: argoappv1.SyncStatusCodeOutOfSync}})
+	require.NotNil(t, cond)
+	assert.Equal(t, argoappv1.ApplicationConditionWaitingForDependencies, cond.Type)
+	assert.Equal(t, "Waiting for dependencies: cert-manager (OutOfSync, Missing)", cond.Message)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
+
+func TestDependencyStatusChanged(t *testing.T) {
+	oldApp := newFakeDependencyApp("a", argoappv1.SyncStatusCodeSynced, health.HealthStatusProgressing)
+	newApp := oldApp.DeepCopy()
+	assert.False(t, dependencyStatusChanged(oldApp, newApp))
+	newApp.Status.Health.Status = health.HealthStatusHealthy
+	assert.True(t, dependencyStatusChanged(oldApp, newApp))
+}
@@ -26,17 +26,19 @@ import (
 
 type MetricsServer struct {
 	*http.Server
-	syncCounter             *prometheus.CounterVec
-	kubectlExecCounter      *prometheus.CounterVec
-	kubectlExecPendingGauge *prometheus.GaugeVec
-	k8sRequestCounter       *prometheus.CounterVec
-	clusterEventsCounter    *prometheus.CounterVec
-	redisRequestCounter     *prometheus.CounterVec
-	reconcileHistogram      *prometheus.HistogramVec
-	redisRequestHistogram   *prometheus.HistogramVec
-	registry                *prometheus.Registry
-	hostname                string
-	cron                    *cron.Cron
+	syncCounter              *prometheus.CounterVec
+	kubectlExecCounter       *prometheus.CounterVec
+	kubectlExecPendingGauge  *prometheus.GaugeVec
+	k8sRequestCounter        *prometheus.CounterVec
+	clusterEventsCounter     *prometheus.CounterVec
+	redisRequestCounter      *prometheus.CounterVec
+	reconcileHistogram       *prometheus.HistogramVec
+	redisRequestHistogram    *prometheus.HistogramVec
+	autoSyncThrottledCounter *prometheus.CounterVec
+	autoSyncQueuedGauge      *prometheus.GaugeVec
+	registry                 *prometheus.Registry
+	hostname                 string
+	cron                     *cron.Cron
 }
 
 const (
@@ -138,6 +140,22 @@ var (
 		},
 		[]string{"hostname", "initiator"},
 	)
+
+	autoSyncThrottledCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_app_auto_sync_throttled_total",
+			Help: "Number of automated syncs delayed by the controller rate limits.",
+		},
+		append(descAppDefaultLabels, "dest_server", "reason"),
+	)
+
+	autoSyncQueuedGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "argocd_app_auto_sync_queued",
+			Help: "Number of applications which automated sync is queued due to the controller rate limits.",
+		},
+		[]string{"dest_server"},
+	)
 )
 
 // NewMetricsServer returns a new prometheus server which collects application metrics
@@ -177,6 +195,8 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 	registry.MustRegister(clusterEventsCounter)
 	registry.MustRegister(redisRequestCounter)
 	registry.MustRegister(redisRequestHistogram)
+	registry.MustRegister(autoSyncThrottledCounter)
+	registry.MustRegister(autoSyncQueuedGauge)
 
 	return &MetricsServer{
 		registry: registry,
@@ -184,16 +204,18 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 			Addr:    addr,
 			Handler: mux,
 		},
-		syncCounter:             syncCounter,
-		k8sRequestCounter:       k8sRequestCounter,
-		kubectlExecCounter:      kubectlExecCounter,
-		kubectlExecPendingGauge: kubectlExecPendingGauge,
-		reconcileHistogram:      reconcileHistogram,
-		clusterEventsCounter:    clusterEventsCounter,
-		redisRequestCounter:     redisRequestCounter,
-		redisRequestHistogram:   redisRequestHistogram,
-		hostname:                hostname,
-		cron:                    cron.New(),
+		syncCounter:              syncCounter,
+		k8sRequestCounter:        k8sRequestCounter,
+		kubectlExecCounter:       kubectlExecCounter,
+		kubectlExecPendingGauge:  kubectlExecPendingGauge,
+		reconcileHistogram:       reconcileHistogram,
+		clusterEventsCounter:     clusterEventsCounter,
+		redisRequestCounter:      redisRequestCounter,
+		redisRequestHistogram:    redisRequestHistogram,
+		autoSyncThrottledCounter: autoSyncThrottledCounter,
+		autoSyncQueuedGauge:      autoSyncQueuedGauge,
+		hostname:                 hostname,
+		cron:                     cron.New(),
 	}, nil
 }
 
@@ -270,6 +292,16 @@ func (m *MetricsServer) IncReconcile(app *argoappv1.Application, duration time.D
 	m.reconcileHistogram.WithLabelValues(app.Namespace, app.Spec.Destination.Server).Observe(duration.Seconds())
 }
 
+// IncAutoSyncThrottled increments the counter of automated syncs delayed by the rate limits for an application
+func (m *MetricsServer) IncAutoSyncThrottled(app *argoappv1.Application, reason string) {
+	m.autoSyncThrottledCounter.WithLabelValues(app.Namespace, app.Name, app.Spec.GetProject(), app.Spec.Destination.Server, reason).Inc()
+}
+
+// SetAutoSyncQueued sets the number of applications which automated sync is queued for a cluster
+func (m *MetricsServer) SetAutoSyncQueued(server string, count int) {
+	m.autoSyncQueuedGauge.WithLabelValues(server).Set(float64(count))
+}
+
 // HasExpiration return true if expiration is set
 func (m *MetricsServer) HasExpiration() bool {
 	return len(m.cron.Entries()) > 0
@@ -291,6 +323,7 @@ func (m *MetricsServer) SetExpiration(cacheExpiration time.Duration) error {
 		m.redisRequestCounter.Reset()
 		m.reconcileHistogram.Reset()
 		m.redisRequestHistogram.Reset()
+		m.autoSyncThrottledCounter.Reset()
 	})
 	if err != nil {
 		return err
@@ -378,7 +411,7 @@ func (c *appCollector) collectApps(ch chan<- prometheus.Metric, app *argoappv1.A
 		healthStatus = health.HealthStatusUnknown
 	}
 
-	addGauge(descAppInfo, 1, git.NormalizeGitURL(app.Spec.Source.RepoURL), app.Spec.Destination.Server, app.Spec.Destination.Namespace, string(syncStatus), string(healthStatus), operation)
+	addGauge(descAppInfo, 1, git.NormalizeGitURL(app.Spec.GetSource().RepoURL), app.Spec.Destination.Server, app.Spec.Destination.Namespace, string(syncStatus), string(healthStatus), operation)
 
 	if len(c.appLabels) > 0 {
 		labelValues := []string{}
@@ -36,6 +36,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/gpg"
 	"github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/oci"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 	"github.com/argoproj/argo-cd/v2/util/stats"
 )
@@ -62,7 +63,7 @@ type managedResource struct {
 
 // AppStateManager defines methods which allow to compare application spec and actual application state.
 type AppStateManager interface {
-	CompareAppState(app *v1alpha1.Application, project *appv1.AppProject, revision string, source v1alpha1.ApplicationSource, noCache bool, noRevisionCache bool, localObjects []string) *comparisonResult
+	CompareAppState(app *v1alpha1.Application, project *appv1.AppProject, revisions []string, sources []v1alpha1.ApplicationSource, noCache bool, noRevisionCache bool, localObjects []string, hasMultipleSources bool) *comparisonResult
 	SyncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState)
 }
 
@@ -105,7 +106,7 @@ type appStateManager struct {
 	persistResourceHealth bool
 }
 
-func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1.ApplicationSource, appLabelKey, revision string, noCache, noRevisionCache, verifySignature bool, proj *v1alpha1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
+func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, appLabelKey string, revisions []string, noCache, noRevisionCache, verifySignature bool, proj *v1alpha1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error) {
 	ts := stats.NewTimingStats()
 	helmRepos, err := m.db.ListHelmRepositories(context.Background())
 	if err != nil {
@@ -116,11 +117,6 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 		return nil, nil, err
 	}
 	ts.AddCheckpoint("helm_ms")
-	repo, err := m.db.GetRepository(context.Background(), source.RepoURL)
-	if err != nil {
-		return nil, nil, err
-	}
-	ts.AddCheckpoint("repo_ms")
 	helmRepositoryCredentials, err := m.db.GetAllHelmRepositoryCredentials(context.Background())
 	if err != nil {
 		return nil, nil, err
@@ -135,10 +131,6 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 	}
 	defer io.Close(conn)
 
-	if revision == "" {
-		revision = source.TargetRevision
-	}
-
 	plugins, err := m.settingsMgr.GetConfigManagementPlugins()
 	if err != nil {
 		return nil, nil, err
@@ -158,11 +150,6 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 		return nil, nil, err
 	}
 
-	kustomizeOptions, err := kustomizeSettings.GetOptions(app.Spec.Source)
-	if err != nil {
-		return nil, nil, err
-	}
-
 	helmOptions, err := m.settingsMgr.GetHelmSettings()
 	if err != nil {
 		return nil, nil, err
@@ -173,43 +160,82 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 		return nil, nil, err
 	}
 	ts.AddCheckpoint("version_ms")
-	manifestInfo, err := repoClient.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
-		Repo:               repo,
-		Repos:              permittedHelmRepos,
-		Revision:           revision,
-		NoCache:            noCache,
-		NoRevisionCache:    noRevisionCache,
-		AppLabelKey:        appLabelKey,
-		AppName:            app.InstanceName(m.namespace),
-		Namespace:          app.Spec.Destination.Namespace,
-		ApplicationSource:  &source,
-		Plugins:            tools,
-		KustomizeOptions:   kustomizeOptions,
-		KubeVersion:        serverVersion,
-		ApiVersions:        argo.APIResourcesToStrings(apiResources, true),
-		VerifySignature:    verifySignature,
-		HelmRepoCreds:      permittedHelmCredentials,
-		TrackingMethod:     string(argo.GetTrackingMethod(m.settingsMgr)),
-		EnabledSourceTypes: enabledSourceTypes,
-		HelmOptions:        helmOptions,
-	})
-	if err != nil {
-		return nil, nil, err
+
+	// The sources with a ref may be referenced by the value files of the other sources
+	var refSources appv1.RefTargetRevisionMapping
+	if app.Spec.HasMultipleSources() {
+		refSources, err = argo.GetRefSources(context.Background(), sources, revisions, m.db)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get ref sources: %w", err)
+		}
 	}
-	targetObjs, err := unmarshalManifests(manifestInfo.GetCompiledManifests())
 
-	if err != nil {
-		return nil, nil, err
+	targetObjs := make([]*unstructured.Unstructured, 0)
+	manifestInfos := make([]*apiclient.ManifestResponse, 0)
+	for i, source := range sources {
+		source := source
+		repo, err := m.db.GetRepository(context.Background(), source.RepoURL)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		revision := source.TargetRevision
+		if i < len(revisions) && revisions[i] != "" {
+			revision = revisions[i]
+		}
+
+		kustomizeOptions, err := kustomizeSettings.GetOptions(source)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// The signatures of OCI artifacts are verified with the cosign public keys of the project instead of GnuPG
+		verifySourceSignature := verifySignature
+		if source.IsOCI() {
+			verifySourceSignature = len(proj.Spec.CosignPublicKeys) > 0
+		}
+
+		manifestInfo, err := repoClient.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+			Repo:               repo,
+			Repos:              permittedHelmRepos,
+			Revision:           revision,
+			NoCache:            noCache,
+			NoRevisionCache:    noRevisionCache,
+			AppLabelKey:        appLabelKey,
+			AppName:            app.InstanceName(m.namespace),
+			Namespace:          app.Spec.Destination.Namespace,
+			ApplicationSource:  &source,
+			Plugins:            tools,
+			KustomizeOptions:   kustomizeOptions,
+			KubeVersion:        serverVersion,
+			ApiVersions:        argo.APIResourcesToStrings(apiResources, true),
+			VerifySignature:    verifySourceSignature,
+			HelmRepoCreds:      permittedHelmCredentials,
+			TrackingMethod:     string(argo.GetTrackingMethod(m.settingsMgr)),
+			EnabledSourceTypes: enabledSourceTypes,
+			HelmOptions:        helmOptions,
+			HasMultipleSources: app.Spec.HasMultipleSources(),
+			RefSources:         refSources,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		sourceTargetObjs, err := unmarshalManifests(manifestInfo.GetCompiledManifests())
+		if err != nil {
+			return nil, nil, err
+		}
+		targetObjs = append(targetObjs, sourceTargetObjs...)
+		manifestInfos = append(manifestInfos, manifestInfo)
 	}
 
-	ts.AddCheckpoint("unmarshal_ms")
+	ts.AddCheckpoint("manifests_ms")
 	logCtx := log.WithField("application", app.QualifiedName())
 	for k, v := range ts.Timings() {
 		logCtx = logCtx.WithField(k, v.Milliseconds())
 	}
 	logCtx = logCtx.WithField("time_ms", time.Since(ts.StartTime).Milliseconds())
 	logCtx.Info("getRepoObjs stats")
-	return targetObjs, manifestInfo, nil
+	return targetObjs, manifestInfos, nil
 }
 
 func unmarshalManifests(manifests []string) ([]*unstructured.Unstructured, error) {
@@ -322,10 +348,41 @@ func verifyGnuPGSignature(revision string, project *appv1.AppProject, manifestIn
 	return conditions
 }
 
+// verifyCosignSignature verifies the cosign signatures of an OCI artifact, as returned by the repository server,
+// against the cosign public keys of the project.
+func verifyCosignSignature(revision string, project *appv1.AppProject, manifestInfo *apiclient.ManifestResponse) []appv1.ApplicationCondition {
+	now := metav1.Now()
+	conditions := make([]appv1.ApplicationCondition, 0)
+	if manifestInfo.VerifyResult == "" {
+		msg := fmt.Sprintf("Target revision %s of OCI artifact is not signed, but a signature is required", revision)
+		return append(conditions, appv1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+	}
+	var signatures []oci.Signature
+	if err := json.Unmarshal([]byte(manifestInfo.VerifyResult), &signatures); err != nil {
+		msg := fmt.Sprintf("Could not verify signature of OCI artifact revision '%s': %v", revision, err)
+		return append(conditions, appv1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+	}
+	if _, err := oci.VerifySignatures(revision, signatures, project.Spec.CosignPublicKeys); err != nil {
+		msg := fmt.Sprintf("Found signatures of OCI artifact revision '%s', but none is allowed in AppProject: %v", revision, err)
+		conditions = append(conditions, appv1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+	}
+	return conditions
+}
+
+func hasOCISource(sources []appv1.ApplicationSource) bool {
+	for i := range sources {
+		if sources[i].IsOCI() {
+			return true
+		}
+	}
+	return false
+}
+
 // CompareAppState compares application git state to the live app state, using the specified
-// revision and supplied source. If revision or overrides are empty, then compares against
-// revision and overrides in the app spec.
-func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *appv1.AppProject, revision string, source v1alpha1.ApplicationSource, noCache bool, noRevisionCache bool, localManifests []string) *comparisonResult {
+// revisions and supplied sources. If revisions or overrides are empty, then compares against
+// revisions and overrides in the app spec. The revision at a given index applies to the source
+// at the same index.
+func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *appv1.AppProject, revisions []string, sources []v1alpha1.ApplicationSource, noCache bool, noRevisionCache bool, localManifests []string, hasMultipleSources bool) *comparisonResult {
 	ts := stats.NewTimingStats()
 	appLabelKey, resourceOverrides, resFilter, err := m.getComparisonSettings()
 
@@ -335,7 +392,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 	if err != nil {
 		return &comparisonResult{
 			syncStatus: &v1alpha1.SyncStatus{
-				ComparedTo: appv1.ComparedTo{Source: source, Destination: app.Spec.Destination},
+				ComparedTo: newComparedTo(sources, app.Spec.Destination, hasMultipleSources),
 				Status:     appv1.SyncStatusCodeUnknown,
 			},
 			healthStatus: &appv1.HealthStatus{Status: health.HealthStatusUnknown},
@@ -356,11 +413,11 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 	logCtx.Infof("Comparing app state (cluster: %s, namespace: %s)", app.Spec.Destination.Server, app.Spec.Destination.Namespace)
 
 	var targetObjs []*unstructured.Unstructured
-	var manifestInfo *apiclient.ManifestResponse
+	var manifestInfos []*apiclient.ManifestResponse
 	now := metav1.Now()
 
 	if len(localManifests) == 0 {
-		targetObjs, manifestInfo, err = m.getRepoObjs(app, source, appLabelKey, revision, noCache, noRevisionCache, verifySignature, project)
+		targetObjs, manifestInfos, err = m.getRepoObjs(app, sources, appLabelKey, revisions, noCache, noRevisionCache, verifySignature, project)
 		if err != nil {
 			targetObjs = make([]*unstructured.Unstructured, 0)
 			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
@@ -369,7 +426,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 	} else {
 		// Prevent applying local manifests for now when signature verification is enabled
 		// This is also enforced on API level, but as a last resort, we also enforce it here
-		if gpg.IsGPGEnabled() && verifySignature {
+		if (gpg.IsGPGEnabled() && verifySignature) || (len(project.Spec.CosignPublicKeys) > 0 && hasOCISource(sources)) {
 			msg := "Cannot use local manifests when signature verification is required"
 			targetObjs = make([]*unstructured.Unstructured, 0)
 			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
@@ -382,7 +439,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 				failedToLoadObjs = true
 			}
 		}
-		manifestInfo = nil
+		manifestInfos = nil
 	}
 	ts.AddCheckpoint("git_ms")
 
@@ -461,8 +518,17 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 	}
 
 	// restore comparison using cached diff result if previous comparison was performed for the same revision
-	revisionChanged := manifestInfo == nil || app.Status.Sync.Revision != manifestInfo.Revision
-	specChanged := !reflect.DeepEqual(app.Status.Sync.ComparedTo, appv1.ComparedTo{Source: app.Spec.Source, Destination: app.Spec.Destination})
+	manifestRevisions := make([]string, 0, len(manifestInfos))
+	for _, manifestInfo := range manifestInfos {
+		manifestRevisions = append(manifestRevisions, manifestInfo.Revision)
+	}
+	var revisionChanged bool
+	if hasMultipleSources {
+		revisionChanged = len(manifestInfos) != len(sources) || !reflect.DeepEqual(app.Status.Sync.Revisions, manifestRevisions)
+	} else {
+		revisionChanged = len(manifestInfos) != len(sources) || app.Status.Sync.Revision != manifestInfos[0].Revision
+	}
+	specChanged := !reflect.DeepEqual(app.Status.Sync.ComparedTo, newComparedTo(app.Spec.GetSources(), app.Spec.Destination, app.Spec.HasMultipleSources()))
 	_, refreshRequested := app.IsRefreshRequested()
 	noCache = noCache || refreshRequested || app.Status.Expired(m.statusRefreshTimeout) || specChanged || revisionChanged
 
@@ -592,14 +658,15 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 		syncCode = v1alpha1.SyncStatusCodeUnknown
 	}
 	syncStatus := v1alpha1.SyncStatus{
-		ComparedTo: appv1.ComparedTo{
-			Source:      source,
-			Destination: app.Spec.Destination,
-		},
-		Status: syncCode,
+		ComparedTo: newComparedTo(sources, app.Spec.Destination, hasMultipleSources),
+		Status:     syncCode,
 	}
-	if manifestInfo != nil {
-		syncStatus.Revision = manifestInfo.Revision
+	if len(manifestInfos) == len(sources) {
+		if hasMultipleSources {
+			syncStatus.Revisions = manifestRevisions
+		} else {
+			syncStatus.Revision = manifestInfos[0].Revision
+		}
 	}
 	ts.AddCheckpoint("sync_ms")
 
@@ -611,8 +678,15 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 	// Git has already performed the signature verification via its GPG interface, and the result is available
 	// in the manifest info received from the repository server. We now need to form our opinion about the result
 	// and stop processing if we do not agree about the outcome.
-	if gpg.IsGPGEnabled() && verifySignature && manifestInfo != nil {
-		conditions = append(conditions, verifyGnuPGSignature(revision, project, manifestInfo)...)
+	// The signatures of OCI artifacts are verified with the cosign public keys of the project instead.
+	for i, manifestInfo := range manifestInfos {
+		if i < len(sources) && sources[i].IsOCI() {
+			if len(project.Spec.CosignPublicKeys) > 0 {
+				conditions = append(conditions, verifyCosignSignature(manifestInfo.Revision, project, manifestInfo)...)
+			}
+		} else if gpg.IsGPGEnabled() && verifySignature {
+			conditions = append(conditions, verifyGnuPGSignature(manifestInfo.Revision, project, manifestInfo)...)
+		}
 	}
 
 	compRes := comparisonResult{
@@ -624,8 +698,10 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 		diffConfig:           diffConfig,
 		diffResultList:       diffResults,
 	}
-	if manifestInfo != nil {
-		compRes.appSourceType = v1alpha1.ApplicationSourceType(manifestInfo.SourceType)
+	for _, manifestInfo := range manifestInfos {
+		if manifestInfo.SourceType != "" {
+			compRes.appSourceType = v1alpha1.ApplicationSourceType(manifestInfo.SourceType)
+		}
 	}
 	app.Status.SetConditions(conditions, map[appv1.ApplicationConditionType]bool{
 		appv1.ApplicationConditionComparisonError:         true,
@@ -638,18 +714,37 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 	return &compRes
 }
 
-func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, revision string, source v1alpha1.ApplicationSource, startedAt metav1.Time) error {
+// newComparedTo returns the ComparedTo of an application comparison, the sources are stored in the
+// Sources field if the application has multiple sources, and in the Source field otherwise.
+func newComparedTo(sources []v1alpha1.ApplicationSource, destination v1alpha1.ApplicationDestination, hasMultipleSources bool) v1alpha1.ComparedTo {
+	comparedTo := v1alpha1.ComparedTo{Destination: destination}
+	if hasMultipleSources {
+		comparedTo.Sources = sources
+	} else if len(sources) > 0 {
+		comparedTo.Source = sources[0]
+	}
+	return comparedTo
+}
+
+func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, revision string, source v1alpha1.ApplicationSource, revisions []string, sources []v1alpha1.ApplicationSource, hasMultipleSources bool, startedAt metav1.Time, reason string) error {
 	var nextID int64
 	if len(app.Status.History) > 0 {
 		nextID = app.Status.History.LastRevisionHistory().ID + 1
 	}
-	app.Status.History = append(app.Status.History, v1alpha1.RevisionHistory{
-		Revision:        revision,
+	history := v1alpha1.RevisionHistory{
 		DeployedAt:      metav1.NewTime(time.Now().UTC()),
 		DeployStartedAt: &startedAt,
 		ID:              nextID,
-		Source:          source,
-	})
+		Reason:          reason,
+	}
+	if hasMultipleSources {
+		history.Revisions = revisions
+		history.Sources = sources
+	} else {
+		history.Revision = revision
+		history.Source = source
+	}
+	app.Status.History = append(app.Status.History, history)
 
 	app.Status.History = app.Status.History.Trunc(app.Spec.GetRevisionHistoryLimit())
 
@@ -1,7 +1,14 @@
 package controller
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
 	"io/ioutil"
 	"os"
 	"testing"
@@ -12,6 +19,7 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	. "github.com/argoproj/gitops-engine/pkg/utils/testing"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
@@ -24,6 +32,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/test"
 	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/oci"
 )
 
 // TestCompareAppStateEmpty tests comparison when both git and live have no objects
@@ -39,7 +48,7 @@ func TestCompareAppStateEmpty(t *testing.T) {
 		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 	}
 	ctrl := newFakeController(&data)
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, nil, false)
 	assert.NotNil(t, compRes)
 	assert.NotNil(t, compRes.syncStatus)
 	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -66,7 +75,7 @@ func TestCompareAppStateMissing(t *testing.T) {
 		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 	}
 	ctrl := newFakeController(&data)
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, nil, false)
 	assert.NotNil(t, compRes)
 	assert.NotNil(t, compRes.syncStatus)
 	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
@@ -75,6 +84,35 @@ func TestCompareAppStateMissing(t *testing.T) {
 	assert.Len(t, app.Status.Conditions, 0)
 }
 
+// TestCompareAppStateMultipleSources tests the comparison of an application with multiple sources
+func TestCompareAppStateMultipleSources(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Sources = argoappv1.ApplicationSources{
+		{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "helm-guestbook", Helm: &argoappv1.ApplicationSourceHelm{ValueFiles: []string{"$values/values.yaml"}}},
+		{RepoURL: "https://github.com/argoproj/argocd-example-values", Ref: "values"},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []*apiclient.Manifest{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{"", ""}, app.Spec.Sources, false, false, nil, true)
+	assert.NotNil(t, compRes)
+	assert.NotNil(t, compRes.syncStatus)
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	assert.Equal(t, []string{"abc123", "abc123"}, compRes.syncStatus.Revisions)
+	assert.Empty(t, compRes.syncStatus.Revision)
+	assert.Equal(t, app.Spec.Sources, compRes.syncStatus.ComparedTo.Sources)
+	assert.True(t, compRes.syncStatus.ComparedTo.Source.IsZero())
+	assert.Len(t, app.Status.Conditions, 0)
+}
+
 // TestCompareAppStateExtra tests when there is an extra object in live but not defined in git
 func TestCompareAppStateExtra(t *testing.T) {
 	pod := NewPod()
@@ -93,7 +131,7 @@ func TestCompareAppStateExtra(t *testing.T) {
 		},
 	}
 	ctrl := newFakeController(&data)
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, nil, false)
 	assert.NotNil(t, compRes)
 	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
 	assert.Equal(t, 1, len(compRes.resources))
@@ -123,7 +161,7 @@ func TestCompareAppStateHook(t *testing.T) {
 		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 	}
 	ctrl := newFakeController(&data)
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, nil, false)
 	assert.NotNil(t, compRes)
 	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
 	assert.Equal(t, 0, len(compRes.resources))
@@ -154,7 +192,7 @@ func TestCompareAppStateSkipHook(t *testing.T) {
 		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 	}
 	ctrl := newFakeController(&data)
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, nil, false)
 	assert.NotNil(t, compRes)
 	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
 	assert.Equal(t, 1, len(compRes.resources))
@@ -180,7 +218,7 @@ func TestCompareAppStateCompareOptionIgnoreExtraneous(t *testing.T) {
 	}
 	ctrl := newFakeController(&data)
 
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, nil, false)
 
 	assert.NotNil(t, compRes)
 	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -208,7 +246,7 @@ func TestCompareAppStateExtraHook(t *testing.T) {
 		},
 	}
 	ctrl := newFakeController(&data)
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, nil, false)
 
 	assert.NotNil(t, compRes)
 	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -257,7 +295,7 @@ func TestCompareAppStateDuplicatedNamespacedResources(t *testing.T) {
 		},
 	}
 	ctrl := newFakeController(&data)
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, nil, false)
 
 	assert.NotNil(t, compRes)
 	assert.Equal(t, 1, len(app.Status.Conditions))
@@ -308,7 +346,7 @@ func TestSetHealth(t *testing.T) {
 		},
 	})
 
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, nil, false)
 
 	assert.Equal(t, health.HealthStatusHealthy, compRes.healthStatus.Status)
 }
@@ -340,7 +378,7 @@ func TestSetHealthSelfReferencedApp(t *testing.T) {
 		},
 	})
 
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, nil, false)
 
 	assert.Equal(t, health.HealthStatusHealthy, compRes.healthStatus.Status)
 }
@@ -410,7 +448,7 @@ func TestReturnUnknownComparisonStateOnSettingLoadError(t *testing.T) {
 		},
 	})
 
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, nil, false)
 
 	assert.Equal(t, health.HealthStatusUnknown, compRes.healthStatus.Status)
 	assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
@@ -427,7 +465,7 @@ func Test_appStateManager_persistRevisionHistory(t *testing.T) {
 		app.Spec.RevisionHistoryLimit = &i
 	}
 	addHistory := func() {
-		err := manager.persistRevisionHistory(app, "my-revision", argoappv1.ApplicationSource{}, metav1.Time{})
+		err := manager.persistRevisionHistory(app, "my-revision", argoappv1.ApplicationSource{}, []string{}, []argoappv1.ApplicationSource{}, false, metav1.Time{}, "")
 		assert.NoError(t, err)
 	}
 	addHistory()
@@ -463,9 +501,13 @@ func Test_appStateManager_persistRevisionHistory(t *testing.T) {
 	assert.Len(t, app.Status.History, 9)
 
 	metav1NowTime := metav1.NewTime(time.Now())
-	err := manager.persistRevisionHistory(app, "my-revision", argoappv1.ApplicationSource{}, metav1NowTime)
+	err := manager.persistRevisionHistory(app, "my-revision", argoappv1.ApplicationSource{}, []string{}, []argoappv1.ApplicationSource{}, false, metav1NowTime, "")
 	assert.NoError(t, err)
 	assert.Equal(t, app.Status.History.LastRevisionHistory().DeployStartedAt, &metav1NowTime)
+
+	err = manager.persistRevisionHistory(app, "my-revision", argoappv1.ApplicationSource{}, []string{}, []argoappv1.ApplicationSource{}, false, metav1NowTime, "rollback of a faulty release")
+	assert.NoError(t, err)
+	assert.Equal(t, "rollback of a faulty release", app.Status.History.LastRevisionHistory().Reason)
 }
 
 // helper function to read contents of a file to string
@@ -517,7 +559,7 @@ func TestSignedResponseNoSignatureRequired(t *testing.T) {
 			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 		}
 		ctrl := newFakeController(&data)
-		compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+		compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, nil, false)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
 		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -539,7 +581,7 @@ func TestSignedResponseNoSignatureRequired(t *testing.T) {
 			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 		}
 		ctrl := newFakeController(&data)
-		compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+		compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, nil, false)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
 		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -568,7 +610,7 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 		}
 		ctrl := newFakeController(&data)
-		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, "", app.Spec.Source, false, false, nil)
+		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, nil, false)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
 		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -590,7 +632,7 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 		}
 		ctrl := newFakeController(&data)
-		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, "abc123", app.Spec.Source, false, false, nil)
+		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, []string{"abc123"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, nil, false)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
 		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -612,7 +654,7 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 		}
 		ctrl := newFakeController(&data)
-		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, "abc123", app.Spec.Source, false, false, nil)
+		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, []string{"abc123"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, nil, false)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
 		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -634,7 +676,7 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 		}
 		ctrl := newFakeController(&data)
-		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, "abc123", app.Spec.Source, false, false, nil)
+		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, []string{"abc123"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, nil, false)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
 		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -659,7 +701,7 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 		ctrl := newFakeController(&data)
 		testProj := signedProj
 		testProj.Spec.SignatureKeys[0].KeyID = "4AEE18F83AFDEB24"
-		compRes := ctrl.appStateManager.CompareAppState(app, &testProj, "abc123", app.Spec.Source, false, false, nil)
+		compRes := ctrl.appStateManager.CompareAppState(app, &testProj, []string{"abc123"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, nil, false)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
 		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -684,7 +726,7 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 		// it doesn't matter for our test whether local manifests are valid
 		localManifests := []string{"foobar"}
 		ctrl := newFakeController(&data)
-		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, "abc123", app.Spec.Source, false, false, localManifests)
+		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, []string{"abc123"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, localManifests, false)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
 		assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
@@ -709,7 +751,7 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 		}
 		ctrl := newFakeController(&data)
-		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, "abc123", app.Spec.Source, false, false, nil)
+		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, []string{"abc123"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, nil, false)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
 		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -734,7 +776,7 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 		// it doesn't matter for our test whether local manifests are valid
 		localManifests := []string{""}
 		ctrl := newFakeController(&data)
-		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, "abc123", app.Spec.Source, false, false, localManifests)
+		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, []string{"abc123"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, localManifests, false)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
 		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -745,6 +787,70 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 
 }
 
+func TestSignedResponseCosignSignatureRequired(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	publicKey := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	digest := "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+	sign := func(digest string) string {
+		payload := []byte(`{"critical":{"identity":{"docker-reference":"registry.example.com/org/manifests"},"image":{"docker-manifest-digest":"` + digest + `"},"type":"cosign container image signature"},"optional":null}`)
+		hash := sha256.Sum256(payload)
+		sig, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+		require.NoError(t, err)
+		data, err := json.Marshal([]oci.Signature{{Payload: payload, Signature: base64.StdEncoding.EncodeToString(sig)}})
+		require.NoError(t, err)
+		return string(data)
+	}
+	cosignProj := argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace},
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:      []string{"*"},
+			Destinations:     []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			CosignPublicKeys: []string{publicKey},
+		},
+	}
+	compare := func(verifyResult string, localManifests []string) *argoappv1.Application {
+		app := newFakeApp()
+		app.Spec.Source.RepoURL = "oci://registry.example.com/org/manifests"
+		data := fakeData{
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests:    []*apiclient.Manifest{},
+				Namespace:    test.FakeDestNamespace,
+				Server:       test.FakeClusterURL,
+				Revision:     digest,
+				VerifyResult: verifyResult,
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		}
+		ctrl := newFakeController(&data)
+		compRes := ctrl.appStateManager.CompareAppState(app, &cosignProj, []string{digest}, []argoappv1.ApplicationSource{app.Spec.Source}, false, false, localManifests, false)
+		assert.NotNil(t, compRes)
+		return app
+	}
+
+	t.Run("GoodSignature", func(t *testing.T) {
+		app := compare(sign(digest), nil)
+		assert.Len(t, app.Status.Conditions, 0)
+	})
+	t.Run("SignatureOfOtherArtifact", func(t *testing.T) {
+		app := compare(sign("sha256:fcde2b2edba56bf408601fb721fe9b5c338d10ee429ea04fae5511b68fbf8fb9"), nil)
+		require.Len(t, app.Status.Conditions, 1)
+		assert.Contains(t, app.Status.Conditions[0].Message, "none is allowed in AppProject")
+	})
+	t.Run("NotSigned", func(t *testing.T) {
+		app := compare("", nil)
+		require.Len(t, app.Status.Conditions, 1)
+		assert.Contains(t, app.Status.Conditions[0].Message, "is not signed")
+	})
+	t.Run("LocalManifests", func(t *testing.T) {
+		app := compare("", []string{"foobar"})
+		require.Len(t, app.Status.Conditions, 1)
+		assert.Contains(t, app.Status.Conditions[0].Message, "Cannot use local manifests")
+	})
+}
+
 func TestComparisonResult_GetHealthStatus(t *testing.T) {
 	status := &argoappv1.HealthStatus{Status: health.HealthStatusMissing}
 	res := comparisonResult{
@@ -9,6 +9,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/sync"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
@@ -26,6 +27,7 @@ import (
 	listersv1alpha1 "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/argo/diff"
+	"github.com/argoproj/argo-cd/v2/util/env"
 	logutils "github.com/argoproj/argo-cd/v2/util/log"
 	"github.com/argoproj/argo-cd/v2/util/lua"
 	"github.com/argoproj/argo-cd/v2/util/rand"
@@ -37,6 +39,9 @@ const (
 	// EnvVarSyncWaveDelay is an environment variable which controls the delay in seconds between
 	// each sync-wave
 	EnvVarSyncWaveDelay = "ARGOCD_SYNC_WAVE_DELAY"
+	// EnvVarSyncWaveHealthGating is an environment variable which controls whether the progression of
+	// sync-waves is gated on the observed generation of the resources, instead of a fixed delay
+	EnvVarSyncWaveHealthGating = "ARGOCD_SYNC_WAVE_HEALTH_GATING"
 )
 
 func (m *appStateManager) getOpenAPISchema(server string) (openapi.Resources, error) {
@@ -65,6 +70,8 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	var syncOp v1alpha1.SyncOperation
 	var syncRes *v1alpha1.SyncOperationResult
 	var source v1alpha1.ApplicationSource
+	var sources v1alpha1.ApplicationSources
+	revisions := make([]string, 0)
 
 	if state.Operation.Sync == nil {
 		state.Phase = common.OperationFailed
@@ -82,30 +89,49 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		return
 	}
 
-	if syncOp.Source == nil {
-		// normal sync case (where source is taken from app.spec.source)
-		source = app.Spec.Source
+	isMultiSourceRevision := app.Spec.HasMultipleSources()
+	if syncOp.Source == nil && len(syncOp.Sources) == 0 {
+		// normal sync case (where source is taken from app.spec.source or app.spec.sources)
+		if isMultiSourceRevision {
+			sources = app.Spec.Sources
+		} else {
+			source = app.Spec.Source
+		}
+	} else if len(syncOp.Sources) > 0 {
+		// rollback case of an application with multiple sources
+		sources = syncOp.Sources
+		isMultiSourceRevision = true
 	} else {
 		// rollback case
 		source = *state.Operation.Sync.Source
+		isMultiSourceRevision = false
 	}
 
 	if state.SyncResult != nil {
 		syncRes = state.SyncResult
 		revision = state.SyncResult.Revision
+		revisions = append(revisions, state.SyncResult.Revisions...)
 	} else {
 		syncRes = &v1alpha1.SyncOperationResult{}
 		// status.operationState.syncResult.source. must be set properly since auto-sync relies
 		// on this information to decide if it should sync (if source is different than the last
 		// sync attempt)
-		syncRes.Source = source
+		if isMultiSourceRevision {
+			syncRes.Sources = sources
+		} else {
+			syncRes.Source = source
+		}
 		state.SyncResult = syncRes
 	}
 
-	if revision == "" {
-		// if we get here, it means we did not remember a commit SHA which we should be syncing to.
-		// This typically indicates we are just about to begin a brand new sync/rollback operation.
-		// Take the value in the requested operation. We will resolve this to a SHA later.
+	// if we get here, it means we did not remember a commit SHA which we should be syncing to.
+	// This typically indicates we are just about to begin a brand new sync/rollback operation.
+	// Take the value in the requested operation. We will resolve this to a SHA later.
+	if isMultiSourceRevision {
+		if len(revisions) != len(sources) {
+			revisions = syncOp.Revisions
+		}
+	} else if revision == "" {
 		revision = syncOp.Revision
 	}
 
@@ -116,10 +142,16 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		return
 	}
 
-	compareResult := m.CompareAppState(app, proj, revision, source, false, true, syncOp.Manifests)
-	// We now have a concrete commit SHA. Save this in the sync result revision so that we remember
+	var compareResult *comparisonResult
+	if isMultiSourceRevision {
+		compareResult = m.CompareAppState(app, proj, revisions, sources, false, true, syncOp.Manifests, true)
+	} else {
+		compareResult = m.CompareAppState(app, proj, []string{revision}, []v1alpha1.ApplicationSource{source}, false, true, syncOp.Manifests, false)
+	}
+	// We now have concrete commit SHAs. Save them in the sync result so that we remember
 	// what we should be syncing to when resuming operations.
 	syncRes.Revision = compareResult.syncStatus.Revision
+	syncRes.Revisions = compareResult.syncStatus.Revisions
 
 	// If there are any comparison or spec errors error conditions do not perform the operation
 	if errConditions := app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{
@@ -212,6 +244,16 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	}
 	trackingMethod := argo.GetTrackingMethod(m.settingsMgr)
 
+	var healthOverride health.HealthOverride = lua.ResourceHealthOverrides(resourceOverrides)
+	healthGating := env.ParseBoolFromEnv(EnvVarSyncWaveHealthGating, true)
+	if healthGating {
+		healthOverride = &syncWaveHealthOverride{
+			override:      healthOverride,
+			waveStartedAt: syncRes.WaveStartedAt,
+			now:           time.Now,
+		}
+	}
+
 	syncCtx, cleanup, err := sync.NewSyncContext(
 		compareResult.syncStatus.Revision,
 		reconciliationResult,
@@ -221,7 +263,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		app.Spec.Destination.Namespace,
 		openAPISchema,
 		sync.WithLogr(logutils.NewLogrusLogger(logEntry)),
-		sync.WithHealthOverride(lua.ResourceHealthOverrides(resourceOverrides)),
+		sync.WithHealthOverride(healthOverride),
 		sync.WithPermissionValidator(func(un *unstructured.Unstructured, res *v1.APIResource) error {
 			if !proj.IsGroupKindPermitted(un.GroupVersionKind().GroupKind(), res.Namespaced) {
 				return fmt.Errorf("resource %s:%s is not permitted in project %s", un.GroupVersionKind().Group, un.GroupVersionKind().Kind, proj.Name)
@@ -256,7 +298,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 			}
 			return false
 		}),
-		sync.WithSyncWaveHook(delayBetweenSyncWaves),
+		sync.WithSyncWaveHook(syncWaveHook(syncRes, healthGating)),
 		sync.WithPruneLast(syncOp.SyncOptions.HasOption(common.SyncOptionPruneLast)),
 		sync.WithResourceModificationChecker(syncOp.SyncOptions.HasOption("ApplyOutOfSyncOnly=true"), compareResult.diffResultList),
 		sync.WithPrunePropagationPolicy(&prunePropagationPolicy),
@@ -289,7 +331,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
 
 	if !syncOp.DryRun && len(syncOp.Resources) == 0 && state.Phase.Successful() {
-		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, source, state.StartedAt)
+		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, source, compareResult.syncStatus.Revisions, sources, isMultiSourceRevision, state.StartedAt, syncOp.Reason)
 		if err != nil {
 			state.Phase = common.OperationError
 			state.Message = fmt.Sprintf("failed to record sync to history: %v", err)
@@ -455,9 +497,8 @@ func hasSharedResourceCondition(app *v1alpha1.Application) (bool, string) {
 // _chance_ to react to the spec change that we just applied. This is important because without
 // this, Argo CD will likely assess resource health too quickly (against the stale object), causing
 // hooks to fire prematurely. See: https://github.com/argoproj/argo-cd/issues/4669.
-// Note, this is not foolproof, since a proper fix would require the CRD record
-// status.observedGeneration coupled with a health.lua that verifies
-// status.observedGeneration == metadata.generation
+// The delay is not needed when the sync waves are gated on the observed generation of the resources
+// (see syncWaveHealthOverride).
 func delayBetweenSyncWaves(phase common.SyncPhase, wave int, finalWave bool) error {
 	if !finalWave {
 		delaySec := 2
@@ -471,3 +512,85 @@ func delayBetweenSyncWaves(phase common.SyncPhase, wave int, finalWave bool) err
 	}
 	return nil
 }
+
+// syncWaveHook returns a gitops-engine SyncWaveHook which records the time at which each sync wave
+// was applied. The next sync wave is delayed unless the sync waves are gated on the health of their
+// resources.
+func syncWaveHook(syncRes *v1alpha1.SyncOperationResult, healthGating bool) common.SyncWaveHook {
+	return func(phase common.SyncPhase, wave int, finalWave bool) error {
+		now := v1.Now()
+		syncRes.WaveStartedAt = &now
+		if healthGating {
+			return nil
+		}
+		return delayBetweenSyncWaves(phase, wave, finalWave)
+	}
+}
+
+// syncWaveHealthOverride gates the progression of sync waves on the health of the resources of the
+// current wave. On top of the health assessed by the wrapped override (or the built-in health
+// checks), a resource is progressing until its controller has observed its latest generation, and is
+// degraded if it is not healthy within the timeout of its sync-wave-timeout annotation.
+type syncWaveHealthOverride struct {
+	override      health.HealthOverride
+	waveStartedAt *v1.Time
+	now           func() time.Time
+}
+
+func (o *syncWaveHealthOverride) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
+	var healthStatus *health.HealthStatus
+	if observedGeneration, ok, err := unstructured.NestedInt64(obj.Object, "status", "observedGeneration"); err == nil && ok && observedGeneration < obj.GetGeneration() {
+		healthStatus = &health.HealthStatus{
+			Status:  health.HealthStatusProgressing,
+			Message: fmt.Sprintf("Waiting for generation %d to be observed (observed generation %d)", obj.GetGeneration(), observedGeneration),
+		}
+	} else {
+		healthStatus, err = health.GetResourceHealth(obj, o.override)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if healthStatus == nil || healthStatus.Status == health.HealthStatusHealthy || healthStatus.Status == health.HealthStatusDegraded {
+		return healthStatus, nil
+	}
+
+	timeoutStr, ok := obj.GetAnnotations()[cdcommon.AnnotationSyncWaveTimeout]
+	if !ok {
+		return healthStatus, nil
+	}
+	timeout, err := parseSyncWaveTimeout(timeoutStr)
+	if err != nil {
+		// the gitops-engine ignores the health of resources returning an error, which would leave the sync
+		// waiting for them forever
+		return &health.HealthStatus{
+			Status:  health.HealthStatusDegraded,
+			Message: fmt.Sprintf("Invalid %s annotation: %v", cdcommon.AnnotationSyncWaveTimeout, err),
+		}, nil
+	}
+	if o.waveStartedAt == nil {
+		return healthStatus, nil
+	}
+	if o.now().Sub(o.waveStartedAt.Time) > timeout {
+		message := fmt.Sprintf("Sync wave timeout of %s exceeded", timeout)
+		if healthStatus.Message != "" {
+			message = fmt.Sprintf("%s: %s", message, healthStatus.Message)
+		}
+		return &health.HealthStatus{Status: health.HealthStatusDegraded, Message: message}, nil
+	}
+	return healthStatus, nil
+}
+
+// parseSyncWaveTimeout parses the value of the sync-wave-timeout annotation, either a number of
+// seconds or a duration such as "5m"
+func parseSyncWaveTimeout(timeoutStr string) (time.Duration, error) {
+	var timeout time.Duration
+	if val, err := strconv.Atoi(timeoutStr); err == nil {
+		timeout = time.Duration(val) * time.Second
+	} else if timeout, err = time.ParseDuration(timeoutStr); err != nil {
+		return 0, fmt.Errorf("unable to parse %s as a duration", timeoutStr)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("timeout %s must be positive", timeoutStr)
+	}
+	return timeout, nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/sync"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
@@ -14,11 +16,13 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	cdcommon "github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/controller/testdata"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/test"
 	"github.com/argoproj/argo-cd/v2/util/argo/diff"
+	"github.com/argoproj/argo-cd/v2/util/lua"
 )
 
 func TestPersistRevisionHistory(t *testing.T) {
@@ -348,3 +352,109 @@ func TestNormalizeTargetResources(t *testing.T) {
 		assert.Equal(t, 2, len(containers))
 	})
 }
+
+func TestSyncWaveHealthOverride(t *testing.T) {
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	newObj := func(generation int64, observedGeneration int64, timeout string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Database",
+			"metadata":   map[string]interface{}{"name": "db", "namespace": test.FakeDestNamespace},
+			"status":     map[string]interface{}{"observedGeneration": observedGeneration},
+		}}
+		obj.SetGeneration(generation)
+		if timeout != "" {
+			obj.SetAnnotations(map[string]string{cdcommon.AnnotationSyncWaveTimeout: timeout})
+		}
+		return obj
+	}
+	newOverride := func(waveStartedAt time.Time) *syncWaveHealthOverride {
+		startedAt := v1.NewTime(waveStartedAt)
+		return &syncWaveHealthOverride{
+			override:      lua.ResourceHealthOverrides{},
+			waveStartedAt: &startedAt,
+			now:           func() time.Time { return now },
+		}
+	}
+
+	t.Run("ObservedGenerationUpToDate", func(t *testing.T) {
+		healthStatus, err := newOverride(now).GetResourceHealth(newObj(2, 2, ""))
+		require.NoError(t, err)
+		assert.Nil(t, healthStatus)
+	})
+	t.Run("ObservedGenerationStale", func(t *testing.T) {
+		healthStatus, err := newOverride(now).GetResourceHealth(newObj(2, 1, ""))
+		require.NoError(t, err)
+		require.NotNil(t, healthStatus)
+		assert.Equal(t, health.HealthStatusProgressing, healthStatus.Status)
+		assert.Contains(t, healthStatus.Message, "Waiting for generation 2")
+	})
+	t.Run("WithinTimeout", func(t *testing.T) {
+		healthStatus, err := newOverride(now.Add(-time.Minute)).GetResourceHealth(newObj(2, 1, "5m"))
+		require.NoError(t, err)
+		require.NotNil(t, healthStatus)
+		assert.Equal(t, health.HealthStatusProgressing, healthStatus.Status)
+	})
+	t.Run("TimeoutExceeded", func(t *testing.T) {
+		healthStatus, err := newOverride(now.Add(-time.Minute)).GetResourceHealth(newObj(2, 1, "30"))
+		require.NoError(t, err)
+		require.NotNil(t, healthStatus)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+		assert.Contains(t, healthStatus.Message, "Sync wave timeout of 30s exceeded")
+	})
+	t.Run("TimeoutIgnoredWhenObserved", func(t *testing.T) {
+		healthStatus, err := newOverride(now.Add(-time.Hour)).GetResourceHealth(newObj(2, 2, "30"))
+		require.NoError(t, err)
+		assert.Nil(t, healthStatus)
+	})
+	t.Run("InvalidTimeout", func(t *testing.T) {
+		healthStatus, err := newOverride(now.Add(-time.Minute)).GetResourceHealth(newObj(2, 1, "soon"))
+		require.NoError(t, err)
+		require.NotNil(t, healthStatus)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+		assert.Contains(t, healthStatus.Message, "Invalid argocd.argoproj.io/sync-wave-timeout annotation")
+	})
+}
+
+func TestSyncWaveHook(t *testing.T) {
+	t.Setenv(EnvVarSyncWaveDelay, "1")
+
+	t.Run("HealthGating", func(t *testing.T) {
+		syncRes := &v1alpha1.SyncOperationResult{}
+		start := time.Now()
+		err := syncWaveHook(syncRes, true)(common.SyncPhaseSync, 0, false)
+		require.NoError(t, err)
+		assert.NotNil(t, syncRes.WaveStartedAt)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+	t.Run("Delay", func(t *testing.T) {
+		syncRes := &v1alpha1.SyncOperationResult{}
+		start := time.Now()
+		err := syncWaveHook(syncRes, false)(common.SyncPhaseSync, 0, false)
+		require.NoError(t, err)
+		assert.NotNil(t, syncRes.WaveStartedAt)
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	})
+}
+
+// TestSyncWaveHealthOverrideInvalidTimeout checks that an invalid sync-wave-timeout annotation fails the
+// resource, since the gitops-engine fails the running tasks which are Degraded but keeps waiting for the
+// ones whose health cannot be assessed
+func TestSyncWaveHealthOverrideInvalidTimeout(t *testing.T) {
+	deployment := test.NewDeployment()
+	deployment.SetGeneration(2)
+	deployment.SetAnnotations(map[string]string{cdcommon.AnnotationSyncWaveTimeout: "soon"})
+	require.NoError(t, unstructured.SetNestedField(deployment.Object, int64(1), "status", "observedGeneration"))
+	startedAt := v1.Now()
+	override := &syncWaveHealthOverride{
+		override:      lua.ResourceHealthOverrides{},
+		waveStartedAt: &startedAt,
+		now:           time.Now,
+	}
+
+	healthStatus, err := health.GetResourceHealth(deployment, override)
+	require.NoError(t, err)
+	require.NotNil(t, healthStatus)
+	assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+	assert.Equal(t, "Invalid argocd.argoproj.io/sync-wave-timeout annotation: unable to parse soon as a duration", healthStatus.Message)
+}
@@ -12,6 +12,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/argoproj/argo-cd/v2/util/glob"
 	"github.com/argoproj/argo-cd/v2/util/notification/settings"
 
 	"github.com/argoproj/notifications-engine/pkg/api"
@@ -56,26 +57,44 @@ func NewController(
 	registry *controller.MetricsRegistry,
 	secretName string,
 	configMapName string,
+	applicationNamespaces []string,
+	selfServiceNotificationEnabled bool,
 ) *notificationController {
 	appClient := client.Resource(applications)
-	appInformer := newInformer(appClient.Namespace(namespace), appLabelSelector)
+	// If we have at least one additional namespace configured, we need to watch on them all.
+	appInformerNs := namespace
+	if len(applicationNamespaces) > 0 {
+		appInformerNs = ""
+	}
+	appInformer := newInformer(appClient.Namespace(appInformerNs), appLabelSelector)
 	appProjInformer := newInformer(newAppProjClient(client, namespace), "")
-	secretInformer := k8s.NewSecretInformer(k8sClient, namespace, secretName)
-	configMapInformer := k8s.NewConfigMapInformer(k8sClient, namespace, configMapName)
-	apiFactory := api.NewFactory(settings.GetFactorySettings(argocdService, secretName, configMapName), namespace, secretInformer, configMapInformer)
+	// Self-service notifications are configured in the namespaces of the applications, so the settings of all
+	// namespaces are watched.
+	settingsInformerNs := namespace
+	if selfServiceNotificationEnabled {
+		settingsInformerNs = ""
+	}
+	secretInformer := k8s.NewSecretInformer(k8sClient, settingsInformerNs, secretName)
+	configMapInformer := k8s.NewConfigMapInformer(k8sClient, settingsInformerNs, configMapName)
+	apiFactory := newNamespacedAPIFactory(settings.GetFactorySettings(argocdService, secretName, configMapName), namespace, selfServiceNotificationEnabled, secretInformer, configMapInformer)
 
 	res := &notificationController{
-		secretInformer:    secretInformer,
-		configMapInformer: configMapInformer,
-		appInformer:       appInformer,
-		appProjInformer:   appProjInformer,
-		apiFactory:        apiFactory}
+		namespace:             namespace,
+		applicationNamespaces: applicationNamespaces,
+		secretInformer:        secretInformer,
+		configMapInformer:     configMapInformer,
+		appInformer:           appInformer,
+		appProjInformer:       appProjInformer,
+		apiFactory:            apiFactory}
 	res.ctrl = controller.NewController(appClient, appInformer, apiFactory,
 		controller.WithSkipProcessing(func(obj v1.Object) (bool, string) {
 			app, ok := (obj).(*unstructured.Unstructured)
 			if !ok {
 				return false, ""
 			}
+			if skip, reason := res.skipAppNamespace(app); skip {
+				return true, reason
+			}
 			return !isAppSyncStatusRefreshed(app, log.WithField("app", obj.GetName())), "sync status out of date"
 		}),
 		controller.WithMetricsRegistry(registry),
@@ -89,7 +108,20 @@ func (c *notificationController) alterDestinations(obj v1.Object, destinations s
 		return destinations
 	}
 
-	if proj := getAppProj(app, c.appProjInformer); proj != nil {
+	// With self-service notifications, the subscriptions of an application outside the control plane's namespace
+	// are resolved with the configuration of its own namespace.
+	if c.apiFactory.isSelfService(app.GetNamespace()) {
+		nsAPI, err := c.apiFactory.getNamespaceAPI(app.GetNamespace())
+		if err != nil {
+			log.WithField("app", app.GetName()).Warnf("Failed to get the notification configuration of namespace %s: %v", app.GetNamespace(), err)
+			return services.Destinations{}
+		}
+		cfg = nsAPI.GetConfig()
+		destinations = cfg.GetGlobalDestinations(app.GetLabels())
+		destinations.Merge(subscriptions.NewAnnotations(app.GetAnnotations()).GetDestinations(cfg.DefaultTriggers, cfg.ServiceDefaultTriggers))
+	}
+
+	if proj := getAppProj(app, c.namespace, c.appProjInformer); proj != nil {
 		destinations.Merge(subscriptions.NewAnnotations(proj.GetAnnotations()).GetDestinations(cfg.DefaultTriggers, cfg.ServiceDefaultTriggers))
 		destinations.Merge(settings.GetLegacyDestinations(proj.GetAnnotations(), cfg.DefaultTriggers, cfg.ServiceDefaultTriggers))
 	}
@@ -116,12 +148,34 @@ func newInformer(resClient dynamic.ResourceInterface, selector string) cache.Sha
 }
 
 type notificationController struct {
-	apiFactory        api.Factory
-	ctrl              controller.NotificationController
-	appInformer       cache.SharedIndexInformer
-	appProjInformer   cache.SharedIndexInformer
-	secretInformer    cache.SharedIndexInformer
-	configMapInformer cache.SharedIndexInformer
+	namespace             string
+	applicationNamespaces []string
+	apiFactory            *namespacedAPIFactory
+	ctrl                  controller.NotificationController
+	appInformer           cache.SharedIndexInformer
+	appProjInformer       cache.SharedIndexInformer
+	secretInformer        cache.SharedIndexInformer
+	configMapInformer     cache.SharedIndexInformer
+}
+
+// skipAppNamespace returns whether notifications must not be sent for the application, because it is neither in
+// the control plane's namespace nor in an enabled namespace, or because its project does not allow its namespace.
+func (c *notificationController) skipAppNamespace(app *unstructured.Unstructured) (bool, string) {
+	if app.GetNamespace() == c.namespace {
+		return false, ""
+	}
+	if !glob.MatchStringInList(c.applicationNamespaces, app.GetNamespace(), false) {
+		return true, fmt.Sprintf("namespace %s is not enabled", app.GetNamespace())
+	}
+	proj := getAppProj(app, c.namespace, c.appProjInformer)
+	if proj == nil {
+		return true, "project not found"
+	}
+	sourceNamespaces, _, err := unstructured.NestedStringSlice(proj.Object, "spec", "sourceNamespaces")
+	if err != nil || !glob.MatchStringInList(sourceNamespaces, app.GetNamespace(), false) {
+		return true, fmt.Sprintf("application is not allowed to use project %s", proj.GetName())
+	}
+	return false, ""
 }
 
 func (c *notificationController) Init(ctx context.Context) error {
@@ -140,12 +194,14 @@ func (c *notificationController) Run(ctx context.Context, processors int) {
 	c.ctrl.Run(processors, ctx.Done())
 }
 
-func getAppProj(app *unstructured.Unstructured, appProjInformer cache.SharedIndexInformer) *unstructured.Unstructured {
+// getAppProj returns the project of the application. Projects are always defined in the control plane's namespace,
+// even for applications in other namespaces.
+func getAppProj(app *unstructured.Unstructured, namespace string, appProjInformer cache.SharedIndexInformer) *unstructured.Unstructured {
 	projName, ok, err := unstructured.NestedString(app.Object, "spec", "project")
 	if !ok || err != nil {
 		return nil
 	}
-	projObj, ok, err := appProjInformer.GetIndexer().GetByKey(fmt.Sprintf("%s/%s", app.GetNamespace(), projName))
+	projObj, ok, err := appProjInformer.GetIndexer().GetByKey(fmt.Sprintf("%s/%s", namespace, projName))
 	if !ok || err != nil {
 		return nil
 	}
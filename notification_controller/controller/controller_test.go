@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+)
+
+func newTestApp(namespace string, project string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "Application",
+		"metadata": map[string]interface{}{
+			"name":      "app",
+			"namespace": namespace,
+		},
+		"spec": map[string]interface{}{
+			"project": project,
+		},
+	}}
+}
+
+func newTestProjInformer(t *testing.T, projs ...*unstructured.Unstructured) cache.SharedIndexInformer {
+	informer := cache.NewSharedIndexInformer(&cache.ListWatch{}, &unstructured.Unstructured{}, 0, cache.Indexers{})
+	for _, proj := range projs {
+		require.NoError(t, informer.GetIndexer().Add(proj))
+	}
+	return informer
+}
+
+func TestSkipAppNamespace(t *testing.T) {
+	proj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "AppProject",
+		"metadata": map[string]interface{}{
+			"name":      "team",
+			"namespace": "argocd",
+		},
+		"spec": map[string]interface{}{
+			"sourceNamespaces": []interface{}{"team-one"},
+		},
+	}}
+	c := &notificationController{
+		namespace:             "argocd",
+		applicationNamespaces: []string{"team-*"},
+		appProjInformer:       newTestProjInformer(t, proj),
+	}
+
+	tests := []struct {
+		name   string
+		app    *unstructured.Unstructured
+		skip   bool
+		reason string
+	}{
+		{"ControlPlaneNamespace", newTestApp("argocd", "default"), false, ""},
+		{"NamespaceNotEnabled", newTestApp("other", "team"), true, "namespace other is not enabled"},
+		{"ProjectNotFound", newTestApp("team-one", "unknown"), true, "project not found"},
+		{"NamespaceNotAllowedByProject", newTestApp("team-two", "team"), true, "application is not allowed to use project team"},
+		{"NamespaceAllowedByProject", newTestApp("team-one", "team"), false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			skip, reason := c.skipAppNamespace(tt.app)
+			assert.Equal(t, tt.skip, skip)
+			assert.Equal(t, tt.reason, reason)
+		})
+	}
+}
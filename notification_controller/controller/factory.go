@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"sync"
+
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/argoproj/notifications-engine/pkg/triggers"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+)
+
+// namespacedAPIFactory creates the notification API from the settings of the control plane's namespace. If
+// self-service notifications are enabled, the triggers and notifications of applications in other namespaces are
+// delegated to an API created from the settings of their own namespace.
+type namespacedAPIFactory struct {
+	settings          api.Settings
+	namespace         string
+	selfService       bool
+	secretInformer    cache.SharedIndexInformer
+	configMapInformer cache.SharedIndexInformer
+
+	lock      sync.Mutex
+	factories map[string]api.Factory
+	// newFactory creates the factory of a namespace
+	newFactory func(namespace string) api.Factory
+}
+
+func newNamespacedAPIFactory(settings api.Settings, namespace string, selfService bool, secretInformer cache.SharedIndexInformer, configMapInformer cache.SharedIndexInformer) *namespacedAPIFactory {
+	f := &namespacedAPIFactory{
+		settings:          settings,
+		namespace:         namespace,
+		selfService:       selfService,
+		secretInformer:    secretInformer,
+		configMapInformer: configMapInformer,
+		factories:         map[string]api.Factory{},
+	}
+	f.newFactory = func(namespace string) api.Factory {
+		return api.NewFactory(f.settings, namespace, f.secretInformer, f.configMapInformer)
+	}
+	// the factory of the control plane's namespace is created eagerly, so that it is notified of all the settings
+	// changes
+	f.getFactory(namespace)
+	return f
+}
+
+func (f *namespacedAPIFactory) getFactory(namespace string) api.Factory {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	factory, ok := f.factories[namespace]
+	if !ok {
+		factory = f.newFactory(namespace)
+		f.factories[namespace] = factory
+	}
+	return factory
+}
+
+// isSelfService returns whether the notifications of applications in the given namespace use the settings of
+// that namespace
+func (f *namespacedAPIFactory) isSelfService(namespace string) bool {
+	return f.selfService && namespace != "" && namespace != f.namespace
+}
+
+// getNamespaceAPI returns the API created from the settings of the given namespace
+func (f *namespacedAPIFactory) getNamespaceAPI(namespace string) (api.API, error) {
+	return f.getFactory(namespace).GetAPI()
+}
+
+func (f *namespacedAPIFactory) GetAPI() (api.API, error) {
+	defaultAPI, err := f.getNamespaceAPI(f.namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !f.selfService {
+		return defaultAPI, nil
+	}
+	return &namespacedAPI{API: defaultAPI, factory: f}, nil
+}
+
+// namespacedAPI sends the notifications of an application with the API of the namespace of the application
+type namespacedAPI struct {
+	api.API
+	factory *namespacedAPIFactory
+}
+
+func (a *namespacedAPI) getObjAPI(obj map[string]interface{}) (api.API, error) {
+	namespace := (&unstructured.Unstructured{Object: obj}).GetNamespace()
+	if !a.factory.isSelfService(namespace) {
+		return a.API, nil
+	}
+	return a.factory.getNamespaceAPI(namespace)
+}
+
+func (a *namespacedAPI) Send(obj map[string]interface{}, templates []string, dest services.Destination) error {
+	objAPI, err := a.getObjAPI(obj)
+	if err != nil {
+		return err
+	}
+	return objAPI.Send(obj, templates, dest)
+}
+
+func (a *namespacedAPI) RunTrigger(triggerName string, vars map[string]interface{}) ([]triggers.ConditionResult, error) {
+	objAPI, err := a.getObjAPI(vars)
+	if err != nil {
+		return nil, err
+	}
+	return objAPI.RunTrigger(triggerName, vars)
+}
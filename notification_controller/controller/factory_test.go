@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/argoproj/notifications-engine/pkg/triggers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAPI records the namespace of the API used to send notifications and run triggers
+type fakeAPI struct {
+	api.API
+	namespace string
+	calls     *[]string
+}
+
+func (a *fakeAPI) Send(obj map[string]interface{}, templates []string, dest services.Destination) error {
+	*a.calls = append(*a.calls, a.namespace)
+	return nil
+}
+
+func (a *fakeAPI) RunTrigger(triggerName string, vars map[string]interface{}) ([]triggers.ConditionResult, error) {
+	*a.calls = append(*a.calls, a.namespace)
+	return nil, nil
+}
+
+type fakeFactory struct {
+	api api.API
+}
+
+func (f *fakeFactory) GetAPI() (api.API, error) {
+	return f.api, nil
+}
+
+// newTestAPIFactory returns a factory of fake APIs, along with the namespaces of the created factories and the
+// namespaces of the APIs that have been called
+func newTestAPIFactory(selfService bool) (*namespacedAPIFactory, *[]string, *[]string) {
+	var created, calls []string
+	f := &namespacedAPIFactory{
+		namespace:   "argocd",
+		selfService: selfService,
+		factories:   map[string]api.Factory{},
+		newFactory: func(namespace string) api.Factory {
+			created = append(created, namespace)
+			return &fakeFactory{api: &fakeAPI{namespace: namespace, calls: &calls}}
+		},
+	}
+	return f, &created, &calls
+}
+
+func newTestAppObj(namespace string) map[string]interface{} {
+	return map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":      "app",
+			"namespace": namespace,
+		},
+	}
+}
+
+func TestNamespacedAPIFactory_SelfServiceDisabled(t *testing.T) {
+	f, created, calls := newTestAPIFactory(false)
+
+	notificationAPI, err := f.GetAPI()
+	require.NoError(t, err)
+	assert.IsType(t, &fakeAPI{}, notificationAPI)
+
+	require.NoError(t, notificationAPI.Send(newTestAppObj("team-one"), nil, services.Destination{}))
+	_, err = notificationAPI.RunTrigger("on-sync-succeeded", newTestAppObj("team-one"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"argocd", "argocd"}, *calls)
+	assert.Equal(t, []string{"argocd"}, *created)
+	assert.False(t, f.isSelfService("team-one"))
+}
+
+func TestNamespacedAPIFactory_DefaultNamespace(t *testing.T) {
+	for _, namespace := range []string{"argocd", ""} {
+		t.Run("namespace "+namespace, func(t *testing.T) {
+			f, created, calls := newTestAPIFactory(true)
+
+			notificationAPI, err := f.GetAPI()
+			require.NoError(t, err)
+
+			require.NoError(t, notificationAPI.Send(newTestAppObj(namespace), nil, services.Destination{}))
+			_, err = notificationAPI.RunTrigger("on-sync-succeeded", newTestAppObj(namespace))
+			require.NoError(t, err)
+
+			assert.Equal(t, []string{"argocd", "argocd"}, *calls)
+			assert.Equal(t, []string{"argocd"}, *created)
+			assert.False(t, f.isSelfService(namespace))
+		})
+	}
+}
+
+func TestNamespacedAPIFactory_OtherNamespace(t *testing.T) {
+	f, created, calls := newTestAPIFactory(true)
+
+	notificationAPI, err := f.GetAPI()
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, notificationAPI.Send(newTestAppObj("team-one"), nil, services.Destination{}))
+		_, err = notificationAPI.RunTrigger("on-sync-succeeded", newTestAppObj("team-one"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, notificationAPI.Send(newTestAppObj("team-two"), nil, services.Destination{}))
+
+	assert.Equal(t, []string{"team-one", "team-one", "team-one", "team-one", "team-two"}, *calls)
+	assert.Equal(t, []string{"argocd", "team-one", "team-two"}, *created)
+	assert.True(t, f.isSelfService("team-one"))
+}
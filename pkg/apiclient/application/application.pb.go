@@ -201,7 +201,9 @@ type RevisionMetadataQuery struct {
 	// the revision of the app
 	Revision *string `protobuf:"bytes,2,req,name=revision" json:"revision,omitempty"`
 	// the application's namespace
-	AppNamespace         *string  `protobuf:"bytes,3,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	AppNamespace *string `protobuf:"bytes,3,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	// the index of the source the revision belongs to, for an application with multiple sources
+	SourceIndex          *int32   `protobuf:"varint,4,opt,name=sourceIndex" json:"sourceIndex,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -261,6 +263,13 @@ func (m *RevisionMetadataQuery) GetAppNamespace() string {
 	return ""
 }
 
+func (m *RevisionMetadataQuery) GetSourceIndex() int32 {
+	if m != nil && m.SourceIndex != nil {
+		return *m.SourceIndex
+	}
+	return 0
+}
+
 // ApplicationEventsQuery is a query for application resource events
 type ApplicationResourceEventsQuery struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
@@ -994,20 +1003,25 @@ func (m *SyncOptions) GetItems() []string {
 
 // ApplicationSyncRequest is a request to apply the config state to live state
 type ApplicationSyncRequest struct {
-	Name                 *string                           `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Revision             *string                           `protobuf:"bytes,2,opt,name=revision" json:"revision,omitempty"`
-	DryRun               *bool                             `protobuf:"varint,3,opt,name=dryRun" json:"dryRun,omitempty"`
-	Prune                *bool                             `protobuf:"varint,4,opt,name=prune" json:"prune,omitempty"`
-	Strategy             *v1alpha1.SyncStrategy            `protobuf:"bytes,5,opt,name=strategy" json:"strategy,omitempty"`
-	Resources            []*v1alpha1.SyncOperationResource `protobuf:"bytes,7,rep,name=resources" json:"resources,omitempty"`
-	Manifests            []string                          `protobuf:"bytes,8,rep,name=manifests" json:"manifests,omitempty"`
-	Infos                []*v1alpha1.Info                  `protobuf:"bytes,9,rep,name=infos" json:"infos,omitempty"`
-	RetryStrategy        *v1alpha1.RetryStrategy           `protobuf:"bytes,10,opt,name=retryStrategy" json:"retryStrategy,omitempty"`
-	SyncOptions          *SyncOptions                      `protobuf:"bytes,11,opt,name=syncOptions" json:"syncOptions,omitempty"`
-	AppNamespace         *string                           `protobuf:"bytes,12,opt,name=appNamespace" json:"appNamespace,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
-	XXX_unrecognized     []byte                            `json:"-"`
-	XXX_sizecache        int32                             `json:"-"`
+	Name          *string                           `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Revision      *string                           `protobuf:"bytes,2,opt,name=revision" json:"revision,omitempty"`
+	DryRun        *bool                             `protobuf:"varint,3,opt,name=dryRun" json:"dryRun,omitempty"`
+	Prune         *bool                             `protobuf:"varint,4,opt,name=prune" json:"prune,omitempty"`
+	Strategy      *v1alpha1.SyncStrategy            `protobuf:"bytes,5,opt,name=strategy" json:"strategy,omitempty"`
+	Resources     []*v1alpha1.SyncOperationResource `protobuf:"bytes,7,rep,name=resources" json:"resources,omitempty"`
+	Manifests     []string                          `protobuf:"bytes,8,rep,name=manifests" json:"manifests,omitempty"`
+	Infos         []*v1alpha1.Info                  `protobuf:"bytes,9,rep,name=infos" json:"infos,omitempty"`
+	RetryStrategy *v1alpha1.RetryStrategy           `protobuf:"bytes,10,opt,name=retryStrategy" json:"retryStrategy,omitempty"`
+	SyncOptions   *SyncOptions                      `protobuf:"bytes,11,opt,name=syncOptions" json:"syncOptions,omitempty"`
+	AppNamespace  *string                           `protobuf:"bytes,12,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	// notBefore is the time before which the sync must not start
+	NotBefore *v1.Time `protobuf:"bytes,13,opt,name=notBefore" json:"notBefore,omitempty"`
+	// preflight performs a server-side dry run of the sync against the cluster and returns its results in the
+	// status.operationState of the returned application, without initiating the sync
+	Preflight            *bool    `protobuf:"varint,14,opt,name=preflight" json:"preflight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ApplicationSyncRequest) Reset()         { *m = ApplicationSyncRequest{} }
@@ -1120,6 +1134,20 @@ func (m *ApplicationSyncRequest) GetAppNamespace() string {
 	return ""
 }
 
+func (m *ApplicationSyncRequest) GetNotBefore() *v1.Time {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
+func (m *ApplicationSyncRequest) GetPreflight() bool {
+	if m != nil && m.Preflight != nil {
+		return *m.Preflight
+	}
+	return false
+}
+
 type ApplicationValidationRequest struct {
 	Application          *v1alpha1.Application `protobuf:"bytes,1,req,name=application" json:"application,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
@@ -1383,11 +1411,16 @@ func (m *ApplicationPatchRequest) GetAppNamespace() string {
 }
 
 type ApplicationRollbackRequest struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Id                   *int64   `protobuf:"varint,2,req,name=id" json:"id,omitempty"`
-	DryRun               *bool    `protobuf:"varint,3,opt,name=dryRun" json:"dryRun,omitempty"`
-	Prune                *bool    `protobuf:"varint,4,opt,name=prune" json:"prune,omitempty"`
-	AppNamespace         *string  `protobuf:"bytes,6,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	// id is the ID of the revision history entry to roll back to
+	Id           *int64  `protobuf:"varint,2,opt,name=id" json:"id,omitempty"`
+	DryRun       *bool   `protobuf:"varint,3,opt,name=dryRun" json:"dryRun,omitempty"`
+	Prune        *bool   `protobuf:"varint,4,opt,name=prune" json:"prune,omitempty"`
+	AppNamespace *string `protobuf:"bytes,6,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	// revision is a revision (e.g. a Git commit SHA or tag) to roll back to instead of a revision history entry
+	Revision *string `protobuf:"bytes,7,opt,name=revision" json:"revision,omitempty"`
+	// reason is recorded in the revision history once the rollback succeeds
+	Reason               *string  `protobuf:"bytes,8,opt,name=reason" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1461,6 +1494,20 @@ func (m *ApplicationRollbackRequest) GetAppNamespace() string {
 	return ""
 }
 
+func (m *ApplicationRollbackRequest) GetRevision() string {
+	if m != nil && m.Revision != nil {
+		return *m.Revision
+	}
+	return ""
+}
+
+func (m *ApplicationRollbackRequest) GetReason() string {
+	if m != nil && m.Reason != nil {
+		return *m.Reason
+	}
+	return ""
+}
+
 type ApplicationResourceRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	Namespace            *string  `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
@@ -2683,172 +2730,176 @@ func init() {
 }
 
 var fileDescriptor_df6e82b174b5eaec = []byte{
-	// 2637 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x8f, 0x1c, 0x47,
-	0x15, 0xa7, 0x66, 0xbf, 0x66, 0xdf, 0xac, 0xbf, 0x2a, 0xf1, 0xd2, 0x69, 0x6f, 0xcc, 0xa6, 0xfd,
-	0xb5, 0x5e, 0x7b, 0x67, 0xbc, 0x83, 0x01, 0x67, 0x03, 0x02, 0x7f, 0xdb, 0x64, 0xed, 0x98, 0x5e,
-	0x3b, 0x46, 0xe1, 0x40, 0x3a, 0x3d, 0xb5, 0xb3, 0xcd, 0xce, 0x74, 0xb7, 0xab, 0x6b, 0xc6, 0x1a,
-	0x19, 0x5f, 0x82, 0x38, 0x81, 0x82, 0x94, 0xe4, 0x80, 0x42, 0x84, 0x50, 0xa2, 0x5c, 0xb8, 0xe4,
-	0x16, 0x21, 0x71, 0x81, 0x0b, 0x02, 0x89, 0x03, 0xe2, 0xe3, 0x12, 0x09, 0x09, 0x59, 0xdc, 0xb8,
-	0xf0, 0x27, 0xa0, 0xaa, 0xae, 0xea, 0xae, 0x9e, 0xe9, 0xe9, 0x99, 0x65, 0x17, 0xe2, 0xd3, 0xf6,
-	0xab, 0xa9, 0x7a, 0xef, 0x57, 0xaf, 0xde, 0x57, 0xbd, 0x5a, 0x38, 0x1e, 0x11, 0xda, 0x25, 0xb4,
-	0xe6, 0x84, 0x61, 0xcb, 0x73, 0x1d, 0xe6, 0x05, 0xbe, 0xfe, 0x5d, 0x0d, 0x69, 0xc0, 0x02, 0x5c,
-	0xd1, 0x86, 0xcc, 0x85, 0x66, 0x10, 0x34, 0x5b, 0xa4, 0xe6, 0x84, 0x5e, 0xcd, 0xf1, 0xfd, 0x80,
-	0x89, 0xe1, 0x28, 0x9e, 0x6a, 0x5a, 0xdb, 0x17, 0xa2, 0xaa, 0x17, 0x88, 0x5f, 0xdd, 0x80, 0x92,
-	0x5a, 0x77, 0xb5, 0xd6, 0x24, 0x3e, 0xa1, 0x0e, 0x23, 0x0d, 0x39, 0xe7, 0x7c, 0x3a, 0xa7, 0xed,
-	0xb8, 0x5b, 0x9e, 0x4f, 0x68, 0xaf, 0x16, 0x6e, 0x37, 0xf9, 0x40, 0x54, 0x6b, 0x13, 0xe6, 0xe4,
-	0xad, 0x5a, 0x6f, 0x7a, 0x6c, 0xab, 0xf3, 0x46, 0xd5, 0x0d, 0xda, 0x35, 0x87, 0x36, 0x83, 0x90,
-	0x06, 0xdf, 0x13, 0x1f, 0x2b, 0x6e, 0xa3, 0xd6, 0xad, 0xa7, 0x0c, 0xf4, 0xbd, 0x74, 0x57, 0x9d,
-	0x56, 0xb8, 0xe5, 0x0c, 0x72, 0xbb, 0x3a, 0x82, 0x1b, 0x25, 0x61, 0x20, 0x75, 0x23, 0x3e, 0x3d,
-	0x16, 0xd0, 0x9e, 0xf6, 0x19, 0xb3, 0xb1, 0x3e, 0x45, 0x70, 0xf0, 0x62, 0x2a, 0xef, 0x5b, 0x1d,
-	0x42, 0x7b, 0x18, 0xc3, 0xa4, 0xef, 0xb4, 0x89, 0x81, 0x16, 0xd1, 0xd2, 0xac, 0x2d, 0xbe, 0xb1,
-	0x01, 0x33, 0x94, 0x6c, 0x52, 0x12, 0x6d, 0x19, 0x25, 0x31, 0xac, 0x48, 0x6c, 0x42, 0x99, 0x0b,
-	0x27, 0x2e, 0x8b, 0x8c, 0x89, 0xc5, 0x89, 0xa5, 0x59, 0x3b, 0xa1, 0xf1, 0x12, 0x1c, 0xa0, 0x24,
-	0x0a, 0x3a, 0xd4, 0x25, 0xaf, 0x12, 0x1a, 0x79, 0x81, 0x6f, 0x4c, 0x8a, 0xd5, 0xfd, 0xc3, 0x9c,
-	0x4b, 0x44, 0x5a, 0xc4, 0x65, 0x01, 0x35, 0xa6, 0xc4, 0x94, 0x84, 0xe6, 0x78, 0x38, 0x70, 0x63,
-	0x3a, 0xc6, 0xc3, 0xbf, 0xb1, 0x05, 0x73, 0x4e, 0x18, 0xde, 0x76, 0xda, 0x24, 0x0a, 0x1d, 0x97,
-	0x18, 0x33, 0xe2, 0xb7, 0xcc, 0x98, 0x75, 0x19, 0x66, 0x6f, 0x07, 0x0d, 0x32, 0x7c, 0x53, 0xfd,
-	0x4c, 0x4a, 0x39, 0x4c, 0xb6, 0xe1, 0xb0, 0x4d, 0xba, 0x1e, 0x07, 0x79, 0x8b, 0x30, 0xa7, 0xe1,
-	0x30, 0xa7, 0x9f, 0x61, 0x29, 0x61, 0x68, 0x42, 0x99, 0xca, 0xc9, 0x46, 0x49, 0x8c, 0x27, 0xf4,
-	0x80, 0xb0, 0x89, 0x1c, 0x61, 0x7f, 0x44, 0x70, 0x54, 0x3b, 0x0e, 0x5b, 0x2a, 0xe9, 0x6a, 0x97,
-	0xf8, 0x2c, 0x1a, 0x2e, 0xf6, 0x2c, 0x1c, 0x52, 0xfa, 0xec, 0xdf, 0xcc, 0xe0, 0x0f, 0x1c, 0x88,
-	0x3e, 0xa8, 0x80, 0xe8, 0x63, 0x78, 0x11, 0x2a, 0x8a, 0xbe, 0x77, 0xf3, 0x8a, 0x3c, 0x34, 0x7d,
-	0x68, 0x60, 0x3b, 0x53, 0x39, 0xdb, 0xf1, 0xc1, 0xd0, 0x76, 0x73, 0xcb, 0xf1, 0xbd, 0x4d, 0x12,
-	0xb1, 0x71, 0xd5, 0x87, 0x76, 0xac, 0xbe, 0x17, 0x60, 0xf6, 0x9a, 0xd7, 0x22, 0x97, 0xb7, 0x3a,
-	0xfe, 0x36, 0x7e, 0x16, 0xa6, 0x5c, 0xfe, 0x21, 0x24, 0xcc, 0xd9, 0x31, 0x61, 0x3d, 0x84, 0x17,
-	0x86, 0x41, 0xba, 0xef, 0xb1, 0x2d, 0xbe, 0x3c, 0x1a, 0x86, 0xcd, 0xdd, 0x22, 0xee, 0x76, 0xd4,
-	0x69, 0xab, 0xa3, 0x55, 0xf4, 0x58, 0xd8, 0x5e, 0x86, 0x23, 0x9a, 0xe0, 0x57, 0x9d, 0x96, 0xd7,
-	0x70, 0x18, 0xb1, 0x49, 0x14, 0x06, 0x7e, 0x44, 0x38, 0x5a, 0x42, 0x69, 0x40, 0xa5, 0x7d, 0xc6,
-	0x04, 0x9e, 0x87, 0x69, 0xe2, 0x33, 0x8f, 0xf5, 0xa4, 0x3a, 0x24, 0x65, 0xbd, 0x0e, 0x96, 0x6e,
-	0x26, 0x41, 0xab, 0x15, 0x74, 0x18, 0xff, 0xf3, 0x86, 0xe3, 0x6e, 0x27, 0x3c, 0xb9, 0xcf, 0xc6,
-	0x3f, 0xc9, 0x9d, 0x28, 0x92, 0x1f, 0xaf, 0x4f, 0x1e, 0xda, 0xba, 0xa9, 0x4e, 0xd8, 0xfa, 0x90,
-	0xf5, 0x4b, 0x04, 0x4b, 0x23, 0x15, 0x75, 0x9f, 0x3a, 0x61, 0x48, 0x28, 0xbe, 0x06, 0x53, 0x0f,
-	0xf8, 0x0f, 0x02, 0x7c, 0xa5, 0x5e, 0xad, 0xea, 0x21, 0x78, 0x24, 0x97, 0x1b, 0x9f, 0xb3, 0xe3,
-	0xe5, 0xb8, 0xaa, 0x8e, 0xac, 0x24, 0xf8, 0xcc, 0x67, 0xf8, 0x24, 0x27, 0xcb, 0xe7, 0x8b, 0x69,
-	0x97, 0xa6, 0x61, 0x32, 0x74, 0x28, 0xb3, 0x0e, 0xc3, 0x33, 0x59, 0xaf, 0x11, 0xfb, 0xb7, 0x7e,
-	0x8d, 0x32, 0xf6, 0x77, 0x99, 0x12, 0xa1, 0xf1, 0x07, 0x1d, 0x12, 0x31, 0xbc, 0x0d, 0x7a, 0x56,
-	0x10, 0x0a, 0xaa, 0xd4, 0x6f, 0x56, 0xd3, 0xb0, 0x5a, 0x55, 0x61, 0x55, 0x7c, 0x7c, 0xd7, 0x6d,
-	0x54, 0xbb, 0xf5, 0x6a, 0xb8, 0xdd, 0xac, 0xf2, 0x20, 0x9d, 0x41, 0xa6, 0x82, 0xb4, 0xbe, 0x55,
-	0x5b, 0xe7, 0xce, 0xcf, 0xb1, 0x13, 0x46, 0x84, 0x32, 0xb1, 0xb3, 0xb2, 0x2d, 0x29, 0x6e, 0x54,
-	0x5d, 0x69, 0x09, 0xc2, 0x68, 0xca, 0x76, 0x42, 0x5b, 0x1f, 0x66, 0xd1, 0xdf, 0x0b, 0x1b, 0x9f,
-	0x15, 0x7a, 0x1d, 0x65, 0xa9, 0x0f, 0xe5, 0x7b, 0x59, 0x94, 0x57, 0x48, 0x8b, 0xa4, 0x28, 0xf3,
-	0xfc, 0xc8, 0x80, 0x19, 0xd7, 0x89, 0x5c, 0xa7, 0xa1, 0x78, 0x29, 0x92, 0x47, 0xb1, 0x90, 0x06,
-	0xa1, 0xd3, 0x14, 0x9c, 0xee, 0x04, 0x2d, 0xcf, 0xed, 0x49, 0x57, 0x1a, 0xfc, 0x61, 0xc0, 0xe7,
-	0x26, 0x73, 0x7c, 0xee, 0x18, 0x54, 0x36, 0x7a, 0xbe, 0xfb, 0x4a, 0x28, 0x32, 0x3c, 0xf7, 0x31,
-	0x8f, 0x91, 0x76, 0x64, 0x20, 0x91, 0xa6, 0x62, 0xc2, 0x7a, 0x7f, 0x0a, 0xe6, 0xb5, 0x1d, 0xf0,
-	0x05, 0x45, 0xf8, 0x8b, 0x62, 0xd4, 0x3c, 0x4c, 0x37, 0x68, 0xcf, 0xee, 0xf8, 0xf2, 0x30, 0x25,
-	0xc5, 0x05, 0x87, 0xb4, 0xe3, 0xc7, 0x20, 0xcb, 0x76, 0x4c, 0xe0, 0x4d, 0x28, 0x47, 0x8c, 0xe7,
-	0xf4, 0x66, 0x4f, 0x44, 0xcf, 0x4a, 0xfd, 0x9b, 0xbb, 0x3b, 0x40, 0x0e, 0x7d, 0x43, 0x72, 0xb4,
-	0x13, 0xde, 0xf8, 0x01, 0xcc, 0xaa, 0xc0, 0x1d, 0x19, 0x33, 0x8b, 0x13, 0x4b, 0x95, 0xfa, 0xc6,
-	0xee, 0x05, 0xbd, 0x12, 0xf2, 0x7a, 0x44, 0x4b, 0x52, 0x76, 0x2a, 0x05, 0x2f, 0xc0, 0x6c, 0x5b,
-	0xfa, 0x7a, 0x64, 0x94, 0x85, 0xb6, 0xd3, 0x01, 0xfc, 0x6d, 0x98, 0xf2, 0xfc, 0xcd, 0x20, 0x32,
-	0x66, 0x05, 0x98, 0x4b, 0xbb, 0x03, 0x73, 0xd3, 0xdf, 0x0c, 0xec, 0x98, 0x21, 0x7e, 0x00, 0xfb,
-	0x28, 0x61, 0xb4, 0xa7, 0xb4, 0x60, 0x80, 0xd0, 0xeb, 0xcb, 0xbb, 0x93, 0x60, 0xeb, 0x2c, 0xed,
-	0xac, 0x04, 0xbc, 0x06, 0x95, 0x28, 0xb5, 0x31, 0xa3, 0x22, 0x04, 0x1a, 0x19, 0x46, 0x9a, 0x0d,
-	0xda, 0xfa, 0xe4, 0x01, 0x1b, 0x9e, 0xcb, 0xb1, 0xe1, 0x1f, 0x21, 0x58, 0x18, 0x4c, 0x1c, 0x42,
-	0xef, 0xff, 0xff, 0x50, 0x60, 0x7d, 0x8c, 0x32, 0xf9, 0x73, 0x20, 0xf3, 0x0c, 0xf7, 0x1b, 0x5e,
-	0x51, 0xc4, 0xb3, 0x45, 0xd1, 0x11, 0xa7, 0x50, 0x7d, 0x08, 0x2f, 0xc3, 0x41, 0x8d, 0x54, 0x99,
-	0x94, 0x4f, 0x1b, 0x18, 0x17, 0x85, 0xa5, 0x94, 0xad, 0x9c, 0x71, 0x52, 0x24, 0xb1, 0xfe, 0x61,
-	0xeb, 0x6f, 0x59, 0xfd, 0xc5, 0x61, 0x74, 0x23, 0x24, 0x85, 0x4e, 0xee, 0xc0, 0x64, 0x14, 0x12,
-	0x57, 0xa0, 0xac, 0xd4, 0x6f, 0xed, 0x99, 0x32, 0x85, 0x5c, 0xc1, 0xba, 0x28, 0xf4, 0x8f, 0x15,
-	0xdb, 0x7e, 0x88, 0xe0, 0xf3, 0x1a, 0xe7, 0x3b, 0x0e, 0x73, 0xb7, 0x8a, 0xb6, 0xc4, 0x63, 0x10,
-	0x9f, 0x23, 0x35, 0x1f, 0x13, 0xdc, 0x51, 0xc5, 0xc7, 0xdd, 0x5e, 0xa8, 0x94, 0x9d, 0x0e, 0x8c,
-	0x55, 0xe3, 0xbd, 0x8d, 0xc0, 0xec, 0xb3, 0x88, 0x51, 0xa6, 0xb0, 0x1f, 0x4a, 0x5e, 0x43, 0x16,
-	0x1d, 0x25, 0xaf, 0xb1, 0xc3, 0xb0, 0xd9, 0x0f, 0x6a, 0x3a, 0x07, 0xd4, 0xa7, 0x7d, 0xa0, 0x54,
-	0x88, 0x2a, 0x00, 0xb5, 0x00, 0xb3, 0x7e, 0x5f, 0xed, 0x9c, 0x0e, 0xe4, 0xd4, 0xcc, 0xa5, 0x81,
-	0x9a, 0xd9, 0x80, 0x99, 0x6e, 0x72, 0xc9, 0x11, 0xe5, 0x96, 0x24, 0xf9, 0x46, 0x9a, 0x34, 0xe8,
-	0x84, 0x52, 0x81, 0x31, 0xc1, 0x51, 0x6c, 0x7b, 0x7e, 0xc3, 0x98, 0x8e, 0x51, 0xf0, 0xef, 0xb1,
-	0xae, 0x35, 0xef, 0x94, 0xe0, 0x0b, 0x39, 0x9b, 0x1b, 0x69, 0x01, 0x4f, 0xc7, 0x0e, 0x13, 0x3b,
-	0x9c, 0x19, 0x6a, 0x87, 0xe5, 0x51, 0x76, 0x38, 0x9b, 0xa3, 0x95, 0xb7, 0x4a, 0xb0, 0x98, 0xa3,
-	0x95, 0xd1, 0x05, 0xc9, 0x53, 0xa3, 0x96, 0xcd, 0x80, 0xca, 0x13, 0x2f, 0xdb, 0x31, 0xc1, 0x3d,
-	0x23, 0xa0, 0xe1, 0x96, 0xe3, 0x1b, 0xe5, 0xd8, 0x33, 0x62, 0x6a, 0x2c, 0x85, 0xfc, 0x1b, 0x81,
-	0xa1, 0xb4, 0x70, 0xd1, 0x15, 0x3a, 0xe9, 0xf8, 0x4f, 0xbf, 0x22, 0xe6, 0x61, 0xda, 0x11, 0x68,
-	0xa5, 0x81, 0x48, 0x6a, 0x60, 0xcb, 0xe5, 0xfc, 0x98, 0x78, 0x24, 0xbb, 0xe5, 0x68, 0xdd, 0x8b,
-	0x58, 0x72, 0x21, 0xda, 0x84, 0x99, 0x98, 0x5b, 0x5c, 0x02, 0x56, 0xea, 0xeb, 0xbb, 0x2d, 0x0c,
-	0x32, 0xea, 0x55, 0xcc, 0xad, 0x17, 0x33, 0x77, 0xbd, 0x34, 0xfa, 0x48, 0x18, 0x26, 0x94, 0x55,
-	0x31, 0x24, 0x0f, 0x20, 0xa1, 0xad, 0x7f, 0x4d, 0x64, 0xc3, 0x7a, 0xd0, 0x58, 0x0f, 0x9a, 0x05,
-	0x57, 0xff, 0xe2, 0x43, 0x33, 0x60, 0x26, 0x0c, 0x1a, 0xda, 0x2d, 0x5f, 0x91, 0x7c, 0x9d, 0x1b,
-	0xf8, 0xcc, 0xf1, 0x7c, 0x42, 0x65, 0x7e, 0x49, 0x07, 0xb8, 0xb2, 0x23, 0xcf, 0x77, 0xc9, 0x06,
-	0x71, 0x03, 0xbf, 0x11, 0x89, 0x53, 0x9b, 0xb0, 0x33, 0x63, 0xf8, 0x06, 0xcc, 0x0a, 0xfa, 0xae,
-	0xd7, 0x8e, 0x83, 0x70, 0xa5, 0xbe, 0x5c, 0x8d, 0x3b, 0x63, 0x55, 0xbd, 0x33, 0x96, 0xea, 0xb0,
-	0x4d, 0x98, 0x53, 0xed, 0xae, 0x56, 0xf9, 0x0a, 0x3b, 0x5d, 0xcc, 0xb1, 0x30, 0xc7, 0x6b, 0xad,
-	0x7b, 0xbe, 0x28, 0x50, 0xb9, 0xa8, 0x74, 0x80, 0x1b, 0xc4, 0x26, 0xcf, 0xe9, 0x0f, 0x95, 0x0f,
-	0xc4, 0x14, 0x5f, 0xd5, 0xf1, 0x99, 0xd7, 0x12, 0xf2, 0x63, 0x07, 0x48, 0x07, 0xc4, 0x2a, 0xaf,
-	0xc5, 0x08, 0x15, 0x25, 0xe0, 0xac, 0x2d, 0xa9, 0xc4, 0xe4, 0x2a, 0x71, 0x1b, 0x48, 0xf9, 0x5e,
-	0x6c, 0x9c, 0x73, 0xba, 0x71, 0xf6, 0x1b, 0xfc, 0xbe, 0x9c, 0x36, 0x89, 0xe8, 0x7d, 0x91, 0xae,
-	0x17, 0x74, 0x22, 0x63, 0x7f, 0x9c, 0xc4, 0x15, 0x3d, 0x60, 0xb0, 0x07, 0x72, 0x0c, 0xf6, 0x37,
-	0x08, 0xca, 0xeb, 0x41, 0xf3, 0xaa, 0xcf, 0x68, 0x4f, 0xdc, 0x8c, 0x02, 0x9f, 0x11, 0x3f, 0xb9,
-	0xae, 0x4b, 0x92, 0xab, 0x9a, 0x79, 0x6d, 0xb2, 0xc1, 0x9c, 0x76, 0x28, 0x6b, 0x92, 0x1d, 0xa9,
-	0x3a, 0x59, 0xcc, 0xb7, 0xdf, 0x72, 0x22, 0x26, 0xbc, 0xb7, 0x6c, 0x8b, 0x6f, 0x0e, 0x34, 0x99,
-	0xb0, 0xc1, 0xa8, 0x74, 0xdd, 0xcc, 0x98, 0x6e, 0x48, 0x53, 0x31, 0x36, 0x49, 0x5a, 0x1b, 0xf0,
-	0x5c, 0x72, 0x15, 0xb8, 0x4b, 0x68, 0xdb, 0xf3, 0x9d, 0xe2, 0x78, 0x3b, 0x4e, 0xd3, 0xed, 0x5e,
-	0xc6, 0x81, 0x78, 0xfd, 0x7c, 0xdf, 0xf3, 0x1b, 0xc1, 0xc3, 0x02, 0x47, 0x18, 0x87, 0xed, 0x9f,
-	0xb3, 0xed, 0x35, 0x8d, 0x6f, 0xe2, 0x9b, 0x37, 0x60, 0x1f, 0xf7, 0xe2, 0x2e, 0x91, 0x3f, 0xc8,
-	0x40, 0x61, 0x0d, 0x6b, 0x69, 0xa4, 0x3c, 0xec, 0xec, 0x42, 0xbc, 0x0e, 0x07, 0x9c, 0x28, 0xf2,
-	0x9a, 0x3e, 0x69, 0x28, 0x5e, 0xa5, 0xb1, 0x79, 0xf5, 0x2f, 0x8d, 0xaf, 0xcd, 0x62, 0x86, 0x3c,
-	0x3b, 0x45, 0x5a, 0x3f, 0x40, 0x70, 0x38, 0x97, 0x49, 0x62, 0xeb, 0x48, 0x0b, 0xaf, 0x26, 0x94,
-	0x23, 0x77, 0x8b, 0x34, 0x3a, 0x2d, 0x55, 0x83, 0x27, 0x34, 0xff, 0xad, 0xd1, 0x89, 0x4f, 0x52,
-	0x86, 0xf7, 0x84, 0xc6, 0x47, 0x01, 0xda, 0x8e, 0xdf, 0x71, 0x5a, 0x02, 0xc2, 0xa4, 0x80, 0xa0,
-	0x8d, 0x58, 0x0b, 0x60, 0xe6, 0x99, 0x81, 0xec, 0xc4, 0xfc, 0x15, 0xc1, 0x7e, 0x15, 0x06, 0xe5,
-	0x19, 0x2e, 0xc1, 0x01, 0x4d, 0x0d, 0xb7, 0xd3, 0xe3, 0xec, 0x1f, 0x1e, 0x11, 0xe2, 0x94, 0x2d,
-	0x4c, 0x64, 0x9b, 0xd5, 0xdd, 0x4c, 0xbb, 0x79, 0xec, 0x3c, 0x84, 0x76, 0x54, 0x89, 0x7d, 0x1f,
-	0x8c, 0x5b, 0x8e, 0xef, 0x34, 0x49, 0x23, 0xd9, 0x5c, 0x62, 0x48, 0xaf, 0xeb, 0xcd, 0x86, 0x5d,
-	0x5f, 0xed, 0x93, 0x72, 0xc6, 0xdb, 0xdc, 0x94, 0x8d, 0x8b, 0xfa, 0xdf, 0x2d, 0xc0, 0xfa, 0xc1,
-	0x13, 0xda, 0xf5, 0x5c, 0x82, 0xdf, 0x46, 0x30, 0xc9, 0xb3, 0x1e, 0x7e, 0x7e, 0x98, 0x9d, 0x89,
-	0x03, 0x30, 0xf7, 0xee, 0x56, 0xc3, 0xa5, 0x59, 0x0b, 0x6f, 0xfe, 0xe5, 0x9f, 0xef, 0x94, 0xe6,
-	0xf1, 0xb3, 0xe2, 0xe9, 0xa4, 0xbb, 0xaa, 0x3f, 0x63, 0x44, 0xf8, 0xc7, 0x08, 0xb0, 0x4c, 0xc5,
-	0x5a, 0x47, 0x1b, 0x9f, 0x19, 0x06, 0x31, 0xa7, 0xf3, 0x6d, 0x3e, 0xaf, 0x85, 0xbc, 0xaa, 0x1b,
-	0x50, 0xc2, 0x03, 0x9c, 0x98, 0x20, 0x00, 0x2c, 0x0b, 0x00, 0xc7, 0xb1, 0x95, 0x07, 0xa0, 0xf6,
-	0x88, 0x1b, 0xc6, 0xe3, 0x1a, 0x89, 0xe5, 0x7e, 0x80, 0x60, 0xea, 0xbe, 0x28, 0x3c, 0x47, 0x28,
-	0x69, 0x63, 0xcf, 0x94, 0x24, 0xc4, 0x09, 0xb4, 0xd6, 0x31, 0x81, 0xf4, 0x79, 0x7c, 0x44, 0x21,
-	0x8d, 0x18, 0x25, 0x4e, 0x3b, 0x03, 0xf8, 0x1c, 0xc2, 0x1f, 0x21, 0x98, 0x8e, 0x7b, 0x96, 0xf8,
-	0xc4, 0x30, 0x94, 0x99, 0x9e, 0xa6, 0xb9, 0x77, 0xb7, 0x7e, 0xeb, 0xb4, 0xc0, 0x78, 0xcc, 0xca,
-	0x3d, 0xce, 0xb5, 0x4c, 0x7b, 0xf0, 0x5d, 0x04, 0x13, 0xd7, 0xc9, 0x48, 0x7b, 0xdb, 0x43, 0x70,
-	0x03, 0x0a, 0xcc, 0x39, 0x6a, 0xfc, 0x21, 0x82, 0xe7, 0xae, 0x13, 0x96, 0x1f, 0xef, 0xf1, 0xd2,
-	0xe8, 0x20, 0x2c, 0xcd, 0xee, 0xcc, 0x18, 0x33, 0x93, 0x40, 0x57, 0x13, 0xc8, 0x4e, 0xe3, 0x53,
-	0x45, 0x46, 0x18, 0xf5, 0x7c, 0xf7, 0xa1, 0xc4, 0xf1, 0x07, 0x04, 0x07, 0xfb, 0xdf, 0x97, 0x70,
-	0x36, 0x43, 0xe4, 0x3e, 0x3f, 0x99, 0xb7, 0x77, 0x1b, 0x50, 0xb2, 0x4c, 0xad, 0x8b, 0x02, 0xf9,
-	0x4b, 0xf8, 0xc5, 0x22, 0xe4, 0xaa, 0xd3, 0x19, 0xd5, 0x1e, 0xa9, 0xcf, 0xc7, 0xe2, 0xc1, 0x53,
-	0xc0, 0x7e, 0x13, 0xc1, 0xdc, 0x75, 0xc2, 0x6e, 0x25, 0x8d, 0xbe, 0x13, 0x63, 0x3d, 0x04, 0x98,
-	0x0b, 0x55, 0xed, 0x5d, 0x52, 0xfd, 0x94, 0xa8, 0x74, 0x45, 0x00, 0x3b, 0x85, 0x4f, 0x14, 0x01,
-	0x4b, 0x9b, 0x8b, 0x1f, 0x20, 0x38, 0xac, 0x83, 0x48, 0x5f, 0x75, 0xbe, 0xb4, 0xb3, 0x67, 0x09,
-	0xf9, 0xb8, 0x31, 0x02, 0x5d, 0x5d, 0xa0, 0x3b, 0x6b, 0xe5, 0x1f, 0x78, 0x7b, 0x00, 0xc5, 0x1a,
-	0x5a, 0x5e, 0x42, 0xf8, 0xb7, 0x08, 0xa6, 0xe3, 0x4e, 0xd4, 0x70, 0x1d, 0x65, 0x1a, 0xfe, 0x7b,
-	0xe9, 0x3d, 0x57, 0x05, 0xe4, 0xaf, 0x9b, 0xe7, 0xf2, 0x15, 0xaa, 0xaf, 0x57, 0x47, 0x5b, 0x15,
-	0x5a, 0xce, 0xba, 0xfd, 0x27, 0x08, 0x20, 0xed, 0xa6, 0xe1, 0xd3, 0xc5, 0xfb, 0xd0, 0x3a, 0x6e,
-	0xe6, 0xde, 0xf6, 0xd3, 0xac, 0xaa, 0xd8, 0xcf, 0x92, 0xb9, 0x58, 0xe8, 0x73, 0x21, 0x71, 0xd7,
-	0xe2, 0xce, 0xdb, 0x2f, 0x10, 0x4c, 0x89, 0x66, 0x09, 0x3e, 0x3e, 0x0c, 0xb3, 0xde, 0x4b, 0xd9,
-	0x4b, 0xd5, 0x9f, 0x14, 0x50, 0x17, 0xeb, 0x45, 0x81, 0x6b, 0x0d, 0x2d, 0xe3, 0x2e, 0x4c, 0xc7,
-	0x8d, 0x8b, 0xe1, 0xe6, 0x91, 0x69, 0x6c, 0x98, 0x8b, 0x05, 0x89, 0x34, 0x36, 0x54, 0x19, 0x33,
-	0x97, 0x47, 0xc5, 0xcc, 0x49, 0x1e, 0xd6, 0xf0, 0xb1, 0xa2, 0xa0, 0xf7, 0x3f, 0x50, 0xcc, 0x19,
-	0x81, 0xee, 0x84, 0xb5, 0x38, 0x2a, 0x6e, 0x72, 0xed, 0xfc, 0x14, 0xc1, 0xc1, 0xfe, 0xba, 0x0b,
-	0x1f, 0xe9, 0x8b, 0x99, 0x7a, 0xb1, 0x69, 0x66, 0xb5, 0x38, 0xac, 0x66, 0xb3, 0xbe, 0x21, 0x50,
-	0xac, 0xe1, 0x0b, 0x23, 0x3d, 0xe3, 0xb6, 0x8a, 0x3a, 0x9c, 0xd1, 0x4a, 0xfa, 0xf0, 0xf1, 0x2b,
-	0x04, 0x73, 0x8a, 0xef, 0x5d, 0x4a, 0x48, 0x31, 0xac, 0xbd, 0x73, 0x04, 0x2e, 0xcb, 0xfa, 0xaa,
-	0x80, 0xff, 0x65, 0x7c, 0x7e, 0x4c, 0xf8, 0x0a, 0xf6, 0x0a, 0xe3, 0x48, 0x7f, 0x87, 0xe0, 0xd0,
-	0xfd, 0xd8, 0xee, 0x3f, 0x23, 0xfc, 0x97, 0x05, 0xfe, 0xaf, 0xe1, 0x97, 0x0a, 0xea, 0xa2, 0x51,
-	0xdb, 0x38, 0x87, 0xf0, 0xc7, 0x08, 0xca, 0xaa, 0x0d, 0x8d, 0x4f, 0x0d, 0x75, 0x8c, 0x6c, 0xa3,
-	0x7a, 0x2f, 0x8d, 0x59, 0x16, 0x01, 0xd6, 0xf1, 0xc2, 0x54, 0x2a, 0xe5, 0x73, 0x83, 0x7e, 0x17,
-	0x01, 0x4e, 0x2e, 0x4d, 0xc9, 0x35, 0x0a, 0x9f, 0xcc, 0x88, 0x1a, 0x7a, 0xcb, 0x36, 0x4f, 0x8d,
-	0x9c, 0x97, 0x4d, 0xa5, 0xcb, 0x85, 0xa9, 0x34, 0x48, 0xe4, 0xbf, 0x85, 0xa0, 0x72, 0x9d, 0x24,
-	0x35, 0x7b, 0x81, 0x2e, 0xb3, 0xfd, 0x75, 0x73, 0x69, 0xf4, 0x44, 0x89, 0xe8, 0xac, 0x40, 0x74,
-	0x12, 0x17, 0xab, 0x4a, 0x01, 0x78, 0x1f, 0xc1, 0xbe, 0x3b, 0xba, 0x89, 0xe2, 0xb3, 0xa3, 0x24,
-	0x65, 0x22, 0xf9, 0xf8, 0xb8, 0xbe, 0x28, 0x70, 0xad, 0x58, 0x63, 0xe1, 0x5a, 0x93, 0x4d, 0xec,
-	0x9f, 0x23, 0x78, 0x46, 0xbf, 0xe4, 0xc8, 0x16, 0xe4, 0x7f, 0xab, 0xb7, 0x82, 0x4e, 0xa6, 0x75,
-	0x5e, 0xe0, 0xab, 0xe2, 0xb3, 0xe3, 0xe0, 0xab, 0xc9, 0xbe, 0x24, 0x7e, 0x0f, 0xc1, 0x21, 0xd1,
-	0x04, 0xd6, 0x19, 0xf7, 0xa5, 0x98, 0x61, 0x2d, 0xe3, 0x31, 0x52, 0x8c, 0x8c, 0x3f, 0xd6, 0x8e,
-	0x40, 0xad, 0xa9, 0x06, 0xef, 0x27, 0x08, 0x4c, 0xe5, 0x94, 0x83, 0x4f, 0x8c, 0xb8, 0x5a, 0xe4,
-	0xc8, 0x83, 0x6f, 0x90, 0x66, 0x6d, 0xec, 0xf9, 0x12, 0xfd, 0x57, 0x04, 0xfa, 0xd5, 0x11, 0xe8,
-	0xe3, 0xc5, 0x2b, 0xba, 0xf7, 0xfe, 0x04, 0xc1, 0x7e, 0x95, 0x8d, 0xa5, 0x59, 0xae, 0x8c, 0x3a,
-	0xf1, 0x9d, 0x66, 0x6f, 0xe9, 0x27, 0xcb, 0xe3, 0xf9, 0xc9, 0xcf, 0x10, 0x1c, 0x52, 0xff, 0x61,
-	0xb4, 0x41, 0xdd, 0x8b, 0x7e, 0xe3, 0x4a, 0xc4, 0x86, 0x57, 0x68, 0x03, 0x6f, 0xca, 0xc3, 0x1d,
-	0xa5, 0xff, 0xff, 0x96, 0xac, 0x55, 0x01, 0xec, 0x8c, 0xb5, 0x90, 0x03, 0x6c, 0x45, 0x3d, 0x68,
-	0x66, 0x0b, 0xc7, 0x8f, 0x10, 0xcc, 0xc8, 0xbe, 0x76, 0x41, 0x05, 0xa6, 0x35, 0xbe, 0xcd, 0xc3,
-	0x99, 0x59, 0xaa, 0x61, 0x6a, 0x7d, 0x47, 0xc8, 0xbe, 0x87, 0x6b, 0x45, 0x4a, 0x09, 0x83, 0x46,
-	0x54, 0x7b, 0x24, 0xbb, 0x95, 0x8f, 0x6b, 0xad, 0xa0, 0x19, 0xbd, 0x66, 0xe1, 0xc2, 0x3a, 0x83,
-	0xcf, 0x39, 0x87, 0x2e, 0x5d, 0xfb, 0xfd, 0x93, 0xa3, 0xe8, 0x4f, 0x4f, 0x8e, 0xa2, 0x7f, 0x3c,
-	0x39, 0x8a, 0x5e, 0xbb, 0x30, 0xde, 0xbf, 0x6f, 0xba, 0x2d, 0x8f, 0xf8, 0x4c, 0x67, 0xfb, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x66, 0x18, 0x7b, 0x39, 0xa4, 0x2a, 0x00, 0x00,
+	// 2697 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x73, 0x1c, 0x47,
+	0x15, 0xa7, 0x57, 0xd2, 0x6a, 0xf5, 0x56, 0xfe, 0xea, 0xc4, 0x62, 0x32, 0x56, 0x8c, 0x32, 0xb6,
+	0x63, 0x59, 0xb6, 0x76, 0x2d, 0x61, 0xc0, 0x51, 0xa0, 0xc0, 0xdf, 0x36, 0x91, 0x1d, 0x33, 0xb2,
+	0x63, 0x2a, 0x1c, 0xc8, 0x64, 0xa6, 0x77, 0x35, 0x68, 0x77, 0x66, 0xdc, 0x33, 0xbb, 0x46, 0x65,
+	0x7c, 0x09, 0x70, 0x02, 0x42, 0x15, 0xc9, 0x81, 0x0a, 0x14, 0x45, 0x25, 0x95, 0x0b, 0x97, 0xdc,
+	0x52, 0x54, 0x71, 0x81, 0x4b, 0x0a, 0xaa, 0x38, 0x50, 0x7c, 0x5c, 0x52, 0x45, 0x15, 0xe5, 0xe2,
+	0xc6, 0x85, 0x3f, 0x81, 0xea, 0x9e, 0xee, 0x99, 0x9e, 0xdd, 0xd9, 0x99, 0x15, 0x12, 0xc4, 0x27,
+	0xcd, 0x7b, 0xdb, 0xfd, 0xfa, 0xd7, 0xaf, 0xdf, 0x57, 0xbf, 0x16, 0x1c, 0x0f, 0x09, 0xed, 0x13,
+	0xda, 0xb4, 0x82, 0xa0, 0xe3, 0xda, 0x56, 0xe4, 0xfa, 0x9e, 0xfa, 0xdd, 0x08, 0xa8, 0x1f, 0xf9,
+	0xb8, 0xae, 0xb0, 0xf4, 0xf9, 0xb6, 0xef, 0xb7, 0x3b, 0xa4, 0x69, 0x05, 0x6e, 0xd3, 0xf2, 0x3c,
+	0x3f, 0xe2, 0xec, 0x30, 0x1e, 0xaa, 0x1b, 0x5b, 0xe7, 0xc3, 0x86, 0xeb, 0xf3, 0x5f, 0x6d, 0x9f,
+	0x92, 0x66, 0x7f, 0xa5, 0xd9, 0x26, 0x1e, 0xa1, 0x56, 0x44, 0x1c, 0x31, 0xe6, 0x5c, 0x3a, 0xa6,
+	0x6b, 0xd9, 0x9b, 0xae, 0x47, 0xe8, 0x76, 0x33, 0xd8, 0x6a, 0x33, 0x46, 0xd8, 0xec, 0x92, 0xc8,
+	0xca, 0x9b, 0xb5, 0xde, 0x76, 0xa3, 0xcd, 0xde, 0xeb, 0x0d, 0xdb, 0xef, 0x36, 0x2d, 0xda, 0xf6,
+	0x03, 0xea, 0x7f, 0x8b, 0x7f, 0x2c, 0xdb, 0x4e, 0xb3, 0xbf, 0x9a, 0x0a, 0x50, 0xf7, 0xd2, 0x5f,
+	0xb1, 0x3a, 0xc1, 0xa6, 0x35, 0x2c, 0xed, 0x4a, 0x89, 0x34, 0x4a, 0x02, 0x5f, 0xe8, 0x86, 0x7f,
+	0xba, 0x91, 0x4f, 0xb7, 0x95, 0xcf, 0x58, 0x8c, 0xf1, 0x31, 0x82, 0x83, 0x17, 0xd2, 0xf5, 0xbe,
+	0xd6, 0x23, 0x74, 0x1b, 0x63, 0x98, 0xf4, 0xac, 0x2e, 0xd1, 0xd0, 0x02, 0x5a, 0x9c, 0x31, 0xf9,
+	0x37, 0xd6, 0x60, 0x9a, 0x92, 0x16, 0x25, 0xe1, 0xa6, 0x56, 0xe1, 0x6c, 0x49, 0x62, 0x1d, 0x6a,
+	0x6c, 0x71, 0x62, 0x47, 0xa1, 0x36, 0xb1, 0x30, 0xb1, 0x38, 0x63, 0x26, 0x34, 0x5e, 0x84, 0x03,
+	0x94, 0x84, 0x7e, 0x8f, 0xda, 0xe4, 0x15, 0x42, 0x43, 0xd7, 0xf7, 0xb4, 0x49, 0x3e, 0x7b, 0x90,
+	0xcd, 0xa4, 0x84, 0xa4, 0x43, 0xec, 0xc8, 0xa7, 0xda, 0x14, 0x1f, 0x92, 0xd0, 0x0c, 0x0f, 0x03,
+	0xae, 0x55, 0x63, 0x3c, 0xec, 0x1b, 0x1b, 0x30, 0x6b, 0x05, 0xc1, 0x2d, 0xab, 0x4b, 0xc2, 0xc0,
+	0xb2, 0x89, 0x36, 0xcd, 0x7f, 0xcb, 0xf0, 0x8c, 0x4b, 0x30, 0x73, 0xcb, 0x77, 0xc8, 0xe8, 0x4d,
+	0x0d, 0x0a, 0xa9, 0xe4, 0x08, 0xf9, 0x11, 0x82, 0xc3, 0x26, 0xe9, 0xbb, 0x0c, 0xe5, 0x4d, 0x12,
+	0x59, 0x8e, 0x15, 0x59, 0x83, 0x12, 0x2b, 0x89, 0x44, 0x1d, 0x6a, 0x54, 0x0c, 0xd6, 0x2a, 0x9c,
+	0x9f, 0xd0, 0x43, 0xab, 0x4d, 0x0c, 0xaf, 0x86, 0x17, 0xa0, 0x1e, 0xeb, 0xe5, 0x86, 0xe7, 0x90,
+	0x6f, 0x73, 0x65, 0x4d, 0x99, 0x2a, 0xcb, 0xf8, 0x23, 0x82, 0xa3, 0xca, 0x89, 0x99, 0x42, 0x8f,
+	0x57, 0xfa, 0xc4, 0x8b, 0xc2, 0xd1, 0xc0, 0xce, 0xc0, 0x21, 0xa9, 0xf2, 0xc1, 0xfd, 0x0e, 0xff,
+	0xc0, 0xa0, 0xaa, 0x4c, 0x09, 0x55, 0xe5, 0x31, 0xa8, 0x92, 0xbe, 0x7b, 0xe3, 0xb2, 0x38, 0x57,
+	0x95, 0x35, 0xb4, 0xe1, 0xa9, 0x1c, 0xf5, 0x7a, 0xa0, 0x29, 0xbb, 0xb9, 0x69, 0x79, 0x6e, 0x8b,
+	0x84, 0xd1, 0xb8, 0x0a, 0x46, 0x3b, 0x55, 0xb0, 0xf1, 0x1c, 0xcc, 0x5c, 0x75, 0x3b, 0xe4, 0xd2,
+	0x66, 0xcf, 0xdb, 0xc2, 0x4f, 0xc3, 0x94, 0xcd, 0x3e, 0xf8, 0x0a, 0xb3, 0x66, 0x4c, 0x18, 0x0f,
+	0xe0, 0xb9, 0x51, 0x90, 0xee, 0xb9, 0xd1, 0x26, 0x9b, 0x1e, 0x8e, 0xc2, 0x66, 0x6f, 0x12, 0x7b,
+	0x2b, 0xec, 0x75, 0xe5, 0xe1, 0x4b, 0x7a, 0x2c, 0x6c, 0x2f, 0xc1, 0x11, 0x65, 0xe1, 0x57, 0xac,
+	0x8e, 0xeb, 0x58, 0x11, 0x31, 0x49, 0x18, 0xf8, 0x5e, 0x48, 0x18, 0x5a, 0x42, 0xa9, 0x4f, 0x85,
+	0x09, 0xc7, 0x04, 0x9e, 0x83, 0x2a, 0xf1, 0x22, 0x37, 0xda, 0x16, 0xea, 0x10, 0x94, 0xf1, 0x1a,
+	0x18, 0xaa, 0x99, 0xf8, 0x9d, 0x8e, 0xdf, 0x8b, 0xd8, 0x9f, 0xd7, 0x2d, 0x7b, 0x2b, 0x91, 0xc9,
+	0xdc, 0x3a, 0xfe, 0x49, 0xec, 0x44, 0x92, 0xec, 0x78, 0x3d, 0xf2, 0xc0, 0x54, 0x8d, 0x79, 0xc2,
+	0x54, 0x59, 0xc6, 0xaf, 0x10, 0x2c, 0x96, 0x2a, 0xea, 0x1e, 0xb5, 0x82, 0x80, 0x50, 0x7c, 0x15,
+	0xa6, 0xee, 0xb3, 0x1f, 0x38, 0xf8, 0xfa, 0x6a, 0xa3, 0xa1, 0x46, 0xe9, 0x52, 0x29, 0xd7, 0x3f,
+	0x65, 0xc6, 0xd3, 0x71, 0x43, 0x1e, 0x59, 0x85, 0xcb, 0x99, 0xcb, 0xc8, 0x49, 0x4e, 0x96, 0x8d,
+	0xe7, 0xc3, 0x2e, 0x56, 0x61, 0x32, 0xb0, 0x68, 0x64, 0x1c, 0x86, 0xa7, 0xb2, 0x5e, 0xc3, 0xf7,
+	0x6f, 0xfc, 0x06, 0x65, 0xec, 0xef, 0x12, 0x25, 0x5c, 0xe3, 0xf7, 0x7b, 0x24, 0x8c, 0xf0, 0x16,
+	0xa8, 0x89, 0x83, 0x2b, 0xa8, 0xbe, 0x7a, 0xa3, 0x91, 0x46, 0xde, 0x86, 0x8c, 0xbc, 0xfc, 0xe3,
+	0x9b, 0xb6, 0xd3, 0xe8, 0xaf, 0x36, 0x82, 0xad, 0x76, 0x83, 0xc5, 0xf1, 0x0c, 0x32, 0x19, 0xc7,
+	0xd5, 0xad, 0x9a, 0xaa, 0x74, 0x76, 0x8e, 0xbd, 0x20, 0x24, 0x34, 0xe2, 0x3b, 0xab, 0x99, 0x82,
+	0x62, 0x46, 0xd5, 0x17, 0x96, 0xc0, 0x8d, 0xa6, 0x66, 0x26, 0xb4, 0xf1, 0x5e, 0x16, 0xfd, 0xdd,
+	0xc0, 0xf9, 0xa4, 0xd0, 0xab, 0x28, 0x2b, 0x03, 0x28, 0xdf, 0xc9, 0xa2, 0xbc, 0x4c, 0x3a, 0x24,
+	0x45, 0x99, 0xe7, 0x47, 0x1a, 0x4c, 0xdb, 0x56, 0x68, 0x5b, 0x8e, 0x94, 0x25, 0x49, 0x16, 0xc5,
+	0x02, 0xea, 0x07, 0x56, 0x9b, 0x4b, 0xba, 0xed, 0x77, 0x5c, 0x7b, 0x5b, 0xb8, 0xd2, 0xf0, 0x0f,
+	0x43, 0x3e, 0x37, 0x99, 0xe3, 0x73, 0xc7, 0xa0, 0xbe, 0xb1, 0xed, 0xd9, 0x2f, 0x07, 0x6c, 0x5e,
+	0xc8, 0x7c, 0xcc, 0x8d, 0x48, 0x37, 0xd4, 0x10, 0xcf, 0x64, 0x31, 0x61, 0x7c, 0xaf, 0x0a, 0x73,
+	0xca, 0x0e, 0xd8, 0x84, 0x22, 0xfc, 0x45, 0x31, 0x6a, 0x0e, 0xaa, 0x0e, 0xdd, 0x36, 0x7b, 0x9e,
+	0x38, 0x4c, 0x41, 0xb1, 0x85, 0x03, 0xda, 0xf3, 0x62, 0x90, 0x35, 0x33, 0x26, 0x70, 0x0b, 0x6a,
+	0x61, 0xc4, 0xd2, 0x7e, 0x7b, 0x9b, 0x47, 0xcf, 0xfa, 0xea, 0x57, 0x77, 0x77, 0x80, 0x0c, 0xfa,
+	0x86, 0x90, 0x68, 0x26, 0xb2, 0xf1, 0x7d, 0x98, 0x91, 0x81, 0x3b, 0xd4, 0xa6, 0x17, 0x26, 0x16,
+	0xeb, 0xab, 0x1b, 0xbb, 0x5f, 0xe8, 0xe5, 0x80, 0xd0, 0xd8, 0x56, 0x84, 0x6c, 0x33, 0x5d, 0x05,
+	0xcf, 0xc3, 0x4c, 0x57, 0xf8, 0x7a, 0xa8, 0xd5, 0xb8, 0xb6, 0x53, 0x06, 0xfe, 0x3a, 0x4c, 0xb9,
+	0x5e, 0xcb, 0x0f, 0xb5, 0x19, 0x0e, 0xe6, 0xe2, 0xee, 0xc0, 0xdc, 0xf0, 0x5a, 0xbe, 0x19, 0x0b,
+	0xc4, 0xf7, 0x61, 0x1f, 0x25, 0x11, 0xdd, 0x96, 0x5a, 0xd0, 0x80, 0xeb, 0xf5, 0xa5, 0xdd, 0xad,
+	0x60, 0xaa, 0x22, 0xcd, 0xec, 0x0a, 0x78, 0x0d, 0xea, 0x61, 0x6a, 0x63, 0x5a, 0x9d, 0x2f, 0xa8,
+	0x65, 0x04, 0x29, 0x36, 0x68, 0xaa, 0x83, 0x87, 0x6c, 0x78, 0x36, 0xa7, 0x68, 0xb8, 0x0e, 0x33,
+	0x9e, 0x1f, 0x5d, 0x24, 0x2d, 0x9f, 0x12, 0x6d, 0x1f, 0x97, 0xbe, 0xd4, 0x88, 0x6b, 0xd4, 0x86,
+	0x5a, 0xa3, 0xa6, 0x7b, 0x60, 0x35, 0x6a, 0xa3, 0xbf, 0xd2, 0xb8, 0xe3, 0x76, 0x89, 0x99, 0x4e,
+	0x66, 0x87, 0x12, 0x50, 0xd2, 0xea, 0xb8, 0xed, 0xcd, 0x48, 0xdb, 0xcf, 0x2d, 0x31, 0x65, 0x18,
+	0x3f, 0x40, 0x30, 0x3f, 0x9c, 0xa0, 0xf8, 0xf9, 0xfe, 0xff, 0x43, 0x8e, 0xf1, 0x01, 0xca, 0xe4,
+	0xe9, 0xa1, 0x0c, 0x37, 0xda, 0x3f, 0x59, 0xe5, 0x12, 0x8f, 0xe6, 0xc5, 0x4d, 0x9c, 0xaa, 0x55,
+	0x16, 0x5e, 0x82, 0x83, 0x0a, 0x29, 0x33, 0x36, 0x1b, 0x36, 0xc4, 0xe7, 0x35, 0xae, 0x58, 0x5b,
+	0x3a, 0xfd, 0x24, 0x4f, 0x96, 0x83, 0x6c, 0xe3, 0x6f, 0x59, 0xfd, 0xc5, 0xe1, 0x7a, 0x23, 0x20,
+	0x85, 0xc1, 0xc4, 0x82, 0xc9, 0x30, 0x20, 0x36, 0x47, 0x59, 0x5f, 0xbd, 0xb9, 0x67, 0xca, 0xe4,
+	0xeb, 0x72, 0xd1, 0x45, 0x29, 0x66, 0xac, 0x18, 0xfa, 0x7d, 0x04, 0x9f, 0x56, 0x24, 0xdf, 0xb6,
+	0x22, 0x7b, 0xb3, 0x68, 0x4b, 0x2c, 0xd6, 0xb1, 0x31, 0x42, 0xf3, 0x31, 0xc1, 0x6d, 0x8f, 0x7d,
+	0xdc, 0xd9, 0x0e, 0xa4, 0xb2, 0x53, 0xc6, 0x58, 0xb5, 0xe4, 0x47, 0x08, 0xf4, 0x01, 0x8b, 0x28,
+	0x33, 0x85, 0xfd, 0x50, 0x71, 0x1d, 0x1e, 0xa4, 0x27, 0xcc, 0x8a, 0xeb, 0xec, 0x30, 0x3c, 0x0f,
+	0x82, 0xaa, 0xe6, 0x38, 0xa7, 0x9a, 0x0c, 0xa6, 0x87, 0x93, 0x01, 0x25, 0x56, 0xe8, 0x7b, 0x5a,
+	0x2d, 0xae, 0xdd, 0x62, 0xca, 0xf8, 0x78, 0x60, 0x23, 0x32, 0x7c, 0x16, 0x6c, 0x64, 0x1e, 0x66,
+	0xbc, 0x81, 0xba, 0x3e, 0x65, 0xe4, 0xd4, 0xf3, 0x95, 0xa1, 0x7a, 0x5e, 0x83, 0xe9, 0x7e, 0x72,
+	0x47, 0xe3, 0xa5, 0xa0, 0x20, 0xd9, 0xe6, 0xdb, 0xd4, 0xef, 0x05, 0x42, 0xe9, 0x31, 0xc1, 0x50,
+	0x6c, 0xb9, 0x9e, 0xa3, 0x55, 0x63, 0x14, 0xec, 0x7b, 0xac, 0x5b, 0xd9, 0x5b, 0x15, 0xf8, 0x4c,
+	0xce, 0xe6, 0x4a, 0xad, 0xe6, 0xc9, 0xd8, 0x61, 0x62, 0xbb, 0xd3, 0x23, 0x6d, 0xb7, 0x56, 0x66,
+	0xbb, 0x33, 0x39, 0x5a, 0x79, 0xb3, 0x02, 0x0b, 0x39, 0x5a, 0x29, 0x2f, 0x96, 0x9e, 0x18, 0xb5,
+	0xb4, 0x7c, 0x2a, 0x4e, 0xbc, 0x66, 0xc6, 0x04, 0xb3, 0x6f, 0x9f, 0x06, 0x9b, 0x56, 0x6c, 0xdf,
+	0x35, 0x53, 0x50, 0x63, 0x29, 0xe4, 0xdf, 0x08, 0x34, 0xa9, 0x85, 0x0b, 0x36, 0xd7, 0x49, 0xcf,
+	0x7b, 0xf2, 0x15, 0x31, 0x07, 0x55, 0x8b, 0xa3, 0x15, 0x06, 0x22, 0xa8, 0xa1, 0x2d, 0xd7, 0xf2,
+	0xe3, 0xe8, 0x91, 0xec, 0x96, 0xc3, 0x75, 0x37, 0x8c, 0x92, 0xcb, 0x5a, 0x0b, 0xa6, 0x63, 0x69,
+	0x71, 0x79, 0x5a, 0x5f, 0x5d, 0xdf, 0x6d, 0xd1, 0x92, 0x51, 0xaf, 0x14, 0x6e, 0xbc, 0x90, 0xb9,
+	0x87, 0xa6, 0xd1, 0x47, 0xc0, 0xd0, 0xa1, 0x26, 0x0b, 0x35, 0x71, 0x00, 0x09, 0x6d, 0xfc, 0x6b,
+	0x22, 0x9b, 0x0a, 0x7c, 0x67, 0xdd, 0x6f, 0x17, 0xb4, 0x25, 0x8a, 0x0f, 0x4d, 0x83, 0xe9, 0xc0,
+	0x77, 0x94, 0x0e, 0x84, 0x24, 0xd9, 0x3c, 0xdb, 0xf7, 0x22, 0xcb, 0xf5, 0x08, 0x15, 0x39, 0x29,
+	0x65, 0x30, 0x65, 0x87, 0xae, 0x67, 0x93, 0x0d, 0x62, 0xfb, 0x9e, 0x13, 0xf2, 0x53, 0x9b, 0x30,
+	0x33, 0x3c, 0x56, 0x34, 0x71, 0x9a, 0x95, 0x40, 0x5a, 0x75, 0xe7, 0x45, 0x53, 0x32, 0x99, 0x61,
+	0x89, 0x2c, 0xb7, 0xb3, 0xee, 0x7a, 0xbc, 0x78, 0x66, 0x4b, 0xa5, 0x0c, 0x66, 0x10, 0x2d, 0x56,
+	0x07, 0x3c, 0x90, 0x3e, 0x10, 0x53, 0x6c, 0x56, 0xcf, 0x8b, 0xdc, 0x0e, 0x5f, 0x3f, 0x76, 0x80,
+	0x94, 0xc1, 0x67, 0xb9, 0x9d, 0x88, 0x50, 0x5e, 0x9e, 0xce, 0x98, 0x82, 0x4a, 0x4c, 0xae, 0xce,
+	0xb9, 0x89, 0xef, 0xc5, 0xc6, 0x39, 0xab, 0x1a, 0xe7, 0xa0, 0xc1, 0xef, 0xcb, 0x69, 0xe1, 0xf0,
+	0xd6, 0x1d, 0xe9, 0xbb, 0x7e, 0x2f, 0x14, 0xd5, 0x5e, 0x42, 0x0f, 0x19, 0xec, 0x81, 0x1c, 0x83,
+	0xfd, 0x2d, 0x82, 0xda, 0xba, 0xdf, 0xbe, 0xe2, 0x45, 0x74, 0x9b, 0xdf, 0xda, 0x7c, 0x2f, 0x22,
+	0x5e, 0xd2, 0x4a, 0x10, 0x24, 0x53, 0x75, 0xe4, 0x76, 0xc9, 0x46, 0x64, 0x75, 0x03, 0x51, 0xc7,
+	0xec, 0x48, 0xd5, 0xc9, 0x64, 0xb6, 0xfd, 0x8e, 0x15, 0x46, 0xdc, 0x7b, 0x6b, 0x26, 0xff, 0x66,
+	0x40, 0x93, 0x01, 0x1b, 0x11, 0x15, 0xae, 0x9b, 0xe1, 0xa9, 0x86, 0x34, 0x15, 0x63, 0x13, 0xa4,
+	0xb1, 0x01, 0xcf, 0x24, 0xd7, 0x94, 0x3b, 0x84, 0x76, 0x5d, 0xcf, 0x2a, 0x8e, 0xb7, 0xe3, 0xf4,
+	0x0c, 0xef, 0x66, 0x1c, 0x88, 0xd5, 0xf6, 0xf7, 0x5c, 0xcf, 0xf1, 0x1f, 0x14, 0x38, 0xc2, 0x38,
+	0x62, 0xff, 0x9c, 0x6d, 0xfd, 0x29, 0x72, 0x13, 0xdf, 0xbc, 0x0e, 0xfb, 0x98, 0x17, 0xf7, 0x89,
+	0xf8, 0x41, 0x04, 0x0a, 0x63, 0x54, 0xbb, 0x25, 0x95, 0x61, 0x66, 0x27, 0xe2, 0x75, 0x38, 0x60,
+	0x85, 0xa1, 0xdb, 0xf6, 0x88, 0x23, 0x65, 0x55, 0xc6, 0x96, 0x35, 0x38, 0x35, 0xbe, 0xd2, 0xf3,
+	0x11, 0xe2, 0xec, 0x24, 0x69, 0x7c, 0x17, 0xc1, 0xe1, 0x5c, 0x21, 0x89, 0xad, 0x23, 0x25, 0xbc,
+	0xb2, 0x36, 0xb1, 0xbd, 0x49, 0x9c, 0x5e, 0x47, 0xd6, 0xed, 0x09, 0xcd, 0x7e, 0x73, 0x7a, 0xf1,
+	0x49, 0x8a, 0xf0, 0x9e, 0xd0, 0xf8, 0x28, 0x40, 0xd7, 0xf2, 0x7a, 0x56, 0x87, 0x43, 0x98, 0xe4,
+	0x10, 0x14, 0x8e, 0x31, 0x0f, 0x7a, 0x9e, 0x19, 0x88, 0x2e, 0xd1, 0x5f, 0x11, 0xec, 0x97, 0x61,
+	0x50, 0x9c, 0xe1, 0x22, 0x1c, 0x50, 0xd4, 0x70, 0x2b, 0x3d, 0xce, 0x41, 0x76, 0x49, 0x88, 0x93,
+	0xb6, 0x30, 0x91, 0xed, 0xb5, 0xf7, 0x33, 0xdd, 0xf2, 0xb1, 0xf3, 0x10, 0xda, 0x51, 0x25, 0xf6,
+	0x1d, 0xd0, 0x6e, 0x5a, 0x9e, 0xd5, 0x26, 0x4e, 0xb2, 0xb9, 0xc4, 0x90, 0x5e, 0x53, 0x1b, 0x21,
+	0xbb, 0x6e, 0x3b, 0x24, 0xe5, 0x8c, 0xdb, 0x6a, 0x89, 0xa6, 0xca, 0xea, 0xdf, 0x0d, 0xc0, 0xea,
+	0xc1, 0x13, 0xda, 0x77, 0x6d, 0x82, 0x7f, 0x82, 0x60, 0x92, 0x65, 0x3d, 0xfc, 0xec, 0x28, 0x3b,
+	0xe3, 0x07, 0xa0, 0xef, 0xdd, 0x4d, 0x88, 0xad, 0x66, 0xcc, 0xbf, 0xf1, 0x97, 0x7f, 0xbe, 0x55,
+	0x99, 0xc3, 0x4f, 0xf3, 0x97, 0x9f, 0xfe, 0x8a, 0xfa, 0x0a, 0x13, 0xe2, 0x1f, 0x22, 0xc0, 0x22,
+	0x15, 0x2b, 0xdd, 0x76, 0x7c, 0x7a, 0x14, 0xc4, 0x9c, 0xae, 0xbc, 0xfe, 0xac, 0x12, 0xf2, 0x1a,
+	0xb6, 0x4f, 0x09, 0x0b, 0x70, 0x7c, 0x00, 0x07, 0xb0, 0xc4, 0x01, 0x1c, 0xc7, 0x46, 0x1e, 0x80,
+	0xe6, 0x43, 0x66, 0x18, 0x8f, 0x9a, 0x24, 0x5e, 0xf7, 0x5d, 0x04, 0x53, 0xf7, 0x78, 0xe1, 0x59,
+	0xa2, 0xa4, 0x8d, 0x3d, 0x53, 0x12, 0x5f, 0x8e, 0xa3, 0x35, 0x8e, 0x71, 0xa4, 0xcf, 0xe2, 0x23,
+	0x12, 0x69, 0x18, 0x51, 0x62, 0x75, 0x33, 0x80, 0xcf, 0x22, 0xfc, 0x3e, 0x82, 0x6a, 0xdc, 0x4f,
+	0xc5, 0x27, 0x46, 0xa1, 0xcc, 0xf4, 0x5b, 0xf5, 0xbd, 0xeb, 0x14, 0x18, 0xa7, 0x38, 0xc6, 0x63,
+	0x46, 0xee, 0x71, 0xae, 0x65, 0x5a, 0x97, 0x6f, 0x23, 0x98, 0xb8, 0x46, 0x4a, 0xed, 0x6d, 0x0f,
+	0xc1, 0x0d, 0x29, 0x30, 0xe7, 0xa8, 0xf1, 0x7b, 0x08, 0x9e, 0xb9, 0x46, 0xa2, 0xfc, 0x78, 0x8f,
+	0x17, 0xcb, 0x83, 0xb0, 0x30, 0xbb, 0xd3, 0x63, 0x8c, 0x4c, 0x02, 0x5d, 0x93, 0x23, 0x3b, 0x85,
+	0x4f, 0x16, 0x19, 0x21, 0x6b, 0x4f, 0x3d, 0x10, 0x38, 0xfe, 0x80, 0xe0, 0xe0, 0xe0, 0xeb, 0x18,
+	0xce, 0x66, 0x88, 0xdc, 0xc7, 0x33, 0xfd, 0xd6, 0x6e, 0x03, 0x4a, 0x56, 0xa8, 0x71, 0x81, 0x23,
+	0x7f, 0x11, 0xbf, 0x50, 0x84, 0x5c, 0x5e, 0xbc, 0xc3, 0xe6, 0x43, 0xf9, 0xf9, 0xa8, 0xd9, 0x15,
+	0x22, 0xf0, 0x1b, 0x08, 0x66, 0xaf, 0x91, 0xe8, 0x66, 0xd2, 0x84, 0x3c, 0x31, 0xd6, 0x23, 0x85,
+	0x3e, 0xdf, 0x50, 0x9e, 0x55, 0xe5, 0x4f, 0x89, 0x4a, 0x97, 0x39, 0xb0, 0x93, 0xf8, 0x44, 0x11,
+	0xb0, 0xb4, 0xf1, 0xf9, 0x2e, 0x82, 0xc3, 0x2a, 0x88, 0xf4, 0xc5, 0xe9, 0x73, 0x3b, 0x7b, 0x32,
+	0x11, 0x0f, 0x2f, 0x25, 0xe8, 0x56, 0x39, 0xba, 0x33, 0x46, 0xfe, 0x81, 0x77, 0x87, 0x50, 0xac,
+	0xa1, 0xa5, 0x45, 0x84, 0x7f, 0x87, 0xa0, 0x1a, 0x77, 0xaf, 0x46, 0xeb, 0x28, 0xf3, 0x18, 0xb1,
+	0x97, 0xde, 0x73, 0x85, 0x43, 0xfe, 0xb2, 0x7e, 0x36, 0x5f, 0xa1, 0xea, 0x7c, 0x79, 0xb4, 0x0d,
+	0xae, 0xe5, 0xac, 0xdb, 0x7f, 0x88, 0x00, 0xd2, 0x0e, 0x1c, 0x3e, 0x55, 0xbc, 0x0f, 0xa5, 0x4b,
+	0xa7, 0xef, 0x6d, 0x0f, 0xce, 0x68, 0xf0, 0xfd, 0x2c, 0xea, 0x0b, 0x85, 0x3e, 0x17, 0x10, 0x7b,
+	0x2d, 0xee, 0xd6, 0xfd, 0x12, 0xc1, 0x14, 0x6f, 0x96, 0xe0, 0xe3, 0xa3, 0x30, 0xab, 0xbd, 0x94,
+	0xbd, 0x54, 0xfd, 0xf3, 0x1c, 0xea, 0xc2, 0x6a, 0x51, 0xe0, 0x5a, 0x43, 0x4b, 0xb8, 0x0f, 0xd5,
+	0xb8, 0x71, 0x31, 0xda, 0x3c, 0x32, 0x8d, 0x0d, 0x7d, 0xa1, 0x20, 0x91, 0xc6, 0x86, 0x2a, 0x62,
+	0xe6, 0x52, 0x59, 0xcc, 0x9c, 0x64, 0x61, 0x0d, 0x1f, 0x2b, 0x0a, 0x7a, 0xff, 0x03, 0xc5, 0x9c,
+	0xe6, 0xe8, 0x4e, 0x18, 0x0b, 0x65, 0x71, 0x93, 0x69, 0xe7, 0xa7, 0x08, 0x0e, 0x0e, 0xd6, 0x5d,
+	0xf8, 0xc8, 0x40, 0xcc, 0x54, 0x8b, 0x4d, 0x3d, 0xab, 0xc5, 0x51, 0x35, 0x9b, 0xf1, 0x15, 0x8e,
+	0x62, 0x0d, 0x9f, 0x2f, 0xf5, 0x8c, 0x5b, 0x32, 0xea, 0x30, 0x41, 0xcb, 0xe9, 0xa3, 0xcc, 0xaf,
+	0x11, 0xcc, 0x4a, 0xb9, 0x77, 0x28, 0x21, 0xc5, 0xb0, 0xf6, 0xce, 0x11, 0xd8, 0x5a, 0xc6, 0x17,
+	0x39, 0xfc, 0xcf, 0xe3, 0x73, 0x63, 0xc2, 0x97, 0xb0, 0x97, 0x23, 0x86, 0xf4, 0x23, 0x04, 0x87,
+	0xee, 0xc5, 0x76, 0xff, 0x09, 0xe1, 0xbf, 0xc4, 0xf1, 0x7f, 0x09, 0xbf, 0x58, 0x50, 0x17, 0x95,
+	0x6d, 0xe3, 0x2c, 0xc2, 0x1f, 0x20, 0xa8, 0xc9, 0xd6, 0x35, 0x3e, 0x39, 0xd2, 0x31, 0xb2, 0xcd,
+	0xed, 0xbd, 0x34, 0x66, 0x51, 0x04, 0x18, 0xc7, 0x0b, 0x53, 0xa9, 0x58, 0x9f, 0x19, 0xf4, 0xdb,
+	0x08, 0x70, 0x72, 0x69, 0x4a, 0xae, 0x51, 0xf8, 0xf9, 0xcc, 0x52, 0x23, 0x6f, 0xd9, 0xfa, 0xc9,
+	0xd2, 0x71, 0xd9, 0x54, 0xba, 0x54, 0x98, 0x4a, 0xfd, 0x64, 0xfd, 0x37, 0x11, 0xd4, 0xaf, 0x91,
+	0xa4, 0x66, 0x2f, 0xd0, 0x65, 0xb6, 0xbf, 0xae, 0x2f, 0x96, 0x0f, 0x14, 0x88, 0xce, 0x70, 0x44,
+	0xcf, 0xe3, 0x62, 0x55, 0x49, 0x00, 0x3f, 0x47, 0xb0, 0xef, 0xb6, 0x6a, 0xa2, 0xf8, 0x4c, 0xd9,
+	0x4a, 0x99, 0x48, 0x3e, 0x3e, 0xae, 0xcf, 0x72, 0x5c, 0xcb, 0xc6, 0x58, 0xb8, 0xd6, 0x44, 0x13,
+	0xfb, 0x17, 0x08, 0x9e, 0x52, 0x2f, 0x39, 0xa2, 0x05, 0xf9, 0xdf, 0xea, 0xad, 0xa0, 0x93, 0x69,
+	0x9c, 0xe3, 0xf8, 0x1a, 0xf8, 0xcc, 0x38, 0xf8, 0x9a, 0xa2, 0x2f, 0x89, 0xdf, 0x41, 0x70, 0x88,
+	0x37, 0x81, 0x55, 0xc1, 0x03, 0x29, 0x66, 0x54, 0xcb, 0x78, 0x8c, 0x14, 0x23, 0xe2, 0x8f, 0xb1,
+	0x23, 0x50, 0x6b, 0xb2, 0xc1, 0xfb, 0x21, 0x02, 0x5d, 0x3a, 0xe5, 0xf0, 0xb3, 0x24, 0x6e, 0x14,
+	0x39, 0xf2, 0xf0, 0xbb, 0xa5, 0xde, 0x1c, 0x7b, 0xbc, 0x40, 0xff, 0x05, 0x8e, 0x7e, 0xa5, 0x04,
+	0x7d, 0x3c, 0x79, 0x59, 0xf5, 0xde, 0x1f, 0x23, 0xd8, 0x2f, 0xb3, 0xb1, 0x30, 0xcb, 0xe5, 0xb2,
+	0x13, 0xdf, 0x69, 0xf6, 0x16, 0x7e, 0xb2, 0x34, 0x9e, 0x9f, 0xfc, 0x0c, 0xc1, 0x21, 0xf9, 0xdf,
+	0x4f, 0x1b, 0xd4, 0xbe, 0xe0, 0x39, 0x97, 0xc3, 0x68, 0x74, 0x85, 0x36, 0xf4, 0x0e, 0xad, 0x2f,
+	0x96, 0x0c, 0x4d, 0x1d, 0x65, 0x85, 0x03, 0x3b, 0x6d, 0xcc, 0xe7, 0x00, 0x5b, 0x96, 0x8f, 0xa0,
+	0xd9, 0xc2, 0xf1, 0x7d, 0x04, 0xd3, 0xa2, 0xaf, 0x5d, 0x50, 0x81, 0x29, 0x8d, 0x6f, 0xfd, 0x70,
+	0x66, 0x94, 0x6c, 0x98, 0x1a, 0xdf, 0xe0, 0x6b, 0xdf, 0xc5, 0xcd, 0x22, 0xa5, 0x04, 0xbe, 0x13,
+	0x36, 0x1f, 0x8a, 0x6e, 0xe5, 0xa3, 0x66, 0xc7, 0x6f, 0x87, 0xaf, 0x1a, 0xb8, 0xb0, 0xce, 0x60,
+	0x63, 0xce, 0xa2, 0x8b, 0x57, 0x7f, 0xff, 0xf8, 0x28, 0xfa, 0xd3, 0xe3, 0xa3, 0xe8, 0x1f, 0x8f,
+	0x8f, 0xa2, 0x57, 0xcf, 0x8f, 0xf7, 0xdf, 0xa7, 0x76, 0xc7, 0x25, 0x5e, 0xa4, 0x8a, 0xfd, 0xcf,
+	0x00, 0x7a, 0x54, 0x4d, 0x50, 0x63, 0x2b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4180,6 +4231,11 @@ func (m *RevisionMetadataQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SourceIndex != nil {
+		i = encodeVarintApplication(dAtA, i, uint64(*m.SourceIndex))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.AppNamespace != nil {
 		i -= len(*m.AppNamespace)
 		copy(dAtA[i:], *m.AppNamespace)
@@ -4831,6 +4887,28 @@ func (m *ApplicationSyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Preflight != nil {
+		i--
+		if *m.Preflight {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.NotBefore != nil {
+		{
+			size, err := m.NotBefore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.AppNamespace != nil {
 		i -= len(*m.AppNamespace)
 		copy(dAtA[i:], *m.AppNamespace)
@@ -5204,6 +5282,20 @@ func (m *ApplicationRollbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Reason != nil {
+		i -= len(*m.Reason)
+		copy(dAtA[i:], *m.Reason)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Reason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Revision != nil {
+		i -= len(*m.Revision)
+		copy(dAtA[i:], *m.Revision)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Revision)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.AppNamespace != nil {
 		i -= len(*m.AppNamespace)
 		copy(dAtA[i:], *m.AppNamespace)
@@ -5231,9 +5323,7 @@ func (m *ApplicationRollbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.Id == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
-	} else {
+	if m.Id != nil {
 		i = encodeVarintApplication(dAtA, i, uint64(*m.Id))
 		i--
 		dAtA[i] = 0x10
@@ -6387,6 +6477,9 @@ func (m *RevisionMetadataQuery) Size() (n int) {
 		l = len(*m.AppNamespace)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.SourceIndex != nil {
+		n += 1 + sovApplication(uint64(*m.SourceIndex))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6719,6 +6812,13 @@ func (m *ApplicationSyncRequest) Size() (n int) {
 		l = len(*m.AppNamespace)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.NotBefore != nil {
+		l = m.NotBefore.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Preflight != nil {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6846,6 +6946,14 @@ func (m *ApplicationRollbackRequest) Size() (n int) {
 		l = len(*m.AppNamespace)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.Revision != nil {
+		l = len(*m.Revision)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Reason != nil {
+		l = len(*m.Reason)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7862,6 +7970,26 @@ func (m *RevisionMetadataQuery) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.AppNamespace = &s
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceIndex", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SourceIndex = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -9803,6 +9931,63 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.AppNamespace = &s
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotBefore == nil {
+				m.NotBefore = &v1.Time{}
+			}
+			if err := m.NotBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preflight", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Preflight = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -10570,7 +10755,6 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Id = &v
-			hasFields[0] |= uint64(0x00000002)
 		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
@@ -10646,6 +10830,72 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.AppNamespace = &s
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Revision = &s
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Reason = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -10665,9 +10915,6 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 	if hasFields[0]&uint64(0x00000001) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -471,6 +471,87 @@ func (m *SyncWindowsQuery) GetName() string {
 	return ""
 }
 
+// SyncWindowsOverrideRequest defines the parameters of an emergency override of the sync windows of an application
+type SyncWindowsOverrideRequest struct {
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Application  string `protobuf:"bytes,2,opt,name=application,proto3" json:"application,omitempty"`
+	AppNamespace string `protobuf:"bytes,3,opt,name=appNamespace,proto3" json:"appNamespace,omitempty"`
+	// duration represents the duration of the override in seconds
+	Duration             int64    `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	Reason               string   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncWindowsOverrideRequest) Reset()         { *m = SyncWindowsOverrideRequest{} }
+func (m *SyncWindowsOverrideRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncWindowsOverrideRequest) ProtoMessage()    {}
+func (*SyncWindowsOverrideRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{8}
+}
+func (m *SyncWindowsOverrideRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindowsOverrideRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncWindowsOverrideRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncWindowsOverrideRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindowsOverrideRequest.Merge(m, src)
+}
+func (m *SyncWindowsOverrideRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindowsOverrideRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindowsOverrideRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindowsOverrideRequest proto.InternalMessageInfo
+
+func (m *SyncWindowsOverrideRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SyncWindowsOverrideRequest) GetApplication() string {
+	if m != nil {
+		return m.Application
+	}
+	return ""
+}
+
+func (m *SyncWindowsOverrideRequest) GetAppNamespace() string {
+	if m != nil {
+		return m.AppNamespace
+	}
+	return ""
+}
+
+func (m *SyncWindowsOverrideRequest) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *SyncWindowsOverrideRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type SyncWindowsResponse struct {
 	Windows              []*v1alpha1.SyncWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -482,7 +563,7 @@ func (m *SyncWindowsResponse) Reset()         { *m = SyncWindowsResponse{} }
 func (m *SyncWindowsResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncWindowsResponse) ProtoMessage()    {}
 func (*SyncWindowsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{8}
+	return fileDescriptor_5f0a51496972c9e2, []int{9}
 }
 func (m *SyncWindowsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -529,7 +610,7 @@ func (m *GlobalProjectsResponse) Reset()         { *m = GlobalProjectsResponse{}
 func (m *GlobalProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*GlobalProjectsResponse) ProtoMessage()    {}
 func (*GlobalProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{9}
+	return fileDescriptor_5f0a51496972c9e2, []int{10}
 }
 func (m *GlobalProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -579,7 +660,7 @@ func (m *DetailedProjectsResponse) Reset()         { *m = DetailedProjectsRespon
 func (m *DetailedProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*DetailedProjectsResponse) ProtoMessage()    {}
 func (*DetailedProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{10}
+	return fileDescriptor_5f0a51496972c9e2, []int{11}
 }
 func (m *DetailedProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,6 +726,7 @@ func init() {
 	proto.RegisterType((*ProjectUpdateRequest)(nil), "project.ProjectUpdateRequest")
 	proto.RegisterType((*EmptyResponse)(nil), "project.EmptyResponse")
 	proto.RegisterType((*SyncWindowsQuery)(nil), "project.SyncWindowsQuery")
+	proto.RegisterType((*SyncWindowsOverrideRequest)(nil), "project.SyncWindowsOverrideRequest")
 	proto.RegisterType((*SyncWindowsResponse)(nil), "project.SyncWindowsResponse")
 	proto.RegisterType((*GlobalProjectsResponse)(nil), "project.GlobalProjectsResponse")
 	proto.RegisterType((*DetailedProjectsResponse)(nil), "project.DetailedProjectsResponse")
@@ -653,67 +735,73 @@ func init() {
 func init() { proto.RegisterFile("server/project/project.proto", fileDescriptor_5f0a51496972c9e2) }
 
 var fileDescriptor_5f0a51496972c9e2 = []byte{
-	// 950 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xe5, 0xa4, 0xcd, 0x6e, 0x27, 0x4b, 0x29, 0xb3, 0xbb, 0xc5, 0x0d, 0xdd, 0x6e, 0x18,
-	0x44, 0x15, 0x15, 0x3a, 0x56, 0x53, 0x90, 0x56, 0x70, 0x62, 0x77, 0xab, 0x80, 0xd4, 0x03, 0xb8,
-	0x20, 0x10, 0x07, 0xd0, 0xc4, 0x7e, 0xca, 0xce, 0xc6, 0xf1, 0x0c, 0x9e, 0x89, 0xb7, 0x21, 0xea,
-	0x05, 0x09, 0x90, 0x38, 0x70, 0x80, 0x13, 0xff, 0x00, 0x27, 0xfe, 0x09, 0x6e, 0x1c, 0x91, 0xf8,
-	0x07, 0x50, 0xc5, 0x1f, 0x82, 0x3c, 0xfe, 0x91, 0x38, 0xa9, 0x11, 0x68, 0xc3, 0x9e, 0x3c, 0x1e,
-	0x3f, 0xbf, 0xef, 0xe7, 0xbd, 0x99, 0x79, 0x6f, 0xd0, 0xae, 0x82, 0x28, 0x86, 0xc8, 0x91, 0x91,
-	0x78, 0x0c, 0x9e, 0xce, 0x9f, 0x54, 0x46, 0x42, 0x0b, 0x7c, 0x2d, 0x7b, 0x6d, 0xed, 0x0e, 0x84,
-	0x18, 0x04, 0xe0, 0x30, 0xc9, 0x1d, 0x16, 0x86, 0x42, 0x33, 0xcd, 0x45, 0xa8, 0x52, 0xb3, 0x16,
-	0x19, 0xde, 0x53, 0x94, 0x0b, 0xf3, 0xd5, 0x13, 0x11, 0x38, 0xf1, 0x91, 0x33, 0x80, 0x10, 0x22,
-	0xa6, 0xc1, 0xcf, 0x6c, 0x4e, 0x07, 0x5c, 0x3f, 0x1a, 0xf7, 0xa9, 0x27, 0x46, 0x0e, 0x8b, 0x06,
-	0x22, 0xf1, 0x6c, 0x06, 0x87, 0x9e, 0xef, 0xc4, 0x5d, 0x47, 0x0e, 0x07, 0xc9, 0xff, 0xca, 0x61,
-	0x52, 0x06, 0xdc, 0x33, 0xfe, 0x9d, 0xf8, 0x88, 0x05, 0xf2, 0x11, 0x5b, 0xf2, 0x46, 0x7e, 0xb0,
-	0xd0, 0xad, 0xf7, 0x53, 0xb6, 0x07, 0x11, 0x30, 0x0d, 0x2e, 0x7c, 0x31, 0x06, 0xa5, 0x71, 0x1f,
-	0xe5, 0xcc, 0xb6, 0xd5, 0xb6, 0x3a, 0xcd, 0xee, 0xbb, 0x74, 0x26, 0x4c, 0x73, 0x61, 0x33, 0xf8,
-	0xdc, 0xf3, 0x69, 0xdc, 0xa5, 0x72, 0x38, 0xa0, 0x89, 0x30, 0x9d, 0x13, 0xa6, 0xb9, 0x30, 0x7d,
-	0x47, 0xca, 0x4c, 0xc7, 0xcd, 0x1d, 0xe3, 0x6d, 0xd4, 0x18, 0x4b, 0x05, 0x91, 0xb6, 0x6b, 0x6d,
-	0xab, 0x73, 0xdd, 0xcd, 0xde, 0xc8, 0x10, 0xed, 0x64, 0xb6, 0x1f, 0x8a, 0x21, 0x84, 0x0f, 0x21,
-	0x80, 0x19, 0x98, 0x5d, 0x06, 0xdb, 0x98, 0xb9, 0xc3, 0x68, 0x2d, 0x12, 0x01, 0x18, 0x67, 0x1b,
-	0xae, 0x19, 0xe3, 0x2d, 0x54, 0xe7, 0x4c, 0xdb, 0xf5, 0xb6, 0xd5, 0xa9, 0xbb, 0xc9, 0x10, 0x6f,
-	0xa2, 0x1a, 0xf7, 0xed, 0x35, 0x63, 0x53, 0xe3, 0x3e, 0xf9, 0xc9, 0x2a, 0xab, 0x95, 0xd3, 0x50,
-	0xad, 0xd6, 0x46, 0x4d, 0x1f, 0x94, 0x17, 0x71, 0x99, 0x04, 0x9a, 0x89, 0xce, 0x4f, 0x15, 0x3c,
-	0xf5, 0x39, 0x9e, 0x5d, 0xb4, 0x01, 0xe7, 0x92, 0x47, 0xa0, 0xde, 0x0b, 0x0d, 0x44, 0xdd, 0x9d,
-	0x4d, 0x64, 0x6c, 0xeb, 0x05, 0xdb, 0xeb, 0xc5, 0xe2, 0x18, 0x34, 0x17, 0x94, 0x14, 0xa1, 0x02,
-	0x7c, 0x0b, 0xad, 0xeb, 0x64, 0x22, 0x63, 0x4a, 0x5f, 0x08, 0x41, 0x37, 0x32, 0xeb, 0x0f, 0xc6,
-	0x10, 0x4d, 0x12, 0xfd, 0x90, 0x8d, 0x20, 0x33, 0x32, 0x63, 0xf2, 0x65, 0xe1, 0xf1, 0x23, 0xe9,
-	0x3f, 0xdb, 0xe5, 0x26, 0xcf, 0xa3, 0xe7, 0x4e, 0x46, 0x52, 0x4f, 0xf2, 0x30, 0xc8, 0x3e, 0xda,
-	0x3a, 0x9b, 0x84, 0xde, 0xc7, 0x3c, 0xf4, 0xc5, 0x13, 0x55, 0x0d, 0x3d, 0x41, 0x37, 0xe7, 0xec,
-	0x8a, 0x2c, 0xf4, 0xd1, 0xb5, 0x27, 0xe9, 0x94, 0x6d, 0xb5, 0xeb, 0x4f, 0xcf, 0x3c, 0xd3, 0x70,
-	0x73, 0xc7, 0xe4, 0x1c, 0x6d, 0xf7, 0x02, 0xd1, 0x67, 0x41, 0x16, 0xcd, 0x4c, 0xfd, 0x33, 0xb4,
-	0xce, 0x35, 0x8c, 0x56, 0xa4, 0x3d, 0x97, 0xaf, 0xd4, 0x2d, 0xf9, 0xb5, 0x8e, 0xec, 0x87, 0xa0,
-	0x19, 0x0f, 0xc0, 0x5f, 0x12, 0x97, 0x68, 0x73, 0x50, 0xc2, 0x5a, 0x39, 0xc5, 0x82, 0xff, 0xf9,
-	0x0d, 0x52, 0xfb, 0xbf, 0xea, 0x41, 0x80, 0x6e, 0x44, 0x20, 0x85, 0xe2, 0x5a, 0x44, 0x1c, 0x94,
-	0x5d, 0x5f, 0x45, 0x4c, 0x6e, 0xee, 0x71, 0xe2, 0x96, 0xbc, 0x63, 0x86, 0xae, 0x7b, 0xc1, 0x58,
-	0x69, 0x88, 0x94, 0xbd, 0x66, 0x94, 0x4e, 0x9e, 0x4e, 0xe9, 0x41, 0xea, 0xcd, 0x2d, 0xdc, 0x76,
-	0x7f, 0x69, 0xa2, 0xcd, 0x2c, 0xca, 0x33, 0x88, 0x62, 0xee, 0x01, 0xfe, 0xce, 0x42, 0xcd, 0xb4,
-	0xc4, 0x98, 0x23, 0x8d, 0x09, 0xcd, 0x3b, 0x45, 0x65, 0x11, 0x6a, 0xdd, 0xb9, 0xd2, 0xa6, 0x38,
-	0x46, 0xf7, 0xbe, 0xfa, 0xe3, 0xaf, 0x1f, 0x6b, 0x5d, 0x72, 0x68, 0xfa, 0x46, 0x7c, 0x94, 0xf7,
-	0x1e, 0xe5, 0x4c, 0xb3, 0xd1, 0x85, 0x93, 0x14, 0x1f, 0xe5, 0x4c, 0x93, 0xc7, 0x85, 0x63, 0xca,
-	0xc5, 0x5b, 0xd6, 0x01, 0xfe, 0xc6, 0x42, 0xcd, 0xb4, 0xba, 0xfe, 0x13, 0x4c, 0xa9, 0xfe, 0xb6,
-	0xb6, 0x0b, 0x9b, 0xf2, 0x61, 0x7e, 0xdb, 0x50, 0xbc, 0x79, 0x70, 0xfc, 0x9f, 0x28, 0x9c, 0x29,
-	0x67, 0xfa, 0x02, 0x7f, 0x6f, 0xa1, 0x46, 0x1a, 0x33, 0x5e, 0x0a, 0xb6, 0x9c, 0x8b, 0x95, 0x6d,
-	0x3b, 0xf2, 0x92, 0x01, 0xbe, 0x4d, 0xb6, 0x16, 0x81, 0x93, 0xcc, 0x7c, 0x6d, 0xa1, 0xb5, 0x53,
-	0xae, 0x34, 0xbe, 0xbd, 0x88, 0x63, 0xca, 0x54, 0xeb, 0x74, 0x55, 0x18, 0x89, 0x08, 0xb1, 0x0d,
-	0x0a, 0xc6, 0x4b, 0x28, 0xf8, 0x1c, 0xe1, 0x1e, 0xe8, 0x85, 0x3a, 0x50, 0x05, 0xf5, 0x72, 0x31,
-	0x5d, 0x55, 0x38, 0x48, 0xc7, 0x28, 0x11, 0xdc, 0x5e, 0x5e, 0xa5, 0xa4, 0xd4, 0x5e, 0x38, 0x7e,
-	0xf6, 0x27, 0xfe, 0xd6, 0x42, 0xf5, 0x1e, 0x54, 0x6a, 0xad, 0x6e, 0x1d, 0xee, 0x1a, 0xa4, 0x1d,
-	0xfc, 0x62, 0x05, 0x12, 0x9e, 0xa2, 0x17, 0x7a, 0xa0, 0xcb, 0x65, 0xb8, 0x0a, 0xeb, 0x6e, 0x31,
-	0x7d, 0x75, 0xd9, 0x26, 0xd4, 0xa8, 0x75, 0xf0, 0x7e, 0x55, 0x02, 0xd2, 0xba, 0x57, 0x2c, 0xc0,
-	0xcf, 0x16, 0x6a, 0xa4, 0xad, 0x72, 0x79, 0x67, 0x96, 0x5a, 0xe8, 0x0a, 0x33, 0x72, 0x6c, 0x18,
-	0x0f, 0x5b, 0x9d, 0xca, 0xa3, 0x44, 0x47, 0xa0, 0x99, 0xcf, 0x34, 0xa3, 0x06, 0x3a, 0xd9, 0xb1,
-	0x9f, 0xa0, 0x46, 0x7a, 0x50, 0xab, 0x52, 0x53, 0x75, 0x70, 0xb3, 0xfc, 0x1f, 0x54, 0xe6, 0xff,
-	0x31, 0x42, 0xc9, 0x2e, 0x3d, 0x89, 0x21, 0xac, 0x4e, 0xfc, 0x1d, 0x9a, 0xde, 0x5d, 0x93, 0x08,
-	0x69, 0x72, 0x77, 0xa5, 0xf1, 0x11, 0x35, 0xbf, 0x98, 0x1d, 0xbe, 0x6f, 0x44, 0xda, 0x78, 0xaf,
-	0x2a, 0xed, 0x90, 0x7a, 0x9f, 0xa2, 0x9b, 0x3d, 0xd0, 0x73, 0xdd, 0xfe, 0x4c, 0x27, 0xa9, 0xdf,
-	0x29, 0x44, 0x17, 0x2f, 0x0c, 0xad, 0xdd, 0xab, 0x3e, 0x15, 0xc1, 0xbd, 0x66, 0x74, 0x5f, 0xc5,
-	0xaf, 0x54, 0xe9, 0xaa, 0x49, 0xe8, 0x65, 0xcd, 0xfe, 0xfe, 0xfd, 0xdf, 0x2e, 0xf7, 0xac, 0xdf,
-	0x2f, 0xf7, 0xac, 0x3f, 0x2f, 0xf7, 0xac, 0x4f, 0xdf, 0xf8, 0x77, 0x17, 0x6d, 0x2f, 0xe0, 0x10,
-	0x16, 0xf7, 0xfd, 0x7e, 0xc3, 0xdc, 0xab, 0x8f, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x1d,
-	0x2d, 0x85, 0x10, 0x0c, 0x00, 0x00,
+	// 1045 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x97, 0xcd, 0x6e, 0xe4, 0x44,
+	0x10, 0xc7, 0xe5, 0x4c, 0x92, 0x4d, 0x2a, 0x21, 0x84, 0x4e, 0x36, 0x4c, 0x86, 0x6c, 0x76, 0xe8,
+	0x15, 0xd1, 0x28, 0x6c, 0x6c, 0x25, 0x01, 0xb1, 0x5a, 0x4e, 0xec, 0x6e, 0x14, 0x90, 0x22, 0x3e,
+	0x1c, 0x10, 0x88, 0x03, 0xa8, 0x63, 0x97, 0x66, 0xbd, 0xf1, 0xb8, 0x9b, 0xee, 0x1e, 0x6f, 0x86,
+	0x28, 0x17, 0x24, 0x40, 0xe2, 0xc0, 0x01, 0x4e, 0xbc, 0x00, 0x07, 0xde, 0x81, 0x03, 0x37, 0x8e,
+	0x48, 0xbc, 0x00, 0x8a, 0x38, 0xf2, 0x10, 0xc8, 0xed, 0x8f, 0xb1, 0x67, 0x62, 0xb4, 0x68, 0x07,
+	0x4e, 0xd3, 0xdd, 0x53, 0xae, 0xff, 0xaf, 0xab, 0xda, 0x55, 0x6d, 0xd8, 0x50, 0x28, 0x63, 0x94,
+	0x8e, 0x90, 0xfc, 0x11, 0x7a, 0x3a, 0xff, 0xb5, 0x85, 0xe4, 0x9a, 0x93, 0x6b, 0xd9, 0xb4, 0xb5,
+	0xd1, 0xe5, 0xbc, 0x1b, 0xa2, 0xc3, 0x44, 0xe0, 0xb0, 0x28, 0xe2, 0x9a, 0xe9, 0x80, 0x47, 0x2a,
+	0x35, 0x6b, 0xd1, 0xd3, 0x3b, 0xca, 0x0e, 0xb8, 0xf9, 0xd7, 0xe3, 0x12, 0x9d, 0x78, 0xd7, 0xe9,
+	0x62, 0x84, 0x92, 0x69, 0xf4, 0x33, 0x9b, 0xa3, 0x6e, 0xa0, 0x1f, 0xf6, 0x4f, 0x6c, 0x8f, 0xf7,
+	0x1c, 0x26, 0xbb, 0x3c, 0xf1, 0x6c, 0x06, 0x3b, 0x9e, 0xef, 0xc4, 0x7b, 0x8e, 0x38, 0xed, 0x26,
+	0xcf, 0x2b, 0x87, 0x09, 0x11, 0x06, 0x9e, 0xf1, 0xef, 0xc4, 0xbb, 0x2c, 0x14, 0x0f, 0xd9, 0x98,
+	0x37, 0xfa, 0x9d, 0x05, 0xab, 0xef, 0xa6, 0x6c, 0xf7, 0x25, 0x32, 0x8d, 0x2e, 0x7e, 0xd6, 0x47,
+	0xa5, 0xc9, 0x09, 0xe4, 0xcc, 0x4d, 0xab, 0x6d, 0x75, 0x16, 0xf6, 0xde, 0xb4, 0x87, 0xc2, 0x76,
+	0x2e, 0x6c, 0x06, 0x9f, 0x7a, 0xbe, 0x1d, 0xef, 0xd9, 0xe2, 0xb4, 0x6b, 0x27, 0xc2, 0x76, 0x49,
+	0xd8, 0xce, 0x85, 0xed, 0x37, 0x84, 0xc8, 0x74, 0xdc, 0xdc, 0x31, 0x59, 0x83, 0xd9, 0xbe, 0x50,
+	0x28, 0x75, 0x73, 0xaa, 0x6d, 0x75, 0xe6, 0xdc, 0x6c, 0x46, 0x4f, 0x61, 0x3d, 0xb3, 0x7d, 0x9f,
+	0x9f, 0x62, 0xf4, 0x00, 0x43, 0x1c, 0x82, 0x35, 0xab, 0x60, 0xf3, 0x43, 0x77, 0x04, 0xa6, 0x25,
+	0x0f, 0xd1, 0x38, 0x9b, 0x77, 0xcd, 0x98, 0x2c, 0x43, 0x23, 0x60, 0xba, 0xd9, 0x68, 0x5b, 0x9d,
+	0x86, 0x9b, 0x0c, 0xc9, 0x12, 0x4c, 0x05, 0x7e, 0x73, 0xda, 0xd8, 0x4c, 0x05, 0x3e, 0xfd, 0xc1,
+	0xaa, 0xaa, 0x55, 0xc3, 0x50, 0xaf, 0xd6, 0x86, 0x05, 0x1f, 0x95, 0x27, 0x03, 0x91, 0x6c, 0x34,
+	0x13, 0x2d, 0x2f, 0x15, 0x3c, 0x8d, 0x12, 0xcf, 0x06, 0xcc, 0xe3, 0x99, 0x08, 0x24, 0xaa, 0xb7,
+	0x22, 0x03, 0xd1, 0x70, 0x87, 0x0b, 0x19, 0xdb, 0x4c, 0xc1, 0x76, 0x1b, 0x56, 0xcb, 0x68, 0x2e,
+	0x2a, 0xc1, 0x23, 0x85, 0x64, 0x15, 0x66, 0x74, 0xb2, 0x90, 0x31, 0xa5, 0x13, 0x4a, 0x61, 0x31,
+	0xb3, 0x7e, 0xaf, 0x8f, 0x72, 0x90, 0xe8, 0x47, 0xac, 0x87, 0x99, 0x91, 0x19, 0xd3, 0xcf, 0x0b,
+	0x8f, 0x1f, 0x08, 0xff, 0xff, 0x4d, 0x37, 0x7d, 0x16, 0x9e, 0x39, 0xe8, 0x09, 0x3d, 0xc8, 0xb7,
+	0x41, 0xb7, 0x60, 0xf9, 0x78, 0x10, 0x79, 0x1f, 0x06, 0x91, 0xcf, 0x1f, 0xab, 0x7a, 0xe8, 0x9f,
+	0x2c, 0x68, 0x95, 0x0c, 0xdf, 0x89, 0x51, 0xca, 0xc0, 0x2f, 0xd8, 0xaf, 0x78, 0x24, 0xc9, 0x4e,
+	0x89, 0x2b, 0xcf, 0x4e, 0x69, 0x89, 0x50, 0x58, 0x64, 0x42, 0xbc, 0xcd, 0x7a, 0xa8, 0x04, 0xf3,
+	0xf2, 0x2c, 0x55, 0xd6, 0x48, 0x0b, 0xe6, 0xfc, 0xbe, 0x4c, 0x5d, 0xa4, 0xc9, 0x2a, 0xe6, 0xc9,
+	0xe1, 0x95, 0xc8, 0x14, 0x8f, 0xb2, 0x7c, 0x65, 0x33, 0x3a, 0x80, 0x95, 0x12, 0x6b, 0x91, 0xb2,
+	0x13, 0xb8, 0xf6, 0x38, 0x5d, 0x6a, 0x5a, 0xed, 0xc6, 0xd3, 0x07, 0x78, 0xa8, 0xe1, 0xe6, 0x8e,
+	0xe9, 0x19, 0xac, 0x1d, 0x86, 0xfc, 0x84, 0x85, 0x59, 0xe8, 0x87, 0xea, 0x9f, 0xc0, 0x4c, 0xa0,
+	0xb1, 0x37, 0x21, 0xed, 0x52, 0x72, 0x53, 0xb7, 0xf4, 0x97, 0x06, 0x34, 0x1f, 0xa0, 0x66, 0x41,
+	0x88, 0xfe, 0x98, 0xb8, 0x80, 0xa5, 0x6e, 0x05, 0x6b, 0xe2, 0x14, 0x23, 0xfe, 0xcb, 0xa7, 0x79,
+	0xea, 0xbf, 0x2a, 0x5e, 0x21, 0x2c, 0x4a, 0x14, 0x5c, 0x05, 0x9a, 0xcb, 0x00, 0x55, 0xb3, 0x31,
+	0x89, 0x3d, 0xb9, 0xb9, 0xc7, 0x81, 0x5b, 0xf1, 0x4e, 0x18, 0xcc, 0x79, 0x61, 0x5f, 0x69, 0x94,
+	0xaa, 0x39, 0x6d, 0x94, 0x0e, 0x9e, 0x4e, 0xe9, 0x7e, 0xea, 0xcd, 0x2d, 0xdc, 0xee, 0xfd, 0xb5,
+	0x08, 0x4b, 0xd9, 0x2e, 0x8f, 0x51, 0xc6, 0x81, 0x87, 0xe4, 0x1b, 0x0b, 0x16, 0xd2, 0x7a, 0x68,
+	0xea, 0x0f, 0xa1, 0x76, 0xde, 0xd6, 0x6a, 0x2b, 0x66, 0xeb, 0xc6, 0x95, 0x36, 0xc5, 0x3b, 0x7f,
+	0xe7, 0x8b, 0xdf, 0xff, 0xfc, 0x7e, 0x6a, 0x8f, 0xee, 0x98, 0x26, 0x17, 0xef, 0xe6, 0x8d, 0x52,
+	0x39, 0xe7, 0xd9, 0xe8, 0xc2, 0x49, 0x2a, 0xa5, 0x72, 0xce, 0x93, 0x9f, 0x0b, 0xc7, 0xd4, 0xb6,
+	0xbb, 0xd6, 0x36, 0xf9, 0xca, 0x82, 0x85, 0xb4, 0x15, 0xfc, 0x13, 0x4c, 0xa5, 0x59, 0xb4, 0xd6,
+	0x0a, 0x9b, 0x6a, 0xe5, 0x79, 0xdd, 0x50, 0xbc, 0xba, 0xbd, 0xff, 0xaf, 0x28, 0x9c, 0xf3, 0x80,
+	0xe9, 0x0b, 0xf2, 0xad, 0x05, 0xb3, 0xe9, 0x9e, 0xc9, 0xd8, 0x66, 0xab, 0xb1, 0x98, 0xd8, 0xb1,
+	0xa3, 0x2f, 0x18, 0xe0, 0xeb, 0x74, 0x79, 0x14, 0x38, 0x89, 0xcc, 0x97, 0x16, 0x4c, 0x1f, 0x05,
+	0x4a, 0x93, 0xeb, 0xa3, 0x38, 0xa6, 0xa6, 0xb6, 0x8e, 0x26, 0x85, 0x91, 0x88, 0xd0, 0xa6, 0x41,
+	0x21, 0x64, 0x0c, 0x85, 0x9c, 0x01, 0x39, 0x44, 0x3d, 0x52, 0x07, 0xea, 0xa0, 0x5e, 0x2c, 0x96,
+	0xeb, 0x0a, 0x07, 0xed, 0x18, 0x25, 0x4a, 0xda, 0xe3, 0x59, 0x4a, 0x8a, 0xfc, 0x85, 0xe3, 0x67,
+	0x4f, 0x92, 0xaf, 0x2d, 0x68, 0x1c, 0x62, 0xad, 0xd6, 0xe4, 0xf2, 0x70, 0xd3, 0x20, 0xad, 0x93,
+	0xe7, 0x6b, 0x90, 0xc8, 0x39, 0x3c, 0x77, 0x88, 0xba, 0x5a, 0x86, 0xeb, 0xb0, 0x6e, 0x16, 0xcb,
+	0x57, 0x97, 0x6d, 0x6a, 0x1b, 0xb5, 0x0e, 0xd9, 0xaa, 0x0b, 0x40, 0x5a, 0xf7, 0x8a, 0x04, 0xfc,
+	0x68, 0xc1, 0x6c, 0xda, 0xd7, 0xc7, 0x4f, 0x66, 0xa5, 0xdf, 0x4f, 0x30, 0x22, 0xfb, 0x86, 0x71,
+	0xa7, 0xd5, 0xa9, 0x7d, 0x95, 0xec, 0x1e, 0x6a, 0xe6, 0x33, 0xcd, 0x6c, 0x03, 0x9d, 0x9c, 0xd8,
+	0x8f, 0x60, 0x36, 0x7d, 0x51, 0xeb, 0x42, 0x53, 0xf7, 0xe2, 0x66, 0xf1, 0xdf, 0xae, 0x8d, 0xff,
+	0x23, 0x80, 0xe4, 0x94, 0x1e, 0xc4, 0x18, 0xd5, 0x07, 0xfe, 0x86, 0x9d, 0x5e, 0xb4, 0x93, 0x1d,
+	0xda, 0x1e, 0x97, 0x68, 0xc7, 0xbb, 0xb6, 0x79, 0xc4, 0x9c, 0xf0, 0x2d, 0x23, 0xd2, 0x26, 0x9b,
+	0x75, 0x61, 0xc7, 0xd4, 0xfb, 0x39, 0xac, 0x1c, 0xa2, 0x2e, 0x75, 0xfb, 0x63, 0x9d, 0x84, 0x7e,
+	0xbd, 0x10, 0x1d, 0xbd, 0xdd, 0xb4, 0x36, 0xae, 0xfa, 0xab, 0xd8, 0xdc, 0xcb, 0x46, 0xf7, 0x25,
+	0x72, 0xab, 0x4e, 0x57, 0x0d, 0x22, 0x2f, 0x6b, 0xf6, 0xe4, 0x67, 0x0b, 0x56, 0xf2, 0x9b, 0x50,
+	0xc9, 0x19, 0xb9, 0x75, 0x95, 0xc4, 0xc8, 0x95, 0x69, 0x82, 0xe9, 0x7f, 0xcd, 0x30, 0xef, 0xd2,
+	0xdb, 0x4f, 0xc0, 0xec, 0xf0, 0x0c, 0xe3, 0xae, 0xb5, 0x7d, 0xef, 0xde, 0xaf, 0x97, 0x9b, 0xd6,
+	0x6f, 0x97, 0x9b, 0xd6, 0x1f, 0x97, 0x9b, 0xd6, 0xc7, 0xaf, 0x3c, 0xd9, 0x57, 0x8d, 0x17, 0x06,
+	0x18, 0x15, 0x1f, 0x57, 0x27, 0xb3, 0xe6, 0x23, 0x66, 0xff, 0xef, 0x01, 0x00, 0x7a, 0x4a, 0x86,
+	0x76, 0x7d, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -750,6 +838,8 @@ type ProjectServiceClient interface {
 	ListEvents(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*v1.EventList, error)
 	// GetSchedulesState returns true if there are any active sync syncWindows
 	GetSyncWindowsState(ctx context.Context, in *SyncWindowsQuery, opts ...grpc.CallOption) (*SyncWindowsResponse, error)
+	// OverrideSyncWindows temporarily allows an application to be synced regardless of the sync windows of its project
+	OverrideSyncWindows(ctx context.Context, in *SyncWindowsOverrideRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error)
 }
 
 type projectServiceClient struct {
@@ -859,6 +949,15 @@ func (c *projectServiceClient) GetSyncWindowsState(ctx context.Context, in *Sync
 	return out, nil
 }
 
+func (c *projectServiceClient) OverrideSyncWindows(ctx context.Context, in *SyncWindowsOverrideRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error) {
+	out := new(v1alpha1.AppProject)
+	err := c.cc.Invoke(ctx, "/project.ProjectService/OverrideSyncWindows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProjectServiceServer is the server API for ProjectService service.
 type ProjectServiceServer interface {
 	// Create a new project token
@@ -883,6 +982,8 @@ type ProjectServiceServer interface {
 	ListEvents(context.Context, *ProjectQuery) (*v1.EventList, error)
 	// GetSchedulesState returns true if there are any active sync syncWindows
 	GetSyncWindowsState(context.Context, *SyncWindowsQuery) (*SyncWindowsResponse, error)
+	// OverrideSyncWindows temporarily allows an application to be synced regardless of the sync windows of its project
+	OverrideSyncWindows(context.Context, *SyncWindowsOverrideRequest) (*v1alpha1.AppProject, error)
 }
 
 // UnimplementedProjectServiceServer can be embedded to have forward compatible implementations.
@@ -922,6 +1023,9 @@ func (*UnimplementedProjectServiceServer) ListEvents(ctx context.Context, req *P
 func (*UnimplementedProjectServiceServer) GetSyncWindowsState(ctx context.Context, req *SyncWindowsQuery) (*SyncWindowsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSyncWindowsState not implemented")
 }
+func (*UnimplementedProjectServiceServer) OverrideSyncWindows(ctx context.Context, req *SyncWindowsOverrideRequest) (*v1alpha1.AppProject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OverrideSyncWindows not implemented")
+}
 
 func RegisterProjectServiceServer(s *grpc.Server, srv ProjectServiceServer) {
 	s.RegisterService(&_ProjectService_serviceDesc, srv)
@@ -1125,6 +1229,24 @@ func _ProjectService_GetSyncWindowsState_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_OverrideSyncWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncWindowsOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).OverrideSyncWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.ProjectService/OverrideSyncWindows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).OverrideSyncWindows(ctx, req.(*SyncWindowsOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ProjectService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "project.ProjectService",
 	HandlerType: (*ProjectServiceServer)(nil),
@@ -1173,6 +1295,10 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSyncWindowsState",
 			Handler:    _ProjectService_GetSyncWindowsState_Handler,
 		},
+		{
+			MethodName: "OverrideSyncWindows",
+			Handler:    _ProjectService_OverrideSyncWindows_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/project/project.proto",
@@ -1508,6 +1634,66 @@ func (m *SyncWindowsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncWindowsOverrideRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindowsOverrideRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWindowsOverrideRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Duration != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AppNamespace) > 0 {
+		i -= len(m.AppNamespace)
+		copy(dAtA[i:], m.AppNamespace)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.AppNamespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Application) > 0 {
+		i -= len(m.Application)
+		copy(dAtA[i:], m.Application)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Application)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncWindowsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1835,6 +2021,37 @@ func (m *SyncWindowsQuery) Size() (n int) {
 	return n
 }
 
+func (m *SyncWindowsOverrideRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Application)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.AppNamespace)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovProject(uint64(m.Duration))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *SyncWindowsResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2769,6 +2986,204 @@ func (m *SyncWindowsQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyncWindowsOverrideRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWindowsOverrideRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWindowsOverrideRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Application = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SyncWindowsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -685,77 +685,76 @@ func init() {
 }
 
 var fileDescriptor_8d38260443475705 = []byte{
-	// 1106 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0x97, 0xf3, 0x67, 0x93, 0xbc, 0xfc, 0xe9, 0x66, 0x52, 0x8a, 0xd9, 0xa6, 0x69, 0xe4, 0x96,
-	0x2a, 0x44, 0xc5, 0x6e, 0x16, 0x21, 0x50, 0x11, 0x48, 0x69, 0x12, 0xb5, 0x11, 0x11, 0x29, 0xae,
-	0xc2, 0x01, 0x81, 0xd0, 0xc4, 0xfb, 0xb2, 0xeb, 0xd6, 0x6b, 0x4f, 0x67, 0x66, 0x17, 0x56, 0x55,
-	0x2f, 0x9c, 0x90, 0xe0, 0x82, 0x10, 0x12, 0x37, 0x2e, 0x48, 0x1c, 0xf8, 0x02, 0x5c, 0xb8, 0x73,
-	0x44, 0xe2, 0x0b, 0xa0, 0x88, 0xcf, 0x81, 0xd0, 0xcc, 0x78, 0x6d, 0x6f, 0xf6, 0x4f, 0x52, 0x11,
-	0x72, 0x9b, 0xf7, 0x9b, 0x99, 0xf7, 0x7e, 0xf3, 0x9b, 0xf7, 0xde, 0xd8, 0xe0, 0x08, 0xe4, 0x6d,
-	0xe4, 0x1e, 0x47, 0x96, 0x88, 0x50, 0x26, 0xbc, 0x53, 0x18, 0xba, 0x8c, 0x27, 0x32, 0x21, 0x90,
+	// 1103 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6e, 0x1c, 0x45,
+	0x10, 0xd6, 0xf8, 0x67, 0x6d, 0x97, 0x7f, 0xb2, 0x6e, 0x87, 0x30, 0x6c, 0x1c, 0xc7, 0x9a, 0x84,
+	0xc8, 0x58, 0x61, 0x26, 0x5e, 0x84, 0x40, 0x41, 0x20, 0x39, 0xb6, 0x95, 0x58, 0x58, 0x38, 0x4c,
+	0x64, 0x0e, 0x08, 0x84, 0xda, 0xb3, 0xe5, 0xdd, 0x49, 0x66, 0x67, 0x3a, 0xdd, 0xbd, 0x0b, 0xab,
+	0x28, 0x17, 0x4e, 0x48, 0x70, 0x41, 0x08, 0x89, 0x1b, 0x17, 0x24, 0x0e, 0xbc, 0x00, 0x17, 0xee,
+	0x1c, 0x91, 0x78, 0x01, 0x64, 0xf1, 0x1c, 0x08, 0x75, 0xf7, 0xec, 0xcc, 0xac, 0xf7, 0xc7, 0x8e,
+	0x30, 0xbe, 0x75, 0x7d, 0xdd, 0x53, 0xf5, 0xd5, 0xd7, 0x55, 0xd5, 0xbb, 0xe0, 0x08, 0xe4, 0x6d,
+	0xe4, 0x1e, 0x47, 0x96, 0x88, 0x50, 0x26, 0xbc, 0x53, 0x58, 0xba, 0x8c, 0x27, 0x32, 0x21, 0x90,
 	0x23, 0x95, 0xe5, 0x7a, 0x92, 0xd4, 0x23, 0xf4, 0x28, 0x0b, 0x3d, 0x1a, 0xc7, 0x89, 0xa4, 0x32,
-	0x4c, 0x62, 0x61, 0x56, 0x56, 0xf6, 0xea, 0xa1, 0x6c, 0xb4, 0x0e, 0xdd, 0x20, 0x69, 0x7a, 0x94,
-	0xd7, 0x13, 0xc6, 0x93, 0xc7, 0x7a, 0xf0, 0x7a, 0x50, 0xf3, 0xda, 0x55, 0x8f, 0x3d, 0xa9, 0xab,
-	0x9d, 0xc2, 0xa3, 0x8c, 0x45, 0x61, 0xa0, 0xf7, 0x7a, 0xed, 0x0d, 0x1a, 0xb1, 0x06, 0xdd, 0xf0,
-	0xea, 0x18, 0x23, 0xa7, 0x12, 0x6b, 0xa9, 0xb7, 0x9d, 0x53, 0xbc, 0x69, 0x5a, 0xa7, 0xd2, 0x77,
-	0x3a, 0x30, 0xef, 0x23, 0x4b, 0x36, 0x19, 0x13, 0x1f, 0xb6, 0x90, 0x77, 0x08, 0x81, 0x09, 0xb5,
-	0xc8, 0xb6, 0x56, 0xad, 0xb5, 0x19, 0x5f, 0x8f, 0x49, 0x05, 0xa6, 0x39, 0xb6, 0x43, 0x11, 0x26,
-	0xb1, 0x3d, 0xa6, 0xf1, 0xcc, 0x26, 0x36, 0x4c, 0x51, 0xc6, 0x3e, 0xa0, 0x4d, 0xb4, 0xc7, 0xf5,
-	0x54, 0xd7, 0x24, 0x2b, 0x00, 0x94, 0xb1, 0x87, 0x3c, 0x79, 0x8c, 0x81, 0xb4, 0x27, 0xf4, 0x64,
-	0x01, 0x71, 0x36, 0x60, 0x6a, 0x93, 0xb1, 0xdd, 0xf8, 0x28, 0x51, 0x41, 0x65, 0x87, 0x61, 0x37,
-	0xa8, 0x1a, 0x2b, 0x8c, 0x51, 0xd9, 0x48, 0x03, 0xea, 0xb1, 0xf3, 0xab, 0x05, 0x4b, 0x29, 0xdd,
-	0x6d, 0x94, 0x34, 0x8c, 0x52, 0xd2, 0x75, 0x28, 0x89, 0xa4, 0xc5, 0x03, 0xe3, 0x61, 0xb6, 0xba,
-	0xef, 0xe6, 0xea, 0xb8, 0x5d, 0x75, 0xf4, 0xe0, 0xb3, 0xa0, 0xe6, 0xb6, 0xab, 0x2e, 0x7b, 0x52,
-	0x77, 0x95, 0xd6, 0x6e, 0x41, 0x6b, 0xb7, 0xab, 0xb5, 0xbb, 0x99, 0x83, 0x8f, 0xb4, 0x5b, 0x3f,
-	0x75, 0x5f, 0x3c, 0xed, 0xd8, 0xa8, 0xd3, 0x8e, 0xf7, 0x9d, 0xf6, 0x5d, 0x28, 0x77, 0x85, 0xf6,
-	0x51, 0xb0, 0x24, 0x16, 0x48, 0x5e, 0x83, 0xc9, 0x50, 0x62, 0x53, 0xd8, 0xd6, 0xea, 0xf8, 0xda,
-	0x6c, 0x75, 0xc9, 0x2d, 0x5c, 0x4f, 0x2a, 0x8d, 0x6f, 0x56, 0x38, 0x5b, 0x30, 0xa3, 0xb6, 0x0f,
+	0x4c, 0x62, 0x61, 0x4e, 0x56, 0xf6, 0xea, 0xa1, 0x6c, 0xb4, 0x0e, 0xdd, 0x20, 0x69, 0x7a, 0x94,
+	0xd7, 0x13, 0xc6, 0x93, 0xc7, 0x7a, 0xf1, 0x7a, 0x50, 0xf3, 0xda, 0x55, 0x8f, 0x3d, 0xa9, 0xab,
+	0x2f, 0x85, 0x47, 0x19, 0x8b, 0xc2, 0x40, 0x7f, 0xeb, 0xb5, 0x37, 0x68, 0xc4, 0x1a, 0x74, 0xc3,
+	0xab, 0x63, 0x8c, 0x9c, 0x4a, 0xac, 0xa5, 0xde, 0x76, 0x4e, 0xf1, 0xa6, 0x69, 0x9d, 0x4a, 0xdf,
+	0xe9, 0xc0, 0xbc, 0x8f, 0x2c, 0xd9, 0x64, 0x4c, 0x7c, 0xd8, 0x42, 0xde, 0x21, 0x04, 0x26, 0xd4,
+	0x21, 0xdb, 0x5a, 0xb5, 0xd6, 0x66, 0x7c, 0xbd, 0x26, 0x15, 0x98, 0xe6, 0xd8, 0x0e, 0x45, 0x98,
+	0xc4, 0xf6, 0x98, 0xc6, 0x33, 0x9b, 0xd8, 0x30, 0x45, 0x19, 0xfb, 0x80, 0x36, 0xd1, 0x1e, 0xd7,
+	0x5b, 0x5d, 0x93, 0xac, 0x00, 0x50, 0xc6, 0x1e, 0xf2, 0xe4, 0x31, 0x06, 0xd2, 0x9e, 0xd0, 0x9b,
+	0x05, 0xc4, 0xd9, 0x80, 0xa9, 0x4d, 0xc6, 0x76, 0xe3, 0xa3, 0x44, 0x05, 0x95, 0x1d, 0x86, 0xdd,
+	0xa0, 0x6a, 0xad, 0x30, 0x46, 0x65, 0x23, 0x0d, 0xa8, 0xd7, 0xce, 0xaf, 0x16, 0x2c, 0xa5, 0x74,
+	0xb7, 0x51, 0xd2, 0x30, 0x4a, 0x49, 0xd7, 0xa1, 0x24, 0x92, 0x16, 0x0f, 0x8c, 0x87, 0xd9, 0xea,
+	0xbe, 0x9b, 0xab, 0xe3, 0x76, 0xd5, 0xd1, 0x8b, 0xcf, 0x82, 0x9a, 0xdb, 0xae, 0xba, 0xec, 0x49,
+	0xdd, 0x55, 0x5a, 0xbb, 0x05, 0xad, 0xdd, 0xae, 0xd6, 0xee, 0x66, 0x0e, 0x3e, 0xd2, 0x6e, 0xfd,
+	0xd4, 0x7d, 0x31, 0xdb, 0xb1, 0x51, 0xd9, 0x8e, 0xf7, 0x65, 0xfb, 0x2e, 0x94, 0xbb, 0x42, 0xfb,
+	0x28, 0x58, 0x12, 0x0b, 0x24, 0xaf, 0xc1, 0x64, 0x28, 0xb1, 0x29, 0x6c, 0x6b, 0x75, 0x7c, 0x6d,
+	0xb6, 0xba, 0xe4, 0x16, 0xae, 0x27, 0x95, 0xc6, 0x37, 0x27, 0x9c, 0x2d, 0x98, 0x51, 0x9f, 0x0f,
 	0xbf, 0x23, 0x07, 0xe6, 0x8e, 0x12, 0x45, 0x15, 0x8f, 0x38, 0x0a, 0x23, 0xdb, 0xb4, 0xdf, 0x83,
 	0x39, 0xbf, 0x4d, 0xc0, 0x25, 0x4d, 0x22, 0x08, 0x50, 0x8c, 0xbe, 0xef, 0x96, 0x40, 0x1e, 0xe7,
-	0xc7, 0xcc, 0x6c, 0x35, 0xc7, 0xa8, 0x10, 0x9f, 0x27, 0xbc, 0x96, 0x9e, 0x32, 0xb3, 0xc9, 0x4d,
-	0x98, 0x17, 0xa2, 0xf1, 0x90, 0x87, 0x6d, 0x2a, 0xf1, 0x7d, 0xec, 0xa4, 0x97, 0xde, 0x0b, 0x2a,
-	0x0f, 0x61, 0x2c, 0x30, 0x68, 0x71, 0xb4, 0x27, 0x35, 0xcb, 0xcc, 0x26, 0xb7, 0x61, 0x51, 0x46,
-	0x62, 0x2b, 0x0a, 0x31, 0x96, 0x5b, 0xc8, 0xe5, 0x36, 0x95, 0xd4, 0x2e, 0x69, 0x2f, 0xfd, 0x13,
-	0x64, 0x1d, 0xca, 0x3d, 0xa0, 0x0a, 0x39, 0xa5, 0x17, 0xf7, 0xe1, 0x59, 0x8a, 0xcd, 0xf4, 0xa6,
-	0x98, 0x3e, 0x23, 0x18, 0x4c, 0x9f, 0x6f, 0x19, 0x66, 0x30, 0xa6, 0x87, 0x11, 0xee, 0x07, 0xa1,
-	0x3d, 0xab, 0xe9, 0xe5, 0x00, 0xb9, 0x03, 0x4b, 0x26, 0xb3, 0x36, 0xd5, 0xcd, 0x66, 0xe7, 0x9c,
-	0xd3, 0x0e, 0x06, 0x4d, 0x91, 0x55, 0x98, 0xcd, 0xe0, 0xdd, 0x6d, 0x7b, 0x7e, 0xd5, 0x5a, 0x1b,
-	0xf7, 0x8b, 0x10, 0x79, 0x1b, 0x5e, 0xce, 0xcd, 0x58, 0x48, 0x1a, 0x45, 0x3a, 0xf5, 0x76, 0xb7,
-	0xed, 0x05, 0xbd, 0x7a, 0xd8, 0x34, 0x79, 0x0f, 0x2a, 0xd9, 0xd4, 0x4e, 0x2c, 0x91, 0x33, 0x1e,
-	0x0a, 0xbc, 0x47, 0x05, 0x1e, 0xf0, 0xc8, 0xbe, 0xa4, 0x49, 0x8d, 0x58, 0x41, 0x2e, 0xc3, 0x24,
-	0xe3, 0xc9, 0x17, 0x1d, 0xbb, 0xac, 0x97, 0x1a, 0x43, 0xe5, 0x38, 0x4b, 0xd3, 0x78, 0xd1, 0xe4,
-	0x78, 0x6a, 0x3a, 0x0b, 0x30, 0xa7, 0xd2, 0xa7, 0x9b, 0xbf, 0xce, 0xcf, 0x16, 0x2c, 0x2a, 0x60,
-	0x8b, 0x23, 0x95, 0xe8, 0xe3, 0xd3, 0x16, 0x0a, 0x49, 0x3e, 0x29, 0x64, 0xd4, 0x6c, 0xf5, 0xc1,
-	0x7f, 0x2b, 0x45, 0x3f, 0xab, 0x88, 0x34, 0x37, 0xaf, 0x40, 0xa9, 0xc5, 0x04, 0x72, 0x99, 0x66,
-	0x78, 0x6a, 0xa9, 0x7b, 0x0b, 0x38, 0xd6, 0xc4, 0x7e, 0x1c, 0x75, 0x74, 0x62, 0x4e, 0xfb, 0x39,
-	0xe0, 0x3c, 0x35, 0x44, 0x0f, 0x58, 0xed, 0xa2, 0x88, 0x56, 0xff, 0x59, 0x30, 0x31, 0x0d, 0xf8,
-	0x08, 0x79, 0x3b, 0x0c, 0x90, 0x7c, 0x63, 0xc1, 0xc4, 0x5e, 0x28, 0x24, 0x79, 0xa9, 0x58, 0xec,
-	0x59, 0x69, 0x57, 0xf6, 0xce, 0x8b, 0x85, 0x0a, 0xe2, 0x5c, 0xff, 0xf2, 0xcf, 0xbf, 0xbf, 0x1b,
-	0xbb, 0x42, 0x2e, 0xeb, 0x27, 0xa9, 0xbd, 0x91, 0xf7, 0xff, 0x10, 0xc5, 0x57, 0x63, 0x16, 0xf9,
-	0xda, 0x82, 0xf1, 0xfb, 0x38, 0x94, 0xcd, 0xb9, 0x69, 0xe2, 0xdc, 0xd0, 0x4c, 0xae, 0x91, 0xab,
-	0x83, 0x98, 0x78, 0xcf, 0x94, 0xf5, 0x9c, 0x7c, 0x6f, 0x41, 0x59, 0xf1, 0xf6, 0x0b, 0x73, 0x17,
-	0x23, 0xd4, 0xf2, 0x28, 0xa1, 0xc8, 0xa7, 0x30, 0x6d, 0x68, 0x1d, 0x0d, 0xa5, 0x53, 0xee, 0x85,
-	0x8f, 0x84, 0xb3, 0xa6, 0x5d, 0x3a, 0x64, 0x75, 0xc4, 0x89, 0x3d, 0xae, 0x5c, 0x36, 0x8d, 0x7b,
-	0xf5, 0x34, 0x90, 0x57, 0x4e, 0xba, 0xcf, 0x5e, 0xe6, 0xca, 0xf2, 0xa0, 0xa9, 0xac, 0x16, 0xcf,
-	0x14, 0x8e, 0xaa, 0x10, 0xdf, 0x5a, 0x30, 0x7f, 0x1f, 0x65, 0xfe, 0x86, 0x92, 0xeb, 0x03, 0x3c,
-	0x17, 0xdf, 0xd7, 0x8a, 0x33, 0x7c, 0x41, 0x46, 0xe0, 0x1d, 0x4d, 0xe0, 0x4d, 0xe7, 0xce, 0x60,
-	0x02, 0xe6, 0x01, 0xd5, 0x7e, 0x0e, 0xfc, 0x3d, 0x4d, 0xa5, 0x66, 0x3c, 0xdc, 0xb5, 0xd6, 0x49,
-	0x5b, 0x53, 0x7a, 0x80, 0x51, 0x73, 0xab, 0x41, 0xb9, 0x1c, 0x2a, 0xf3, 0x4a, 0x11, 0xce, 0x97,
-	0x67, 0x24, 0x5c, 0x4d, 0x62, 0x8d, 0xdc, 0x1a, 0xa5, 0x42, 0x03, 0xa3, 0x66, 0x60, 0xc2, 0xfc,
-	0x60, 0x41, 0xc9, 0x74, 0x2f, 0x72, 0xed, 0x64, 0xc4, 0x9e, 0xae, 0x76, 0x8e, 0xa5, 0xf0, 0xaa,
-	0xe6, 0xb8, 0xec, 0x0c, 0xcc, 0xb5, 0xbb, 0xba, 0x79, 0xa8, 0xd2, 0xfc, 0xd1, 0x82, 0x72, 0x97,
-	0x42, 0x77, 0xef, 0xc5, 0x91, 0x74, 0x4e, 0x27, 0x49, 0x7e, 0xb2, 0xa0, 0x64, 0x3a, 0x6a, 0x3f,
-	0xaf, 0x9e, 0x4e, 0x7b, 0x8e, 0xbc, 0x36, 0xcc, 0x05, 0x57, 0x46, 0xa4, 0xb9, 0xa6, 0xf2, 0x3c,
-	0x17, 0xf2, 0x17, 0x0b, 0xca, 0x5d, 0x3a, 0xc3, 0x85, 0xfc, 0xbf, 0x08, 0xbb, 0x2f, 0x46, 0x98,
-	0x50, 0x28, 0x6d, 0x63, 0x84, 0x12, 0x87, 0x95, 0x80, 0x7d, 0x12, 0xce, 0x92, 0xff, 0x96, 0xe9,
-	0xb1, 0xeb, 0xa3, 0x7a, 0xac, 0x12, 0xa4, 0x01, 0x65, 0x13, 0xa2, 0xa0, 0xc7, 0x0b, 0x07, 0xbb,
-	0x71, 0x86, 0x60, 0xe4, 0x19, 0x2c, 0x7c, 0x44, 0xa3, 0x50, 0x29, 0x6b, 0xbe, 0x39, 0xc9, 0xd5,
-	0xbe, 0x4e, 0x92, 0x7f, 0x8b, 0x8e, 0x88, 0x56, 0xd5, 0xd1, 0x6e, 0x3b, 0x37, 0x47, 0xd5, 0x75,
-	0x3b, 0x0d, 0x65, 0x94, 0xbc, 0xb7, 0xf3, 0xfb, 0xf1, 0x8a, 0xf5, 0xc7, 0xf1, 0x8a, 0xf5, 0xd7,
-	0xf1, 0x8a, 0xf5, 0xf1, 0x5b, 0x67, 0xfb, 0xfb, 0x0a, 0xf4, 0x47, 0x63, 0xe1, 0x3f, 0xe9, 0xb0,
-	0xa4, 0x7f, 0x94, 0xde, 0xf8, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa5, 0xef, 0x04, 0xc6, 0x0d, 0x0e,
-	0x00, 0x00,
+	0x69, 0x66, 0xb6, 0xda, 0x63, 0x54, 0x88, 0xcf, 0x13, 0x5e, 0x4b, 0xb3, 0xcc, 0x6c, 0x72, 0x13,
+	0xe6, 0x85, 0x68, 0x3c, 0xe4, 0x61, 0x9b, 0x4a, 0x7c, 0x1f, 0x3b, 0xe9, 0xa5, 0xf7, 0x82, 0xca,
+	0x43, 0x18, 0x0b, 0x0c, 0x5a, 0x1c, 0xed, 0x49, 0xcd, 0x32, 0xb3, 0xc9, 0x6d, 0x58, 0x94, 0x91,
+	0xd8, 0x8a, 0x42, 0x8c, 0xe5, 0x16, 0x72, 0xb9, 0x4d, 0x25, 0xb5, 0x4b, 0xda, 0x4b, 0xff, 0x06,
+	0x59, 0x87, 0x72, 0x0f, 0xa8, 0x42, 0x4e, 0xe9, 0xc3, 0x7d, 0x78, 0x56, 0x62, 0x33, 0xbd, 0x25,
+	0xa6, 0x73, 0x04, 0x83, 0xe9, 0xfc, 0x96, 0x61, 0x06, 0x63, 0x7a, 0x18, 0xe1, 0x7e, 0x10, 0xda,
+	0xb3, 0x9a, 0x5e, 0x0e, 0x90, 0x3b, 0xb0, 0x64, 0x2a, 0x6b, 0x93, 0xb1, 0x3c, 0x25, 0x7b, 0x4e,
+	0x3b, 0x18, 0xb4, 0x45, 0x56, 0x61, 0x36, 0x83, 0x77, 0xb7, 0xed, 0xf9, 0x55, 0x6b, 0x6d, 0xdc,
+	0x2f, 0x42, 0xe4, 0x6d, 0x78, 0x39, 0x37, 0x63, 0x21, 0x69, 0x14, 0xe9, 0xd2, 0xdb, 0xdd, 0xb6,
+	0x17, 0xf4, 0xe9, 0x61, 0xdb, 0xe4, 0x3d, 0xa8, 0x64, 0x5b, 0x3b, 0xb1, 0x44, 0xce, 0x78, 0x28,
+	0xf0, 0x1e, 0x15, 0x78, 0xc0, 0x23, 0xfb, 0x92, 0x26, 0x35, 0xe2, 0x04, 0xb9, 0x0c, 0x93, 0x8c,
+	0x27, 0x5f, 0x74, 0xec, 0xb2, 0x3e, 0x6a, 0x0c, 0x55, 0xe3, 0x2c, 0x2d, 0xe3, 0x45, 0x53, 0xe3,
+	0xa9, 0xe9, 0x2c, 0xc0, 0x9c, 0x2a, 0x9f, 0x6e, 0xfd, 0x3a, 0x3f, 0x5b, 0xb0, 0xa8, 0x80, 0x2d,
+	0x8e, 0x54, 0xa2, 0x8f, 0x4f, 0x5b, 0x28, 0x24, 0xf9, 0xa4, 0x50, 0x51, 0xb3, 0xd5, 0x07, 0xff,
+	0xad, 0x15, 0xfd, 0xac, 0x23, 0xd2, 0xda, 0xbc, 0x02, 0xa5, 0x16, 0x13, 0xc8, 0x65, 0x5a, 0xe1,
+	0xa9, 0xa5, 0xee, 0x2d, 0xe0, 0x58, 0x13, 0xfb, 0x71, 0xd4, 0xd1, 0x85, 0x39, 0xed, 0xe7, 0x80,
+	0xf3, 0xd4, 0x10, 0x3d, 0x60, 0xb5, 0x8b, 0x22, 0x5a, 0xfd, 0x67, 0x01, 0x16, 0x73, 0xf0, 0x11,
+	0xf2, 0x76, 0x18, 0x20, 0xf9, 0xc6, 0x82, 0x89, 0xbd, 0x50, 0x48, 0xf2, 0x52, 0xb1, 0xd9, 0xb3,
+	0xd6, 0xae, 0xec, 0x9d, 0x17, 0x0b, 0x15, 0xc4, 0xb9, 0xfe, 0xe5, 0x9f, 0x7f, 0x7f, 0x37, 0x76,
+	0x85, 0x5c, 0xd6, 0x4f, 0x52, 0x7b, 0x23, 0x9f, 0xff, 0x21, 0x8a, 0xaf, 0xc6, 0x2c, 0xf2, 0xb5,
+	0x05, 0xe3, 0xf7, 0x71, 0x28, 0x9b, 0x73, 0xd3, 0xc4, 0xb9, 0xa1, 0x99, 0x5c, 0x23, 0x57, 0x07,
+	0x31, 0xf1, 0x9e, 0x29, 0xeb, 0x39, 0xf9, 0xde, 0x82, 0xb2, 0xe2, 0xed, 0x17, 0xf6, 0x2e, 0x46,
+	0xa8, 0xe5, 0x51, 0x42, 0x91, 0x4f, 0x61, 0xda, 0xd0, 0x3a, 0x1a, 0x4a, 0xa7, 0xdc, 0x0b, 0x1f,
+	0x09, 0x67, 0x4d, 0xbb, 0x74, 0xc8, 0xea, 0x88, 0x8c, 0x3d, 0xae, 0x5c, 0x36, 0x8d, 0x7b, 0xf5,
+	0x34, 0x90, 0x57, 0x4e, 0xba, 0xcf, 0x5e, 0xe6, 0xca, 0xf2, 0xa0, 0xad, 0xac, 0x17, 0xcf, 0x14,
+	0x8e, 0xaa, 0x10, 0xdf, 0x5a, 0x30, 0x7f, 0x1f, 0x65, 0xfe, 0x86, 0x92, 0xeb, 0x03, 0x3c, 0x17,
+	0xdf, 0xd7, 0x8a, 0x33, 0xfc, 0x40, 0x46, 0xe0, 0x1d, 0x4d, 0xe0, 0x4d, 0xe7, 0xce, 0x60, 0x02,
+	0xe6, 0x01, 0xd5, 0x7e, 0x0e, 0xfc, 0x3d, 0x4d, 0xa5, 0x66, 0x3c, 0xdc, 0xb5, 0xd6, 0x49, 0x5b,
+	0x53, 0x7a, 0x80, 0x51, 0x73, 0xab, 0x41, 0xb9, 0x1c, 0x2a, 0xf3, 0x4a, 0x11, 0xce, 0x8f, 0x67,
+	0x24, 0x5c, 0x4d, 0x62, 0x8d, 0xdc, 0x1a, 0xa5, 0x42, 0x03, 0xa3, 0x66, 0x60, 0xc2, 0xfc, 0x60,
+	0x41, 0xc9, 0x4c, 0x2f, 0x72, 0xed, 0x64, 0xc4, 0x9e, 0xa9, 0x76, 0x8e, 0xad, 0xf0, 0xaa, 0xe6,
+	0xb8, 0xec, 0x0c, 0xac, 0xb5, 0xbb, 0x7a, 0x78, 0xa8, 0xd6, 0xfc, 0xd1, 0x82, 0x72, 0x97, 0x42,
+	0xf7, 0xdb, 0x8b, 0x23, 0xe9, 0x9c, 0x4e, 0x92, 0xfc, 0x64, 0x41, 0xc9, 0x4c, 0xd4, 0x7e, 0x5e,
+	0x3d, 0x93, 0xf6, 0x1c, 0x79, 0x6d, 0x98, 0x0b, 0xae, 0x8c, 0x28, 0x73, 0x4d, 0xe5, 0x79, 0x2e,
+	0xe4, 0x2f, 0x16, 0x94, 0xbb, 0x74, 0x86, 0x0b, 0xf9, 0x7f, 0x11, 0x76, 0x5f, 0x8c, 0x30, 0xa1,
+	0x50, 0xda, 0xc6, 0x08, 0x25, 0x0e, 0x6b, 0x01, 0xfb, 0x24, 0x9c, 0x15, 0xff, 0x2d, 0x33, 0x63,
+	0xd7, 0x47, 0xcd, 0x58, 0x25, 0x48, 0x03, 0xca, 0x26, 0x44, 0x41, 0x8f, 0x17, 0x0e, 0x76, 0xe3,
+	0x0c, 0xc1, 0xc8, 0x33, 0x58, 0xf8, 0x88, 0x46, 0xa1, 0x52, 0xd6, 0xfc, 0xe6, 0x24, 0x57, 0xfb,
+	0x26, 0x49, 0xfe, 0x5b, 0x74, 0x44, 0xb4, 0xaa, 0x8e, 0x76, 0xdb, 0xb9, 0x39, 0xaa, 0xaf, 0xdb,
+	0x69, 0x28, 0xa3, 0xe4, 0xbd, 0x9d, 0xdf, 0x8f, 0x57, 0xac, 0x3f, 0x8e, 0x57, 0xac, 0xbf, 0x8e,
+	0x57, 0xac, 0x8f, 0xdf, 0x3a, 0xdb, 0xbf, 0xaf, 0x40, 0xff, 0x68, 0xcc, 0xdd, 0x77, 0x0e, 0x4b,
+	0xfa, 0x8f, 0xd2, 0x1b, 0xff, 0x0e, 0x00, 0xa5, 0xef, 0x04, 0xc6, 0x0d, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -8,6 +8,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/glob"
+	"github.com/argoproj/argo-cd/v2/util/oci"
 
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/grpc/codes"
@@ -46,6 +47,8 @@ type AppProject struct {
 type AppProjectStatus struct {
 	// JWTTokensByRole contains a list of JWT tokens issued for a given role
 	JWTTokensByRole map[string]JWTTokens `json:"jwtTokensByRole,omitempty" protobuf:"bytes,1,opt,name=jwtTokensByRole"`
+	// SyncWindowOverrides contains the temporary overrides of the sync windows of applications in the project
+	SyncWindowOverrides []SyncWindowOverride `json:"syncWindowOverrides,omitempty" protobuf:"bytes,2,rep,name=syncWindowOverrides"`
 }
 
 // GetRoleByName returns the role in a project by the name with its index
@@ -193,6 +196,12 @@ func (p *AppProject) ValidateProject() error {
 		srcRepos[src] = true
 	}
 
+	for _, key := range p.Spec.CosignPublicKeys {
+		if _, err := oci.ParsePublicKey(key); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid cosign public key: %v", err)
+		}
+	}
+
 	roleNames := make(map[string]bool)
 	for _, role := range p.Spec.Roles {
 		if _, ok := roleNames[role.Name]; ok {
@@ -25,6 +25,8 @@ import (
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/watch"
 )
 
 // Utility struct for a reference to a secret key.
@@ -52,13 +54,90 @@ type ApplicationSetSpec struct {
 	Generators []ApplicationSetGenerator `json:"generators" protobuf:"bytes,2,name=generators"`
 	Template   ApplicationSetTemplate    `json:"template" protobuf:"bytes,3,name=template"`
 	SyncPolicy *ApplicationSetSyncPolicy `json:"syncPolicy,omitempty" protobuf:"bytes,4,name=syncPolicy"`
+	Strategy   *ApplicationSetStrategy   `json:"strategy,omitempty" protobuf:"bytes,5,opt,name=strategy"`
+	// IgnoreApplicationDifferences lists the fields of the generated Applications which the ApplicationSet controller
+	// does not update, so that they can be modified directly on the Applications.
+	IgnoreApplicationDifferences ApplicationSetIgnoreDifferences `json:"ignoreApplicationDifferences,omitempty" protobuf:"bytes,6,name=ignoreApplicationDifferences"`
+	// TemplatePatch is a Go template, which is rendered with the parameters of each generated Application, and then
+	// applied to the Application as a strategic merge patch. It requires goTemplate.
+	TemplatePatch *string `json:"templatePatch,omitempty" protobuf:"bytes,7,opt,name=templatePatch"`
 }
 
+// ApplicationSetStrategy configures how generated Applications are updated in sequence.
+type ApplicationSetStrategy struct {
+	// Type of the strategy, either AllAtOnce (the default) or RollingSync.
+	Type        string                         `json:"type,omitempty" protobuf:"bytes,1,opt,name=type"`
+	RollingSync *ApplicationSetRolloutStrategy `json:"rollingSync,omitempty" protobuf:"bytes,2,opt,name=rollingSync"`
+}
+
+// ApplicationSetRolloutStrategy configures the ordered steps in which generated Applications are synced.
+type ApplicationSetRolloutStrategy struct {
+	Steps []ApplicationSetRolloutStep `json:"steps,omitempty" protobuf:"bytes,1,rep,name=steps"`
+}
+
+// ApplicationSetRolloutStep selects the Applications which are updated together in a single step of a rollout.
+type ApplicationSetRolloutStep struct {
+	// MatchExpressions select Applications by their labels. All expressions must match for an Application to be part
+	// of the step.
+	MatchExpressions []ApplicationMatchExpression `json:"matchExpressions,omitempty" protobuf:"bytes,1,rep,name=matchExpressions"`
+	// MaxUpdate is the maximum number (or percentage) of Applications of the step which may be updating at once.
+	// If unset, all Applications of the step are updated at once.
+	MaxUpdate *intstr.IntOrString `json:"maxUpdate,omitempty" protobuf:"bytes,2,opt,name=maxUpdate"`
+}
+
+// ApplicationMatchExpression is a label selector requirement matched against the labels of generated Applications.
+type ApplicationMatchExpression struct {
+	Key string `json:"key,omitempty" protobuf:"bytes,1,opt,name=key"`
+	// Operator is one of In, NotIn, Exists or DoesNotExist.
+	Operator string   `json:"operator,omitempty" protobuf:"bytes,2,opt,name=operator"`
+	Values   []string `json:"values,omitempty" protobuf:"bytes,3,rep,name=values"`
+}
+
+const (
+	// ApplicationSetStrategyAllAtOnce updates all generated Applications at once
+	ApplicationSetStrategyAllAtOnce = "AllAtOnce"
+	// ApplicationSetStrategyRollingSync syncs generated Applications in the ordered steps of the strategy
+	ApplicationSetStrategyRollingSync = "RollingSync"
+)
+
 // ApplicationSetSyncPolicy configures how generated Applications will relate to their
 // ApplicationSet.
 type ApplicationSetSyncPolicy struct {
 	// PreserveResourcesOnDeletion will preserve resources on deletion. If PreserveResourcesOnDeletion is set to true, these Applications will not be deleted.
 	PreserveResourcesOnDeletion bool `json:"preserveResourcesOnDeletion,omitempty" protobuf:"bytes,1,name=syncPolicy"`
+	// ApplicationsSync represents the policy applied on the generated applications. Possible values are create-only, create-update, create-delete, sync
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=create-only;create-update;create-delete;sync
+	ApplicationsSync *ApplicationsSyncPolicy `json:"applicationsSync,omitempty" protobuf:"bytes,2,opt,name=applicationsSync,casttype=ApplicationsSyncPolicy"`
+}
+
+// ApplicationsSyncPolicy representation
+// "create-only" means applications are only created. If the generator's result contains update, applications won't be updated
+// "create-update" means applications are only created/Updated. If the generator's result contains update, applications will be updated, but not deleted
+// "create-delete" means applications are only created/deleted. If the generator's result contains update, applications won't be updated, if it results in deleted applications, the applications will be deleted
+// "sync" means create/update/deleted. If the generator's result contains update, applications will be updated, if it results in deleted applications, the applications will be deleted
+// If no ApplicationsSyncPolicy is defined, it defaults it to sync
+type ApplicationsSyncPolicy string
+
+// sync / create-only / create-update / create-delete
+const (
+	ApplicationsSyncPolicyCreateOnly   ApplicationsSyncPolicy = "create-only"
+	ApplicationsSyncPolicyCreateUpdate ApplicationsSyncPolicy = "create-update"
+	ApplicationsSyncPolicyCreateDelete ApplicationsSyncPolicy = "create-delete"
+	ApplicationsSyncPolicySync         ApplicationsSyncPolicy = "sync"
+)
+
+// ApplicationSetIgnoreDifferences configures how the ApplicationSet controller will ignore differences in live
+// applications when applying changes from generated applications.
+type ApplicationSetIgnoreDifferences []ApplicationSetResourceIgnoreDifferences
+
+// ApplicationSetResourceIgnoreDifferences configures how the ApplicationSet controller will ignore differences in live
+// applications when applying changes from generated applications.
+type ApplicationSetResourceIgnoreDifferences struct {
+	// Name is the name of the application to ignore differences for. If not specified, the rule applies to all applications.
+	Name string `json:"name,omitempty" protobuf:"bytes,1,name=name"`
+	// JSONPointers is a list of JSON pointers to fields to ignore differences for.
+	JSONPointers []string `json:"jsonPointers,omitempty" protobuf:"bytes,2,name=jsonPointers"`
 }
 
 // ApplicationSetTemplate represents argocd ApplicationSpec
@@ -90,6 +169,8 @@ type ApplicationSetGenerator struct {
 
 	// Selector allows to post-filter all generator.
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,9,name=selector"`
+
+	Plugin *PluginGenerator `json:"plugin,omitempty" protobuf:"bytes,10,name=plugin"`
 }
 
 // ApplicationSetNestedGenerator represents a generator nested within a combination-type generator (MatrixGenerator or
@@ -110,6 +191,8 @@ type ApplicationSetNestedGenerator struct {
 
 	// Selector allows to post-filter all generator.
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,9,name=selector"`
+
+	Plugin *PluginGenerator `json:"plugin,omitempty" protobuf:"bytes,10,name=plugin"`
 }
 
 type ApplicationSetNestedGenerators []ApplicationSetNestedGenerator
@@ -125,6 +208,7 @@ type ApplicationSetTerminalGenerator struct {
 	SCMProvider             *SCMProviderGenerator `json:"scmProvider,omitempty" protobuf:"bytes,4,name=scmProvider"`
 	ClusterDecisionResource *DuckTypeGenerator    `json:"clusterDecisionResource,omitempty" protobuf:"bytes,5,name=clusterDecisionResource"`
 	PullRequest             *PullRequestGenerator `json:"pullRequest,omitempty" protobuf:"bytes,6,name=pullRequest"`
+	Plugin                  *PluginGenerator      `json:"plugin,omitempty" protobuf:"bytes,7,name=plugin"`
 }
 
 type ApplicationSetTerminalGenerators []ApplicationSetTerminalGenerator
@@ -142,6 +226,7 @@ func (g ApplicationSetTerminalGenerators) toApplicationSetNestedGenerators() []A
 			SCMProvider:             terminalGenerator.SCMProvider,
 			ClusterDecisionResource: terminalGenerator.ClusterDecisionResource,
 			PullRequest:             terminalGenerator.PullRequest,
+			Plugin:                  terminalGenerator.Plugin,
 		}
 	}
 	return nestedGenerators
@@ -278,6 +363,49 @@ type DuckTypeGenerator struct {
 	Values map[string]string `json:"values,omitempty" protobuf:"bytes,6,name=values"`
 }
 
+// PluginConfigMapRef references the ConfigMap holding the connection settings of a plugin
+type PluginConfigMapRef struct {
+	// Name of the ConfigMap
+	Name string `json:"name" protobuf:"bytes,1,name=name"`
+}
+
+// PluginParameters contains the parameters passed to a plugin
+type PluginParameters map[string]apiextensionsv1.JSON
+
+// ToMap returns the parameters with their values decoded from JSON
+func (p PluginParameters) ToMap() (map[string]interface{}, error) {
+	out := make(map[string]interface{})
+	for k, v := range p {
+		var value interface{}
+		if err := json.Unmarshal(v.Raw, &value); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal plugin parameter %q: %w", k, err)
+		}
+		out[k] = value
+	}
+	return out, nil
+}
+
+// PluginInput is the input sent to a plugin
+type PluginInput struct {
+	// Parameters contains the information to pass to the plugin. It is a map, the keys must be strings, and the
+	// values can be any type.
+	Parameters PluginParameters `json:"parameters,omitempty" protobuf:"bytes,1,name=parameters"`
+}
+
+// PluginGenerator defines a generator which gets its parameters from an external plugin service
+type PluginGenerator struct {
+	// ConfigMapRef is a ConfigMap with the baseUrl of the plugin, and a reference to the secret holding its token
+	ConfigMapRef PluginConfigMapRef `json:"configMapRef" protobuf:"bytes,1,name=configMapRef"`
+	Input        PluginInput        `json:"input,omitempty" protobuf:"bytes,2,name=input"`
+	// RequeueAfterSeconds determines how long the ApplicationSet controller will wait before reconciling the
+	// ApplicationSet again. The response of the plugin is cached for the same duration.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"bytes,3,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,4,name=template"`
+	// Values contains key/value pairs which are passed directly as parameters to the template. These values will not
+	// be sent as parameters to the plugin.
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,5,name=values"`
+}
+
 type GitGenerator struct {
 	RepoURL             string                      `json:"repoURL" protobuf:"bytes,1,name=repoURL"`
 	Directories         []GitDirectoryGeneratorItem `json:"directories,omitempty" protobuf:"bytes,2,name=directories"`
@@ -418,6 +546,8 @@ type PullRequestGenerator struct {
 	GitLab          *PullRequestGeneratorGitLab          `json:"gitlab,omitempty" protobuf:"bytes,2,opt,name=gitlab"`
 	Gitea           *PullRequestGeneratorGitea           `json:"gitea,omitempty" protobuf:"bytes,3,opt,name=gitea"`
 	BitbucketServer *PullRequestGeneratorBitbucketServer `json:"bitbucketServer,omitempty" protobuf:"bytes,4,opt,name=bitbucketServer"`
+	Bitbucket       *PullRequestGeneratorBitbucket       `json:"bitbucket,omitempty" protobuf:"bytes,8,opt,name=bitbucket"`
+	AzureDevOps     *PullRequestGeneratorAzureDevOps     `json:"azuredevops,omitempty" protobuf:"bytes,9,opt,name=azuredevops"`
 	// Filters for which pull requests should be considered.
 	Filters []PullRequestGeneratorFilter `json:"filters,omitempty" protobuf:"bytes,5,rep,name=filters"`
 	// Standard parameters.
@@ -425,6 +555,22 @@ type PullRequestGenerator struct {
 	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,7,opt,name=template"`
 }
 
+// PullRequestGeneratorAzureDevOps defines connection info specific to AzureDevOps.
+type PullRequestGeneratorAzureDevOps struct {
+	// Azure DevOps org to scan. Required.
+	Organization string `json:"organization" protobuf:"bytes,1,opt,name=organization"`
+	// Azure DevOps project name to scan. Required.
+	Project string `json:"project" protobuf:"bytes,2,opt,name=project"`
+	// Azure DevOps repo name to scan. Required.
+	Repo string `json:"repo" protobuf:"bytes,3,opt,name=repo"`
+	// The Azure DevOps API URL to talk to. If blank, use https://dev.azure.com/.
+	API string `json:"api,omitempty" protobuf:"bytes,4,opt,name=api"`
+	// Authentication token reference.
+	TokenRef *SecretRef `json:"tokenRef,omitempty" protobuf:"bytes,5,opt,name=tokenRef"`
+	// Labels is used to filter the PRs that you want to target
+	Labels []string `json:"labels,omitempty" protobuf:"bytes,6,rep,name=labels"`
+}
+
 // PullRequestGenerator defines connection info specific to Gitea.
 type PullRequestGeneratorGitea struct {
 	// Gitea org or user to scan. Required.
@@ -481,6 +627,26 @@ type PullRequestGeneratorBitbucketServer struct {
 	BasicAuth *BasicAuthBitbucketServer `json:"basicAuth,omitempty" protobuf:"bytes,4,opt,name=basicAuth"`
 }
 
+// PullRequestGeneratorBitbucket defines connection info specific to Bitbucket Cloud (API version 2).
+type PullRequestGeneratorBitbucket struct {
+	// Workspace to scan. Required.
+	Owner string `json:"owner" protobuf:"bytes,1,opt,name=owner"`
+	// Repo name to scan. Required.
+	Repo string `json:"repo" protobuf:"bytes,2,opt,name=repo"`
+	// The Bitbucket REST API URL to talk to. If blank, uses https://api.bitbucket.org/2.0.
+	API string `json:"api,omitempty" protobuf:"bytes,3,opt,name=api"`
+	// Credentials for Basic auth, with an app password
+	BasicAuth *BasicAuthBitbucketServer `json:"basicAuth,omitempty" protobuf:"bytes,4,opt,name=basicAuth"`
+	// Credentials for Bearer auth, with a repository, project or workspace access token
+	BearerToken *BearerTokenBitbucketCloud `json:"bearerToken,omitempty" protobuf:"bytes,5,opt,name=bearerToken"`
+}
+
+// BearerTokenBitbucketCloud defines the Bearer token for Bitbucket Cloud.
+type BearerTokenBitbucketCloud struct {
+	// Access token reference.
+	TokenRef *SecretRef `json:"tokenRef" protobuf:"bytes,1,opt,name=tokenRef"`
+}
+
 // BasicAuthBitbucketServer defines the username/(password or personal access token) for Basic auth.
 type BasicAuthBitbucketServer struct {
 	// Username for Basic auth
@@ -493,15 +659,55 @@ type BasicAuthBitbucketServer struct {
 // If multiple filter types are set on a single struct, they will be AND'd together. All filters must
 // pass for a pull request to be included.
 type PullRequestGeneratorFilter struct {
+	// A regex which must match the source branch name.
 	BranchMatch *string `json:"branchMatch,omitempty" protobuf:"bytes,1,opt,name=branchMatch"`
+	// A regex which must match the target branch name.
+	TargetBranchMatch *string `json:"targetBranchMatch,omitempty" protobuf:"bytes,2,opt,name=targetBranchMatch"`
+	// A regex which must match the title of the pull request.
+	TitleMatch *string `json:"titleMatch,omitempty" protobuf:"bytes,3,opt,name=titleMatch"`
+	// The login of the user who must have opened the pull request.
+	Author *string `json:"author,omitempty" protobuf:"bytes,4,opt,name=author"`
+	// Exclude the draft pull requests.
+	ExcludeDrafts bool `json:"excludeDrafts,omitempty" protobuf:"varint,5,opt,name=excludeDrafts"`
 }
 
 // ApplicationSetStatus defines the observed state of ApplicationSet
 type ApplicationSetStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
-	Conditions []ApplicationSetCondition `json:"conditions,omitempty" protobuf:"bytes,1,name=conditions"`
-}
+	Conditions        []ApplicationSetCondition         `json:"conditions,omitempty" protobuf:"bytes,1,name=conditions"`
+	ApplicationStatus []ApplicationSetApplicationStatus `json:"applicationStatus,omitempty" protobuf:"bytes,2,name=applicationStatus"`
+}
+
+// ApplicationSetApplicationStatus contains details about each Application managed by the ApplicationSet
+type ApplicationSetApplicationStatus struct {
+	// Application contains the name of the Application resource
+	Application string `json:"application" protobuf:"bytes,1,opt,name=application"`
+	// LastTransitionTime is the time the status was last updated
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,2,opt,name=lastTransitionTime"`
+	// Message contains human-readable message indicating details about the status
+	Message string `json:"message" protobuf:"bytes,3,opt,name=message"`
+	// Status contains the status of the Application (Waiting, Pending, Progressing or Healthy)
+	Status string `json:"status" protobuf:"bytes,4,opt,name=status"`
+	// Step tracks the rollout step the Application is currently in, if the ApplicationSet uses a RollingSync strategy
+	Step string `json:"step" protobuf:"bytes,5,opt,name=step"`
+	// Health contains the health status of the Application
+	Health HealthStatus `json:"health,omitempty" protobuf:"bytes,6,opt,name=health"`
+	// Sync contains the sync status of the Application
+	Sync SyncStatusCode `json:"sync,omitempty" protobuf:"bytes,7,opt,name=sync,casttype=SyncStatusCode"`
+}
+
+// Statuses of an Application managed by an ApplicationSet. Only the RollingSync strategy moves Applications to Pending.
+const (
+	// ApplicationSetApplicationStatusWaiting indicates the Application has pending changes
+	ApplicationSetApplicationStatusWaiting = "Waiting"
+	// ApplicationSetApplicationStatusPending indicates a sync of the Application was requested
+	ApplicationSetApplicationStatusPending = "Pending"
+	// ApplicationSetApplicationStatusProgressing indicates the Application is being synced
+	ApplicationSetApplicationStatusProgressing = "Progressing"
+	// ApplicationSetApplicationStatusHealthy indicates the Application is synced and healthy
+	ApplicationSetApplicationStatusHealthy = "Healthy"
+)
 
 // ApplicationSetCondition contains details about an applicationset condition, which is usally an error or warning
 type ApplicationSetCondition struct {
@@ -541,6 +747,7 @@ const (
 	ApplicationSetConditionErrorOccurred       ApplicationSetConditionType = "ErrorOccurred"
 	ApplicationSetConditionParametersGenerated ApplicationSetConditionType = "ParametersGenerated"
 	ApplicationSetConditionResourcesUpToDate   ApplicationSetConditionType = "ResourcesUpToDate"
+	ApplicationSetConditionRolloutProgressing  ApplicationSetConditionType = "RolloutProgressing"
 )
 
 type ApplicationSetReasonType string
@@ -557,6 +764,10 @@ const (
 	ApplicationSetReasonDeleteApplicationError           = "DeleteApplicationError"
 	ApplicationSetReasonRefreshApplicationError          = "RefreshApplicationError"
 	ApplicationSetReasonApplicationValidationError       = "ApplicationValidationError"
+	ApplicationSetReasonApplicationSetModified           = "ApplicationSetModified"
+	ApplicationSetReasonApplicationSetRolloutComplete    = "ApplicationSetRolloutComplete"
+	ApplicationSetReasonSyncApplicationError             = "SyncApplicationError"
+	ApplicationSetReasonTemplatePatchError               = "TemplatePatchError"
 )
 
 // ApplicationSetList contains a list of ApplicationSet
@@ -568,6 +779,18 @@ type ApplicationSetList struct {
 	Items           []ApplicationSet `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+// ApplicationSetWatchEvent contains information about application set change.
+type ApplicationSetWatchEvent struct {
+	Type watch.EventType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=k8s.io/apimachinery/pkg/watch.EventType"`
+
+	// ApplicationSet is:
+	//  * If Type is Added or Modified: the new state of the object.
+	//  * If Type is Deleted: the state of the object immediately before deletion.
+	//  * If Type is Error: *api.Status is recommended; other types may make sense
+	//    depending on context.
+	ApplicationSet ApplicationSet `json:"applicationSet" protobuf:"bytes,2,opt,name=applicationSet"`
+}
+
 // func init() {
 // 	SchemeBuilder.Register(&ApplicationSet{}, &ApplicationSetList{})
 // }
@@ -585,6 +808,12 @@ func (a *ApplicationSet) RefreshRequired() bool {
 func (status *ApplicationSetStatus) SetConditions(conditions []ApplicationSetCondition, evaluatedTypes map[ApplicationSetConditionType]bool) {
 	applicationSetConditions := make([]ApplicationSetCondition, 0)
 	now := metav1.Now()
+	for i := range status.Conditions {
+		condition := status.Conditions[i]
+		if _, ok := evaluatedTypes[condition.Type]; !ok {
+			applicationSetConditions = append(applicationSetConditions, condition)
+		}
+	}
 	for i := range conditions {
 		condition := conditions[i]
 		if condition.LastTransitionTime == nil {
@@ -608,6 +837,17 @@ func (status *ApplicationSetStatus) SetConditions(conditions []ApplicationSetCon
 	status.Conditions = applicationSetConditions
 }
 
+// SetApplicationStatus updates the status of the given Application
+func (status *ApplicationSetStatus) SetApplicationStatus(newStatus ApplicationSetApplicationStatus) {
+	for i := range status.ApplicationStatus {
+		if status.ApplicationStatus[i].Application == newStatus.Application {
+			status.ApplicationStatus[i] = newStatus
+			return
+		}
+	}
+	status.ApplicationStatus = append(status.ApplicationStatus, newStatus)
+}
+
 func findConditionIndex(conditions []ApplicationSetCondition, t ApplicationSetConditionType) int {
 	for i := range conditions {
 		if conditions[i].Type == t {
@@ -107,6 +107,22 @@ func TestApplicationSetSetConditions(t *testing.T) {
 			validate: func(t *testing.T, a *ApplicationSet) {
 				assert.Equal(t, tenMinsAgo.Time, a.Status.Conditions[0].LastTransitionTime.Time)
 			},
+		}, {
+			name: "condition not evaluated is preserved",
+			existing: []ApplicationSetCondition{
+				testAppSetCond(ApplicationSetConditionRolloutProgressing, "foo", fiveMinsAgo, ApplicationSetConditionStatusTrue, ApplicationSetReasonApplicationSetModified),
+				testAppSetCond(ApplicationSetConditionResourcesUpToDate, "bar", tenMinsAgo, ApplicationSetConditionStatusFalse, ApplicationSetReasonApplicationSetUpToDate),
+			},
+			incoming: []ApplicationSetCondition{
+				testAppSetCond(ApplicationSetConditionResourcesUpToDate, "bar", tenMinsAgo, ApplicationSetConditionStatusTrue, ApplicationSetReasonApplicationSetUpToDate),
+			},
+			evaluatedTypes: map[ApplicationSetConditionType]bool{
+				ApplicationSetConditionResourcesUpToDate: true,
+			},
+			expected: []ApplicationSetCondition{
+				testAppSetCond(ApplicationSetConditionResourcesUpToDate, "bar", tenMinsAgo, ApplicationSetConditionStatusTrue, ApplicationSetReasonApplicationSetUpToDate),
+				testAppSetCond(ApplicationSetConditionRolloutProgressing, "foo", fiveMinsAgo, ApplicationSetConditionStatusTrue, ApplicationSetReasonApplicationSetModified),
+			},
 		},
 	}
 	for _, tt := range tests {
@@ -17,12 +17,14 @@ import (
 	v12 "k8s.io/api/core/v1"
 	v11 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
 
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 	k8s_io_apimachinery_pkg_watch "k8s.io/apimachinery/pkg/watch"
 )
 
@@ -233,10 +235,38 @@ func (m *ApplicationCondition) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationCondition proto.InternalMessageInfo
 
+func (m *ApplicationDependency) Reset()      { *m = ApplicationDependency{} }
+func (*ApplicationDependency) ProtoMessage() {}
+func (*ApplicationDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{7}
+}
+func (m *ApplicationDependency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDependency.Merge(m, src)
+}
+func (m *ApplicationDependency) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDependency proto.InternalMessageInfo
+
 func (m *ApplicationDestination) Reset()      { *m = ApplicationDestination{} }
 func (*ApplicationDestination) ProtoMessage() {}
 func (*ApplicationDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{7}
+	return fileDescriptor_030104ce3b95bcac, []int{8}
 }
 func (m *ApplicationDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,7 +294,7 @@ var xxx_messageInfo_ApplicationDestination proto.InternalMessageInfo
 func (m *ApplicationList) Reset()      { *m = ApplicationList{} }
 func (*ApplicationList) ProtoMessage() {}
 func (*ApplicationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{8}
+	return fileDescriptor_030104ce3b95bcac, []int{9}
 }
 func (m *ApplicationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,10 +319,38 @@ func (m *ApplicationList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationList proto.InternalMessageInfo
 
+func (m *ApplicationMatchExpression) Reset()      { *m = ApplicationMatchExpression{} }
+func (*ApplicationMatchExpression) ProtoMessage() {}
+func (*ApplicationMatchExpression) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{10}
+}
+func (m *ApplicationMatchExpression) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationMatchExpression) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationMatchExpression) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationMatchExpression.Merge(m, src)
+}
+func (m *ApplicationMatchExpression) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationMatchExpression) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationMatchExpression.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationMatchExpression proto.InternalMessageInfo
+
 func (m *ApplicationSet) Reset()      { *m = ApplicationSet{} }
 func (*ApplicationSet) ProtoMessage() {}
 func (*ApplicationSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{9}
+	return fileDescriptor_030104ce3b95bcac, []int{11}
 }
 func (m *ApplicationSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,10 +375,38 @@ func (m *ApplicationSet) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSet proto.InternalMessageInfo
 
+func (m *ApplicationSetApplicationStatus) Reset()      { *m = ApplicationSetApplicationStatus{} }
+func (*ApplicationSetApplicationStatus) ProtoMessage() {}
+func (*ApplicationSetApplicationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{12}
+}
+func (m *ApplicationSetApplicationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetApplicationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetApplicationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetApplicationStatus.Merge(m, src)
+}
+func (m *ApplicationSetApplicationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetApplicationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetApplicationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetApplicationStatus proto.InternalMessageInfo
+
 func (m *ApplicationSetCondition) Reset()      { *m = ApplicationSetCondition{} }
 func (*ApplicationSetCondition) ProtoMessage() {}
 func (*ApplicationSetCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{10}
+	return fileDescriptor_030104ce3b95bcac, []int{13}
 }
 func (m *ApplicationSetCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -348,7 +434,7 @@ var xxx_messageInfo_ApplicationSetCondition proto.InternalMessageInfo
 func (m *ApplicationSetGenerator) Reset()      { *m = ApplicationSetGenerator{} }
 func (*ApplicationSetGenerator) ProtoMessage() {}
 func (*ApplicationSetGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{11}
+	return fileDescriptor_030104ce3b95bcac, []int{14}
 }
 func (m *ApplicationSetGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -376,7 +462,7 @@ var xxx_messageInfo_ApplicationSetGenerator proto.InternalMessageInfo
 func (m *ApplicationSetList) Reset()      { *m = ApplicationSetList{} }
 func (*ApplicationSetList) ProtoMessage() {}
 func (*ApplicationSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{12}
+	return fileDescriptor_030104ce3b95bcac, []int{15}
 }
 func (m *ApplicationSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -404,7 +490,7 @@ var xxx_messageInfo_ApplicationSetList proto.InternalMessageInfo
 func (m *ApplicationSetNestedGenerator) Reset()      { *m = ApplicationSetNestedGenerator{} }
 func (*ApplicationSetNestedGenerator) ProtoMessage() {}
 func (*ApplicationSetNestedGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{13}
+	return fileDescriptor_030104ce3b95bcac, []int{16}
 }
 func (m *ApplicationSetNestedGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -429,10 +515,96 @@ func (m *ApplicationSetNestedGenerator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSetNestedGenerator proto.InternalMessageInfo
 
+func (m *ApplicationSetResourceIgnoreDifferences) Reset() {
+	*m = ApplicationSetResourceIgnoreDifferences{}
+}
+func (*ApplicationSetResourceIgnoreDifferences) ProtoMessage() {}
+func (*ApplicationSetResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{17}
+}
+func (m *ApplicationSetResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetResourceIgnoreDifferences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetResourceIgnoreDifferences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetResourceIgnoreDifferences.Merge(m, src)
+}
+func (m *ApplicationSetResourceIgnoreDifferences) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetResourceIgnoreDifferences) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetResourceIgnoreDifferences.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetResourceIgnoreDifferences proto.InternalMessageInfo
+
+func (m *ApplicationSetRolloutStep) Reset()      { *m = ApplicationSetRolloutStep{} }
+func (*ApplicationSetRolloutStep) ProtoMessage() {}
+func (*ApplicationSetRolloutStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{18}
+}
+func (m *ApplicationSetRolloutStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetRolloutStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetRolloutStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetRolloutStep.Merge(m, src)
+}
+func (m *ApplicationSetRolloutStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetRolloutStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetRolloutStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetRolloutStep proto.InternalMessageInfo
+
+func (m *ApplicationSetRolloutStrategy) Reset()      { *m = ApplicationSetRolloutStrategy{} }
+func (*ApplicationSetRolloutStrategy) ProtoMessage() {}
+func (*ApplicationSetRolloutStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{19}
+}
+func (m *ApplicationSetRolloutStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetRolloutStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetRolloutStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetRolloutStrategy.Merge(m, src)
+}
+func (m *ApplicationSetRolloutStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetRolloutStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetRolloutStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetRolloutStrategy proto.InternalMessageInfo
+
 func (m *ApplicationSetSpec) Reset()      { *m = ApplicationSetSpec{} }
 func (*ApplicationSetSpec) ProtoMessage() {}
 func (*ApplicationSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{14}
+	return fileDescriptor_030104ce3b95bcac, []int{20}
 }
 func (m *ApplicationSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -460,7 +632,7 @@ var xxx_messageInfo_ApplicationSetSpec proto.InternalMessageInfo
 func (m *ApplicationSetStatus) Reset()      { *m = ApplicationSetStatus{} }
 func (*ApplicationSetStatus) ProtoMessage() {}
 func (*ApplicationSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{15}
+	return fileDescriptor_030104ce3b95bcac, []int{21}
 }
 func (m *ApplicationSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -485,10 +657,38 @@ func (m *ApplicationSetStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSetStatus proto.InternalMessageInfo
 
+func (m *ApplicationSetStrategy) Reset()      { *m = ApplicationSetStrategy{} }
+func (*ApplicationSetStrategy) ProtoMessage() {}
+func (*ApplicationSetStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{22}
+}
+func (m *ApplicationSetStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetStrategy.Merge(m, src)
+}
+func (m *ApplicationSetStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetStrategy proto.InternalMessageInfo
+
 func (m *ApplicationSetSyncPolicy) Reset()      { *m = ApplicationSetSyncPolicy{} }
 func (*ApplicationSetSyncPolicy) ProtoMessage() {}
 func (*ApplicationSetSyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{16}
+	return fileDescriptor_030104ce3b95bcac, []int{23}
 }
 func (m *ApplicationSetSyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -516,7 +716,7 @@ var xxx_messageInfo_ApplicationSetSyncPolicy proto.InternalMessageInfo
 func (m *ApplicationSetTemplate) Reset()      { *m = ApplicationSetTemplate{} }
 func (*ApplicationSetTemplate) ProtoMessage() {}
 func (*ApplicationSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{17}
+	return fileDescriptor_030104ce3b95bcac, []int{24}
 }
 func (m *ApplicationSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -544,7 +744,7 @@ var xxx_messageInfo_ApplicationSetTemplate proto.InternalMessageInfo
 func (m *ApplicationSetTemplateMeta) Reset()      { *m = ApplicationSetTemplateMeta{} }
 func (*ApplicationSetTemplateMeta) ProtoMessage() {}
 func (*ApplicationSetTemplateMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{18}
+	return fileDescriptor_030104ce3b95bcac, []int{25}
 }
 func (m *ApplicationSetTemplateMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -572,7 +772,7 @@ var xxx_messageInfo_ApplicationSetTemplateMeta proto.InternalMessageInfo
 func (m *ApplicationSetTerminalGenerator) Reset()      { *m = ApplicationSetTerminalGenerator{} }
 func (*ApplicationSetTerminalGenerator) ProtoMessage() {}
 func (*ApplicationSetTerminalGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{19}
+	return fileDescriptor_030104ce3b95bcac, []int{26}
 }
 func (m *ApplicationSetTerminalGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -597,10 +797,38 @@ func (m *ApplicationSetTerminalGenerator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSetTerminalGenerator proto.InternalMessageInfo
 
+func (m *ApplicationSetWatchEvent) Reset()      { *m = ApplicationSetWatchEvent{} }
+func (*ApplicationSetWatchEvent) ProtoMessage() {}
+func (*ApplicationSetWatchEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{27}
+}
+func (m *ApplicationSetWatchEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetWatchEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetWatchEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetWatchEvent.Merge(m, src)
+}
+func (m *ApplicationSetWatchEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetWatchEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetWatchEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetWatchEvent proto.InternalMessageInfo
+
 func (m *ApplicationSource) Reset()      { *m = ApplicationSource{} }
 func (*ApplicationSource) ProtoMessage() {}
 func (*ApplicationSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{20}
+	return fileDescriptor_030104ce3b95bcac, []int{28}
 }
 func (m *ApplicationSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -628,7 +856,7 @@ var xxx_messageInfo_ApplicationSource proto.InternalMessageInfo
 func (m *ApplicationSourceDirectory) Reset()      { *m = ApplicationSourceDirectory{} }
 func (*ApplicationSourceDirectory) ProtoMessage() {}
 func (*ApplicationSourceDirectory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{21}
+	return fileDescriptor_030104ce3b95bcac, []int{29}
 }
 func (m *ApplicationSourceDirectory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -656,7 +884,7 @@ var xxx_messageInfo_ApplicationSourceDirectory proto.InternalMessageInfo
 func (m *ApplicationSourceHelm) Reset()      { *m = ApplicationSourceHelm{} }
 func (*ApplicationSourceHelm) ProtoMessage() {}
 func (*ApplicationSourceHelm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{22}
+	return fileDescriptor_030104ce3b95bcac, []int{30}
 }
 func (m *ApplicationSourceHelm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,7 +912,7 @@ var xxx_messageInfo_ApplicationSourceHelm proto.InternalMessageInfo
 func (m *ApplicationSourceJsonnet) Reset()      { *m = ApplicationSourceJsonnet{} }
 func (*ApplicationSourceJsonnet) ProtoMessage() {}
 func (*ApplicationSourceJsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{23}
+	return fileDescriptor_030104ce3b95bcac, []int{31}
 }
 func (m *ApplicationSourceJsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -712,7 +940,7 @@ var xxx_messageInfo_ApplicationSourceJsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKustomize) Reset()      { *m = ApplicationSourceKustomize{} }
 func (*ApplicationSourceKustomize) ProtoMessage() {}
 func (*ApplicationSourceKustomize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{24}
+	return fileDescriptor_030104ce3b95bcac, []int{32}
 }
 func (m *ApplicationSourceKustomize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -740,7 +968,7 @@ var xxx_messageInfo_ApplicationSourceKustomize proto.InternalMessageInfo
 func (m *ApplicationSourcePlugin) Reset()      { *m = ApplicationSourcePlugin{} }
 func (*ApplicationSourcePlugin) ProtoMessage() {}
 func (*ApplicationSourcePlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{25}
+	return fileDescriptor_030104ce3b95bcac, []int{33}
 }
 func (m *ApplicationSourcePlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -765,10 +993,38 @@ func (m *ApplicationSourcePlugin) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSourcePlugin proto.InternalMessageInfo
 
+func (m *ApplicationSourcePluginParameter) Reset()      { *m = ApplicationSourcePluginParameter{} }
+func (*ApplicationSourcePluginParameter) ProtoMessage() {}
+func (*ApplicationSourcePluginParameter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{34}
+}
+func (m *ApplicationSourcePluginParameter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSourcePluginParameter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSourcePluginParameter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSourcePluginParameter.Merge(m, src)
+}
+func (m *ApplicationSourcePluginParameter) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSourcePluginParameter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSourcePluginParameter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSourcePluginParameter proto.InternalMessageInfo
+
 func (m *ApplicationSpec) Reset()      { *m = ApplicationSpec{} }
 func (*ApplicationSpec) ProtoMessage() {}
 func (*ApplicationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{26}
+	return fileDescriptor_030104ce3b95bcac, []int{35}
 }
 func (m *ApplicationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -796,7 +1052,7 @@ var xxx_messageInfo_ApplicationSpec proto.InternalMessageInfo
 func (m *ApplicationStatus) Reset()      { *m = ApplicationStatus{} }
 func (*ApplicationStatus) ProtoMessage() {}
 func (*ApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{27}
+	return fileDescriptor_030104ce3b95bcac, []int{36}
 }
 func (m *ApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -824,7 +1080,7 @@ var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSummary) Reset()      { *m = ApplicationSummary{} }
 func (*ApplicationSummary) ProtoMessage() {}
 func (*ApplicationSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{28}
+	return fileDescriptor_030104ce3b95bcac, []int{37}
 }
 func (m *ApplicationSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -852,7 +1108,7 @@ var xxx_messageInfo_ApplicationSummary proto.InternalMessageInfo
 func (m *ApplicationTree) Reset()      { *m = ApplicationTree{} }
 func (*ApplicationTree) ProtoMessage() {}
 func (*ApplicationTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{29}
+	return fileDescriptor_030104ce3b95bcac, []int{38}
 }
 func (m *ApplicationTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -880,7 +1136,7 @@ var xxx_messageInfo_ApplicationTree proto.InternalMessageInfo
 func (m *ApplicationWatchEvent) Reset()      { *m = ApplicationWatchEvent{} }
 func (*ApplicationWatchEvent) ProtoMessage() {}
 func (*ApplicationWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{30}
+	return fileDescriptor_030104ce3b95bcac, []int{39}
 }
 func (m *ApplicationWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -908,7 +1164,7 @@ var xxx_messageInfo_ApplicationWatchEvent proto.InternalMessageInfo
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{31}
+	return fileDescriptor_030104ce3b95bcac, []int{40}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -936,7 +1192,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *BasicAuthBitbucketServer) Reset()      { *m = BasicAuthBitbucketServer{} }
 func (*BasicAuthBitbucketServer) ProtoMessage() {}
 func (*BasicAuthBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{32}
+	return fileDescriptor_030104ce3b95bcac, []int{41}
 }
 func (m *BasicAuthBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -961,10 +1217,38 @@ func (m *BasicAuthBitbucketServer) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BasicAuthBitbucketServer proto.InternalMessageInfo
 
+func (m *BearerTokenBitbucketCloud) Reset()      { *m = BearerTokenBitbucketCloud{} }
+func (*BearerTokenBitbucketCloud) ProtoMessage() {}
+func (*BearerTokenBitbucketCloud) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{42}
+}
+func (m *BearerTokenBitbucketCloud) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BearerTokenBitbucketCloud) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BearerTokenBitbucketCloud) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BearerTokenBitbucketCloud.Merge(m, src)
+}
+func (m *BearerTokenBitbucketCloud) XXX_Size() int {
+	return m.Size()
+}
+func (m *BearerTokenBitbucketCloud) XXX_DiscardUnknown() {
+	xxx_messageInfo_BearerTokenBitbucketCloud.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BearerTokenBitbucketCloud proto.InternalMessageInfo
+
 func (m *Cluster) Reset()      { *m = Cluster{} }
 func (*Cluster) ProtoMessage() {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{33}
+	return fileDescriptor_030104ce3b95bcac, []int{43}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -992,7 +1276,7 @@ var xxx_messageInfo_Cluster proto.InternalMessageInfo
 func (m *ClusterCacheInfo) Reset()      { *m = ClusterCacheInfo{} }
 func (*ClusterCacheInfo) ProtoMessage() {}
 func (*ClusterCacheInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{34}
+	return fileDescriptor_030104ce3b95bcac, []int{44}
 }
 func (m *ClusterCacheInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1020,7 +1304,7 @@ var xxx_messageInfo_ClusterCacheInfo proto.InternalMessageInfo
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{35}
+	return fileDescriptor_030104ce3b95bcac, []int{45}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1048,7 +1332,7 @@ var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 func (m *ClusterGenerator) Reset()      { *m = ClusterGenerator{} }
 func (*ClusterGenerator) ProtoMessage() {}
 func (*ClusterGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{36}
+	return fileDescriptor_030104ce3b95bcac, []int{46}
 }
 func (m *ClusterGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1076,7 +1360,7 @@ var xxx_messageInfo_ClusterGenerator proto.InternalMessageInfo
 func (m *ClusterInfo) Reset()      { *m = ClusterInfo{} }
 func (*ClusterInfo) ProtoMessage() {}
 func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{37}
+	return fileDescriptor_030104ce3b95bcac, []int{47}
 }
 func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1104,7 +1388,7 @@ var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
 func (m *ClusterList) Reset()      { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage() {}
 func (*ClusterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{38}
+	return fileDescriptor_030104ce3b95bcac, []int{48}
 }
 func (m *ClusterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1132,7 +1416,7 @@ var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 func (m *Command) Reset()      { *m = Command{} }
 func (*Command) ProtoMessage() {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{39}
+	return fileDescriptor_030104ce3b95bcac, []int{49}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1160,7 +1444,7 @@ var xxx_messageInfo_Command proto.InternalMessageInfo
 func (m *ComparedTo) Reset()      { *m = ComparedTo{} }
 func (*ComparedTo) ProtoMessage() {}
 func (*ComparedTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{40}
+	return fileDescriptor_030104ce3b95bcac, []int{50}
 }
 func (m *ComparedTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1188,7 +1472,7 @@ var xxx_messageInfo_ComparedTo proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{41}
+	return fileDescriptor_030104ce3b95bcac, []int{51}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1216,7 +1500,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConfigManagementPlugin) Reset()      { *m = ConfigManagementPlugin{} }
 func (*ConfigManagementPlugin) ProtoMessage() {}
 func (*ConfigManagementPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{42}
+	return fileDescriptor_030104ce3b95bcac, []int{52}
 }
 func (m *ConfigManagementPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1244,7 +1528,7 @@ var xxx_messageInfo_ConfigManagementPlugin proto.InternalMessageInfo
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{43}
+	return fileDescriptor_030104ce3b95bcac, []int{53}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1272,7 +1556,7 @@ var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 func (m *DuckTypeGenerator) Reset()      { *m = DuckTypeGenerator{} }
 func (*DuckTypeGenerator) ProtoMessage() {}
 func (*DuckTypeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{44}
+	return fileDescriptor_030104ce3b95bcac, []int{54}
 }
 func (m *DuckTypeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1300,7 +1584,7 @@ var xxx_messageInfo_DuckTypeGenerator proto.InternalMessageInfo
 func (m *EnvEntry) Reset()      { *m = EnvEntry{} }
 func (*EnvEntry) ProtoMessage() {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{45}
+	return fileDescriptor_030104ce3b95bcac, []int{55}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1328,7 +1612,7 @@ var xxx_messageInfo_EnvEntry proto.InternalMessageInfo
 func (m *ExecProviderConfig) Reset()      { *m = ExecProviderConfig{} }
 func (*ExecProviderConfig) ProtoMessage() {}
 func (*ExecProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{46}
+	return fileDescriptor_030104ce3b95bcac, []int{56}
 }
 func (m *ExecProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1356,7 +1640,7 @@ var xxx_messageInfo_ExecProviderConfig proto.InternalMessageInfo
 func (m *GitDirectoryGeneratorItem) Reset()      { *m = GitDirectoryGeneratorItem{} }
 func (*GitDirectoryGeneratorItem) ProtoMessage() {}
 func (*GitDirectoryGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{47}
+	return fileDescriptor_030104ce3b95bcac, []int{57}
 }
 func (m *GitDirectoryGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1384,7 +1668,7 @@ var xxx_messageInfo_GitDirectoryGeneratorItem proto.InternalMessageInfo
 func (m *GitFileGeneratorItem) Reset()      { *m = GitFileGeneratorItem{} }
 func (*GitFileGeneratorItem) ProtoMessage() {}
 func (*GitFileGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{48}
+	return fileDescriptor_030104ce3b95bcac, []int{58}
 }
 func (m *GitFileGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1412,7 +1696,7 @@ var xxx_messageInfo_GitFileGeneratorItem proto.InternalMessageInfo
 func (m *GitGenerator) Reset()      { *m = GitGenerator{} }
 func (*GitGenerator) ProtoMessage() {}
 func (*GitGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{49}
+	return fileDescriptor_030104ce3b95bcac, []int{59}
 }
 func (m *GitGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1440,7 +1724,7 @@ var xxx_messageInfo_GitGenerator proto.InternalMessageInfo
 func (m *GnuPGPublicKey) Reset()      { *m = GnuPGPublicKey{} }
 func (*GnuPGPublicKey) ProtoMessage() {}
 func (*GnuPGPublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{50}
+	return fileDescriptor_030104ce3b95bcac, []int{60}
 }
 func (m *GnuPGPublicKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1468,7 +1752,7 @@ var xxx_messageInfo_GnuPGPublicKey proto.InternalMessageInfo
 func (m *GnuPGPublicKeyList) Reset()      { *m = GnuPGPublicKeyList{} }
 func (*GnuPGPublicKeyList) ProtoMessage() {}
 func (*GnuPGPublicKeyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{51}
+	return fileDescriptor_030104ce3b95bcac, []int{61}
 }
 func (m *GnuPGPublicKeyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1496,7 +1780,7 @@ var xxx_messageInfo_GnuPGPublicKeyList proto.InternalMessageInfo
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{52}
+	return fileDescriptor_030104ce3b95bcac, []int{62}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1524,7 +1808,7 @@ var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 func (m *HelmFileParameter) Reset()      { *m = HelmFileParameter{} }
 func (*HelmFileParameter) ProtoMessage() {}
 func (*HelmFileParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{53}
+	return fileDescriptor_030104ce3b95bcac, []int{63}
 }
 func (m *HelmFileParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1552,7 +1836,7 @@ var xxx_messageInfo_HelmFileParameter proto.InternalMessageInfo
 func (m *HelmOptions) Reset()      { *m = HelmOptions{} }
 func (*HelmOptions) ProtoMessage() {}
 func (*HelmOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{54}
+	return fileDescriptor_030104ce3b95bcac, []int{64}
 }
 func (m *HelmOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1580,7 +1864,7 @@ var xxx_messageInfo_HelmOptions proto.InternalMessageInfo
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{55}
+	return fileDescriptor_030104ce3b95bcac, []int{65}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1608,7 +1892,7 @@ var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 func (m *HostInfo) Reset()      { *m = HostInfo{} }
 func (*HostInfo) ProtoMessage() {}
 func (*HostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{56}
+	return fileDescriptor_030104ce3b95bcac, []int{66}
 }
 func (m *HostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1636,7 +1920,7 @@ var xxx_messageInfo_HostInfo proto.InternalMessageInfo
 func (m *HostResourceInfo) Reset()      { *m = HostResourceInfo{} }
 func (*HostResourceInfo) ProtoMessage() {}
 func (*HostResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{57}
+	return fileDescriptor_030104ce3b95bcac, []int{67}
 }
 func (m *HostResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1664,7 +1948,7 @@ var xxx_messageInfo_HostResourceInfo proto.InternalMessageInfo
 func (m *Info) Reset()      { *m = Info{} }
 func (*Info) ProtoMessage() {}
 func (*Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{58}
+	return fileDescriptor_030104ce3b95bcac, []int{68}
 }
 func (m *Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1692,7 +1976,7 @@ var xxx_messageInfo_Info proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{59}
+	return fileDescriptor_030104ce3b95bcac, []int{69}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1720,7 +2004,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{60}
+	return fileDescriptor_030104ce3b95bcac, []int{70}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1748,7 +2032,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JWTTokens) Reset()      { *m = JWTTokens{} }
 func (*JWTTokens) ProtoMessage() {}
 func (*JWTTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{61}
+	return fileDescriptor_030104ce3b95bcac, []int{71}
 }
 func (m *JWTTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1776,7 +2060,7 @@ var xxx_messageInfo_JWTTokens proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{62}
+	return fileDescriptor_030104ce3b95bcac, []int{72}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1804,7 +2088,7 @@ var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 func (m *KnownTypeField) Reset()      { *m = KnownTypeField{} }
 func (*KnownTypeField) ProtoMessage() {}
 func (*KnownTypeField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{63}
+	return fileDescriptor_030104ce3b95bcac, []int{73}
 }
 func (m *KnownTypeField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1832,7 +2116,7 @@ var xxx_messageInfo_KnownTypeField proto.InternalMessageInfo
 func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
 func (*KustomizeOptions) ProtoMessage() {}
 func (*KustomizeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{64}
+	return fileDescriptor_030104ce3b95bcac, []int{74}
 }
 func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1857,10 +2141,94 @@ func (m *KustomizeOptions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KustomizeOptions proto.InternalMessageInfo
 
+func (m *KustomizePatch) Reset()      { *m = KustomizePatch{} }
+func (*KustomizePatch) ProtoMessage() {}
+func (*KustomizePatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{75}
+}
+func (m *KustomizePatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KustomizePatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KustomizePatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KustomizePatch.Merge(m, src)
+}
+func (m *KustomizePatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *KustomizePatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_KustomizePatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KustomizePatch proto.InternalMessageInfo
+
+func (m *KustomizeReplica) Reset()      { *m = KustomizeReplica{} }
+func (*KustomizeReplica) ProtoMessage() {}
+func (*KustomizeReplica) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{76}
+}
+func (m *KustomizeReplica) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KustomizeReplica) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KustomizeReplica) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KustomizeReplica.Merge(m, src)
+}
+func (m *KustomizeReplica) XXX_Size() int {
+	return m.Size()
+}
+func (m *KustomizeReplica) XXX_DiscardUnknown() {
+	xxx_messageInfo_KustomizeReplica.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KustomizeReplica proto.InternalMessageInfo
+
+func (m *KustomizeSelector) Reset()      { *m = KustomizeSelector{} }
+func (*KustomizeSelector) ProtoMessage() {}
+func (*KustomizeSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{77}
+}
+func (m *KustomizeSelector) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KustomizeSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KustomizeSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KustomizeSelector.Merge(m, src)
+}
+func (m *KustomizeSelector) XXX_Size() int {
+	return m.Size()
+}
+func (m *KustomizeSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_KustomizeSelector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KustomizeSelector proto.InternalMessageInfo
+
 func (m *ListGenerator) Reset()      { *m = ListGenerator{} }
 func (*ListGenerator) ProtoMessage() {}
 func (*ListGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{65}
+	return fileDescriptor_030104ce3b95bcac, []int{78}
 }
 func (m *ListGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1888,7 +2256,7 @@ var xxx_messageInfo_ListGenerator proto.InternalMessageInfo
 func (m *MatrixGenerator) Reset()      { *m = MatrixGenerator{} }
 func (*MatrixGenerator) ProtoMessage() {}
 func (*MatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{66}
+	return fileDescriptor_030104ce3b95bcac, []int{79}
 }
 func (m *MatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1916,7 +2284,7 @@ var xxx_messageInfo_MatrixGenerator proto.InternalMessageInfo
 func (m *MergeGenerator) Reset()      { *m = MergeGenerator{} }
 func (*MergeGenerator) ProtoMessage() {}
 func (*MergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{67}
+	return fileDescriptor_030104ce3b95bcac, []int{80}
 }
 func (m *MergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1944,7 +2312,7 @@ var xxx_messageInfo_MergeGenerator proto.InternalMessageInfo
 func (m *NestedMatrixGenerator) Reset()      { *m = NestedMatrixGenerator{} }
 func (*NestedMatrixGenerator) ProtoMessage() {}
 func (*NestedMatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{68}
+	return fileDescriptor_030104ce3b95bcac, []int{81}
 }
 func (m *NestedMatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1972,7 +2340,7 @@ var xxx_messageInfo_NestedMatrixGenerator proto.InternalMessageInfo
 func (m *NestedMergeGenerator) Reset()      { *m = NestedMergeGenerator{} }
 func (*NestedMergeGenerator) ProtoMessage() {}
 func (*NestedMergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{69}
+	return fileDescriptor_030104ce3b95bcac, []int{82}
 }
 func (m *NestedMergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2000,7 +2368,7 @@ var xxx_messageInfo_NestedMergeGenerator proto.InternalMessageInfo
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{70}
+	return fileDescriptor_030104ce3b95bcac, []int{83}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2028,7 +2396,7 @@ var xxx_messageInfo_Operation proto.InternalMessageInfo
 func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
 func (*OperationInitiator) ProtoMessage() {}
 func (*OperationInitiator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{71}
+	return fileDescriptor_030104ce3b95bcac, []int{84}
 }
 func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2056,7 +2424,7 @@ var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{72}
+	return fileDescriptor_030104ce3b95bcac, []int{85}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2081,10 +2449,66 @@ func (m *OperationState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OperationState proto.InternalMessageInfo
 
+func (m *OptionalArray) Reset()      { *m = OptionalArray{} }
+func (*OptionalArray) ProtoMessage() {}
+func (*OptionalArray) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{86}
+}
+func (m *OptionalArray) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptionalArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OptionalArray) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptionalArray.Merge(m, src)
+}
+func (m *OptionalArray) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptionalArray) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptionalArray.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptionalArray proto.InternalMessageInfo
+
+func (m *OptionalMap) Reset()      { *m = OptionalMap{} }
+func (*OptionalMap) ProtoMessage() {}
+func (*OptionalMap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{87}
+}
+func (m *OptionalMap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptionalMap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OptionalMap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptionalMap.Merge(m, src)
+}
+func (m *OptionalMap) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptionalMap) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptionalMap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptionalMap proto.InternalMessageInfo
+
 func (m *OrphanedResourceKey) Reset()      { *m = OrphanedResourceKey{} }
 func (*OrphanedResourceKey) ProtoMessage() {}
 func (*OrphanedResourceKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{73}
+	return fileDescriptor_030104ce3b95bcac, []int{88}
 }
 func (m *OrphanedResourceKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2112,7 +2536,7 @@ var xxx_messageInfo_OrphanedResourceKey proto.InternalMessageInfo
 func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
 func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
 func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{74}
+	return fileDescriptor_030104ce3b95bcac, []int{89}
 }
 func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2140,7 +2564,7 @@ var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
 func (m *OverrideIgnoreDiff) Reset()      { *m = OverrideIgnoreDiff{} }
 func (*OverrideIgnoreDiff) ProtoMessage() {}
 func (*OverrideIgnoreDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{75}
+	return fileDescriptor_030104ce3b95bcac, []int{90}
 }
 func (m *OverrideIgnoreDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2165,10 +2589,94 @@ func (m *OverrideIgnoreDiff) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OverrideIgnoreDiff proto.InternalMessageInfo
 
+func (m *PluginConfigMapRef) Reset()      { *m = PluginConfigMapRef{} }
+func (*PluginConfigMapRef) ProtoMessage() {}
+func (*PluginConfigMapRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{91}
+}
+func (m *PluginConfigMapRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PluginConfigMapRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PluginConfigMapRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PluginConfigMapRef.Merge(m, src)
+}
+func (m *PluginConfigMapRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *PluginConfigMapRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_PluginConfigMapRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PluginConfigMapRef proto.InternalMessageInfo
+
+func (m *PluginGenerator) Reset()      { *m = PluginGenerator{} }
+func (*PluginGenerator) ProtoMessage() {}
+func (*PluginGenerator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{92}
+}
+func (m *PluginGenerator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PluginGenerator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PluginGenerator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PluginGenerator.Merge(m, src)
+}
+func (m *PluginGenerator) XXX_Size() int {
+	return m.Size()
+}
+func (m *PluginGenerator) XXX_DiscardUnknown() {
+	xxx_messageInfo_PluginGenerator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PluginGenerator proto.InternalMessageInfo
+
+func (m *PluginInput) Reset()      { *m = PluginInput{} }
+func (*PluginInput) ProtoMessage() {}
+func (*PluginInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{93}
+}
+func (m *PluginInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PluginInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PluginInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PluginInput.Merge(m, src)
+}
+func (m *PluginInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *PluginInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_PluginInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PluginInput proto.InternalMessageInfo
+
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{76}
+	return fileDescriptor_030104ce3b95bcac, []int{94}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2196,7 +2704,7 @@ var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 func (m *PullRequestGenerator) Reset()      { *m = PullRequestGenerator{} }
 func (*PullRequestGenerator) ProtoMessage() {}
 func (*PullRequestGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{77}
+	return fileDescriptor_030104ce3b95bcac, []int{95}
 }
 func (m *PullRequestGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2221,10 +2729,66 @@ func (m *PullRequestGenerator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PullRequestGenerator proto.InternalMessageInfo
 
+func (m *PullRequestGeneratorAzureDevOps) Reset()      { *m = PullRequestGeneratorAzureDevOps{} }
+func (*PullRequestGeneratorAzureDevOps) ProtoMessage() {}
+func (*PullRequestGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{96}
+}
+func (m *PullRequestGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullRequestGeneratorAzureDevOps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PullRequestGeneratorAzureDevOps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullRequestGeneratorAzureDevOps.Merge(m, src)
+}
+func (m *PullRequestGeneratorAzureDevOps) XXX_Size() int {
+	return m.Size()
+}
+func (m *PullRequestGeneratorAzureDevOps) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullRequestGeneratorAzureDevOps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PullRequestGeneratorAzureDevOps proto.InternalMessageInfo
+
+func (m *PullRequestGeneratorBitbucket) Reset()      { *m = PullRequestGeneratorBitbucket{} }
+func (*PullRequestGeneratorBitbucket) ProtoMessage() {}
+func (*PullRequestGeneratorBitbucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{97}
+}
+func (m *PullRequestGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullRequestGeneratorBitbucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PullRequestGeneratorBitbucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullRequestGeneratorBitbucket.Merge(m, src)
+}
+func (m *PullRequestGeneratorBitbucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PullRequestGeneratorBitbucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullRequestGeneratorBitbucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PullRequestGeneratorBitbucket proto.InternalMessageInfo
+
 func (m *PullRequestGeneratorBitbucketServer) Reset()      { *m = PullRequestGeneratorBitbucketServer{} }
 func (*PullRequestGeneratorBitbucketServer) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{78}
+	return fileDescriptor_030104ce3b95bcac, []int{98}
 }
 func (m *PullRequestGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2252,7 +2816,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucketServer proto.InternalMessageInf
 func (m *PullRequestGeneratorFilter) Reset()      { *m = PullRequestGeneratorFilter{} }
 func (*PullRequestGeneratorFilter) ProtoMessage() {}
 func (*PullRequestGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{79}
+	return fileDescriptor_030104ce3b95bcac, []int{99}
 }
 func (m *PullRequestGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2280,7 +2844,7 @@ var xxx_messageInfo_PullRequestGeneratorFilter proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitLab) Reset()      { *m = PullRequestGeneratorGitLab{} }
 func (*PullRequestGeneratorGitLab) ProtoMessage() {}
 func (*PullRequestGeneratorGitLab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{80}
+	return fileDescriptor_030104ce3b95bcac, []int{100}
 }
 func (m *PullRequestGeneratorGitLab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2308,7 +2872,7 @@ var xxx_messageInfo_PullRequestGeneratorGitLab proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitea) Reset()      { *m = PullRequestGeneratorGitea{} }
 func (*PullRequestGeneratorGitea) ProtoMessage() {}
 func (*PullRequestGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{81}
+	return fileDescriptor_030104ce3b95bcac, []int{101}
 }
 func (m *PullRequestGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2336,7 +2900,7 @@ var xxx_messageInfo_PullRequestGeneratorGitea proto.InternalMessageInfo
 func (m *PullRequestGeneratorGithub) Reset()      { *m = PullRequestGeneratorGithub{} }
 func (*PullRequestGeneratorGithub) ProtoMessage() {}
 func (*PullRequestGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{82}
+	return fileDescriptor_030104ce3b95bcac, []int{102}
 }
 func (m *PullRequestGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2361,10 +2925,38 @@ func (m *PullRequestGeneratorGithub) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PullRequestGeneratorGithub proto.InternalMessageInfo
 
+func (m *RefTarget) Reset()      { *m = RefTarget{} }
+func (*RefTarget) ProtoMessage() {}
+func (*RefTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{103}
+}
+func (m *RefTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RefTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefTarget.Merge(m, src)
+}
+func (m *RefTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefTarget proto.InternalMessageInfo
+
 func (m *RepoCreds) Reset()      { *m = RepoCreds{} }
 func (*RepoCreds) ProtoMessage() {}
 func (*RepoCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{83}
+	return fileDescriptor_030104ce3b95bcac, []int{104}
 }
 func (m *RepoCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2392,7 +2984,7 @@ var xxx_messageInfo_RepoCreds proto.InternalMessageInfo
 func (m *RepoCredsList) Reset()      { *m = RepoCredsList{} }
 func (*RepoCredsList) ProtoMessage() {}
 func (*RepoCredsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{84}
+	return fileDescriptor_030104ce3b95bcac, []int{105}
 }
 func (m *RepoCredsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2420,7 +3012,7 @@ var xxx_messageInfo_RepoCredsList proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{85}
+	return fileDescriptor_030104ce3b95bcac, []int{106}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2448,7 +3040,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryCertificate) Reset()      { *m = RepositoryCertificate{} }
 func (*RepositoryCertificate) ProtoMessage() {}
 func (*RepositoryCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{86}
+	return fileDescriptor_030104ce3b95bcac, []int{107}
 }
 func (m *RepositoryCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2476,7 +3068,7 @@ var xxx_messageInfo_RepositoryCertificate proto.InternalMessageInfo
 func (m *RepositoryCertificateList) Reset()      { *m = RepositoryCertificateList{} }
 func (*RepositoryCertificateList) ProtoMessage() {}
 func (*RepositoryCertificateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{87}
+	return fileDescriptor_030104ce3b95bcac, []int{108}
 }
 func (m *RepositoryCertificateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2504,7 +3096,7 @@ var xxx_messageInfo_RepositoryCertificateList proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{88}
+	return fileDescriptor_030104ce3b95bcac, []int{109}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2532,7 +3124,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceAction) Reset()      { *m = ResourceAction{} }
 func (*ResourceAction) ProtoMessage() {}
 func (*ResourceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{89}
+	return fileDescriptor_030104ce3b95bcac, []int{110}
 }
 func (m *ResourceAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2560,7 +3152,7 @@ var xxx_messageInfo_ResourceAction proto.InternalMessageInfo
 func (m *ResourceActionDefinition) Reset()      { *m = ResourceActionDefinition{} }
 func (*ResourceActionDefinition) ProtoMessage() {}
 func (*ResourceActionDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{90}
+	return fileDescriptor_030104ce3b95bcac, []int{111}
 }
 func (m *ResourceActionDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2588,7 +3180,7 @@ var xxx_messageInfo_ResourceActionDefinition proto.InternalMessageInfo
 func (m *ResourceActionParam) Reset()      { *m = ResourceActionParam{} }
 func (*ResourceActionParam) ProtoMessage() {}
 func (*ResourceActionParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{91}
+	return fileDescriptor_030104ce3b95bcac, []int{112}
 }
 func (m *ResourceActionParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2616,7 +3208,7 @@ var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{92}
+	return fileDescriptor_030104ce3b95bcac, []int{113}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2644,7 +3236,7 @@ var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{93}
+	return fileDescriptor_030104ce3b95bcac, []int{114}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2672,7 +3264,7 @@ var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{94}
+	return fileDescriptor_030104ce3b95bcac, []int{115}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2700,7 +3292,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{95}
+	return fileDescriptor_030104ce3b95bcac, []int{116}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2728,7 +3320,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{96}
+	return fileDescriptor_030104ce3b95bcac, []int{117}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2756,7 +3348,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{97}
+	return fileDescriptor_030104ce3b95bcac, []int{118}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2784,7 +3376,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{98}
+	return fileDescriptor_030104ce3b95bcac, []int{119}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2812,7 +3404,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{99}
+	return fileDescriptor_030104ce3b95bcac, []int{120}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2840,7 +3432,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{100}
+	return fileDescriptor_030104ce3b95bcac, []int{121}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2868,7 +3460,7 @@ var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{101}
+	return fileDescriptor_030104ce3b95bcac, []int{122}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2896,7 +3488,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{102}
+	return fileDescriptor_030104ce3b95bcac, []int{123}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2924,7 +3516,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{103}
+	return fileDescriptor_030104ce3b95bcac, []int{124}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2952,7 +3544,7 @@ var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 func (m *SCMProviderGenerator) Reset()      { *m = SCMProviderGenerator{} }
 func (*SCMProviderGenerator) ProtoMessage() {}
 func (*SCMProviderGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{104}
+	return fileDescriptor_030104ce3b95bcac, []int{125}
 }
 func (m *SCMProviderGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2980,7 +3572,7 @@ var xxx_messageInfo_SCMProviderGenerator proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAzureDevOps) Reset()      { *m = SCMProviderGeneratorAzureDevOps{} }
 func (*SCMProviderGeneratorAzureDevOps) ProtoMessage() {}
 func (*SCMProviderGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{105}
+	return fileDescriptor_030104ce3b95bcac, []int{126}
 }
 func (m *SCMProviderGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3008,7 +3600,7 @@ var xxx_messageInfo_SCMProviderGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucket) Reset()      { *m = SCMProviderGeneratorBitbucket{} }
 func (*SCMProviderGeneratorBitbucket) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{106}
+	return fileDescriptor_030104ce3b95bcac, []int{127}
 }
 func (m *SCMProviderGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3036,7 +3628,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucket proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucketServer) Reset()      { *m = SCMProviderGeneratorBitbucketServer{} }
 func (*SCMProviderGeneratorBitbucketServer) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{107}
+	return fileDescriptor_030104ce3b95bcac, []int{128}
 }
 func (m *SCMProviderGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3064,7 +3656,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucketServer proto.InternalMessageInf
 func (m *SCMProviderGeneratorFilter) Reset()      { *m = SCMProviderGeneratorFilter{} }
 func (*SCMProviderGeneratorFilter) ProtoMessage() {}
 func (*SCMProviderGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{108}
+	return fileDescriptor_030104ce3b95bcac, []int{129}
 }
 func (m *SCMProviderGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3092,7 +3684,7 @@ var xxx_messageInfo_SCMProviderGeneratorFilter proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitea) Reset()      { *m = SCMProviderGeneratorGitea{} }
 func (*SCMProviderGeneratorGitea) ProtoMessage() {}
 func (*SCMProviderGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{109}
+	return fileDescriptor_030104ce3b95bcac, []int{130}
 }
 func (m *SCMProviderGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3120,7 +3712,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitea proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGithub) Reset()      { *m = SCMProviderGeneratorGithub{} }
 func (*SCMProviderGeneratorGithub) ProtoMessage() {}
 func (*SCMProviderGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{110}
+	return fileDescriptor_030104ce3b95bcac, []int{131}
 }
 func (m *SCMProviderGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3148,7 +3740,7 @@ var xxx_messageInfo_SCMProviderGeneratorGithub proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitlab) Reset()      { *m = SCMProviderGeneratorGitlab{} }
 func (*SCMProviderGeneratorGitlab) ProtoMessage() {}
 func (*SCMProviderGeneratorGitlab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{111}
+	return fileDescriptor_030104ce3b95bcac, []int{132}
 }
 func (m *SCMProviderGeneratorGitlab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3176,7 +3768,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitlab proto.InternalMessageInfo
 func (m *SecretRef) Reset()      { *m = SecretRef{} }
 func (*SecretRef) ProtoMessage() {}
 func (*SecretRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{112}
+	return fileDescriptor_030104ce3b95bcac, []int{133}
 }
 func (m *SecretRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3204,7 +3796,7 @@ var xxx_messageInfo_SecretRef proto.InternalMessageInfo
 func (m *SignatureKey) Reset()      { *m = SignatureKey{} }
 func (*SignatureKey) ProtoMessage() {}
 func (*SignatureKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{113}
+	return fileDescriptor_030104ce3b95bcac, []int{134}
 }
 func (m *SignatureKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3232,7 +3824,7 @@ var xxx_messageInfo_SignatureKey proto.InternalMessageInfo
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{114}
+	return fileDescriptor_030104ce3b95bcac, []int{135}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3260,7 +3852,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{115}
+	return fileDescriptor_030104ce3b95bcac, []int{136}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3288,7 +3880,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{116}
+	return fileDescriptor_030104ce3b95bcac, []int{137}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3316,7 +3908,7 @@ var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{117}
+	return fileDescriptor_030104ce3b95bcac, []int{138}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3344,7 +3936,7 @@ var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{118}
+	return fileDescriptor_030104ce3b95bcac, []int{139}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3372,7 +3964,7 @@ var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{119}
+	return fileDescriptor_030104ce3b95bcac, []int{140}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3400,7 +3992,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{120}
+	return fileDescriptor_030104ce3b95bcac, []int{141}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3428,7 +4020,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{121}
+	return fileDescriptor_030104ce3b95bcac, []int{142}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3456,7 +4048,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{122}
+	return fileDescriptor_030104ce3b95bcac, []int{143}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3484,7 +4076,7 @@ var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
 func (*SyncWindow) ProtoMessage() {}
 func (*SyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{123}
+	return fileDescriptor_030104ce3b95bcac, []int{144}
 }
 func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3509,10 +4101,38 @@ func (m *SyncWindow) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
 
+func (m *SyncWindowOverride) Reset()      { *m = SyncWindowOverride{} }
+func (*SyncWindowOverride) ProtoMessage() {}
+func (*SyncWindowOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{145}
+}
+func (m *SyncWindowOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindowOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncWindowOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindowOverride.Merge(m, src)
+}
+func (m *SyncWindowOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindowOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindowOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindowOverride proto.InternalMessageInfo
+
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{124}
+	return fileDescriptor_030104ce3b95bcac, []int{146}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3546,21 +4166,29 @@ func init() {
 	proto.RegisterMapType((map[string]JWTTokens)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.AppProjectStatus.JwtTokensByRoleEntry")
 	proto.RegisterType((*Application)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Application")
 	proto.RegisterType((*ApplicationCondition)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationCondition")
+	proto.RegisterType((*ApplicationDependency)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationDependency")
 	proto.RegisterType((*ApplicationDestination)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationDestination")
 	proto.RegisterType((*ApplicationList)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationList")
+	proto.RegisterType((*ApplicationMatchExpression)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationMatchExpression")
 	proto.RegisterType((*ApplicationSet)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSet")
+	proto.RegisterType((*ApplicationSetApplicationStatus)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSetApplicationStatus")
 	proto.RegisterType((*ApplicationSetCondition)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSetCondition")
 	proto.RegisterType((*ApplicationSetGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSetGenerator")
 	proto.RegisterType((*ApplicationSetList)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSetList")
 	proto.RegisterType((*ApplicationSetNestedGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSetNestedGenerator")
+	proto.RegisterType((*ApplicationSetResourceIgnoreDifferences)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSetResourceIgnoreDifferences")
+	proto.RegisterType((*ApplicationSetRolloutStep)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSetRolloutStep")
+	proto.RegisterType((*ApplicationSetRolloutStrategy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSetRolloutStrategy")
 	proto.RegisterType((*ApplicationSetSpec)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSetSpec")
 	proto.RegisterType((*ApplicationSetStatus)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSetStatus")
+	proto.RegisterType((*ApplicationSetStrategy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSetStrategy")
 	proto.RegisterType((*ApplicationSetSyncPolicy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSetSyncPolicy")
 	proto.RegisterType((*ApplicationSetTemplate)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSetTemplate")
 	proto.RegisterType((*ApplicationSetTemplateMeta)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSetTemplateMeta")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSetTemplateMeta.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSetTemplateMeta.LabelsEntry")
 	proto.RegisterType((*ApplicationSetTerminalGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSetTerminalGenerator")
+	proto.RegisterType((*ApplicationSetWatchEvent)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSetWatchEvent")
 	proto.RegisterType((*ApplicationSource)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSource")
 	proto.RegisterType((*ApplicationSourceDirectory)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSourceDirectory")
 	proto.RegisterType((*ApplicationSourceHelm)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSourceHelm")
@@ -3569,6 +4197,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSourceKustomize.CommonAnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSourceKustomize.CommonLabelsEntry")
 	proto.RegisterType((*ApplicationSourcePlugin)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSourcePlugin")
+	proto.RegisterType((*ApplicationSourcePluginParameter)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSourcePluginParameter")
 	proto.RegisterType((*ApplicationSpec)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSpec")
 	proto.RegisterType((*ApplicationStatus)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationStatus")
 	proto.RegisterType((*ApplicationSummary)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSummary")
@@ -3576,6 +4205,7 @@ func init() {
 	proto.RegisterType((*ApplicationWatchEvent)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationWatchEvent")
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Backoff")
 	proto.RegisterType((*BasicAuthBitbucketServer)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.BasicAuthBitbucketServer")
+	proto.RegisterType((*BearerTokenBitbucketCloud)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.BearerTokenBitbucketCloud")
 	proto.RegisterType((*Cluster)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Cluster")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Cluster.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Cluster.LabelsEntry")
@@ -3613,6 +4243,10 @@ func init() {
 	proto.RegisterType((*JsonnetVar)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.JsonnetVar")
 	proto.RegisterType((*KnownTypeField)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.KnownTypeField")
 	proto.RegisterType((*KustomizeOptions)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.KustomizeOptions")
+	proto.RegisterType((*KustomizePatch)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.KustomizePatch")
+	proto.RegisterMapType((map[string]bool)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.KustomizePatch.OptionsEntry")
+	proto.RegisterType((*KustomizeReplica)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.KustomizeReplica")
+	proto.RegisterType((*KustomizeSelector)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.KustomizeSelector")
 	proto.RegisterType((*ListGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ListGenerator")
 	proto.RegisterType((*MatrixGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.MatrixGenerator")
 	proto.RegisterType((*MergeGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.MergeGenerator")
@@ -3621,16 +4255,27 @@ func init() {
 	proto.RegisterType((*Operation)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Operation")
 	proto.RegisterType((*OperationInitiator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OperationInitiator")
 	proto.RegisterType((*OperationState)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OperationState")
+	proto.RegisterType((*OptionalArray)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OptionalArray")
+	proto.RegisterType((*OptionalMap)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OptionalMap")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OptionalMap.MapEntry")
 	proto.RegisterType((*OrphanedResourceKey)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OrphanedResourceKey")
 	proto.RegisterType((*OrphanedResourcesMonitorSettings)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OrphanedResourcesMonitorSettings")
 	proto.RegisterType((*OverrideIgnoreDiff)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OverrideIgnoreDiff")
+	proto.RegisterType((*PluginConfigMapRef)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PluginConfigMapRef")
+	proto.RegisterType((*PluginGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PluginGenerator")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PluginGenerator.ValuesEntry")
+	proto.RegisterType((*PluginInput)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PluginInput")
+	proto.RegisterMapType((PluginParameters)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PluginInput.ParametersEntry")
 	proto.RegisterType((*ProjectRole)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ProjectRole")
 	proto.RegisterType((*PullRequestGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PullRequestGenerator")
+	proto.RegisterType((*PullRequestGeneratorAzureDevOps)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PullRequestGeneratorAzureDevOps")
+	proto.RegisterType((*PullRequestGeneratorBitbucket)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PullRequestGeneratorBitbucket")
 	proto.RegisterType((*PullRequestGeneratorBitbucketServer)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PullRequestGeneratorBitbucketServer")
 	proto.RegisterType((*PullRequestGeneratorFilter)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PullRequestGeneratorFilter")
 	proto.RegisterType((*PullRequestGeneratorGitLab)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PullRequestGeneratorGitLab")
 	proto.RegisterType((*PullRequestGeneratorGitea)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PullRequestGeneratorGitea")
 	proto.RegisterType((*PullRequestGeneratorGithub)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PullRequestGeneratorGithub")
+	proto.RegisterType((*RefTarget)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RefTarget")
 	proto.RegisterType((*RepoCreds)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RepoCreds")
 	proto.RegisterType((*RepoCredsList)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RepoCredsList")
 	proto.RegisterType((*Repository)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Repository")
@@ -3676,6 +4321,7 @@ func init() {
 	proto.RegisterType((*SyncStrategyApply)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncStrategyApply")
 	proto.RegisterType((*SyncStrategyHook)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncStrategyHook")
 	proto.RegisterType((*SyncWindow)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncWindow")
+	proto.RegisterType((*SyncWindowOverride)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncWindowOverride")
 	proto.RegisterType((*TLSClientConfig)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.TLSClientConfig")
 }
 
@@ -3684,571 +4330,670 @@ func init() {
 }
 
 var fileDescriptor_030104ce3b95bcac = []byte{
-	// 9015 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x1c, 0xc9,
-	0x75, 0xd8, 0xcd, 0x2e, 0x16, 0xd8, 0x7d, 0xf8, 0x20, 0xd1, 0x24, 0xef, 0x70, 0xbc, 0x3b, 0x82,
-	0x35, 0x57, 0x96, 0xce, 0xd1, 0x1d, 0x90, 0xa3, 0x4f, 0xca, 0xc5, 0x67, 0x9f, 0x8c, 0x05, 0x48,
-	0x10, 0x24, 0x40, 0xe0, 0x1e, 0x40, 0xd2, 0x3a, 0x59, 0x1f, 0x83, 0xd9, 0xde, 0xc5, 0x10, 0xbb,
-	0x33, 0x7b, 0x33, 0xb3, 0x20, 0xf6, 0x6c, 0xcb, 0x92, 0x6c, 0xc7, 0x4a, 0xe9, 0x33, 0x52, 0xaa,
-	0x22, 0x57, 0xa5, 0x12, 0x45, 0x76, 0xb9, 0xe2, 0x4a, 0x54, 0x49, 0x7e, 0xe5, 0xeb, 0x97, 0xed,
-	0xfc, 0x50, 0x2a, 0x49, 0x45, 0x55, 0x71, 0x59, 0x4e, 0x9c, 0x40, 0x12, 0x53, 0x29, 0x27, 0xae,
-	0x8a, 0xab, 0xe2, 0xe8, 0x4f, 0x58, 0xf9, 0xe1, 0xea, 0x8f, 0xe9, 0xee, 0x99, 0xdd, 0x25, 0x16,
-	0xc4, 0x80, 0xa4, 0x54, 0xf7, 0x6f, 0xb7, 0xdf, 0x9b, 0xf7, 0xde, 0xf4, 0x74, 0xbf, 0x7e, 0xaf,
-	0xfb, 0xbd, 0xd7, 0xb0, 0xda, 0xf0, 0xe2, 0x9d, 0xce, 0xf6, 0x9c, 0x1b, 0xb4, 0xe6, 0x9d, 0xb0,
-	0x11, 0xb4, 0xc3, 0xe0, 0x0e, 0xff, 0xf1, 0x8a, 0x5b, 0x9b, 0xdf, 0xbb, 0x34, 0xdf, 0xde, 0x6d,
-	0xcc, 0x3b, 0x6d, 0x2f, 0x9a, 0x77, 0xda, 0xed, 0xa6, 0xe7, 0x3a, 0xb1, 0x17, 0xf8, 0xf3, 0x7b,
-	0xaf, 0x3a, 0xcd, 0xf6, 0x8e, 0xf3, 0xea, 0x7c, 0x83, 0xfa, 0x34, 0x74, 0x62, 0x5a, 0x9b, 0x6b,
-	0x87, 0x41, 0x1c, 0x90, 0x9f, 0xd1, 0xd4, 0xe6, 0x12, 0x6a, 0xfc, 0xc7, 0x27, 0xdc, 0xda, 0xdc,
-	0xde, 0xa5, 0xb9, 0xf6, 0x6e, 0x63, 0x8e, 0x51, 0x9b, 0x33, 0xa8, 0xcd, 0x25, 0xd4, 0xce, 0xbf,
-	0x62, 0xc8, 0xd2, 0x08, 0x1a, 0xc1, 0x3c, 0x27, 0xba, 0xdd, 0xa9, 0xf3, 0x7f, 0xfc, 0x0f, 0xff,
-	0x25, 0x98, 0x9d, 0xb7, 0x77, 0x5f, 0x8f, 0xe6, 0xbc, 0x80, 0x89, 0x37, 0xef, 0x06, 0x21, 0x9d,
-	0xdf, 0xeb, 0x11, 0xe8, 0xfc, 0x55, 0x8d, 0x43, 0xf7, 0x63, 0xea, 0x47, 0x5e, 0xe0, 0x47, 0xaf,
-	0x30, 0x11, 0x68, 0xb8, 0x47, 0x43, 0xf3, 0xf5, 0x0c, 0x84, 0x7e, 0x94, 0x5e, 0xd3, 0x94, 0x5a,
-	0x8e, 0xbb, 0xe3, 0xf9, 0x34, 0xec, 0xea, 0xc7, 0x5b, 0x34, 0x76, 0xfa, 0x3d, 0x35, 0x3f, 0xe8,
-	0xa9, 0xb0, 0xe3, 0xc7, 0x5e, 0x8b, 0xf6, 0x3c, 0xf0, 0xa1, 0xc3, 0x1e, 0x88, 0xdc, 0x1d, 0xda,
-	0x72, 0xb2, 0xcf, 0xd9, 0xef, 0xc0, 0xe4, 0xc2, 0xed, 0xcd, 0x85, 0x4e, 0xbc, 0xb3, 0x18, 0xf8,
-	0x75, 0xaf, 0x41, 0x3e, 0x08, 0xe3, 0x6e, 0xb3, 0x13, 0xc5, 0x34, 0xbc, 0xe1, 0xb4, 0xe8, 0x8c,
-	0x75, 0xd1, 0x7a, 0xa9, 0x52, 0x3d, 0xf3, 0xed, 0x83, 0xd9, 0xa7, 0xee, 0x1d, 0xcc, 0x8e, 0x2f,
-	0x6a, 0x10, 0x9a, 0x78, 0xe4, 0x27, 0x61, 0x2c, 0x0c, 0x9a, 0x74, 0x01, 0x6f, 0xcc, 0x14, 0xf8,
-	0x23, 0xa7, 0xe4, 0x23, 0x63, 0x28, 0x9a, 0x31, 0x81, 0xdb, 0x7f, 0x54, 0x00, 0x58, 0x68, 0xb7,
-	0x37, 0xc2, 0xe0, 0x0e, 0x75, 0x63, 0xf2, 0x49, 0x28, 0xb3, 0x5e, 0xa8, 0x39, 0xb1, 0xc3, 0xb9,
-	0x8d, 0x5f, 0xfa, 0xab, 0x73, 0xe2, 0x65, 0xe6, 0xcc, 0x97, 0xd1, 0x63, 0x80, 0x61, 0xcf, 0xed,
-	0xbd, 0x3a, 0xb7, 0xbe, 0xcd, 0x9e, 0x5f, 0xa3, 0xb1, 0x53, 0x25, 0x92, 0x19, 0xe8, 0x36, 0x54,
-	0x54, 0x89, 0x0f, 0x23, 0x51, 0x9b, 0xba, 0x5c, 0xb0, 0xf1, 0x4b, 0xab, 0x73, 0xc7, 0x19, 0x6c,
-	0x73, 0x5a, 0xf2, 0xcd, 0x36, 0x75, 0xab, 0x13, 0x92, 0xf3, 0x08, 0xfb, 0x87, 0x9c, 0x0f, 0xd9,
-	0x83, 0xd1, 0x28, 0x76, 0xe2, 0x4e, 0x34, 0x53, 0xe4, 0x1c, 0x6f, 0xe4, 0xc6, 0x91, 0x53, 0xad,
-	0x4e, 0x49, 0x9e, 0xa3, 0xe2, 0x3f, 0x4a, 0x6e, 0xf6, 0x7f, 0xb3, 0x60, 0x4a, 0x23, 0xaf, 0x7a,
-	0x51, 0x4c, 0x7e, 0xa1, 0xa7, 0x73, 0xe7, 0x86, 0xeb, 0x5c, 0xf6, 0x34, 0xef, 0xda, 0xd3, 0x92,
-	0x59, 0x39, 0x69, 0x31, 0x3a, 0xb6, 0x05, 0x25, 0x2f, 0xa6, 0xad, 0x68, 0xa6, 0x70, 0xb1, 0xf8,
-	0xd2, 0xf8, 0xa5, 0xab, 0x79, 0xbd, 0x67, 0x75, 0x52, 0x32, 0x2d, 0xad, 0x30, 0xf2, 0x28, 0xb8,
-	0xd8, 0xbf, 0x3b, 0x61, 0xbe, 0x1f, 0xeb, 0x70, 0xf2, 0x2a, 0x8c, 0x47, 0x41, 0x27, 0x74, 0x29,
-	0xd2, 0x76, 0x10, 0xcd, 0x58, 0x17, 0x8b, 0x6c, 0xe8, 0xb1, 0x91, 0xba, 0xa9, 0x9b, 0xd1, 0xc4,
-	0x21, 0x5f, 0xb2, 0x60, 0xa2, 0x46, 0xa3, 0xd8, 0xf3, 0x39, 0xff, 0x44, 0xf8, 0xad, 0x63, 0x0b,
-	0x9f, 0x34, 0x2e, 0x69, 0xe2, 0xd5, 0xb3, 0xf2, 0x45, 0x26, 0x8c, 0xc6, 0x08, 0x53, 0xfc, 0xd9,
-	0x8c, 0xab, 0xd1, 0xc8, 0x0d, 0xbd, 0x36, 0xfb, 0xcf, 0xc7, 0x8c, 0x31, 0xe3, 0x96, 0x34, 0x08,
-	0x4d, 0x3c, 0xe2, 0x43, 0x89, 0xcd, 0xa8, 0x68, 0x66, 0x84, 0xcb, 0xbf, 0x72, 0x3c, 0xf9, 0x65,
-	0xa7, 0xb2, 0xc9, 0xaa, 0x7b, 0x9f, 0xfd, 0x8b, 0x50, 0xb0, 0x21, 0x5f, 0xb4, 0x60, 0x46, 0xce,
-	0x78, 0xa4, 0xa2, 0x43, 0x6f, 0xef, 0x78, 0x31, 0x6d, 0x7a, 0x51, 0x3c, 0x53, 0xe2, 0x32, 0xcc,
-	0x0f, 0x37, 0xb6, 0x96, 0xc3, 0xa0, 0xd3, 0xbe, 0xee, 0xf9, 0xb5, 0xea, 0x45, 0xc9, 0x69, 0x66,
-	0x71, 0x00, 0x61, 0x1c, 0xc8, 0x92, 0x7c, 0xcd, 0x82, 0xf3, 0xbe, 0xd3, 0xa2, 0x51, 0xdb, 0x61,
-	0x9f, 0x56, 0x80, 0xab, 0x4d, 0xc7, 0xdd, 0xe5, 0x12, 0x8d, 0x3e, 0x9c, 0x44, 0xb6, 0x94, 0xe8,
-	0xfc, 0x8d, 0x81, 0xa4, 0xf1, 0x01, 0x6c, 0xc9, 0x6f, 0x59, 0x30, 0x1d, 0x84, 0xed, 0x1d, 0xc7,
-	0xa7, 0xb5, 0x04, 0x1a, 0xcd, 0x8c, 0xf1, 0xa9, 0xf7, 0xf1, 0xe3, 0x7d, 0xa2, 0xf5, 0x2c, 0xd9,
-	0xb5, 0xc0, 0xf7, 0xe2, 0x20, 0xdc, 0xa4, 0x71, 0xec, 0xf9, 0x8d, 0xa8, 0x7a, 0xee, 0xde, 0xc1,
-	0xec, 0x74, 0x0f, 0x16, 0xf6, 0xca, 0x43, 0x7e, 0x11, 0xc6, 0xa3, 0xae, 0xef, 0xde, 0xf6, 0xfc,
-	0x5a, 0x70, 0x37, 0x9a, 0x29, 0xe7, 0x31, 0x7d, 0x37, 0x15, 0x41, 0x39, 0x01, 0x35, 0x03, 0x34,
-	0xb9, 0xf5, 0xff, 0x70, 0x7a, 0x28, 0x55, 0xf2, 0xfe, 0x70, 0x7a, 0x30, 0x3d, 0x80, 0x2d, 0xf9,
-	0x0d, 0x0b, 0x26, 0x23, 0xaf, 0xe1, 0x3b, 0x71, 0x27, 0xa4, 0xd7, 0x69, 0x37, 0x9a, 0x01, 0x2e,
-	0xc8, 0xb5, 0x63, 0xf6, 0x8a, 0x41, 0xb2, 0x7a, 0x4e, 0xca, 0x38, 0x69, 0xb6, 0x46, 0x98, 0xe6,
-	0xdb, 0x6f, 0xa2, 0xe9, 0x61, 0x3d, 0x9e, 0xef, 0x44, 0xd3, 0x83, 0x7a, 0x20, 0x4b, 0xf2, 0x73,
-	0x70, 0x5a, 0x34, 0xa9, 0x9e, 0x8d, 0x66, 0x26, 0xb8, 0xa2, 0x3d, 0x7b, 0xef, 0x60, 0xf6, 0xf4,
-	0x66, 0x06, 0x86, 0x3d, 0xd8, 0xe4, 0x1d, 0x98, 0x6d, 0xd3, 0xb0, 0xe5, 0xc5, 0xeb, 0x7e, 0xb3,
-	0x9b, 0xa8, 0x6f, 0x37, 0x68, 0xd3, 0x9a, 0x14, 0x27, 0x9a, 0x99, 0xbc, 0x68, 0xbd, 0x54, 0xae,
-	0xbe, 0x5f, 0x8a, 0x39, 0xbb, 0xf1, 0x60, 0x74, 0x3c, 0x8c, 0x9e, 0xfd, 0x6f, 0x0b, 0x70, 0x3a,
-	0xbb, 0x70, 0x92, 0xdf, 0xb1, 0xe0, 0xd4, 0x9d, 0xbb, 0xf1, 0x56, 0xb0, 0x4b, 0xfd, 0xa8, 0xda,
-	0x65, 0xea, 0x8d, 0x2f, 0x19, 0xe3, 0x97, 0xdc, 0x7c, 0x97, 0xe8, 0xb9, 0x6b, 0x69, 0x2e, 0x97,
-	0xfd, 0x38, 0xec, 0x56, 0x9f, 0x91, 0x6f, 0x77, 0xea, 0xda, 0xed, 0x2d, 0x13, 0x8a, 0x59, 0xa1,
-	0xce, 0x7f, 0xde, 0x82, 0xb3, 0xfd, 0x48, 0x90, 0xd3, 0x50, 0xdc, 0xa5, 0x5d, 0x61, 0x95, 0x21,
-	0xfb, 0x49, 0x3e, 0x06, 0xa5, 0x3d, 0xa7, 0xd9, 0xa1, 0xd2, 0xba, 0x59, 0x3e, 0xde, 0x8b, 0x28,
-	0xc9, 0x50, 0x50, 0xfd, 0xe9, 0xc2, 0xeb, 0x96, 0xfd, 0x1f, 0x8b, 0x30, 0x6e, 0xac, 0x6f, 0x8f,
-	0xc0, 0x62, 0x0b, 0x52, 0x16, 0xdb, 0x5a, 0x6e, 0x4b, 0xf3, 0x40, 0x93, 0xed, 0x6e, 0xc6, 0x64,
-	0x5b, 0xcf, 0x8f, 0xe5, 0x03, 0x6d, 0x36, 0x12, 0x43, 0x25, 0x68, 0x33, 0x8b, 0x9c, 0x2d, 0xfd,
-	0x23, 0x79, 0x7c, 0xc2, 0xf5, 0x84, 0x5c, 0x75, 0xf2, 0xde, 0xc1, 0x6c, 0x45, 0xfd, 0x45, 0xcd,
-	0xc8, 0xfe, 0xae, 0x05, 0x67, 0x0d, 0x19, 0x17, 0x03, 0xbf, 0xe6, 0xf1, 0x4f, 0x7b, 0x11, 0x46,
-	0xe2, 0x6e, 0x3b, 0x31, 0xfb, 0x55, 0x4f, 0x6d, 0x75, 0xdb, 0x14, 0x39, 0x84, 0x19, 0xfa, 0x2d,
-	0x1a, 0x45, 0x4e, 0x83, 0x66, 0x0d, 0xfd, 0x35, 0xd1, 0x8c, 0x09, 0x9c, 0x84, 0x40, 0x9a, 0x4e,
-	0x14, 0x6f, 0x85, 0x8e, 0x1f, 0x71, 0xf2, 0x5b, 0x5e, 0x8b, 0xca, 0x0e, 0xfe, 0x2b, 0xc3, 0x8d,
-	0x18, 0xf6, 0x44, 0xf5, 0xe9, 0x7b, 0x07, 0xb3, 0x64, 0xb5, 0x87, 0x12, 0xf6, 0xa1, 0x6e, 0x7f,
-	0xcd, 0x82, 0xa7, 0xfb, 0xdb, 0x62, 0xe4, 0x7d, 0x30, 0x2a, 0xbc, 0x37, 0xf9, 0x76, 0xfa, 0x93,
-	0xf0, 0x56, 0x94, 0x50, 0x32, 0x0f, 0x15, 0xb5, 0x4e, 0xc8, 0x77, 0x9c, 0x96, 0xa8, 0x15, 0xbd,
-	0xb8, 0x68, 0x1c, 0xd6, 0x69, 0xec, 0x8f, 0xb4, 0xdc, 0x54, 0xa7, 0x71, 0x27, 0x89, 0x43, 0xec,
-	0xef, 0x59, 0x70, 0xca, 0x90, 0xea, 0x11, 0x98, 0xe6, 0x7e, 0xda, 0x34, 0x5f, 0xc9, 0x6d, 0x3c,
-	0x0f, 0xb0, 0xcd, 0xef, 0x15, 0xb8, 0x6d, 0xae, 0x46, 0x3d, 0x7d, 0x14, 0x8e, 0x5d, 0x98, 0x52,
-	0x13, 0x1b, 0xf9, 0xcd, 0x59, 0x3a, 0xd8, 0xb9, 0x7b, 0x37, 0xa3, 0x29, 0x30, 0x57, 0xae, 0x0f,
-	0x76, 0xf0, 0xfe, 0xac, 0x00, 0xcf, 0xa4, 0x1f, 0xd0, 0x33, 0xf7, 0xc3, 0xa9, 0x99, 0xfb, 0x01,
-	0x73, 0xe6, 0xde, 0x3f, 0x98, 0x7d, 0x6e, 0xc0, 0x63, 0x3f, 0x32, 0x13, 0x9b, 0x2c, 0xab, 0x7e,
-	0x1f, 0xe1, 0xd2, 0xcd, 0xa7, 0xfb, 0xe8, 0xfe, 0xc1, 0xec, 0x0b, 0x03, 0xde, 0x31, 0xa3, 0x71,
-	0xdf, 0x07, 0xa3, 0x21, 0x75, 0xa2, 0xc0, 0x9f, 0x29, 0xa5, 0xd5, 0x00, 0xf2, 0x56, 0x94, 0x50,
-	0xfb, 0x7b, 0xe5, 0x6c, 0x67, 0x2f, 0x8b, 0xbd, 0x93, 0x20, 0x24, 0x1e, 0x8c, 0x70, 0x6b, 0x4c,
-	0x0c, 0xeb, 0xeb, 0xc7, 0x1b, 0x02, 0x6c, 0xf6, 0x2a, 0xd2, 0xd5, 0x32, 0xfb, 0x6a, 0xac, 0x09,
-	0x39, 0x0b, 0xb2, 0x0f, 0x65, 0x37, 0x31, 0x92, 0x0a, 0x79, 0x6c, 0x27, 0x48, 0x13, 0x49, 0x73,
-	0x9c, 0x60, 0x2a, 0x44, 0x59, 0x56, 0x8a, 0x1b, 0xa1, 0x50, 0x6c, 0x78, 0xb1, 0xfc, 0xac, 0xc7,
-	0x34, 0x83, 0x97, 0x3d, 0xe3, 0x15, 0xc7, 0xee, 0x1d, 0xcc, 0x16, 0x97, 0xbd, 0x18, 0x19, 0x7d,
-	0xf2, 0xeb, 0x16, 0x8c, 0x47, 0x6e, 0x6b, 0x23, 0x0c, 0xf6, 0xbc, 0x1a, 0x0d, 0xe5, 0x22, 0x78,
-	0xcc, 0x69, 0xb5, 0xb9, 0xb8, 0x96, 0x10, 0xd4, 0x7c, 0x85, 0x5b, 0xa2, 0x21, 0x68, 0xf2, 0x65,
-	0xc6, 0xe1, 0x33, 0xf2, 0xdd, 0x97, 0xa8, 0xeb, 0x45, 0x6c, 0xc9, 0x94, 0xb6, 0x30, 0x1f, 0x29,
-	0xc7, 0x36, 0x0a, 0x96, 0x3a, 0xee, 0x2e, 0x9b, 0x6f, 0x5a, 0xa0, 0xe7, 0xee, 0x1d, 0xcc, 0x3e,
-	0xb3, 0xd8, 0x9f, 0x27, 0x0e, 0x12, 0x86, 0x77, 0x58, 0xbb, 0xd3, 0x6c, 0x22, 0x7d, 0xa7, 0x43,
-	0xb9, 0xa7, 0x9b, 0x43, 0x87, 0x6d, 0x68, 0x82, 0x99, 0x0e, 0x33, 0x20, 0x68, 0xf2, 0x25, 0xef,
-	0xc0, 0x68, 0xcb, 0x89, 0x43, 0x6f, 0x5f, 0xba, 0xb7, 0xc7, 0x34, 0xd3, 0xd6, 0x38, 0x2d, 0xcd,
-	0x1c, 0xd8, 0x9c, 0x14, 0x8d, 0x28, 0x19, 0x91, 0x16, 0x94, 0x5a, 0x34, 0x6c, 0xd0, 0x99, 0x72,
-	0x1e, 0x5b, 0x79, 0x6b, 0x8c, 0x94, 0x66, 0x58, 0x61, 0x8b, 0x1a, 0x6f, 0x43, 0xc1, 0x85, 0x7c,
-	0x0c, 0xca, 0x11, 0x6d, 0x52, 0x37, 0x0e, 0xc2, 0x99, 0x0a, 0xe7, 0xf8, 0x53, 0x43, 0x2e, 0xd1,
-	0xce, 0x36, 0x6d, 0x6e, 0xca, 0x47, 0xc5, 0x04, 0x4b, 0xfe, 0xa1, 0x22, 0x69, 0xff, 0x0f, 0x0b,
-	0x48, 0x5a, 0xc3, 0x3c, 0x02, 0xc3, 0xe0, 0x9d, 0xb4, 0x61, 0xb0, 0x9a, 0xe7, 0xf2, 0x35, 0xc0,
-	0x36, 0xf8, 0x76, 0x19, 0x32, 0xba, 0xf9, 0x06, 0x8d, 0x62, 0x5a, 0x7b, 0x4f, 0x9f, 0xbe, 0xa7,
-	0x4f, 0xdf, 0xd3, 0xa7, 0x4a, 0x9f, 0x6e, 0x67, 0xf4, 0xe9, 0x9b, 0xc6, 0xac, 0xd7, 0x67, 0x4c,
-	0x9f, 0x50, 0x87, 0x50, 0xa6, 0x04, 0x06, 0x02, 0xd3, 0x04, 0xd7, 0x36, 0xd7, 0x6f, 0xf4, 0x55,
-	0xa0, 0x9f, 0x48, 0x2b, 0xd0, 0xe3, 0xb2, 0x78, 0xe4, 0x2a, 0xf3, 0x5e, 0x31, 0xab, 0x32, 0xf9,
-	0x31, 0xc0, 0x25, 0x80, 0x46, 0xb0, 0x45, 0x5b, 0xed, 0xa6, 0x13, 0x0b, 0x13, 0xb8, 0xac, 0x5d,
-	0x87, 0x65, 0x05, 0x41, 0x03, 0x8b, 0xfc, 0x4d, 0x0b, 0xa0, 0x91, 0x7c, 0x9a, 0x44, 0x1d, 0xde,
-	0xcc, 0x53, 0x1d, 0xea, 0x0f, 0xaf, 0x65, 0x51, 0x0c, 0xd1, 0x60, 0x4e, 0x3e, 0x6b, 0x41, 0x39,
-	0x4e, 0xc4, 0x17, 0x0a, 0x62, 0x2b, 0x4f, 0x49, 0x92, 0x97, 0xd6, 0x2b, 0x83, 0xea, 0x12, 0xc5,
-	0x97, 0xfc, 0x0d, 0x0b, 0x20, 0xea, 0xfa, 0xee, 0x46, 0xd0, 0xf4, 0xdc, 0xae, 0xd4, 0x1b, 0xb7,
-	0x72, 0x75, 0x6f, 0x14, 0xf5, 0xea, 0x14, 0xeb, 0x0d, 0xfd, 0x1f, 0x0d, 0xce, 0xf6, 0x37, 0xd3,
-	0xbb, 0x13, 0xca, 0x2f, 0xe2, 0x9f, 0xcc, 0x4d, 0xcc, 0xfa, 0x48, 0x6e, 0xdd, 0xe5, 0xfa, 0xc9,
-	0x94, 0xd3, 0xa0, 0x3f, 0x99, 0x6a, 0x8a, 0xd0, 0x60, 0x6e, 0x7f, 0xc6, 0x82, 0x99, 0x41, 0x6f,
-	0x47, 0x28, 0x3c, 0xd7, 0x0e, 0x29, 0x9f, 0x43, 0x6a, 0xd3, 0x7d, 0xdd, 0x5f, 0xa2, 0x4d, 0xca,
-	0xf7, 0x79, 0xc4, 0x00, 0x7d, 0x51, 0x72, 0x78, 0x6e, 0x63, 0x30, 0x2a, 0x3e, 0x88, 0x8e, 0xfd,
-	0xdb, 0x85, 0xd4, 0x66, 0x87, 0xf1, 0xa1, 0xc9, 0xd7, 0xad, 0x1e, 0x2b, 0xe2, 0xe7, 0x4f, 0x62,
-	0x44, 0x71, 0x7b, 0x43, 0xed, 0xbd, 0x0f, 0xc6, 0x79, 0x8c, 0x9b, 0x7b, 0xf6, 0xbf, 0x1f, 0x81,
-	0x07, 0x48, 0xa6, 0xb6, 0x6f, 0xac, 0x41, 0xdb, 0x37, 0x47, 0xdf, 0x11, 0xfa, 0x82, 0x05, 0xa3,
-	0x4d, 0xa6, 0xd0, 0xa2, 0x99, 0x22, 0x1f, 0xa4, 0xb5, 0x93, 0xea, 0x7b, 0xa1, 0x37, 0x23, 0xb1,
-	0xc1, 0xac, 0x5c, 0x59, 0xd1, 0x88, 0x52, 0x06, 0xf2, 0x0d, 0x0b, 0xc6, 0x1d, 0xdf, 0x0f, 0x62,
-	0x79, 0xe2, 0x29, 0x4e, 0x0c, 0xbd, 0x13, 0x93, 0x69, 0x41, 0xf3, 0x12, 0x82, 0xa9, 0xd3, 0x4c,
-	0x03, 0x82, 0xa6, 0x48, 0x64, 0x0e, 0xa0, 0xee, 0xf9, 0x4e, 0xd3, 0x7b, 0x97, 0x19, 0x66, 0x25,
-	0x7e, 0xbc, 0xc0, 0x75, 0xc4, 0x15, 0xd5, 0x8a, 0x06, 0xc6, 0xf9, 0xbf, 0x0e, 0xe3, 0xc6, 0x9b,
-	0xf7, 0xd9, 0x17, 0x3f, 0x6b, 0xee, 0x8b, 0x57, 0x8c, 0xed, 0xec, 0xf3, 0x6f, 0xc2, 0xe9, 0xac,
-	0x80, 0x47, 0x79, 0xde, 0xfe, 0x9d, 0x51, 0x98, 0xcd, 0xbe, 0x7c, 0xd8, 0x62, 0xa2, 0xbd, 0x67,
-	0xd0, 0xbe, 0x67, 0xd0, 0xbe, 0x67, 0xd0, 0x26, 0x7f, 0xec, 0x3f, 0x28, 0xc1, 0xb4, 0x39, 0x51,
-	0x84, 0x74, 0x3f, 0x09, 0x63, 0x21, 0x6d, 0x07, 0x37, 0x71, 0x55, 0x6a, 0x5c, 0x1d, 0x29, 0x24,
-	0x9a, 0x31, 0x81, 0x33, 0xcd, 0xdc, 0x76, 0xe2, 0x1d, 0xa9, 0x72, 0x95, 0x66, 0xde, 0x70, 0xe2,
-	0x1d, 0xe4, 0x10, 0xf2, 0x26, 0x4c, 0xc5, 0x4e, 0xd8, 0xa0, 0x31, 0xd2, 0x3d, 0xde, 0x09, 0x72,
-	0x77, 0xf0, 0x69, 0x89, 0x3b, 0xb5, 0x95, 0x82, 0x62, 0x06, 0x9b, 0xbc, 0x03, 0x23, 0x3b, 0xb4,
-	0xd9, 0x92, 0x16, 0xf7, 0x66, 0x7e, 0x1a, 0x91, 0xbf, 0xeb, 0x55, 0xda, 0x6c, 0x89, 0xf9, 0xca,
-	0x7e, 0x21, 0x67, 0xc5, 0xbe, 0x4e, 0x65, 0xb7, 0x13, 0xc5, 0x41, 0xcb, 0x7b, 0x37, 0xb1, 0xc3,
-	0x7f, 0x3e, 0x67, 0xc6, 0xd7, 0x13, 0xfa, 0xe2, 0x0c, 0x48, 0xfd, 0x45, 0xcd, 0x99, 0xcb, 0x51,
-	0xf3, 0x42, 0x6e, 0x57, 0x77, 0x67, 0xe0, 0x44, 0xe4, 0x58, 0x4a, 0xe8, 0x0b, 0x39, 0xd4, 0x5f,
-	0xd4, 0x9c, 0x49, 0x17, 0x46, 0xdb, 0xcd, 0x4e, 0xc3, 0xf3, 0x67, 0xc6, 0xb9, 0x0c, 0x37, 0x73,
-	0x96, 0x61, 0x83, 0x13, 0x17, 0xde, 0x90, 0xf8, 0x8d, 0x92, 0x21, 0x79, 0x11, 0x4a, 0xee, 0x8e,
-	0x13, 0xc6, 0x33, 0x13, 0x7c, 0xd0, 0xa8, 0xdd, 0x8b, 0x45, 0xd6, 0x88, 0x02, 0x66, 0xff, 0x83,
-	0x42, 0xda, 0x7a, 0x48, 0xbf, 0x98, 0x18, 0xce, 0x6e, 0x27, 0x8c, 0x12, 0xbf, 0xc3, 0x18, 0xce,
-	0xbc, 0x19, 0x13, 0x38, 0xf9, 0x8c, 0x05, 0x63, 0x77, 0xa2, 0xc0, 0xf7, 0x69, 0x2c, 0x35, 0xf5,
-	0xad, 0x9c, 0xdf, 0xf5, 0x9a, 0xa0, 0xae, 0x65, 0x90, 0x0d, 0x98, 0xf0, 0x65, 0xe2, 0xd2, 0x7d,
-	0xb7, 0xd9, 0xa9, 0x25, 0xc7, 0x55, 0x0a, 0xf5, 0xb2, 0x68, 0xc6, 0x04, 0xce, 0x50, 0x3d, 0x5f,
-	0xa0, 0x8e, 0xa4, 0x51, 0x57, 0x7c, 0x89, 0x2a, 0xe1, 0xf6, 0x3f, 0x2d, 0xc1, 0xb9, 0xbe, 0xa3,
-	0x9f, 0xad, 0xeb, 0x7c, 0xe5, 0xbc, 0xe2, 0x35, 0x69, 0x12, 0x9f, 0xc5, 0xd7, 0xf5, 0x5b, 0xaa,
-	0x15, 0x0d, 0x0c, 0xf2, 0x2b, 0x00, 0x6d, 0x27, 0x74, 0x5a, 0x54, 0xae, 0x67, 0xc5, 0xe3, 0x2f,
-	0x9f, 0x4c, 0x8e, 0x8d, 0x84, 0xa6, 0xb6, 0xeb, 0x55, 0x53, 0x84, 0x06, 0x4b, 0xf2, 0x41, 0x18,
-	0x0f, 0x69, 0x93, 0x3a, 0x11, 0x0f, 0x60, 0xc8, 0x46, 0x63, 0xa1, 0x06, 0xa1, 0x89, 0x47, 0xde,
-	0x07, 0xa3, 0xfc, 0x2d, 0x92, 0xe3, 0x09, 0x65, 0x8a, 0xf1, 0xf7, 0x8c, 0x50, 0x42, 0xc9, 0x97,
-	0x2d, 0x98, 0xaa, 0x7b, 0x4d, 0xaa, 0xb9, 0xcb, 0xd8, 0xa9, 0xf5, 0xe3, 0xbf, 0xe4, 0x15, 0x93,
-	0xae, 0x56, 0x81, 0xa9, 0xe6, 0x08, 0x33, 0xec, 0xd9, 0x67, 0xde, 0xa3, 0x21, 0xd7, 0x9d, 0xa3,
-	0xe9, 0xcf, 0x7c, 0x4b, 0x34, 0x63, 0x02, 0x27, 0x0b, 0x70, 0xaa, 0xed, 0x44, 0xd1, 0x62, 0x48,
-	0x6b, 0xd4, 0x8f, 0x3d, 0xa7, 0x29, 0x22, 0x9b, 0xca, 0x3a, 0xb2, 0x61, 0x23, 0x0d, 0xc6, 0x2c,
-	0x3e, 0xf9, 0x08, 0x3c, 0xe3, 0x35, 0xfc, 0x20, 0xa4, 0x6b, 0x5e, 0x14, 0x79, 0x7e, 0x43, 0x0f,
-	0x03, 0xae, 0x0a, 0xcb, 0xd5, 0x59, 0x49, 0xea, 0x99, 0x95, 0xfe, 0x68, 0x38, 0xe8, 0x79, 0xf2,
-	0x32, 0x94, 0xa3, 0x5d, 0xaf, 0xbd, 0x18, 0xd6, 0x22, 0xbe, 0xf5, 0x50, 0xd6, 0xde, 0xee, 0xa6,
-	0x6c, 0x47, 0x85, 0x61, 0xff, 0x66, 0x21, 0xed, 0xbf, 0x99, 0xf3, 0x87, 0x44, 0x6c, 0x96, 0xc4,
-	0xb7, 0x9c, 0x30, 0x71, 0x32, 0x8f, 0x19, 0x1b, 0x25, 0xe9, 0xde, 0x72, 0x42, 0x73, 0xbe, 0x71,
-	0x06, 0x98, 0x70, 0x22, 0x77, 0x60, 0x24, 0x6e, 0x3a, 0x39, 0x05, 0x53, 0x1a, 0x1c, 0xf5, 0x29,
-	0xfe, 0xea, 0x42, 0x84, 0x9c, 0x07, 0x79, 0x9e, 0xd9, 0xa7, 0xdb, 0xc2, 0x3b, 0xa9, 0x24, 0x26,
-	0xe5, 0x76, 0x84, 0xbc, 0xd5, 0xfe, 0xde, 0x58, 0x1f, 0x95, 0xa7, 0x16, 0x11, 0x72, 0x09, 0x80,
-	0xb9, 0x3a, 0x1b, 0x21, 0xad, 0x7b, 0xfb, 0x72, 0x11, 0x57, 0xd3, 0xea, 0x86, 0x82, 0xa0, 0x81,
-	0x95, 0x3c, 0xb3, 0xd9, 0xa9, 0xb3, 0x67, 0x0a, 0xbd, 0xcf, 0x08, 0x08, 0x1a, 0x58, 0xe4, 0x35,
-	0x18, 0xf5, 0x5a, 0x4e, 0x83, 0x26, 0x62, 0x3e, 0xcf, 0xe6, 0xd3, 0x0a, 0x6f, 0xb9, 0x7f, 0x30,
-	0x3b, 0xa5, 0x04, 0xe2, 0x4d, 0x28, 0x71, 0xc9, 0x6f, 0x5b, 0x30, 0xe1, 0x06, 0xad, 0x56, 0xe0,
-	0x0b, 0x07, 0x41, 0x7a, 0x3b, 0x77, 0x4e, 0x6a, 0x89, 0x9d, 0x5b, 0x34, 0x98, 0x09, 0x77, 0x47,
-	0x45, 0x7d, 0x9a, 0x20, 0x4c, 0x49, 0x65, 0x4e, 0xbb, 0xd2, 0x21, 0xd3, 0xee, 0x5f, 0x58, 0x30,
-	0x2d, 0x9e, 0x35, 0xfc, 0x16, 0x19, 0xe0, 0x18, 0x9c, 0xf0, 0x6b, 0xf5, 0xb8, 0x72, 0xcf, 0x4a,
-	0x31, 0xa7, 0x7b, 0xe0, 0xd8, 0x2b, 0x24, 0x59, 0x86, 0xe9, 0x7a, 0x10, 0xba, 0xd4, 0xec, 0x08,
-	0xa9, 0x33, 0x14, 0xa1, 0x2b, 0x59, 0x04, 0xec, 0x7d, 0x86, 0xdc, 0x82, 0xa7, 0x8d, 0x46, 0xb3,
-	0x1f, 0x84, 0xda, 0xb8, 0x20, 0xa9, 0x3d, 0x7d, 0xa5, 0x2f, 0x16, 0x0e, 0x78, 0x9a, 0x19, 0x90,
-	0x1c, 0xa2, 0xdc, 0x78, 0xa9, 0x3a, 0xb4, 0xf6, 0x4c, 0x41, 0x31, 0x83, 0xcd, 0xd6, 0x37, 0x37,
-	0x68, 0xb5, 0x03, 0x9f, 0xfa, 0xb1, 0x08, 0x19, 0x94, 0xeb, 0xdb, 0xa2, 0x6a, 0x45, 0x03, 0xe3,
-	0xfc, 0x87, 0x61, 0xba, 0x67, 0xbc, 0x1c, 0xc9, 0x7b, 0x5d, 0x82, 0xa7, 0xfb, 0x7f, 0x99, 0x23,
-	0xf9, 0xb0, 0x7f, 0xcf, 0x4a, 0x1f, 0x6e, 0x1b, 0x96, 0xd2, 0x10, 0xfb, 0x21, 0x0e, 0x14, 0xa9,
-	0xbf, 0x27, 0x15, 0xd5, 0x95, 0xe3, 0x8d, 0xc0, 0xcb, 0xfe, 0x9e, 0x18, 0x58, 0xdc, 0xe9, 0xbb,
-	0xec, 0xef, 0x21, 0xa3, 0x6d, 0xff, 0xed, 0xd1, 0x54, 0xc4, 0xcc, 0x66, 0x12, 0xa4, 0x25, 0xdc,
-	0x2d, 0x2b, 0xef, 0x20, 0x2d, 0x11, 0xf2, 0xa8, 0xe3, 0x2e, 0x84, 0x87, 0x25, 0xd9, 0x91, 0xcf,
-	0x5b, 0x3c, 0x44, 0x3b, 0x89, 0x24, 0x92, 0xc6, 0xdb, 0xc9, 0x44, 0x8c, 0x9b, 0x81, 0xdf, 0x49,
-	0x23, 0x9a, 0xdc, 0x99, 0xe6, 0x68, 0x8b, 0x60, 0xc3, 0xac, 0x09, 0x97, 0x04, 0x71, 0x27, 0x70,
-	0xb2, 0xdf, 0x67, 0x47, 0x37, 0x87, 0x30, 0xdf, 0xc3, 0xf7, 0x70, 0xc9, 0x37, 0x2c, 0x98, 0x16,
-	0x0b, 0xf5, 0x92, 0x57, 0xaf, 0xd3, 0x90, 0xfa, 0x2e, 0x4d, 0x4c, 0x9d, 0xdb, 0xc7, 0x93, 0x20,
-	0xf1, 0x73, 0x57, 0xb2, 0xe4, 0xb5, 0x4a, 0xe9, 0x01, 0x61, 0xaf, 0x30, 0xa4, 0x06, 0x23, 0x9e,
-	0x5f, 0x0f, 0xa4, 0x22, 0xad, 0x1e, 0x4f, 0xa8, 0x15, 0xbf, 0x1e, 0xe8, 0xb9, 0xc2, 0xfe, 0x21,
-	0xa7, 0x4e, 0x56, 0xe1, 0x6c, 0x28, 0xbd, 0xcd, 0xab, 0x5e, 0xc4, 0x5c, 0x86, 0x55, 0xaf, 0xe5,
-	0xc5, 0x5c, 0x09, 0x16, 0xab, 0x33, 0xf7, 0x0e, 0x66, 0xcf, 0x62, 0x1f, 0x38, 0xf6, 0x7d, 0xca,
-	0xfe, 0x61, 0x25, 0xed, 0x52, 0x8b, 0x7d, 0xf1, 0x5f, 0x86, 0x4a, 0xa8, 0x62, 0xcd, 0xad, 0x3c,
-	0xce, 0x75, 0x93, 0x3e, 0x96, 0x01, 0x49, 0x6a, 0xb7, 0x53, 0x47, 0x95, 0x6b, 0x8e, 0xcc, 0x70,
-	0x61, 0x5f, 0x5e, 0x4e, 0x8b, 0x1c, 0xc6, 0x97, 0xe4, 0xaa, 0xf7, 0x72, 0xbb, 0xbe, 0x8b, 0x9c,
-	0x07, 0x09, 0x61, 0x74, 0x87, 0x3a, 0xcd, 0x78, 0x27, 0x9f, 0x6d, 0xa7, 0xab, 0x9c, 0x56, 0x36,
-	0xec, 0x4a, 0xb4, 0xa2, 0xe4, 0x44, 0xf6, 0x61, 0x6c, 0x47, 0x7c, 0x04, 0x69, 0x4b, 0xac, 0x1d,
-	0xb7, 0x73, 0x53, 0x5f, 0x56, 0xcf, 0x5f, 0xd9, 0x80, 0x09, 0x3b, 0x7e, 0x24, 0x63, 0x1c, 0x78,
-	0x88, 0xe9, 0x93, 0x5f, 0xc4, 0xd9, 0xd0, 0xa7, 0x1d, 0xe4, 0x93, 0x30, 0x11, 0x52, 0x37, 0xf0,
-	0x5d, 0xaf, 0x49, 0x6b, 0x0b, 0xc9, 0x96, 0xd2, 0x51, 0x62, 0xbd, 0x4e, 0x33, 0x7b, 0x08, 0x0d,
-	0x1a, 0x98, 0xa2, 0x48, 0x3e, 0x67, 0xc1, 0x94, 0x0a, 0x50, 0x65, 0x1f, 0x84, 0xca, 0x4d, 0x99,
-	0xd5, 0x9c, 0xc2, 0x61, 0x39, 0xcd, 0x2a, 0x61, 0x6b, 0x7a, 0xba, 0x0d, 0x33, 0x7c, 0xc9, 0xdb,
-	0x00, 0xc1, 0x36, 0x3f, 0x74, 0x61, 0xaf, 0x5a, 0x3e, 0xf2, 0xab, 0x4e, 0x89, 0x80, 0xc5, 0x84,
-	0x02, 0x1a, 0xd4, 0xc8, 0x75, 0x00, 0x31, 0x6d, 0xb6, 0xba, 0x6d, 0x61, 0x6b, 0xe8, 0x60, 0x3d,
-	0xd8, 0x54, 0x90, 0xfb, 0x07, 0xb3, 0xbd, 0x0e, 0x35, 0x0f, 0xd6, 0x33, 0x1e, 0x27, 0xbf, 0x08,
-	0x63, 0x51, 0xa7, 0xd5, 0x72, 0xd4, 0xfe, 0x4d, 0x8e, 0x21, 0x90, 0x82, 0xae, 0x1e, 0x9b, 0xb2,
-	0x01, 0x13, 0x8e, 0xe4, 0x0e, 0x53, 0x6c, 0x91, 0xf4, 0xf4, 0xf9, 0x2c, 0x12, 0x6b, 0xf3, 0x38,
-	0x7f, 0xa7, 0x0f, 0xc9, 0xe7, 0xce, 0x62, 0x1f, 0x9c, 0xfb, 0x07, 0xb3, 0x4f, 0xa7, 0xdb, 0x57,
-	0x03, 0xc1, 0x16, 0xfb, 0xd2, 0xb4, 0xfd, 0xf4, 0xa9, 0xaf, 0x94, 0xe0, 0x35, 0x98, 0xa0, 0xfb,
-	0x31, 0x0d, 0x7d, 0xa7, 0x79, 0x13, 0x57, 0x93, 0xdd, 0x05, 0x3e, 0xd0, 0x2e, 0x1b, 0xed, 0x98,
-	0xc2, 0x22, 0xb6, 0xf2, 0x2a, 0x0a, 0x1c, 0x1f, 0xb4, 0x57, 0x91, 0xf8, 0x10, 0xf6, 0xff, 0x2b,
-	0xa4, 0xac, 0x8f, 0xad, 0x90, 0x52, 0x12, 0x40, 0xc9, 0x0f, 0x6a, 0x4a, 0xc1, 0x5e, 0xcb, 0x47,
-	0xc1, 0xde, 0x08, 0x6a, 0x46, 0xc2, 0x15, 0xfb, 0x17, 0xa1, 0xe0, 0xc3, 0x33, 0x52, 0x92, 0xd4,
-	0x1d, 0x0e, 0x90, 0x06, 0x57, 0x9e, 0x9c, 0x55, 0x46, 0xca, 0xba, 0xc9, 0x08, 0xd3, 0x7c, 0xc9,
-	0x2e, 0x94, 0x76, 0x82, 0x28, 0x4e, 0x0e, 0xb3, 0x8e, 0x69, 0xf1, 0x5d, 0x0d, 0xa2, 0x98, 0x2f,
-	0x97, 0xea, 0xb5, 0x59, 0x4b, 0x84, 0x82, 0x87, 0xfd, 0xa7, 0x56, 0x6a, 0x2f, 0xe9, 0xb6, 0x13,
-	0xbb, 0x3b, 0x97, 0xf7, 0xa8, 0xcf, 0xe6, 0x8e, 0x19, 0xe2, 0xfa, 0xd7, 0x32, 0x21, 0xae, 0xef,
-	0x1f, 0x94, 0x01, 0x7b, 0x97, 0x51, 0x98, 0xe3, 0x24, 0x8c, 0x70, 0xd7, 0x4f, 0x5b, 0x30, 0x6e,
-	0x88, 0x27, 0x17, 0xaf, 0x1c, 0xe3, 0xa4, 0xf5, 0x99, 0x97, 0x6e, 0x44, 0x93, 0xa5, 0xfd, 0x55,
-	0x0b, 0xc6, 0xaa, 0x8e, 0xbb, 0x1b, 0xd4, 0xeb, 0xe4, 0x65, 0x28, 0xd7, 0x3a, 0x32, 0x0d, 0x40,
-	0xbc, 0x9f, 0xda, 0xbc, 0x58, 0x92, 0xed, 0xa8, 0x30, 0xd8, 0x18, 0xae, 0x3b, 0x3c, 0xc6, 0xa2,
-	0xc0, 0xcd, 0x08, 0x3e, 0x86, 0xaf, 0xf0, 0x16, 0x94, 0x10, 0xf2, 0x41, 0x18, 0x6f, 0x39, 0xfb,
-	0xc9, 0xc3, 0xd9, 0x8d, 0xac, 0x35, 0x0d, 0x42, 0x13, 0xcf, 0xfe, 0x37, 0x16, 0xcc, 0x54, 0x9d,
-	0xc8, 0x73, 0x17, 0x3a, 0xf1, 0x4e, 0xd5, 0x8b, 0xb7, 0x3b, 0xee, 0x2e, 0x8d, 0x45, 0x88, 0x3c,
-	0x93, 0xb2, 0x13, 0xb1, 0xa9, 0xa4, 0xdc, 0x03, 0x25, 0xe5, 0x4d, 0xd9, 0x8e, 0x0a, 0x83, 0xbc,
-	0x0b, 0xe3, 0x6d, 0x27, 0x8a, 0xee, 0x06, 0x61, 0x0d, 0x69, 0x3d, 0x9f, 0x04, 0x95, 0x4d, 0xea,
-	0x86, 0x34, 0x46, 0x5a, 0x97, 0x67, 0x0f, 0x9a, 0x3e, 0x9a, 0xcc, 0xec, 0xdf, 0xab, 0xc0, 0x98,
-	0x3c, 0x38, 0x19, 0x3a, 0xf0, 0x3f, 0x71, 0x7c, 0x0a, 0x03, 0x1d, 0x9f, 0x08, 0x46, 0x5d, 0x9e,
-	0x26, 0x2d, 0xad, 0x8f, 0xeb, 0xb9, 0x9c, 0xb4, 0x89, 0xcc, 0x6b, 0x2d, 0x96, 0xf8, 0x8f, 0x92,
-	0x15, 0xf9, 0x8a, 0x05, 0xa7, 0xdc, 0xc0, 0xf7, 0xa9, 0xab, 0x97, 0xc6, 0x91, 0x3c, 0xce, 0xce,
-	0x17, 0xd3, 0x44, 0xf5, 0x2e, 0x5e, 0x06, 0x80, 0x59, 0xf6, 0xe4, 0x0d, 0x98, 0x14, 0x7d, 0x76,
-	0x2b, 0xb5, 0x85, 0xa1, 0xf3, 0xdb, 0x4c, 0x20, 0xa6, 0x71, 0x99, 0xcb, 0xec, 0xeb, 0x4c, 0xb2,
-	0x51, 0xed, 0x32, 0x1b, 0x39, 0x64, 0x06, 0x06, 0x09, 0x81, 0x84, 0xb4, 0x1e, 0xd2, 0x68, 0x47,
-	0x1e, 0x2c, 0xf1, 0x65, 0x79, 0xec, 0xe1, 0xa2, 0xcd, 0xb1, 0x87, 0x12, 0xf6, 0xa1, 0x4e, 0x76,
-	0xa5, 0x6f, 0x50, 0xce, 0x43, 0x2b, 0xc8, 0xcf, 0x3c, 0xd0, 0x45, 0x98, 0x85, 0x52, 0xb4, 0xe3,
-	0x84, 0x35, 0x6e, 0x0e, 0x14, 0x45, 0x50, 0xd5, 0x26, 0x6b, 0x40, 0xd1, 0x4e, 0x96, 0xe0, 0x74,
-	0x26, 0x3b, 0x2f, 0xe2, 0x0b, 0x7e, 0xb9, 0x3a, 0x23, 0xc9, 0x9d, 0xce, 0xe4, 0xf5, 0x45, 0xd8,
-	0xf3, 0x84, 0xe9, 0x37, 0x8e, 0x1f, 0xe2, 0x37, 0x76, 0x55, 0xf8, 0xc2, 0x04, 0xd7, 0xf8, 0x6f,
-	0xe5, 0xd2, 0x01, 0x43, 0xc5, 0x2a, 0x7c, 0x31, 0x13, 0xab, 0x30, 0xc9, 0x05, 0xb8, 0x95, 0x8f,
-	0x00, 0x47, 0x0f, 0x4c, 0x78, 0x9c, 0x81, 0x06, 0x3f, 0xb4, 0x20, 0xf9, 0xae, 0x8b, 0x8e, 0xbb,
-	0x43, 0xd9, 0x90, 0x21, 0x6f, 0xc2, 0x94, 0xf2, 0xbc, 0x16, 0x83, 0x8e, 0x2f, 0x62, 0x0c, 0x8a,
-	0x7a, 0xc3, 0x0a, 0x53, 0x50, 0xcc, 0x60, 0x93, 0x79, 0xa8, 0xb0, 0x7e, 0x12, 0x8f, 0x8a, 0xd5,
-	0x43, 0x79, 0x77, 0x0b, 0x1b, 0x2b, 0xf2, 0x29, 0x8d, 0x43, 0x02, 0x98, 0x6e, 0x3a, 0x51, 0xcc,
-	0x25, 0x60, 0x8e, 0xd8, 0x43, 0xe6, 0x7a, 0xf0, 0xe4, 0xe4, 0xd5, 0x2c, 0x21, 0xec, 0xa5, 0x6d,
-	0x7f, 0x77, 0x04, 0x26, 0x53, 0x9a, 0xf1, 0x88, 0xcb, 0xce, 0xcb, 0x50, 0x4e, 0x56, 0x02, 0xa9,
-	0xca, 0x15, 0xb6, 0x5a, 0x2e, 0x14, 0x06, 0x5b, 0x26, 0xb7, 0xa9, 0x13, 0xd2, 0x90, 0xe7, 0x3d,
-	0x66, 0x97, 0xc9, 0xaa, 0x06, 0xa1, 0x89, 0xc7, 0x95, 0x72, 0xdc, 0x8c, 0x16, 0x9b, 0x1e, 0xf5,
-	0x63, 0x21, 0x66, 0x3e, 0x4a, 0x79, 0x6b, 0x75, 0xd3, 0x24, 0xaa, 0x95, 0x72, 0x06, 0x80, 0x59,
-	0xf6, 0xe4, 0xd7, 0x2c, 0x98, 0x74, 0xee, 0x46, 0xba, 0x96, 0x87, 0x8c, 0x4a, 0x38, 0xe6, 0x22,
-	0x95, 0x2a, 0x0f, 0x52, 0x9d, 0x66, 0xea, 0x3d, 0xd5, 0x84, 0x69, 0xa6, 0xe4, 0xeb, 0x16, 0x10,
-	0xba, 0x4f, 0xdd, 0x24, 0x6e, 0x42, 0xca, 0x32, 0x9a, 0x87, 0x83, 0x72, 0xb9, 0x87, 0xae, 0xd0,
-	0xea, 0xbd, 0xed, 0xd8, 0x47, 0x06, 0xfb, 0x5f, 0x15, 0xd5, 0x84, 0xd2, 0xa1, 0x3a, 0x8e, 0x11,
-	0xb1, 0x6a, 0x3d, 0x7c, 0xc4, 0xaa, 0x3e, 0x6b, 0xea, 0x89, 0x5a, 0x4d, 0x87, 0x77, 0x16, 0x1e,
-	0x53, 0x78, 0xe7, 0x67, 0x2d, 0x75, 0x44, 0x29, 0xcc, 0xf8, 0xb7, 0xf3, 0x0d, 0x13, 0x9a, 0x13,
-	0x27, 0x9d, 0x19, 0xed, 0x9e, 0x3e, 0xfe, 0x64, 0xda, 0xd4, 0x40, 0x3b, 0x92, 0x36, 0xfc, 0x2f,
-	0x45, 0x18, 0x37, 0x56, 0xd2, 0xbe, 0x66, 0x91, 0xf5, 0x84, 0x99, 0x45, 0x85, 0x23, 0x98, 0x45,
-	0xbf, 0x02, 0x15, 0x37, 0xd1, 0xf2, 0xf9, 0x14, 0x8e, 0xc9, 0xae, 0x1d, 0x5a, 0xd1, 0xab, 0x26,
-	0xd4, 0x3c, 0xc9, 0x32, 0x4c, 0x1b, 0x64, 0xe4, 0x0a, 0x31, 0xc2, 0x57, 0x08, 0xb5, 0xb1, 0xba,
-	0x90, 0x45, 0xc0, 0xde, 0x67, 0xc8, 0xab, 0xcc, 0xb3, 0xf2, 0xe4, 0x7b, 0x25, 0xc1, 0x7c, 0xdc,
-	0x5c, 0x5f, 0xd8, 0x58, 0x49, 0x9a, 0xd1, 0xc4, 0xb1, 0xbf, 0x6b, 0xa9, 0x8f, 0xfb, 0x08, 0x72,
-	0x60, 0xee, 0xa4, 0x73, 0x60, 0x2e, 0xe7, 0xd2, 0xcd, 0x03, 0x92, 0x5f, 0x6e, 0xc0, 0xd8, 0x62,
-	0xd0, 0x6a, 0x39, 0x7e, 0x8d, 0xfc, 0x04, 0x8c, 0xb9, 0xe2, 0xa7, 0xdc, 0xaa, 0x18, 0x67, 0xc6,
-	0x97, 0x84, 0x62, 0x02, 0x23, 0xcf, 0xc3, 0x88, 0x13, 0x36, 0x92, 0xed, 0x09, 0x7e, 0x36, 0xbb,
-	0x10, 0x36, 0x22, 0xe4, 0xad, 0xf6, 0xd7, 0x0a, 0xc0, 0xcf, 0x96, 0x9c, 0x90, 0xd6, 0xb6, 0x82,
-	0xf7, 0xce, 0x44, 0x84, 0xd7, 0xfa, 0x05, 0x0b, 0x88, 0x3a, 0x71, 0x53, 0xc1, 0x0d, 0xcc, 0xd8,
-	0x51, 0x67, 0x6f, 0xd2, 0x72, 0xd0, 0x73, 0x20, 0x01, 0xa0, 0xc6, 0x19, 0xc2, 0x05, 0x7c, 0x31,
-	0x51, 0x50, 0xc5, 0x74, 0xcc, 0x10, 0x57, 0x6b, 0x52, 0x5f, 0xd9, 0xbf, 0x5f, 0x80, 0xa7, 0xc5,
-	0x9a, 0xb3, 0xe6, 0xf8, 0x4e, 0x83, 0xb6, 0x98, 0x54, 0xc3, 0x9e, 0xae, 0xb9, 0xcc, 0xf7, 0xf0,
-	0x92, 0x10, 0xa1, 0xe3, 0x0e, 0x4e, 0x31, 0xa8, 0xc4, 0x30, 0x5a, 0xf1, 0xbd, 0x18, 0x39, 0x71,
-	0x12, 0x41, 0x39, 0x29, 0x05, 0x26, 0x95, 0x4d, 0x4e, 0x8c, 0xd4, 0xbc, 0x93, 0x0b, 0x03, 0x45,
-	0xc5, 0x88, 0x59, 0x66, 0xcd, 0xc0, 0xdd, 0x45, 0xda, 0x0e, 0xb8, 0x62, 0x31, 0x22, 0x34, 0x56,
-	0x65, 0x3b, 0x2a, 0x0c, 0xfb, 0xf7, 0x2d, 0xc8, 0xaa, 0x5c, 0xee, 0xca, 0x8b, 0x2c, 0xe0, 0xac,
-	0x2b, 0x9f, 0x4e, 0xf2, 0x3d, 0x42, 0x32, 0xf3, 0x2f, 0xc0, 0xb8, 0x13, 0xb3, 0x55, 0x52, 0xf8,
-	0x95, 0xc5, 0x87, 0xdb, 0xee, 0x5d, 0x0b, 0x6a, 0x5e, 0xdd, 0xe3, 0xfe, 0xa4, 0x49, 0xce, 0xfe,
-	0x8b, 0x11, 0x98, 0xee, 0x89, 0xeb, 0x24, 0xaf, 0xc3, 0x84, 0x2b, 0x87, 0x47, 0x1b, 0x69, 0x5d,
-	0xbe, 0x8c, 0x11, 0x36, 0xa0, 0x61, 0x98, 0xc2, 0x1c, 0x62, 0x80, 0xae, 0xc0, 0x99, 0x90, 0x79,
-	0xb2, 0x1d, 0xba, 0x50, 0x8f, 0x69, 0xb8, 0x49, 0xdd, 0xc0, 0xaf, 0x89, 0x6c, 0xf5, 0x62, 0xf5,
-	0x99, 0x7b, 0x07, 0xb3, 0x67, 0xb0, 0x17, 0x8c, 0xfd, 0x9e, 0x21, 0x6d, 0x98, 0x6c, 0x9a, 0x46,
-	0x8e, 0xb4, 0x70, 0x1f, 0xca, 0x3e, 0x52, 0x8b, 0x60, 0xaa, 0x19, 0xd3, 0x0c, 0xd2, 0x96, 0x52,
-	0xe9, 0x31, 0x59, 0x4a, 0xbf, 0xaa, 0x2d, 0x25, 0x71, 0x36, 0xf8, 0xd1, 0x9c, 0xe3, 0x7a, 0x4f,
-	0xda, 0x54, 0x7a, 0x0b, 0xca, 0xc9, 0xb1, 0xfa, 0x10, 0xfa, 0xe6, 0xc5, 0x14, 0x9d, 0x01, 0x1a,
-	0xed, 0x7e, 0x01, 0xfa, 0x58, 0xd9, 0x6c, 0x9e, 0xe9, 0x25, 0x2d, 0x35, 0xcf, 0x8e, 0xb6, 0xac,
-	0x91, 0x7d, 0x11, 0x52, 0x20, 0x2c, 0xd3, 0x8f, 0xe4, 0xed, 0x25, 0xe8, 0x28, 0x83, 0x71, 0x29,
-	0x9f, 0x8a, 0x34, 0x20, 0x97, 0x00, 0xb4, 0x25, 0x22, 0xa3, 0xf7, 0xd4, 0x71, 0x98, 0x36, 0x58,
-	0xd0, 0xc0, 0x62, 0x4e, 0xa3, 0xe7, 0x47, 0xb1, 0xd3, 0x6c, 0x5e, 0xf5, 0xfc, 0x58, 0xee, 0x7e,
-	0xa9, 0x55, 0x6a, 0x45, 0x83, 0xd0, 0xc4, 0x3b, 0xff, 0x21, 0xe3, 0xbb, 0x1c, 0xe5, 0x7b, 0xee,
-	0xc0, 0xb3, 0xcb, 0x5e, 0xac, 0x62, 0x4e, 0xd5, 0x38, 0x62, 0x86, 0x86, 0x0a, 0x92, 0xb6, 0x06,
-	0x06, 0x49, 0x1b, 0x31, 0x9f, 0x85, 0x74, 0x88, 0x6a, 0x36, 0xe6, 0xd3, 0x7e, 0x1d, 0xce, 0x2e,
-	0x7b, 0xf1, 0x15, 0xaf, 0x49, 0x8f, 0xc8, 0xc4, 0xfe, 0xbd, 0x11, 0x98, 0x30, 0x83, 0xf8, 0x8f,
-	0x12, 0xe7, 0xfd, 0x25, 0x66, 0x4b, 0xc8, 0xb7, 0xf3, 0xd4, 0x39, 0xc7, 0xed, 0x63, 0x67, 0x14,
-	0xf4, 0xef, 0x31, 0xc3, 0x9c, 0xd0, 0x3c, 0xd1, 0x14, 0x80, 0xdc, 0x85, 0x52, 0x9d, 0xc7, 0x24,
-	0x16, 0xf3, 0x38, 0x71, 0xed, 0xd7, 0xa3, 0x7a, 0x9a, 0x89, 0xa8, 0x46, 0xc1, 0x8f, 0xad, 0x90,
-	0x61, 0x3a, 0x92, 0x5d, 0x29, 0x2a, 0x15, 0xc3, 0xae, 0x30, 0x06, 0xa9, 0xfa, 0xd2, 0x43, 0xa8,
-	0xfa, 0x94, 0xe2, 0x1d, 0x7d, 0x3c, 0x8a, 0xd7, 0xfe, 0x42, 0x01, 0xa6, 0x96, 0xfd, 0xce, 0xc6,
-	0xf2, 0x46, 0x67, 0xbb, 0xe9, 0xb9, 0xd7, 0x69, 0x97, 0x29, 0xa7, 0x5d, 0xda, 0x5d, 0x59, 0x92,
-	0x63, 0x48, 0xf5, 0xda, 0x75, 0xd6, 0x88, 0x02, 0xc6, 0xa6, 0x63, 0xdd, 0xf3, 0x1b, 0x34, 0x6c,
-	0x87, 0x9e, 0xdc, 0xd5, 0x32, 0xa6, 0xe3, 0x15, 0x0d, 0x42, 0x13, 0x8f, 0xd1, 0x0e, 0xee, 0xfa,
-	0x34, 0xcc, 0x9a, 0x72, 0xeb, 0xac, 0x11, 0x05, 0x8c, 0x21, 0xc5, 0x61, 0x27, 0x8a, 0xe5, 0xe7,
-	0x50, 0x48, 0x5b, 0xac, 0x11, 0x05, 0x8c, 0x8d, 0xf5, 0xa8, 0xb3, 0xcd, 0x8f, 0x74, 0x33, 0xc1,
-	0x7c, 0x9b, 0xa2, 0x19, 0x13, 0x38, 0x43, 0xdd, 0xa5, 0xdd, 0x25, 0xe6, 0xd8, 0x64, 0xc2, 0x6d,
-	0xaf, 0x8b, 0x66, 0x4c, 0xe0, 0xbc, 0x3e, 0x40, 0xba, 0x3b, 0x7e, 0xe4, 0xea, 0x03, 0xa4, 0xc5,
-	0x1f, 0xe0, 0x22, 0x7d, 0xd3, 0x82, 0x09, 0x33, 0x10, 0x83, 0x34, 0x32, 0x56, 0xde, 0x7a, 0x4f,
-	0xad, 0x97, 0x9f, 0xed, 0x57, 0x7e, 0xb8, 0xe1, 0xc5, 0x41, 0x3b, 0x7a, 0x85, 0xfa, 0x0d, 0xcf,
-	0xa7, 0xfc, 0xe8, 0x4f, 0x04, 0x70, 0xa4, 0xa2, 0x3c, 0x16, 0x83, 0x1a, 0x7d, 0x08, 0x33, 0xd1,
-	0xbe, 0x0d, 0xd3, 0x3d, 0x31, 0xd6, 0x43, 0x2c, 0xae, 0x87, 0xa6, 0xb0, 0xd8, 0x08, 0xe3, 0x8c,
-	0xf0, 0x7a, 0x5b, 0x44, 0x5a, 0x2c, 0xc2, 0xb4, 0x30, 0x00, 0x18, 0xa7, 0x4d, 0x77, 0x87, 0xb6,
-	0x54, 0xdc, 0x3c, 0xdf, 0x42, 0xbd, 0x95, 0x05, 0x62, 0x2f, 0xbe, 0xfd, 0x45, 0x0b, 0x26, 0x53,
-	0x61, 0xef, 0x39, 0x99, 0x01, 0x7c, 0xa6, 0x05, 0x3c, 0x2e, 0x28, 0xf4, 0x7c, 0x71, 0x0a, 0x56,
-	0x36, 0x66, 0x9a, 0x06, 0xa1, 0x89, 0x67, 0x7f, 0xb5, 0x00, 0xe5, 0xe4, 0xd8, 0x77, 0x08, 0x51,
-	0x3e, 0x6f, 0xc1, 0xa4, 0xda, 0xb6, 0xe6, 0xfb, 0x21, 0x62, 0x30, 0xde, 0x38, 0xfe, 0xc1, 0xb3,
-	0x0a, 0x1e, 0xf3, 0xeb, 0x81, 0xb6, 0x49, 0xd1, 0x64, 0x86, 0x69, 0xde, 0xe4, 0x16, 0x40, 0xd4,
-	0x8d, 0x62, 0xda, 0x32, 0x76, 0x66, 0x6c, 0x63, 0xc6, 0xcd, 0xb9, 0x41, 0x48, 0xd9, 0xfc, 0xba,
-	0x11, 0xd4, 0xe8, 0xa6, 0xc2, 0xd4, 0x46, 0x84, 0x6e, 0x43, 0x83, 0x92, 0xfd, 0x4f, 0x0a, 0x70,
-	0x3a, 0x2b, 0x12, 0xf9, 0x28, 0x4c, 0x24, 0xdc, 0x8d, 0xfa, 0xcb, 0xc9, 0x59, 0xf7, 0x04, 0x1a,
-	0xb0, 0xfb, 0x07, 0xb3, 0xb3, 0xbd, 0xa5, 0xac, 0xe7, 0x4c, 0x14, 0x4c, 0x11, 0x13, 0x67, 0x07,
-	0xf2, 0x90, 0xab, 0xda, 0x5d, 0x68, 0xb7, 0xe5, 0x01, 0x80, 0x71, 0x76, 0x60, 0x42, 0x31, 0x83,
-	0x4d, 0x36, 0xe0, 0xac, 0xd1, 0x72, 0x83, 0x7a, 0x8d, 0x9d, 0xed, 0x20, 0x4c, 0x7c, 0x8b, 0xe7,
-	0x75, 0xc8, 0x47, 0x2f, 0x0e, 0xf6, 0x7d, 0x92, 0xad, 0x77, 0xae, 0xd3, 0x76, 0x5c, 0x2f, 0xee,
-	0xca, 0xad, 0x26, 0xa5, 0x9b, 0x16, 0x65, 0x3b, 0x2a, 0x0c, 0x7b, 0x0d, 0x46, 0x86, 0x1c, 0x41,
-	0x43, 0xd9, 0xb4, 0x6f, 0x41, 0x99, 0x91, 0x4b, 0x0c, 0x9c, 0x3c, 0x48, 0x06, 0x50, 0x4e, 0x4a,
-	0x28, 0x12, 0x1b, 0x8a, 0x9e, 0x93, 0x1c, 0xcf, 0xa8, 0xd7, 0x5a, 0x89, 0xa2, 0x0e, 0x77, 0x13,
-	0x19, 0x90, 0xbc, 0x08, 0x45, 0xba, 0xdf, 0xce, 0x9e, 0xc3, 0x5c, 0xde, 0x6f, 0x7b, 0x21, 0x8d,
-	0x18, 0x12, 0xdd, 0x6f, 0x93, 0xf3, 0x50, 0xf0, 0x6a, 0x72, 0x91, 0x02, 0x89, 0x53, 0x58, 0x59,
-	0xc2, 0x82, 0x57, 0xb3, 0xf7, 0xa1, 0xa2, 0x6a, 0x36, 0x92, 0xdd, 0x44, 0x77, 0x5b, 0x79, 0xc4,
-	0x69, 0x24, 0x74, 0x07, 0x68, 0xed, 0x0e, 0x80, 0x4e, 0x32, 0xc8, 0x4b, 0xbf, 0x5c, 0x84, 0x11,
-	0x37, 0x90, 0xb9, 0x49, 0x65, 0x4d, 0x86, 0x2b, 0x6d, 0x0e, 0xb1, 0x6f, 0xc3, 0xd4, 0x75, 0x3f,
-	0xb8, 0xcb, 0x2b, 0x97, 0x5d, 0xf1, 0x68, 0xb3, 0xc6, 0x08, 0xd7, 0xd9, 0x8f, 0xac, 0x89, 0xc0,
-	0xa1, 0x28, 0x60, 0xaa, 0xb0, 0x61, 0x61, 0x50, 0x61, 0x43, 0xfb, 0xd3, 0x16, 0x9c, 0x56, 0xd1,
-	0xef, 0x89, 0x36, 0x7e, 0x1d, 0x26, 0xb6, 0x3b, 0x5e, 0xb3, 0x26, 0xff, 0x67, 0x1d, 0xf5, 0xaa,
-	0x01, 0xc3, 0x14, 0x26, 0x73, 0x2b, 0xb6, 0x3d, 0xdf, 0x09, 0xbb, 0x1b, 0x5a, 0xfd, 0x2b, 0x8d,
-	0x50, 0x55, 0x10, 0x34, 0xb0, 0xec, 0xcf, 0x16, 0x60, 0x32, 0x95, 0xec, 0x4b, 0x9a, 0x50, 0xa6,
-	0x4d, 0xbe, 0x7d, 0x94, 0x7c, 0xd4, 0xe3, 0x96, 0xec, 0x50, 0x03, 0xf1, 0xb2, 0xa4, 0x8b, 0x8a,
-	0xc3, 0x13, 0x71, 0x4e, 0x61, 0xff, 0xc3, 0x02, 0x9c, 0xca, 0xd4, 0x82, 0x22, 0x5f, 0x4e, 0xd7,
-	0xea, 0xb0, 0xf2, 0xf0, 0xca, 0x1f, 0x58, 0x91, 0xe8, 0x68, 0x15, 0x3b, 0x1e, 0x57, 0x57, 0xfd,
-	0x61, 0x01, 0xa6, 0xd2, 0x45, 0xac, 0x9e, 0xc0, 0x9e, 0xfa, 0x00, 0x54, 0x78, 0x69, 0x18, 0x5e,
-	0x53, 0x59, 0x38, 0xff, 0x3c, 0x19, 0x74, 0x2d, 0x69, 0x44, 0x0d, 0x7f, 0x22, 0x0a, 0xa1, 0xd8,
-	0xff, 0xc8, 0x82, 0x73, 0xe2, 0x2d, 0xb3, 0xe3, 0xf0, 0x6f, 0xf5, 0xeb, 0xdd, 0x8f, 0xe5, 0x2b,
-	0x60, 0xa6, 0x94, 0xc0, 0x61, 0xfd, 0xcb, 0x6b, 0xb9, 0x4a, 0x69, 0xd3, 0x43, 0xe1, 0x09, 0x14,
-	0xf6, 0x48, 0x83, 0xc1, 0xfe, 0xc3, 0x22, 0xe8, 0xf2, 0xb5, 0xc4, 0x93, 0x51, 0xe6, 0xb9, 0x94,
-	0x54, 0xd8, 0xec, 0xfa, 0xae, 0x2e, 0x94, 0x5b, 0xce, 0x04, 0x99, 0xff, 0x86, 0x05, 0xe3, 0x9e,
-	0xef, 0xc5, 0x9e, 0xc3, 0xcd, 0x95, 0x7c, 0xea, 0x8b, 0x2a, 0x76, 0x2b, 0x82, 0x72, 0x10, 0x9a,
-	0x3b, 0x46, 0x8a, 0x19, 0x9a, 0x9c, 0xc9, 0x27, 0x65, 0x1c, 0x52, 0x31, 0xb7, 0x1c, 0x85, 0x72,
-	0x26, 0xf8, 0xa8, 0x0d, 0xa5, 0x90, 0xc6, 0x61, 0x92, 0x1d, 0x72, 0xfd, 0xb8, 0xc1, 0xa5, 0x71,
-	0xd8, 0xdd, 0x8c, 0x43, 0x27, 0xa6, 0x0d, 0xc3, 0xdd, 0xe3, 0xcd, 0x28, 0x18, 0xd9, 0x11, 0x90,
-	0xde, 0xbe, 0x38, 0x62, 0x8c, 0xc7, 0x3c, 0x54, 0x9c, 0x4e, 0x1c, 0xb4, 0x58, 0x37, 0xc9, 0x4d,
-	0x2d, 0x1d, 0xc5, 0x92, 0x00, 0x50, 0xe3, 0xd8, 0x5f, 0x2e, 0x41, 0x26, 0xec, 0x9b, 0xec, 0x9b,
-	0xa5, 0x97, 0xad, 0x7c, 0x4b, 0x2f, 0x2b, 0x61, 0xfa, 0x95, 0x5f, 0x26, 0x0d, 0x28, 0xb5, 0x77,
-	0x9c, 0x28, 0xb1, 0x46, 0xde, 0x4a, 0xba, 0x69, 0x83, 0x35, 0xde, 0x3f, 0x98, 0xfd, 0xb9, 0xe1,
-	0xbc, 0x5b, 0x36, 0x56, 0xe7, 0x45, 0xce, 0x9d, 0x66, 0xcd, 0x69, 0xa0, 0xa0, 0x6f, 0xfa, 0xb7,
-	0xc5, 0x43, 0x8e, 0x41, 0x3e, 0x23, 0xab, 0x3f, 0x21, 0x8d, 0x3a, 0xcd, 0x58, 0x8e, 0x86, 0xb7,
-	0x72, 0x9c, 0x65, 0x82, 0xb0, 0x4e, 0x1a, 0x12, 0xff, 0xd1, 0x60, 0x4a, 0x3e, 0x0a, 0x95, 0x28,
-	0x76, 0xc2, 0xf8, 0x21, 0x53, 0x0c, 0x54, 0xa7, 0x6f, 0x26, 0x44, 0x50, 0xd3, 0x23, 0x6f, 0xf3,
-	0x0a, 0x33, 0x5e, 0xb4, 0xf3, 0x90, 0xe1, 0x83, 0x49, 0x35, 0x1a, 0x49, 0x01, 0x0d, 0x6a, 0xcc,
-	0xd8, 0xe3, 0x63, 0x5b, 0x9c, 0x99, 0x97, 0xb9, 0x35, 0xaf, 0x54, 0x21, 0x2a, 0x08, 0x1a, 0x58,
-	0xf6, 0xa7, 0xe0, 0x4c, 0xf6, 0xae, 0x06, 0xb9, 0xe1, 0xd5, 0x08, 0x83, 0x4e, 0x3b, 0x6b, 0xcd,
-	0xf2, 0x5a, 0xfe, 0x28, 0x60, 0xcc, 0x9a, 0xdd, 0xf5, 0xfc, 0x5a, 0xd6, 0x9a, 0xbd, 0xee, 0xf9,
-	0x35, 0xe4, 0x90, 0x21, 0x6a, 0x52, 0xff, 0x6b, 0x0b, 0x2e, 0x1e, 0x76, 0xa5, 0x04, 0x79, 0x1e,
-	0x46, 0xee, 0x3a, 0x61, 0x52, 0xb1, 0x8a, 0xeb, 0x8e, 0xdb, 0x4e, 0xe8, 0x23, 0x6f, 0x25, 0x5d,
-	0x18, 0x15, 0x69, 0x55, 0xd2, 0x3f, 0x7f, 0x2b, 0xdf, 0x0b, 0x2e, 0xae, 0x53, 0xe3, 0x74, 0x44,
-	0xa4, 0x74, 0xa1, 0x64, 0x68, 0x7f, 0xdf, 0x02, 0xb2, 0xbe, 0x47, 0xc3, 0xd0, 0xab, 0x19, 0x89,
-	0x60, 0xe4, 0x35, 0x98, 0xb8, 0xb3, 0xb9, 0x7e, 0x63, 0x23, 0xf0, 0x7c, 0x9e, 0x5b, 0x6f, 0xa4,
-	0x04, 0x5c, 0x33, 0xda, 0x31, 0x85, 0x45, 0x16, 0x61, 0xfa, 0xce, 0x3b, 0xcc, 0x02, 0xbf, 0xbc,
-	0xdf, 0x0e, 0x69, 0x14, 0xa9, 0x6b, 0x61, 0xe4, 0x9e, 0xcb, 0xb5, 0xb7, 0x32, 0x40, 0xec, 0xc5,
-	0x27, 0xeb, 0x70, 0xae, 0xc5, 0x0f, 0x7b, 0x6b, 0xdc, 0xf1, 0x88, 0xc4, 0xc9, 0x6f, 0x98, 0x24,
-	0x2f, 0x3f, 0x7b, 0xef, 0x60, 0xf6, 0xdc, 0x5a, 0x3f, 0x04, 0xec, 0xff, 0x9c, 0xfd, 0xad, 0x02,
-	0x8c, 0x1b, 0xd7, 0xb2, 0x0c, 0xe1, 0x62, 0x65, 0x6e, 0x92, 0x29, 0x0c, 0x79, 0x93, 0xcc, 0x4b,
-	0x50, 0x6e, 0x07, 0x4d, 0xcf, 0xf5, 0x54, 0xa6, 0x35, 0xaf, 0xf8, 0xb3, 0x21, 0xdb, 0x50, 0x41,
-	0xc9, 0x5d, 0xa8, 0xa8, 0xab, 0x0a, 0x64, 0x2e, 0x54, 0x5e, 0x4e, 0xa6, 0x9a, 0xbc, 0xfa, 0x0a,
-	0x02, 0xcd, 0x8b, 0xd8, 0x30, 0xca, 0x47, 0x7e, 0x12, 0x4d, 0xc2, 0x03, 0xde, 0xf9, 0x94, 0x88,
-	0x50, 0x42, 0xec, 0x5f, 0x1f, 0x83, 0xb3, 0xfd, 0xaa, 0xd4, 0x90, 0x5f, 0x82, 0x51, 0x21, 0x63,
-	0x3e, 0x85, 0xd0, 0xfa, 0xf1, 0x58, 0xe6, 0x04, 0xa5, 0x58, 0xfc, 0x37, 0x4a, 0x9e, 0x92, 0x7b,
-	0xd3, 0xd9, 0x96, 0x66, 0xc4, 0xc9, 0x70, 0x5f, 0x75, 0x34, 0xf7, 0x55, 0x47, 0x70, 0x6f, 0x3a,
-	0xdb, 0x64, 0x1f, 0x4a, 0x0d, 0x2f, 0xa6, 0x8e, 0x34, 0xa6, 0x6f, 0x9f, 0x08, 0x73, 0xea, 0x88,
-	0xa0, 0x65, 0xfe, 0x13, 0x05, 0x43, 0xf2, 0x0d, 0x0b, 0x4e, 0x6d, 0xa7, 0xf3, 0x07, 0xe4, 0xaa,
-	0xe2, 0x9c, 0x40, 0x25, 0xa2, 0x34, 0xa3, 0xea, 0x99, 0x7b, 0x07, 0xb3, 0xa7, 0x32, 0x8d, 0x98,
-	0x15, 0x87, 0xfc, 0xaa, 0x05, 0x63, 0x75, 0xaf, 0x69, 0x54, 0xe1, 0x38, 0x81, 0x8f, 0x73, 0x85,
-	0x33, 0xd0, 0x2b, 0xaf, 0xf8, 0x1f, 0x61, 0xc2, 0x79, 0xd0, 0x29, 0xce, 0xe8, 0x71, 0x4f, 0x71,
-	0xc6, 0x1e, 0x93, 0xfb, 0xf4, 0x77, 0x0a, 0xf0, 0xe2, 0x10, 0xdf, 0xc8, 0x8c, 0x47, 0xb7, 0x0e,
-	0x89, 0x47, 0xbf, 0x08, 0x23, 0x21, 0x6d, 0x07, 0xd9, 0xf5, 0x8e, 0x07, 0x8c, 0x70, 0x08, 0x79,
-	0x01, 0x8a, 0x4e, 0xdb, 0x93, 0xcb, 0x9d, 0x3a, 0xe4, 0x5d, 0xd8, 0x58, 0x41, 0xd6, 0xce, 0xbe,
-	0x74, 0x65, 0x3b, 0xc9, 0x6a, 0xc9, 0xa7, 0xb4, 0xe5, 0xa0, 0x24, 0x19, 0xe1, 0xd0, 0x28, 0x28,
-	0x6a, 0xbe, 0xf6, 0x3a, 0x9c, 0x1f, 0x3c, 0x42, 0xc8, 0xab, 0x30, 0xbe, 0x1d, 0x3a, 0xbe, 0xbb,
-	0xb3, 0xe6, 0xc4, 0x6e, 0x72, 0xd4, 0xca, 0xc3, 0xe6, 0xaa, 0xba, 0x19, 0x4d, 0x1c, 0xfb, 0x0f,
-	0x0a, 0xfd, 0x29, 0x0a, 0x25, 0x70, 0x94, 0x1e, 0x96, 0xfd, 0x57, 0x18, 0xd0, 0x7f, 0xef, 0x40,
-	0x39, 0xe6, 0x41, 0xd0, 0xb4, 0x2e, 0x35, 0x49, 0x6e, 0x79, 0x3c, 0x7c, 0xad, 0xd9, 0x92, 0xc4,
-	0x51, 0xb1, 0x61, 0x2a, 0xbf, 0xa9, 0x0b, 0x78, 0x48, 0x95, 0x9f, 0x49, 0x16, 0x58, 0x82, 0xd3,
-	0x46, 0xc1, 0x31, 0x11, 0x03, 0x2a, 0x0e, 0xe0, 0x54, 0x62, 0xc4, 0x46, 0x06, 0x8e, 0x3d, 0x4f,
-	0xd8, 0xdf, 0x2c, 0xc0, 0xb3, 0x03, 0x35, 0x9b, 0x3e, 0x25, 0xb4, 0x1e, 0x70, 0x4a, 0x78, 0xec,
-	0x01, 0x6a, 0x76, 0xf0, 0xc8, 0xa3, 0xe9, 0xe0, 0x97, 0xa1, 0xec, 0xf9, 0x11, 0x75, 0x3b, 0xa1,
-	0xe8, 0x34, 0x23, 0x1a, 0x6b, 0x45, 0xb6, 0xa3, 0xc2, 0xb0, 0xff, 0x68, 0xf0, 0x50, 0x63, 0xab,
-	0xdc, 0x8f, 0x6d, 0x2f, 0xbd, 0x01, 0x93, 0x4e, 0xbb, 0x2d, 0xf0, 0xf8, 0x89, 0x4c, 0x26, 0xd5,
-	0x69, 0xc1, 0x04, 0x62, 0x1a, 0xd7, 0x18, 0xc3, 0xa3, 0x83, 0xc6, 0xb0, 0xfd, 0x67, 0x25, 0xa8,
-	0xb0, 0x1e, 0x58, 0x0c, 0x69, 0x2d, 0x62, 0x1d, 0xd0, 0x09, 0x9b, 0xb2, 0x17, 0x55, 0x07, 0xdc,
-	0xc4, 0x55, 0x64, 0xed, 0x29, 0x2f, 0xb9, 0x70, 0xa4, 0x4c, 0x88, 0xe2, 0xa1, 0x99, 0x10, 0x6f,
-	0xc0, 0x64, 0x14, 0xed, 0x6c, 0x84, 0xde, 0x9e, 0x13, 0x33, 0xdb, 0x5b, 0x9e, 0x78, 0xeb, 0xe8,
-	0xe5, 0xcd, 0xab, 0x1a, 0x88, 0x69, 0x5c, 0xb2, 0x0c, 0xd3, 0x3a, 0x1f, 0x81, 0x86, 0x31, 0x3f,
-	0xe0, 0x16, 0x5d, 0xa5, 0x82, 0x87, 0x75, 0x06, 0x83, 0x44, 0xc0, 0xde, 0x67, 0xd8, 0x94, 0x4e,
-	0x35, 0x32, 0x41, 0x46, 0xd3, 0x53, 0x3a, 0x45, 0x87, 0xc9, 0xd2, 0xf3, 0x04, 0x59, 0x83, 0x33,
-	0x62, 0x5c, 0xf0, 0x9b, 0xb9, 0xd4, 0x1b, 0x8d, 0x71, 0x42, 0xcf, 0x49, 0x42, 0x67, 0x96, 0x7b,
-	0x51, 0xb0, 0xdf, 0x73, 0xcc, 0xb0, 0x56, 0xcd, 0x2b, 0x4b, 0xd2, 0xc1, 0x53, 0x86, 0xb5, 0x22,
-	0xb3, 0x52, 0x43, 0x13, 0x8f, 0x7c, 0x04, 0x9e, 0xd1, 0x7f, 0x45, 0x1c, 0x90, 0xd8, 0xf5, 0x58,
-	0x92, 0xa9, 0x5e, 0xaa, 0xd8, 0xd5, 0x72, 0x5f, 0xb4, 0x1a, 0x0e, 0x7a, 0x9e, 0x6c, 0xc3, 0x79,
-	0x05, 0xba, 0xcc, 0xbc, 0x98, 0x76, 0xe8, 0x45, 0xb4, 0xea, 0x44, 0xf4, 0x66, 0xd8, 0xe4, 0xc9,
-	0x61, 0x15, 0x5d, 0x96, 0x77, 0xd9, 0x8b, 0xaf, 0xf6, 0xc3, 0xc4, 0x55, 0x7c, 0x00, 0x15, 0x32,
-	0x0f, 0x15, 0xea, 0x3b, 0xdb, 0x4d, 0xba, 0xbe, 0xb8, 0xc2, 0x53, 0xc6, 0x8c, 0x4d, 0x96, 0xcb,
-	0x09, 0x00, 0x35, 0x8e, 0x3a, 0x64, 0x99, 0x18, 0x78, 0xc8, 0xf2, 0x27, 0x16, 0x4c, 0xaa, 0xc1,
-	0xfe, 0x08, 0xa2, 0x19, 0x9a, 0xe9, 0x68, 0x86, 0xe5, 0xe3, 0xee, 0x6e, 0x49, 0xc9, 0x07, 0x1c,
-	0x89, 0xfd, 0x69, 0x05, 0x80, 0x5f, 0x32, 0xea, 0xf1, 0xea, 0x0d, 0x89, 0xba, 0xb3, 0x06, 0xaa,
-	0xbb, 0x27, 0x76, 0x3a, 0xf7, 0x4b, 0xae, 0x28, 0x3d, 0xde, 0xe4, 0x8a, 0x4d, 0x38, 0x97, 0x2c,
-	0x46, 0xc2, 0xe1, 0xbf, 0x1a, 0x44, 0x4a, 0x3b, 0x94, 0xab, 0x2f, 0x48, 0x42, 0xe7, 0x56, 0xfa,
-	0x21, 0x61, 0xff, 0x67, 0x53, 0x6b, 0xe0, 0xd8, 0x61, 0x6b, 0xa0, 0x9e, 0x10, 0xab, 0xf5, 0xa4,
-	0xee, 0x54, 0x66, 0x42, 0xac, 0x5e, 0xd9, 0x44, 0x8d, 0xd3, 0x5f, 0x2b, 0x56, 0x72, 0xd2, 0x8a,
-	0x70, 0x64, 0xad, 0x98, 0xcc, 0xcf, 0xf1, 0x81, 0xb7, 0xbb, 0x25, 0x7b, 0x0c, 0x13, 0x03, 0xf7,
-	0x18, 0xde, 0x84, 0x29, 0xcf, 0xdf, 0xa1, 0xa1, 0x17, 0xd3, 0x1a, 0x9f, 0x0b, 0xf2, 0xea, 0x46,
-	0x15, 0x43, 0xb0, 0x92, 0x82, 0x62, 0x06, 0x3b, 0xad, 0x54, 0xa6, 0x86, 0x50, 0x2a, 0x03, 0x54,
-	0xf9, 0xa9, 0x7c, 0x54, 0xf9, 0xe9, 0xe3, 0xab, 0xf2, 0xe9, 0x13, 0x55, 0xe5, 0x24, 0x17, 0x55,
-	0xfe, 0x22, 0x94, 0xda, 0x61, 0xb0, 0xdf, 0x9d, 0x39, 0x93, 0x36, 0xcf, 0x36, 0x58, 0x23, 0x0a,
-	0x98, 0xe9, 0x2e, 0x9c, 0x7d, 0xb0, 0xbb, 0x60, 0x7f, 0xae, 0x00, 0xe7, 0xb4, 0xa6, 0x63, 0xe3,
-	0xcb, 0xab, 0xb3, 0xb9, 0xce, 0x8b, 0x03, 0x8a, 0x83, 0x68, 0x23, 0x7c, 0x45, 0x47, 0xc2, 0x28,
-	0x08, 0x1a, 0x58, 0x3c, 0x0a, 0x84, 0x86, 0xbc, 0x3a, 0x43, 0x56, 0x0d, 0x2e, 0xca, 0x76, 0x54,
-	0x18, 0xfc, 0x86, 0x72, 0x1a, 0xc6, 0x32, 0xb2, 0x2e, 0x9b, 0xb1, 0xb9, 0xa8, 0x41, 0x68, 0xe2,
-	0x91, 0x97, 0x04, 0x13, 0x3e, 0x05, 0x99, 0x2a, 0x9c, 0x90, 0x75, 0xad, 0x93, 0x59, 0xa7, 0xa0,
-	0x89, 0x38, 0x3c, 0xdc, 0xa7, 0xd4, 0x2b, 0x0e, 0x3f, 0x3c, 0x51, 0x18, 0xf6, 0xff, 0xb5, 0xe0,
-	0xd9, 0xbe, 0x5d, 0xf1, 0x08, 0x96, 0xb7, 0xfd, 0xf4, 0xf2, 0xb6, 0x79, 0xfc, 0xe5, 0xad, 0xe7,
-	0x2d, 0x06, 0x2c, 0x75, 0xff, 0xd9, 0x82, 0x29, 0x8d, 0xff, 0x08, 0x5e, 0xd5, 0xcb, 0xf5, 0xae,
-	0x71, 0x2d, 0xba, 0xd8, 0xb9, 0x4a, 0xbd, 0xdb, 0x9f, 0xf0, 0x77, 0x13, 0x7b, 0xd0, 0x0b, 0x6e,
-	0x72, 0x2f, 0xe6, 0x21, 0x7b, 0xaf, 0x5d, 0x18, 0xe5, 0x55, 0x64, 0xa3, 0x7c, 0xf6, 0xc2, 0xd3,
-	0xfc, 0x79, 0x1c, 0x9f, 0xde, 0x0b, 0xe7, 0x7f, 0x23, 0x94, 0x0c, 0x79, 0xed, 0x10, 0x2f, 0x62,
-	0xfa, 0xb2, 0x26, 0x03, 0x67, 0x74, 0xed, 0x10, 0xd9, 0x8e, 0x0a, 0xc3, 0x6e, 0xc1, 0x4c, 0x9a,
-	0xf8, 0x12, 0xad, 0xf3, 0x23, 0xc7, 0xa1, 0x5e, 0x73, 0x1e, 0x2a, 0x0e, 0x7f, 0x6a, 0xb5, 0xe3,
-	0x64, 0xaf, 0x42, 0x58, 0x48, 0x00, 0xa8, 0x71, 0xec, 0xdf, 0xb5, 0xe0, 0x4c, 0x9f, 0x97, 0xc9,
-	0x31, 0x60, 0x28, 0xd6, 0x5a, 0x60, 0xc0, 0x85, 0xa5, 0x35, 0x5a, 0x77, 0x92, 0x43, 0x2d, 0x43,
-	0xab, 0x2d, 0x89, 0x66, 0x4c, 0xe0, 0xf6, 0xff, 0xb6, 0xe0, 0x54, 0x5a, 0xd6, 0x88, 0x5c, 0x03,
-	0x22, 0x5e, 0x66, 0xc9, 0x8b, 0xdc, 0x60, 0x8f, 0x86, 0x5d, 0xf6, 0xe6, 0x42, 0xea, 0xf3, 0x92,
-	0x12, 0x59, 0xe8, 0xc1, 0xc0, 0x3e, 0x4f, 0xf1, 0xda, 0x06, 0x35, 0xd5, 0xdb, 0xc9, 0x48, 0xb9,
-	0x95, 0xe7, 0x48, 0xd1, 0x1f, 0xd3, 0xdc, 0xf8, 0x57, 0x2c, 0xd1, 0xe4, 0x6f, 0x7f, 0x7f, 0x04,
-	0x54, 0x44, 0x21, 0x3f, 0x3e, 0xc9, 0xe9, 0xf0, 0x29, 0x75, 0x5f, 0x46, 0xf1, 0x08, 0x37, 0xa8,
-	0x8e, 0x3c, 0xe8, 0x68, 0x43, 0x94, 0x6e, 0x37, 0x37, 0x79, 0xd4, 0x1b, 0x6e, 0x69, 0x10, 0x9a,
-	0x78, 0x4c, 0x92, 0xa6, 0xb7, 0x47, 0xc5, 0x43, 0xa3, 0x69, 0x49, 0x56, 0x13, 0x00, 0x6a, 0x1c,
-	0x26, 0x49, 0xcd, 0xab, 0xd7, 0xa5, 0xa7, 0xa8, 0x24, 0x61, 0xbd, 0x83, 0x1c, 0xc2, 0x30, 0x76,
-	0x82, 0x60, 0x57, 0xda, 0x7f, 0x0a, 0xe3, 0x6a, 0x10, 0xec, 0x22, 0x87, 0x30, 0x8b, 0xc5, 0x0f,
-	0xc2, 0x16, 0xbf, 0xaa, 0xa2, 0xa6, 0xb8, 0x48, 0xbb, 0x4f, 0x59, 0x2c, 0x37, 0x7a, 0x51, 0xb0,
-	0xdf, 0x73, 0x6c, 0x04, 0xb6, 0x43, 0x5a, 0xf3, 0xdc, 0xd8, 0xa4, 0x06, 0xe9, 0x11, 0xb8, 0xd1,
-	0x83, 0x81, 0x7d, 0x9e, 0x22, 0x0b, 0x70, 0x2a, 0x89, 0x08, 0x4d, 0x32, 0x5e, 0x84, 0x31, 0xa8,
-	0xec, 0x70, 0x4c, 0x83, 0x31, 0x8b, 0xcf, 0xb4, 0x4d, 0x4b, 0x26, 0xbb, 0x71, 0x33, 0xd1, 0xd0,
-	0x36, 0x49, 0x12, 0x1c, 0x2a, 0x0c, 0xfb, 0x33, 0x45, 0xb6, 0x3a, 0x0e, 0x28, 0xd8, 0xf8, 0xc8,
-	0x0e, 0x3b, 0xd3, 0x23, 0x72, 0x64, 0x88, 0x11, 0xf9, 0x1a, 0x4c, 0xdc, 0x89, 0x02, 0x5f, 0x1d,
-	0x24, 0x96, 0x06, 0x1e, 0x24, 0x1a, 0x58, 0xfd, 0x0f, 0x12, 0x47, 0xf3, 0x3a, 0x48, 0x1c, 0x7b,
-	0xc8, 0x83, 0xc4, 0x7f, 0x57, 0x02, 0x55, 0x6e, 0xed, 0x06, 0x8d, 0xef, 0x06, 0xe1, 0xae, 0xe7,
-	0x37, 0x78, 0x24, 0xed, 0x37, 0x2c, 0x98, 0x10, 0xf3, 0x45, 0xd6, 0xe6, 0x15, 0x51, 0x42, 0xf5,
-	0x9c, 0x4a, 0x8c, 0xa5, 0x98, 0xcd, 0x6d, 0x19, 0x8c, 0x32, 0x85, 0x92, 0x4d, 0x10, 0xa6, 0x24,
-	0x22, 0xbf, 0x0c, 0x90, 0x5c, 0xda, 0x50, 0xcf, 0xe9, 0x3a, 0x63, 0x75, 0x85, 0x06, 0xad, 0x6b,
-	0xdb, 0x74, 0x4b, 0x31, 0x41, 0x83, 0x21, 0xf9, 0x5c, 0xf6, 0x2a, 0x9f, 0x4f, 0x9e, 0x48, 0xdf,
-	0x0c, 0x53, 0x1a, 0x07, 0x61, 0xcc, 0xf3, 0x1b, 0x6c, 0x9c, 0xc8, 0xb3, 0xd7, 0xf7, 0xf7, 0x8b,
-	0x42, 0x5f, 0x0d, 0x9c, 0x5a, 0xd5, 0x69, 0x3a, 0xbe, 0x4b, 0xc3, 0x15, 0x81, 0x6e, 0x56, 0xee,
-	0xe7, 0x0d, 0x98, 0x10, 0xea, 0xa9, 0xa1, 0x57, 0x1a, 0xa6, 0x86, 0xde, 0xf9, 0x0f, 0xc3, 0x74,
-	0xcf, 0xc7, 0x3c, 0x52, 0x69, 0x9c, 0x87, 0xaf, 0xaa, 0x63, 0xff, 0x87, 0x8a, 0x5e, 0xb4, 0x6e,
-	0x04, 0x35, 0x51, 0xc9, 0x2d, 0xd4, 0x5f, 0x54, 0xda, 0x9e, 0x39, 0x0e, 0x11, 0xa3, 0xfa, 0xbf,
-	0x6a, 0x44, 0x93, 0x25, 0x1b, 0xa3, 0x6d, 0x27, 0xa4, 0xfe, 0x49, 0x8f, 0xd1, 0x0d, 0xc5, 0x04,
-	0x0d, 0x86, 0x64, 0x27, 0x15, 0x25, 0x76, 0xe5, 0xf8, 0x51, 0x62, 0x3c, 0x43, 0xad, 0x5f, 0xa9,
-	0xaa, 0xaf, 0x58, 0x30, 0xe5, 0xa7, 0x46, 0xae, 0xdc, 0x87, 0xdf, 0x3a, 0x89, 0x59, 0x21, 0xaa,
-	0x75, 0xa6, 0xdb, 0x30, 0xc3, 0xbf, 0xdf, 0x92, 0x56, 0x3a, 0xe2, 0x92, 0xa6, 0x4b, 0x42, 0x8e,
-	0x0e, 0x2a, 0x09, 0x49, 0x7c, 0x55, 0x78, 0x76, 0x2c, 0xf7, 0xc2, 0xb3, 0xd0, 0xa7, 0xe8, 0xec,
-	0x6d, 0xa8, 0xb8, 0x21, 0x75, 0xe2, 0x87, 0xac, 0x41, 0xca, 0x0f, 0x21, 0x17, 0x13, 0x02, 0xa8,
-	0x69, 0x91, 0x4f, 0x29, 0x7d, 0x56, 0xc9, 0xd3, 0xfc, 0x64, 0x53, 0x71, 0x28, 0x2d, 0xf6, 0xd5,
-	0x4c, 0x81, 0x2f, 0xc8, 0x23, 0x44, 0x39, 0x25, 0xc5, 0x8f, 0x56, 0x95, 0xaf, 0xff, 0x54, 0x84,
-	0xd3, 0x89, 0xf8, 0x49, 0x44, 0x13, 0xb3, 0x57, 0xc4, 0x38, 0xd0, 0xce, 0x86, 0xb2, 0x57, 0xae,
-	0x26, 0x00, 0xd4, 0x38, 0xcc, 0x3e, 0xee, 0x44, 0x74, 0xbd, 0x4d, 0xfd, 0x55, 0x6f, 0x3b, 0x92,
-	0xe7, 0x79, 0xea, 0xbd, 0x6f, 0x6a, 0x10, 0x9a, 0x78, 0xcc, 0x39, 0x12, 0x7e, 0x4a, 0x94, 0x0d,
-	0x10, 0x94, 0xfe, 0x0f, 0x26, 0x70, 0xf2, 0x9b, 0x7d, 0x2b, 0x7a, 0xe7, 0x13, 0x1a, 0xdb, 0x13,
-	0xc8, 0x75, 0xc4, 0x52, 0xde, 0x5f, 0xb6, 0xe0, 0xd4, 0x6e, 0x2a, 0x2b, 0x24, 0x59, 0x22, 0x8f,
-	0x99, 0xbf, 0x98, 0x4e, 0x35, 0xd1, 0x2a, 0x25, 0xdd, 0x1e, 0x61, 0x96, 0xbb, 0xfd, 0x7f, 0x2c,
-	0x30, 0x97, 0x8b, 0xe1, 0x2c, 0x5d, 0xe3, 0x4e, 0x88, 0xc2, 0x21, 0x77, 0x42, 0x24, 0x46, 0x71,
-	0x71, 0x38, 0x27, 0x6c, 0xe4, 0x08, 0x4e, 0x58, 0x69, 0xa0, 0x15, 0xfd, 0x02, 0x14, 0x3b, 0x5e,
-	0x4d, 0xfa, 0x51, 0xfa, 0x70, 0x72, 0x65, 0x09, 0x59, 0xbb, 0xfd, 0x2f, 0x4b, 0x7a, 0xdf, 0x44,
-	0x46, 0x74, 0xfe, 0x58, 0xbc, 0x76, 0x5d, 0xa5, 0xa3, 0x8a, 0x37, 0xbf, 0xd1, 0x93, 0x8e, 0xfa,
-	0x33, 0x47, 0x0f, 0xd8, 0x15, 0x1d, 0x34, 0x28, 0x1b, 0x75, 0xec, 0x90, 0x68, 0xdd, 0x3b, 0x50,
-	0x66, 0xae, 0x26, 0xdf, 0x00, 0x2d, 0xa7, 0x84, 0x2a, 0x5f, 0x95, 0xed, 0xf7, 0x0f, 0x66, 0x7f,
-	0xfa, 0xe8, 0x62, 0x25, 0x4f, 0xa3, 0xa2, 0x4f, 0x22, 0xa8, 0xb0, 0xdf, 0x3c, 0xb0, 0x58, 0x3a,
-	0xb1, 0x37, 0x95, 0x2e, 0x4a, 0x00, 0xb9, 0x44, 0x2d, 0x6b, 0x3e, 0xc4, 0x87, 0x0a, 0xbf, 0x4d,
-	0x80, 0x33, 0x15, 0xbe, 0xee, 0x86, 0x0a, 0xef, 0x4d, 0x00, 0xf7, 0x0f, 0x66, 0xdf, 0x38, 0x3a,
-	0x53, 0xf5, 0x38, 0x6a, 0x16, 0xf6, 0x57, 0x47, 0xf4, 0xd8, 0x95, 0x59, 0xc8, 0x3f, 0x16, 0x63,
-	0xf7, 0xf5, 0xcc, 0xd8, 0xbd, 0xd8, 0x33, 0x76, 0xa7, 0x74, 0xc5, 0xfd, 0xd4, 0x68, 0x7c, 0xd4,
-	0x06, 0xcf, 0xe1, 0xfb, 0x2a, 0xdc, 0xd2, 0x7b, 0xa7, 0xe3, 0x85, 0x34, 0xda, 0x08, 0x3b, 0xbe,
-	0xe7, 0x37, 0xe4, 0x65, 0x2d, 0x86, 0xa5, 0x97, 0x02, 0x63, 0x16, 0x9f, 0xdf, 0x11, 0xd5, 0xf5,
-	0xdd, 0xdb, 0xce, 0x9e, 0x18, 0x55, 0x46, 0x62, 0xe6, 0xa6, 0x6c, 0x47, 0x85, 0x61, 0x7f, 0x8b,
-	0x9f, 0x56, 0x1b, 0x19, 0x0d, 0x6c, 0x4c, 0x34, 0xf9, 0xf5, 0x0d, 0x22, 0xab, 0x53, 0x8d, 0x09,
-	0x71, 0x67, 0x83, 0x80, 0x91, 0xbb, 0x30, 0xb6, 0x2d, 0xca, 0x3a, 0xe7, 0x53, 0xc3, 0x49, 0xd6,
-	0x88, 0xe6, 0xa5, 0x0e, 0x93, 0x82, 0xd1, 0xf7, 0xf5, 0x4f, 0x4c, 0xb8, 0xd9, 0x7f, 0xbf, 0x08,
-	0xa7, 0x32, 0x97, 0x0b, 0xa4, 0x2a, 0x4a, 0x14, 0x0e, 0xad, 0x28, 0xf1, 0x71, 0x80, 0x1a, 0x6d,
-	0x37, 0x83, 0x2e, 0x37, 0x3b, 0x47, 0x8e, 0x6c, 0x76, 0x2a, 0x4f, 0x65, 0x49, 0x51, 0x41, 0x83,
-	0xa2, 0x4c, 0x65, 0x15, 0x05, 0x2a, 0x32, 0xa9, 0xac, 0x46, 0x29, 0xb3, 0xd1, 0x47, 0x5b, 0xca,
-	0xcc, 0x83, 0x53, 0x42, 0x44, 0x95, 0x37, 0xf0, 0x10, 0xe9, 0x01, 0x3c, 0xe2, 0x74, 0x29, 0x4d,
-	0x06, 0xb3, 0x74, 0xed, 0x2f, 0x15, 0x98, 0xb1, 0x27, 0x3a, 0x7b, 0x2d, 0x39, 0xda, 0x78, 0x1f,
-	0x8c, 0x3a, 0x9d, 0x78, 0x27, 0xe8, 0xa9, 0x4f, 0xbd, 0xc0, 0x5b, 0x51, 0x42, 0xc9, 0x2a, 0x8c,
-	0xd4, 0x74, 0xba, 0xe1, 0x51, 0x84, 0xd3, 0xfb, 0x98, 0x4e, 0x4c, 0x91, 0x53, 0x21, 0xcf, 0xc3,
-	0x48, 0xec, 0x34, 0x52, 0x57, 0x80, 0x6d, 0x39, 0x8d, 0x08, 0x79, 0xab, 0xb9, 0x16, 0x8d, 0x1c,
-	0xb2, 0x16, 0xbd, 0x01, 0x93, 0x91, 0xd7, 0xf0, 0x9d, 0xb8, 0x13, 0x52, 0xe3, 0xcc, 0x4c, 0x07,
-	0x1a, 0x98, 0x40, 0x4c, 0xe3, 0xda, 0xdf, 0xaf, 0xc0, 0xd9, 0x7e, 0x77, 0xb1, 0xe6, 0x1d, 0xb4,
-	0xdd, 0x8f, 0xc7, 0xa3, 0x0b, 0xda, 0x1e, 0xc0, 0xbd, 0x69, 0x04, 0x6d, 0x37, 0x8d, 0xa0, 0xed,
-	0xcf, 0x59, 0x50, 0x51, 0xb1, 0xca, 0x32, 0xde, 0xf2, 0xa3, 0x27, 0x70, 0xdf, 0x6d, 0xc2, 0x42,
-	0x86, 0xac, 0x26, 0x7f, 0x51, 0x33, 0x3f, 0xb9, 0x28, 0xee, 0x07, 0x0a, 0x74, 0xa4, 0x28, 0x6e,
-	0x15, 0xe2, 0x5e, 0xca, 0x23, 0xc4, 0x7d, 0xc0, 0xa7, 0xea, 0x1b, 0xe2, 0xfe, 0x15, 0xe6, 0xca,
-	0xbe, 0xdb, 0x09, 0xe9, 0x12, 0xdd, 0x5b, 0x6f, 0x47, 0x52, 0x6f, 0x7d, 0x2c, 0x7f, 0x01, 0x16,
-	0x34, 0x13, 0x59, 0x48, 0x53, 0x37, 0xa0, 0x29, 0x42, 0x2a, 0xa4, 0x7d, 0x2c, 0x8f, 0x90, 0xf6,
-	0x7e, 0xe2, 0x1c, 0x1a, 0xd2, 0xfe, 0x06, 0x4c, 0xba, 0xcd, 0xc0, 0xa7, 0x1b, 0x61, 0x10, 0x07,
-	0x6e, 0xd0, 0x94, 0x36, 0xaa, 0x52, 0x09, 0x8b, 0x26, 0x10, 0xd3, 0xb8, 0x83, 0xe2, 0xe1, 0x2b,
-	0xc7, 0x8d, 0x87, 0x87, 0xc7, 0x14, 0x0f, 0xff, 0xe7, 0x05, 0x98, 0x3d, 0xe4, 0xa3, 0x92, 0xd7,
-	0x61, 0x22, 0x08, 0x1b, 0x8e, 0xef, 0xbd, 0x2b, 0xd2, 0x11, 0x4b, 0xe9, 0x3a, 0x03, 0xeb, 0x06,
-	0x0c, 0x53, 0x98, 0x49, 0xc4, 0xec, 0xe8, 0x80, 0x88, 0xd9, 0x0f, 0xc2, 0x78, 0x4c, 0x9d, 0x96,
-	0x0c, 0xe0, 0x90, 0x7e, 0x85, 0x3e, 0x37, 0xd3, 0x20, 0x34, 0xf1, 0xd8, 0x30, 0x9a, 0x72, 0x5c,
-	0x97, 0x46, 0x51, 0x12, 0x12, 0x2b, 0xf7, 0xa0, 0x72, 0x8b, 0xb7, 0xe5, 0x5b, 0x7b, 0x0b, 0x29,
-	0x16, 0x98, 0x61, 0xc9, 0x84, 0x77, 0x9a, 0x4d, 0x11, 0xfd, 0x4e, 0x93, 0x4b, 0x3d, 0xf5, 0x66,
-	0x8e, 0x06, 0xa1, 0x89, 0x67, 0xff, 0x56, 0x01, 0x5e, 0x78, 0xa0, 0x7a, 0x19, 0x3a, 0x5a, 0xb9,
-	0x13, 0xd1, 0x30, 0x7b, 0xee, 0x74, 0x33, 0xa2, 0x21, 0x72, 0x88, 0xe8, 0xa5, 0x76, 0xdb, 0xb8,
-	0x84, 0x22, 0xef, 0xe0, 0x78, 0xd1, 0x4b, 0x29, 0x16, 0x98, 0x61, 0x99, 0xed, 0xa5, 0x91, 0x21,
-	0x7b, 0xe9, 0x1f, 0x17, 0xe0, 0xc5, 0x21, 0x94, 0x70, 0x8e, 0x49, 0x04, 0xe9, 0x24, 0x8c, 0xe2,
-	0xe3, 0x49, 0xc2, 0x78, 0xd8, 0xee, 0xfa, 0x56, 0x01, 0xce, 0x0f, 0xd6, 0x85, 0xe4, 0x67, 0x99,
-	0x6f, 0x92, 0xc4, 0x94, 0x98, 0x09, 0x1c, 0x67, 0x84, 0x5f, 0x92, 0x02, 0x61, 0x16, 0x97, 0xcc,
-	0x01, 0xb4, 0x9d, 0x78, 0x27, 0xba, 0xbc, 0xef, 0x45, 0xb1, 0x4c, 0x3d, 0x9c, 0x12, 0x3b, 0xfe,
-	0x49, 0x2b, 0x1a, 0x18, 0x8c, 0x1d, 0xff, 0xb7, 0x14, 0xdc, 0x08, 0x62, 0xf1, 0x90, 0xb0, 0xe3,
-	0xce, 0x88, 0x5b, 0x78, 0x53, 0x20, 0xcc, 0xe2, 0x32, 0x76, 0x7c, 0x37, 0x56, 0x08, 0x2a, 0x6f,
-	0x2c, 0x66, 0xec, 0x56, 0x55, 0x2b, 0x1a, 0x18, 0xd9, 0xd4, 0x94, 0xd2, 0x10, 0xa9, 0x29, 0xff,
-	0xbc, 0x00, 0xcf, 0x0e, 0x5c, 0x4b, 0x87, 0x9b, 0x80, 0x4f, 0x5e, 0x4e, 0xca, 0xc3, 0x8d, 0x9d,
-	0x23, 0x66, 0x5a, 0xfc, 0xd7, 0x01, 0x23, 0x4d, 0x66, 0x5a, 0x64, 0x97, 0x0a, 0xeb, 0xa8, 0x4b,
-	0xc5, 0x13, 0xd4, 0x9f, 0x3d, 0xc9, 0x15, 0x23, 0x47, 0x48, 0xae, 0xc8, 0x7c, 0x8c, 0xd2, 0x90,
-	0x13, 0xf9, 0x3b, 0x83, 0xbb, 0x97, 0xd9, 0xde, 0x43, 0xed, 0xfa, 0x2c, 0xc1, 0x69, 0x79, 0xf5,
-	0xf9, 0x66, 0x67, 0x5b, 0x26, 0xa6, 0x16, 0xd2, 0x17, 0xb2, 0xac, 0x64, 0xe0, 0xd8, 0xf3, 0xc4,
-	0x13, 0x98, 0xec, 0xf2, 0x90, 0x5d, 0xfa, 0x71, 0xa8, 0x28, 0xda, 0x22, 0x00, 0x54, 0x7d, 0xd0,
-	0x9e, 0x00, 0x50, 0xf5, 0x35, 0x0d, 0x2c, 0xd6, 0x13, 0xbb, 0xb4, 0x9b, 0x1d, 0x99, 0xd7, 0x69,
-	0x97, 0x1f, 0x9e, 0xd8, 0x3f, 0x05, 0x13, 0xca, 0x89, 0x1c, 0xb6, 0x28, 0xa4, 0xfd, 0xbf, 0x46,
-	0x60, 0x32, 0x55, 0x80, 0x20, 0xb5, 0x15, 0x62, 0x1d, 0xba, 0x15, 0xc2, 0x43, 0x66, 0x3b, 0x7e,
-	0x52, 0x33, 0xd5, 0x08, 0x99, 0xed, 0xf8, 0x14, 0x05, 0x8c, 0xb9, 0xee, 0xb5, 0xb0, 0x8b, 0x1d,
-	0x5f, 0x06, 0xde, 0x29, 0xd7, 0x7d, 0x89, 0xb7, 0xa2, 0x84, 0x92, 0x4f, 0x5b, 0x30, 0x11, 0xf1,
-	0x7d, 0x36, 0xb1, 0x91, 0x24, 0x3f, 0xe8, 0xb5, 0x3c, 0xee, 0xca, 0x94, 0xc5, 0x36, 0xf8, 0x99,
-	0xbd, 0xd9, 0x82, 0x29, 0x8e, 0xe4, 0xd7, 0x2c, 0xf3, 0x96, 0xd0, 0xd1, 0x3c, 0x02, 0x46, 0xb3,
-	0xf5, 0x1d, 0xc4, 0x36, 0xcb, 0x83, 0x2f, 0x0b, 0x8d, 0xd4, 0x2e, 0xcf, 0xd8, 0xc9, 0xec, 0xf2,
-	0x40, 0x9f, 0x1d, 0x9e, 0x0f, 0x40, 0xa5, 0xe5, 0xf8, 0x5e, 0x9d, 0x46, 0x71, 0x34, 0x53, 0x36,
-	0xca, 0xce, 0x24, 0x8d, 0xa8, 0xe1, 0x6c, 0xb1, 0x8b, 0xf8, 0x8b, 0x89, 0x73, 0xb1, 0x8a, 0xbe,
-	0xbe, 0x60, 0x53, 0x37, 0xa3, 0x89, 0x63, 0xff, 0x33, 0x0b, 0xce, 0xf5, 0xed, 0x8c, 0x27, 0x37,
-	0xc2, 0x89, 0x2d, 0xd0, 0x67, 0xfa, 0x14, 0xe8, 0x20, 0xdd, 0x13, 0xbb, 0x4c, 0x56, 0x56, 0x00,
-	0x99, 0x1c, 0x38, 0x36, 0x8e, 0xb6, 0x57, 0xa9, 0xf7, 0x0b, 0x8b, 0x8f, 0x74, 0xbf, 0x90, 0x99,
-	0x82, 0xc6, 0xb5, 0xc7, 0xe4, 0x53, 0x66, 0x2d, 0x1a, 0x2b, 0xaf, 0xba, 0x29, 0x82, 0xb8, 0xaa,
-	0x65, 0x23, 0x7a, 0xad, 0x5f, 0x69, 0x9b, 0xec, 0x78, 0x2d, 0x1c, 0x3e, 0x5e, 0x49, 0x33, 0x29,
-	0xfa, 0x53, 0xcc, 0xbf, 0xe8, 0x4f, 0xa5, 0xa7, 0xe0, 0xcf, 0xdf, 0xb5, 0xc4, 0x48, 0xcb, 0xbc,
-	0x92, 0xd6, 0xb0, 0xd6, 0x03, 0x34, 0xec, 0xcb, 0xfc, 0x7a, 0x9e, 0xfa, 0x55, 0xea, 0x34, 0xa5,
-	0x26, 0x36, 0x6f, 0xda, 0xe1, 0xed, 0xa8, 0x30, 0x78, 0x31, 0xef, 0x66, 0x33, 0xb8, 0x7b, 0xb9,
-	0xd5, 0x8e, 0xbb, 0x52, 0x27, 0xeb, 0x62, 0xde, 0x0a, 0x82, 0x06, 0x96, 0xfd, 0x17, 0x96, 0xf8,
-	0x9c, 0xf2, 0xd8, 0xe7, 0xf5, 0x4c, 0xf1, 0xd9, 0xe1, 0x4f, 0x4c, 0x7e, 0x49, 0xdc, 0x05, 0x2f,
-	0x6e, 0xe6, 0xc8, 0xe7, 0x36, 0x64, 0x7d, 0xd3, 0x87, 0x79, 0x45, 0x6f, 0xd2, 0x86, 0x06, 0xbf,
-	0xd4, 0xe4, 0x29, 0x1e, 0x36, 0x79, 0xec, 0x3f, 0xb7, 0x20, 0xb5, 0x58, 0x90, 0x36, 0x94, 0x98,
-	0x04, 0xdd, 0x7c, 0xee, 0x11, 0x31, 0x49, 0xb3, 0x89, 0x25, 0x87, 0x05, 0xff, 0x89, 0x82, 0x11,
-	0x69, 0xca, 0x03, 0x9f, 0x42, 0x1e, 0x77, 0xdd, 0x98, 0x0c, 0xaf, 0x06, 0xc1, 0xae, 0xd8, 0xd0,
-	0xd6, 0x87, 0x47, 0xf6, 0xeb, 0x30, 0xdd, 0x23, 0x14, 0x2f, 0x1d, 0x19, 0x24, 0x97, 0xa7, 0x18,
-	0x23, 0x90, 0x17, 0xb2, 0x45, 0x01, 0xb3, 0xbf, 0x65, 0xc1, 0xe9, 0x2c, 0x79, 0xf2, 0x75, 0x0b,
-	0xa6, 0xa3, 0x2c, 0xbd, 0x93, 0xea, 0x3b, 0x15, 0x0c, 0xd1, 0x03, 0xc2, 0x5e, 0x21, 0xec, 0xff,
-	0x2f, 0xd5, 0xd3, 0x6d, 0xcf, 0xaf, 0x05, 0x77, 0xd5, 0xe2, 0x62, 0x0d, 0x5c, 0x5c, 0xd8, 0x14,
-	0x73, 0x77, 0x68, 0xad, 0xd3, 0xec, 0x49, 0xbf, 0xd9, 0x94, 0xed, 0xa8, 0x30, 0x52, 0x37, 0x95,
-	0x16, 0x0f, 0xbd, 0xa9, 0xf4, 0x35, 0x98, 0x30, 0x2f, 0x08, 0x92, 0xb9, 0xfc, 0xdc, 0x56, 0x31,
-	0xef, 0x12, 0xc2, 0x14, 0x56, 0xe6, 0x8a, 0xc8, 0xd2, 0xa1, 0x57, 0x44, 0xbe, 0x04, 0x65, 0x79,
-	0xdd, 0x61, 0x12, 0xc2, 0x25, 0x72, 0x7b, 0x64, 0x1b, 0x2a, 0x28, 0x53, 0x10, 0x2d, 0xc7, 0xef,
-	0x38, 0x4d, 0xd6, 0x43, 0x32, 0xe5, 0x4f, 0xcd, 0xac, 0x35, 0x05, 0x41, 0x03, 0x8b, 0xbd, 0x71,
-	0xec, 0xb5, 0xe8, 0xdb, 0x81, 0x9f, 0x1c, 0xb6, 0xeb, 0xed, 0x3e, 0xd9, 0x8e, 0x0a, 0xc3, 0xfe,
-	0x9f, 0x16, 0x64, 0xef, 0x6a, 0x4b, 0x39, 0x80, 0xd6, 0xa1, 0x69, 0x86, 0xe9, 0x14, 0xaa, 0xc2,
-	0x50, 0x29, 0x54, 0x66, 0x76, 0x53, 0xf1, 0x81, 0xd9, 0x4d, 0x3f, 0xa1, 0x0b, 0x90, 0x8b, 0x34,
-	0xa8, 0xf1, 0x7e, 0xc5, 0xc7, 0x89, 0x0d, 0xa3, 0xae, 0xa3, 0xb2, 0xb8, 0x27, 0x84, 0x59, 0xb5,
-	0xb8, 0xc0, 0x91, 0x24, 0xa4, 0xba, 0xfd, 0xed, 0x1f, 0x5c, 0x78, 0xea, 0x3b, 0x3f, 0xb8, 0xf0,
-	0xd4, 0x1f, 0xff, 0xe0, 0xc2, 0x53, 0x9f, 0xbe, 0x77, 0xc1, 0xfa, 0xf6, 0xbd, 0x0b, 0xd6, 0x77,
-	0xee, 0x5d, 0xb0, 0xfe, 0xf8, 0xde, 0x05, 0xeb, 0xfb, 0xf7, 0x2e, 0x58, 0x5f, 0xf9, 0xef, 0x17,
-	0x9e, 0x7a, 0xbb, 0x6f, 0x70, 0x04, 0xfb, 0xf1, 0x8a, 0x5b, 0x9b, 0xdf, 0xbb, 0xc4, 0xcf, 0xe7,
-	0xd9, 0x6c, 0x98, 0x37, 0x86, 0xc0, 0x7c, 0x32, 0x1b, 0xfe, 0x32, 0x00, 0x00, 0xff, 0xff, 0x34,
-	0x21, 0xde, 0x60, 0x69, 0xb4, 0x00, 0x00,
+	// 10604 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x70, 0x24, 0xc9,
+	0x71, 0x18, 0x7b, 0x1e, 0xc0, 0x4c, 0xe1, 0xb1, 0xbb, 0xbd, 0xbb, 0x77, 0x73, 0x7b, 0xbc, 0xc3,
+	0x46, 0x9f, 0x45, 0x52, 0x16, 0x0f, 0x2b, 0xae, 0x48, 0xea, 0x4c, 0x4a, 0x94, 0x30, 0xc0, 0x3e,
+	0x70, 0x0b, 0xec, 0xe2, 0x12, 0xb8, 0x5d, 0x92, 0xa7, 0x23, 0xd9, 0x98, 0x29, 0x00, 0x7d, 0x98,
+	0xe9, 0x9e, 0xeb, 0xee, 0xc1, 0x02, 0x27, 0x92, 0x22, 0x29, 0xdb, 0x94, 0xcd, 0xa7, 0xc9, 0x0f,
+	0x91, 0xe1, 0x90, 0x45, 0x89, 0xb2, 0xc3, 0x0e, 0x9b, 0x61, 0x3b, 0xf4, 0x61, 0xd9, 0xfa, 0x92,
+	0x6c, 0x47, 0x30, 0x82, 0x56, 0x88, 0x61, 0x2b, 0x44, 0xd9, 0x92, 0xd7, 0xe4, 0x3a, 0x1c, 0xb2,
+	0xa5, 0x90, 0xc2, 0x0f, 0x85, 0x23, 0xbc, 0x3f, 0x76, 0x64, 0xbd, 0xab, 0x7b, 0x06, 0x18, 0x00,
+	0x8d, 0xdd, 0x23, 0x7d, 0x5f, 0xc0, 0x54, 0x66, 0x67, 0x66, 0x57, 0x57, 0x65, 0x65, 0x65, 0x65,
+	0x66, 0x91, 0xa5, 0xcd, 0x20, 0xdd, 0xea, 0xaf, 0xcf, 0xb6, 0xa2, 0xee, 0x25, 0x3f, 0xde, 0x8c,
+	0x7a, 0x71, 0xf4, 0x0a, 0xfb, 0xe7, 0xd9, 0x56, 0xfb, 0xd2, 0xce, 0xe5, 0x4b, 0xbd, 0xed, 0xcd,
+	0x4b, 0x7e, 0x2f, 0x48, 0x2e, 0xf9, 0xbd, 0x5e, 0x27, 0x68, 0xf9, 0x69, 0x10, 0x85, 0x97, 0x76,
+	0xde, 0xe1, 0x77, 0x7a, 0x5b, 0xfe, 0x3b, 0x2e, 0x6d, 0xd2, 0x90, 0xc6, 0x7e, 0x4a, 0xdb, 0xb3,
+	0xbd, 0x38, 0x4a, 0x23, 0xf7, 0x27, 0x34, 0xb5, 0x59, 0x49, 0x8d, 0xfd, 0xf3, 0xe1, 0x56, 0x7b,
+	0x76, 0xe7, 0xf2, 0x6c, 0x6f, 0x7b, 0x73, 0x16, 0xa9, 0xcd, 0x1a, 0xd4, 0x66, 0x25, 0xb5, 0x0b,
+	0xcf, 0x1a, 0xb2, 0x6c, 0x46, 0x9b, 0xd1, 0x25, 0x46, 0x74, 0xbd, 0xbf, 0xc1, 0x7e, 0xb1, 0x1f,
+	0xec, 0x3f, 0xce, 0xec, 0x82, 0xb7, 0xfd, 0x5c, 0x32, 0x1b, 0x44, 0x28, 0xde, 0xa5, 0x56, 0x14,
+	0xd3, 0x4b, 0x3b, 0x39, 0x81, 0x2e, 0x5c, 0xd7, 0x38, 0x74, 0x37, 0xa5, 0x61, 0x12, 0x44, 0x61,
+	0xf2, 0x2c, 0x8a, 0x40, 0xe3, 0x1d, 0x1a, 0x9b, 0xaf, 0x67, 0x20, 0x0c, 0xa2, 0xf4, 0x4e, 0x4d,
+	0xa9, 0xeb, 0xb7, 0xb6, 0x82, 0x90, 0xc6, 0x7b, 0xfa, 0xf1, 0x2e, 0x4d, 0xfd, 0x41, 0x4f, 0x5d,
+	0x1a, 0xf6, 0x54, 0xdc, 0x0f, 0xd3, 0xa0, 0x4b, 0x73, 0x0f, 0xbc, 0xfb, 0xa0, 0x07, 0x92, 0xd6,
+	0x16, 0xed, 0xfa, 0xb9, 0xe7, 0x7e, 0x6c, 0xd8, 0x73, 0xfd, 0x34, 0xe8, 0x5c, 0x0a, 0xc2, 0x34,
+	0x49, 0xe3, 0xec, 0x43, 0xde, 0xab, 0x64, 0x6a, 0xee, 0xce, 0xea, 0x5c, 0x3f, 0xdd, 0x9a, 0x8f,
+	0xc2, 0x8d, 0x60, 0xd3, 0x7d, 0x17, 0x99, 0x68, 0x75, 0xfa, 0x49, 0x4a, 0xe3, 0x9b, 0x7e, 0x97,
+	0x36, 0x9c, 0x8b, 0xce, 0xdb, 0xea, 0xcd, 0xb3, 0xdf, 0xbc, 0x37, 0xf3, 0xa6, 0xfb, 0xf7, 0x66,
+	0x26, 0xe6, 0x35, 0x08, 0x4c, 0x3c, 0xf7, 0x87, 0xc9, 0x78, 0x1c, 0x75, 0xe8, 0x1c, 0xdc, 0x6c,
+	0x94, 0xd8, 0x23, 0xa7, 0xc4, 0x23, 0xe3, 0xc0, 0x9b, 0x41, 0xc2, 0xbd, 0xdf, 0x2f, 0x11, 0x32,
+	0xd7, 0xeb, 0xad, 0xc4, 0xd1, 0x2b, 0xb4, 0x95, 0xba, 0x1f, 0x21, 0x35, 0xec, 0xba, 0xb6, 0x9f,
+	0xfa, 0x8c, 0xdb, 0xc4, 0xe5, 0x1f, 0x9d, 0xe5, 0x6f, 0x32, 0x6b, 0xbe, 0x89, 0x1e, 0x38, 0x88,
+	0x3d, 0xbb, 0xf3, 0x8e, 0xd9, 0x5b, 0xeb, 0xf8, 0xfc, 0x32, 0x4d, 0xfd, 0xa6, 0x2b, 0x98, 0x11,
+	0xdd, 0x06, 0x8a, 0xaa, 0x1b, 0x92, 0x4a, 0xd2, 0xa3, 0x2d, 0x26, 0xd8, 0xc4, 0xe5, 0xa5, 0xd9,
+	0xe3, 0x8c, 0xd0, 0x59, 0x2d, 0xf9, 0x6a, 0x8f, 0xb6, 0x9a, 0x93, 0x82, 0x73, 0x05, 0x7f, 0x01,
+	0xe3, 0xe3, 0xee, 0x90, 0xb1, 0x24, 0xf5, 0xd3, 0x7e, 0xd2, 0x28, 0x33, 0x8e, 0x37, 0x0b, 0xe3,
+	0xc8, 0xa8, 0x36, 0xa7, 0x05, 0xcf, 0x31, 0xfe, 0x1b, 0x04, 0x37, 0xef, 0x3f, 0x3a, 0x64, 0x5a,
+	0x23, 0x2f, 0x05, 0x49, 0xea, 0xfe, 0x4c, 0xae, 0x73, 0x67, 0x47, 0xeb, 0x5c, 0x7c, 0x9a, 0x75,
+	0xed, 0x69, 0xc1, 0xac, 0x26, 0x5b, 0x8c, 0x8e, 0xed, 0x92, 0x6a, 0x90, 0xd2, 0x6e, 0xd2, 0x28,
+	0x5d, 0x2c, 0xbf, 0x6d, 0xe2, 0xf2, 0xf5, 0xa2, 0xde, 0xb3, 0x39, 0x25, 0x98, 0x56, 0x17, 0x91,
+	0x3c, 0x70, 0x2e, 0xde, 0x9f, 0x4e, 0x9a, 0xef, 0x87, 0x1d, 0xee, 0xbe, 0x83, 0x4c, 0x24, 0x51,
+	0x3f, 0x6e, 0x51, 0xa0, 0xbd, 0x28, 0x69, 0x38, 0x17, 0xcb, 0x38, 0xf4, 0x70, 0xa4, 0xae, 0xea,
+	0x66, 0x30, 0x71, 0xdc, 0xcf, 0x3b, 0x64, 0xb2, 0x4d, 0x93, 0x34, 0x08, 0x19, 0x7f, 0x29, 0xfc,
+	0xda, 0xb1, 0x85, 0x97, 0x8d, 0x0b, 0x9a, 0x78, 0xf3, 0x9c, 0x78, 0x91, 0x49, 0xa3, 0x31, 0x01,
+	0x8b, 0x3f, 0xce, 0xb8, 0x36, 0x4d, 0x5a, 0x71, 0xd0, 0xc3, 0xdf, 0x8d, 0xb2, 0x3d, 0xe3, 0x16,
+	0x34, 0x08, 0x4c, 0x3c, 0x37, 0x24, 0x55, 0x9c, 0x51, 0x49, 0xa3, 0xc2, 0xe4, 0x5f, 0x3c, 0x9e,
+	0xfc, 0xa2, 0x53, 0x71, 0xb2, 0xea, 0xde, 0xc7, 0x5f, 0x09, 0x70, 0x36, 0xee, 0xe7, 0x1c, 0xd2,
+	0x10, 0x33, 0x1e, 0x28, 0xef, 0xd0, 0x3b, 0x5b, 0x41, 0x4a, 0x3b, 0x41, 0x92, 0x36, 0xaa, 0x4c,
+	0x86, 0x4b, 0xa3, 0x8d, 0xad, 0x6b, 0x71, 0xd4, 0xef, 0xdd, 0x08, 0xc2, 0x76, 0xf3, 0xa2, 0xe0,
+	0xd4, 0x98, 0x1f, 0x42, 0x18, 0x86, 0xb2, 0x74, 0xbf, 0xec, 0x90, 0x0b, 0xa1, 0xdf, 0xa5, 0x49,
+	0xcf, 0x6f, 0x51, 0x09, 0x6e, 0x76, 0xfc, 0xd6, 0x36, 0x93, 0x68, 0xec, 0x68, 0x12, 0x79, 0x42,
+	0xa2, 0x0b, 0x37, 0x87, 0x92, 0x86, 0x7d, 0xd8, 0xba, 0x5f, 0x77, 0xc8, 0x99, 0x28, 0xee, 0x6d,
+	0xf9, 0x21, 0x6d, 0x4b, 0x68, 0xd2, 0x18, 0x67, 0x53, 0xef, 0x43, 0xc7, 0xfb, 0x44, 0xb7, 0xb2,
+	0x64, 0x97, 0xa3, 0x30, 0x48, 0xa3, 0x78, 0x95, 0xa6, 0x69, 0x10, 0x6e, 0x26, 0xcd, 0xf3, 0xf7,
+	0xef, 0xcd, 0x9c, 0xc9, 0x61, 0x41, 0x5e, 0x1e, 0xf7, 0x67, 0xc9, 0x44, 0xb2, 0x17, 0xb6, 0xee,
+	0x04, 0x61, 0x3b, 0xba, 0x9b, 0x34, 0x6a, 0x45, 0x4c, 0xdf, 0x55, 0x45, 0x50, 0x4c, 0x40, 0xcd,
+	0x00, 0x4c, 0x6e, 0x83, 0x3f, 0x9c, 0x1e, 0x4a, 0xf5, 0xa2, 0x3f, 0x9c, 0x1e, 0x4c, 0xfb, 0xb0,
+	0x75, 0x3f, 0xed, 0x90, 0xa9, 0x24, 0xd8, 0x0c, 0xfd, 0xb4, 0x1f, 0xd3, 0x1b, 0x74, 0x2f, 0x69,
+	0x10, 0x26, 0xc8, 0xf3, 0xc7, 0xec, 0x15, 0x83, 0x64, 0xf3, 0xbc, 0x90, 0x71, 0xca, 0x6c, 0x4d,
+	0xc0, 0xe6, 0x3b, 0x68, 0xa2, 0xe9, 0x61, 0x3d, 0x51, 0xec, 0x44, 0xd3, 0x83, 0x7a, 0x28, 0x4b,
+	0xf7, 0xa7, 0xc9, 0x69, 0xde, 0xa4, 0x7a, 0x36, 0x69, 0x4c, 0x32, 0x45, 0x7b, 0xee, 0xfe, 0xbd,
+	0x99, 0xd3, 0xab, 0x19, 0x18, 0xe4, 0xb0, 0xdd, 0x57, 0xc9, 0x4c, 0x8f, 0xc6, 0xdd, 0x20, 0xbd,
+	0x15, 0x76, 0xf6, 0xa4, 0xfa, 0x6e, 0x45, 0x3d, 0xda, 0x16, 0xe2, 0x24, 0x8d, 0xa9, 0x8b, 0xce,
+	0xdb, 0x6a, 0xcd, 0xb7, 0x0a, 0x31, 0x67, 0x56, 0xf6, 0x47, 0x87, 0x83, 0xe8, 0xa1, 0xd0, 0xad,
+	0x08, 0xfb, 0x75, 0xa5, 0xbf, 0xde, 0x09, 0x5a, 0xec, 0x83, 0x4e, 0x6b, 0xa1, 0xe7, 0x33, 0x30,
+	0xc8, 0x61, 0x7b, 0x7f, 0x5a, 0x26, 0xa7, 0xb3, 0x4b, 0xaf, 0xfb, 0xf7, 0x1c, 0x72, 0xea, 0x95,
+	0xbb, 0xe9, 0x5a, 0xb4, 0x4d, 0xc3, 0xa4, 0xb9, 0x87, 0x0a, 0x92, 0x2d, 0x3a, 0x13, 0x97, 0x5b,
+	0xc5, 0x2e, 0xf2, 0xb3, 0xcf, 0xdb, 0x5c, 0xae, 0x84, 0x69, 0xbc, 0xd7, 0x7c, 0x5c, 0xf4, 0xcf,
+	0xa9, 0xe7, 0xef, 0xac, 0x99, 0x50, 0xc8, 0x0a, 0xe5, 0xfe, 0xb2, 0x43, 0xce, 0xea, 0x49, 0x77,
+	0x6b, 0x87, 0xc6, 0x71, 0xd0, 0xa6, 0x72, 0xb1, 0x5b, 0x29, 0x6a, 0xaa, 0x4b, 0xc2, 0xcd, 0x27,
+	0x85, 0x64, 0x67, 0xf3, 0xb0, 0x04, 0x06, 0x49, 0x72, 0xe1, 0x33, 0x0e, 0x39, 0x37, 0xe8, 0x25,
+	0xdd, 0xd3, 0xa4, 0xbc, 0x4d, 0xf7, 0xb8, 0xe5, 0x09, 0xf8, 0xaf, 0xfb, 0x32, 0xa9, 0xee, 0xf8,
+	0x9d, 0x3e, 0x15, 0x16, 0xdc, 0xb5, 0xe3, 0x49, 0xaf, 0xfa, 0x0e, 0x38, 0xd5, 0xf7, 0x94, 0x9e,
+	0x73, 0xbc, 0xdf, 0x2d, 0x93, 0x09, 0x63, 0x0d, 0x7f, 0x08, 0x56, 0x69, 0x64, 0x59, 0xa5, 0xcb,
+	0x85, 0x99, 0x1f, 0x43, 0xcd, 0xd2, 0xbb, 0x19, 0xb3, 0xf4, 0x56, 0x71, 0x2c, 0xf7, 0xb5, 0x4b,
+	0xdd, 0x94, 0xd4, 0xa3, 0x1e, 0x8d, 0x19, 0x6a, 0xa3, 0x52, 0xc4, 0x27, 0xbc, 0x25, 0xc9, 0x35,
+	0xa7, 0xee, 0xdf, 0x9b, 0xa9, 0xab, 0x9f, 0xa0, 0x19, 0x79, 0xdf, 0x71, 0xc8, 0x39, 0x43, 0xc6,
+	0xf9, 0x28, 0x6c, 0x07, 0xec, 0xd3, 0x5e, 0x24, 0x95, 0x74, 0xaf, 0x27, 0xb7, 0x36, 0xaa, 0xa7,
+	0xd6, 0xf6, 0x7a, 0x14, 0x18, 0x04, 0x37, 0x33, 0x5d, 0x9a, 0x24, 0xfe, 0x26, 0xcd, 0x6e, 0x66,
+	0x96, 0x79, 0x33, 0x48, 0xb8, 0x1b, 0x13, 0xb7, 0xe3, 0x27, 0xe9, 0x5a, 0xec, 0x87, 0x09, 0x23,
+	0xbf, 0x16, 0x74, 0xa9, 0xe8, 0xe0, 0xbf, 0x3c, 0xda, 0x88, 0xc1, 0x27, 0x9a, 0x8f, 0xdd, 0xbf,
+	0x37, 0xe3, 0x2e, 0xe5, 0x28, 0xc1, 0x00, 0xea, 0xde, 0x57, 0x1c, 0x72, 0xde, 0xb2, 0x37, 0x7b,
+	0x34, 0x6c, 0xd3, 0xb0, 0xb5, 0x87, 0xaf, 0x16, 0xfa, 0xdd, 0xdc, 0xab, 0xb1, 0xed, 0x1a, 0x83,
+	0xb8, 0x2f, 0x93, 0x5a, 0x42, 0x3b, 0xb4, 0x95, 0x46, 0xb1, 0x18, 0x79, 0x3f, 0x36, 0xe2, 0x86,
+	0xc0, 0x5f, 0xa7, 0x9d, 0x55, 0xf1, 0x68, 0x73, 0x12, 0x77, 0x04, 0xf2, 0x17, 0x28, 0x92, 0xde,
+	0x97, 0x1d, 0xf2, 0xd8, 0x60, 0x53, 0xd8, 0x7d, 0x0b, 0x19, 0xe3, 0x3b, 0x6e, 0x21, 0x9d, 0x1e,
+	0x2d, 0xac, 0x15, 0x04, 0xd4, 0xbd, 0x44, 0xea, 0x6a, 0x99, 0x16, 0xdd, 0x7f, 0x46, 0xa0, 0xd6,
+	0xf5, 0xda, 0xae, 0x71, 0xd4, 0x4b, 0x97, 0x87, 0xbd, 0xb4, 0xf7, 0x9f, 0x1c, 0x72, 0xca, 0x90,
+	0xea, 0x21, 0xec, 0x8c, 0x42, 0x7b, 0x67, 0xb4, 0x58, 0xd8, 0x54, 0x1b, 0xb2, 0x35, 0xfa, 0x9c,
+	0x43, 0x2e, 0x18, 0x58, 0xcb, 0x7e, 0xda, 0xda, 0xba, 0xb2, 0xdb, 0x8b, 0x69, 0x92, 0x60, 0xdf,
+	0x3f, 0x65, 0xa8, 0xd4, 0xe6, 0x84, 0xa0, 0x50, 0xbe, 0x41, 0xf7, 0xb8, 0x7e, 0x7d, 0x3b, 0xa9,
+	0xf1, 0x79, 0x23, 0x06, 0x45, 0x5d, 0xbf, 0xdb, 0x2d, 0xd1, 0x0e, 0x0a, 0xc3, 0xf5, 0xc8, 0x18,
+	0xd3, 0x9b, 0xa8, 0x47, 0x70, 0x41, 0x25, 0xf8, 0x11, 0x6f, 0xb3, 0x16, 0x10, 0x10, 0xef, 0x7e,
+	0x89, 0x4c, 0x1b, 0xf2, 0xac, 0xd2, 0x87, 0xb1, 0xcf, 0x8f, 0x2d, 0x8d, 0xba, 0x52, 0x9c, 0x7a,
+	0xa3, 0xc3, 0xf7, 0xfa, 0xaf, 0x65, 0x94, 0x2a, 0x14, 0xca, 0x75, 0xff, 0xfd, 0xfe, 0x7f, 0x2b,
+	0x93, 0x19, 0xfb, 0x81, 0x9c, 0x4e, 0xc6, 0xcd, 0xa5, 0xc1, 0x28, 0xeb, 0xce, 0x31, 0xf0, 0xc1,
+	0xc4, 0x1b, 0xa2, 0xd6, 0x4a, 0x27, 0xa9, 0xd6, 0x4c, 0xad, 0x5b, 0x3e, 0x40, 0xeb, 0xbe, 0x45,
+	0xf5, 0x7a, 0x25, 0xa3, 0x4b, 0xec, 0x95, 0xe7, 0x22, 0xa9, 0x24, 0x29, 0xed, 0x35, 0xaa, 0xb6,
+	0x6a, 0x58, 0x4d, 0x69, 0x0f, 0x18, 0xc4, 0x8d, 0xc9, 0xd8, 0x16, 0xf5, 0x3b, 0xe9, 0x56, 0x63,
+	0xec, 0xa2, 0x73, 0x7c, 0x73, 0xff, 0x3a, 0xa3, 0x95, 0xfd, 0x6e, 0xbc, 0x15, 0x04, 0x27, 0xf7,
+	0x32, 0xa9, 0xa0, 0x41, 0xc4, 0x76, 0x85, 0xf5, 0xe6, 0xd3, 0x4a, 0xaa, 0xbd, 0xb0, 0xf5, 0xe0,
+	0xde, 0xcc, 0x34, 0xfe, 0xe5, 0x14, 0xe6, 0xa3, 0x36, 0x05, 0x86, 0xeb, 0xfd, 0x49, 0x89, 0x3c,
+	0x6e, 0x7f, 0x6b, 0xbd, 0xa0, 0xfd, 0x94, 0xb5, 0xa0, 0xfd, 0x88, 0xb9, 0xa0, 0x3d, 0xb8, 0x37,
+	0xf3, 0xe4, 0x90, 0xc7, 0xbe, 0x6f, 0xd6, 0x3b, 0xf7, 0x5a, 0xe6, 0x6b, 0x5f, 0xb2, 0xbf, 0xf6,
+	0x83, 0x7b, 0x33, 0x4f, 0x0d, 0x79, 0xc7, 0xcc, 0x70, 0x78, 0x0b, 0x19, 0x8b, 0xa9, 0x9f, 0x44,
+	0xa1, 0x18, 0x10, 0xea, 0x03, 0x01, 0x6b, 0x05, 0x01, 0xf5, 0xfe, 0x6d, 0x3d, 0xdb, 0xd9, 0xd7,
+	0xb8, 0xdb, 0x34, 0x8a, 0xdd, 0x80, 0x54, 0xd8, 0x46, 0x8c, 0xab, 0xb0, 0x1b, 0xc7, 0x1b, 0x2e,
+	0xb8, 0x72, 0x28, 0xd2, 0xcd, 0x1a, 0x7e, 0x35, 0x6c, 0x02, 0xc6, 0xc2, 0xdd, 0x25, 0xb5, 0x96,
+	0xdc, 0x1f, 0x95, 0x8a, 0xf0, 0x24, 0x8a, 0xdd, 0x91, 0xe6, 0xc8, 0x96, 0x71, 0xb5, 0xa9, 0x52,
+	0xdc, 0x5c, 0x4a, 0xca, 0x9b, 0x41, 0xda, 0x28, 0x17, 0x31, 0x25, 0xae, 0x05, 0xc6, 0x2b, 0x8e,
+	0xe3, 0xba, 0x73, 0x2d, 0x48, 0x01, 0xe9, 0xbb, 0x7f, 0xcd, 0x21, 0x13, 0x49, 0xab, 0xbb, 0x12,
+	0x47, 0x3b, 0x41, 0x9b, 0xc6, 0x8d, 0x4a, 0x11, 0x2a, 0x74, 0x75, 0x7e, 0x59, 0x12, 0xd4, 0x7c,
+	0xb9, 0x47, 0x42, 0x43, 0xc0, 0xe4, 0x8b, 0xbb, 0xba, 0xc7, 0xc5, 0xbb, 0x2f, 0xd0, 0x56, 0x80,
+	0x4b, 0xa6, 0xdc, 0x06, 0x37, 0xaa, 0x45, 0xd8, 0xca, 0x0b, 0xfd, 0xd6, 0x36, 0xce, 0x37, 0x2d,
+	0xd0, 0x93, 0xf7, 0xef, 0xcd, 0x3c, 0x3e, 0x3f, 0x98, 0x27, 0x0c, 0x13, 0x86, 0x75, 0x58, 0xaf,
+	0xdf, 0xe9, 0x00, 0x7d, 0xb5, 0x4f, 0x99, 0x93, 0xab, 0x80, 0x0e, 0x5b, 0xd1, 0x04, 0x33, 0x1d,
+	0x66, 0x40, 0xc0, 0xe4, 0xeb, 0xbe, 0x4a, 0xc6, 0xba, 0x7e, 0x1a, 0x07, 0xbb, 0x8d, 0xf1, 0x22,
+	0x76, 0x2f, 0xcb, 0x8c, 0x96, 0x66, 0xce, 0x2c, 0x0a, 0xde, 0x08, 0x82, 0x11, 0xfa, 0x9a, 0xbb,
+	0x34, 0xde, 0xa4, 0x8d, 0x5a, 0x11, 0x5e, 0xfc, 0x65, 0x24, 0xa5, 0x19, 0xd6, 0xd1, 0xa0, 0x62,
+	0x6d, 0xc0, 0xb9, 0x58, 0x76, 0x72, 0xbd, 0x70, 0x3b, 0x19, 0x3b, 0xb0, 0xd7, 0xe9, 0x6f, 0x06,
+	0x61, 0x83, 0x14, 0xd1, 0x81, 0x2b, 0x8c, 0x56, 0xa6, 0x03, 0x79, 0x23, 0x08, 0x46, 0xde, 0x7f,
+	0x71, 0x88, 0x6b, 0x2b, 0xb5, 0x87, 0x60, 0x07, 0xbf, 0x6a, 0xdb, 0xc1, 0x4b, 0x45, 0x5a, 0x47,
+	0x43, 0x4c, 0xe1, 0xdf, 0xac, 0x93, 0xcc, 0x72, 0x70, 0x93, 0x26, 0x29, 0x6d, 0xbf, 0xa1, 0xc2,
+	0xdf, 0x50, 0xe1, 0x6f, 0xa8, 0x70, 0xf9, 0xc3, 0x5d, 0xcf, 0xa8, 0xf0, 0xf7, 0x19, 0xb3, 0x5e,
+	0x1f, 0x83, 0x7f, 0x58, 0x9d, 0x93, 0x9b, 0x12, 0x18, 0x08, 0xa8, 0x09, 0x9e, 0x5f, 0xbd, 0x75,
+	0x73, 0xa0, 0xce, 0xfe, 0xb0, 0xad, 0xb3, 0x8f, 0xcb, 0xe2, 0xff, 0x07, 0x2d, 0xfd, 0x49, 0x87,
+	0xbc, 0xd5, 0xd6, 0x5e, 0x72, 0xe4, 0x2c, 0x6e, 0x86, 0x51, 0x4c, 0x17, 0x82, 0x8d, 0x0d, 0x1a,
+	0xd3, 0x10, 0xdd, 0xea, 0x07, 0x7b, 0x7b, 0xde, 0x49, 0x26, 0x5f, 0x49, 0xa2, 0x70, 0x25, 0x0a,
+	0x42, 0xa1, 0x82, 0x70, 0xc3, 0x7e, 0x1a, 0x0f, 0x24, 0xb1, 0x47, 0x65, 0x3b, 0x58, 0x58, 0xde,
+	0xdf, 0x2e, 0x91, 0x27, 0x32, 0x32, 0x44, 0x9d, 0x4e, 0xd4, 0x4f, 0x71, 0xdf, 0xe4, 0xfe, 0x1d,
+	0x87, 0x9c, 0xee, 0xda, 0xfe, 0x85, 0x44, 0xf8, 0xc0, 0xdf, 0x5f, 0x98, 0x7a, 0xcf, 0x38, 0x30,
+	0x9a, 0x0d, 0xf1, 0x72, 0xa7, 0x33, 0x80, 0x04, 0x72, 0xb2, 0xb8, 0x2f, 0x93, 0x7a, 0xd7, 0xdf,
+	0x7d, 0xb1, 0xd7, 0xf6, 0x53, 0xb9, 0x65, 0x1d, 0xee, 0x69, 0xe8, 0xa7, 0x41, 0x67, 0x96, 0xc7,
+	0x46, 0xcc, 0x2e, 0x86, 0xe9, 0xad, 0x78, 0x35, 0x8d, 0x83, 0x70, 0x93, 0xfb, 0x15, 0x97, 0x25,
+	0x19, 0xd0, 0x14, 0xbd, 0x5f, 0x72, 0xc8, 0x53, 0x43, 0x7a, 0x27, 0xf6, 0x53, 0xba, 0xb9, 0xe7,
+	0x7e, 0x94, 0x54, 0x71, 0x6f, 0x29, 0x7b, 0xe5, 0x4e, 0x91, 0x8b, 0x9e, 0xf1, 0x25, 0xf4, 0xfa,
+	0x87, 0xbf, 0x12, 0xe0, 0x4c, 0xbd, 0x3f, 0x1b, 0xcb, 0xae, 0xf3, 0xec, 0xa4, 0xfc, 0x32, 0x21,
+	0x9b, 0xd1, 0x1a, 0xed, 0xf6, 0x3a, 0x7e, 0xca, 0x87, 0x4c, 0x4d, 0xbb, 0x53, 0xae, 0x29, 0x08,
+	0x18, 0x58, 0xee, 0xdf, 0x70, 0x08, 0xd9, 0x94, 0xc3, 0x55, 0xae, 0xe1, 0x2f, 0x16, 0xf9, 0x3a,
+	0x7a, 0x32, 0x68, 0x59, 0x14, 0x43, 0x30, 0x98, 0xbb, 0x9f, 0x72, 0x48, 0x2d, 0x95, 0xe2, 0xf3,
+	0x55, 0x6d, 0xad, 0x48, 0x49, 0xe4, 0x4b, 0x6b, 0x73, 0x46, 0x75, 0x89, 0xe2, 0xeb, 0xfe, 0x75,
+	0x87, 0x10, 0xdc, 0x8e, 0xaf, 0x44, 0x9d, 0xa0, 0xb5, 0x27, 0x16, 0xbb, 0xdb, 0x85, 0xba, 0x7c,
+	0x14, 0xf5, 0xe6, 0x34, 0xf6, 0x86, 0xfe, 0x0d, 0x06, 0x67, 0xf7, 0xe3, 0xa4, 0x96, 0x88, 0xe1,
+	0xd6, 0xa8, 0x16, 0xdf, 0x19, 0x72, 0x28, 0x0b, 0xcd, 0x28, 0x7e, 0x81, 0xe2, 0xe9, 0xfe, 0xae,
+	0x43, 0xde, 0x1c, 0x30, 0x85, 0x64, 0x7a, 0x7b, 0xb5, 0x6e, 0x12, 0xc7, 0xef, 0xb4, 0xd0, 0xa1,
+	0x3f, 0x4c, 0x11, 0x36, 0xff, 0x92, 0xf8, 0x64, 0x6f, 0x5e, 0xdc, 0x47, 0x24, 0xd8, 0x57, 0x60,
+	0xf7, 0xc7, 0xc9, 0x94, 0xfc, 0xcc, 0x2b, 0xa8, 0x51, 0x84, 0x77, 0xe6, 0x0c, 0x1e, 0xd7, 0xae,
+	0x99, 0x00, 0xb0, 0xf1, 0xbc, 0x6f, 0x95, 0xc8, 0xb9, 0x6c, 0xef, 0x31, 0x6f, 0x03, 0xce, 0x9e,
+	0x96, 0xf4, 0x44, 0x48, 0x65, 0x50, 0xe8, 0xec, 0x51, 0x7e, 0x0e, 0x3d, 0x7b, 0x54, 0x53, 0x02,
+	0x06, 0x73, 0x34, 0x8f, 0xce, 0xf8, 0x59, 0xe7, 0xa0, 0x98, 0xd0, 0x2f, 0x17, 0x29, 0x52, 0xfe,
+	0x54, 0xe8, 0x09, 0x21, 0xda, 0x99, 0x1c, 0x08, 0xf2, 0x22, 0x79, 0xdf, 0xb2, 0x0f, 0x10, 0x8c,
+	0xb1, 0x38, 0xc2, 0xb9, 0xcd, 0xe7, 0x1d, 0x32, 0x11, 0x47, 0x9d, 0x4e, 0x10, 0x6e, 0xe2, 0xbc,
+	0x11, 0xca, 0xff, 0xa5, 0x13, 0xd1, 0xbf, 0x62, 0x82, 0x30, 0x23, 0x0b, 0x34, 0x4f, 0x30, 0x05,
+	0xc0, 0x88, 0xac, 0xc6, 0xb0, 0xf9, 0xed, 0x52, 0xf2, 0x24, 0x2e, 0x5a, 0x68, 0xfa, 0xa8, 0xc8,
+	0x8c, 0x5b, 0xe1, 0x02, 0xed, 0x50, 0xe5, 0xaa, 0xad, 0x35, 0x9f, 0x11, 0xaf, 0xf9, 0xe4, 0xca,
+	0x70, 0x54, 0xd8, 0x8f, 0x8e, 0xfb, 0x41, 0x72, 0xda, 0x78, 0xaf, 0x44, 0x75, 0x4c, 0xbd, 0x39,
+	0x8b, 0x0b, 0xea, 0x5c, 0x06, 0xf6, 0xe0, 0xde, 0xcc, 0x63, 0xd9, 0x36, 0xa1, 0x80, 0x72, 0x74,
+	0xbc, 0x5f, 0x2b, 0x65, 0xbf, 0x96, 0x5a, 0x3b, 0xbe, 0xe2, 0xe4, 0x36, 0x96, 0xef, 0x3f, 0x09,
+	0x7d, 0xcd, 0xb6, 0xa0, 0x2a, 0xf8, 0x63, 0x38, 0xce, 0x23, 0x3c, 0x79, 0xf5, 0xfe, 0x4d, 0x85,
+	0xec, 0x23, 0xd9, 0x08, 0x76, 0xdc, 0xa1, 0xcf, 0xc4, 0x3e, 0xeb, 0x90, 0xb1, 0x0e, 0xda, 0xb8,
+	0xfc, 0x90, 0x66, 0xe2, 0x72, 0xfb, 0xa4, 0xfa, 0x9e, 0x9b, 0xd2, 0x09, 0x8f, 0x4f, 0x50, 0x0e,
+	0x55, 0xde, 0x08, 0x42, 0x06, 0xf7, 0x6b, 0x0e, 0x99, 0xf0, 0xc3, 0x30, 0x4a, 0x45, 0xc8, 0x1d,
+	0x0f, 0x59, 0x0b, 0x4e, 0x4c, 0xa6, 0x39, 0xcd, 0x8b, 0x0b, 0xa6, 0x4f, 0x3c, 0x34, 0x04, 0x4c,
+	0x91, 0xdc, 0x59, 0x42, 0x36, 0x82, 0xd0, 0xef, 0x04, 0xaf, 0xa1, 0xa1, 0x5c, 0x65, 0x86, 0x32,
+	0x5b, 0x81, 0xaf, 0xaa, 0x56, 0x30, 0x30, 0x2e, 0xfc, 0x15, 0x32, 0x61, 0xbc, 0xf9, 0x80, 0xa0,
+	0x85, 0x73, 0x66, 0xd0, 0x42, 0xdd, 0x88, 0x35, 0xb8, 0xf0, 0x3e, 0x72, 0x3a, 0x2b, 0xe0, 0x61,
+	0x9e, 0xf7, 0xbe, 0x3a, 0x9e, 0x3d, 0xf7, 0x59, 0xa3, 0x71, 0x17, 0x45, 0x7b, 0xc3, 0xc7, 0xf1,
+	0x86, 0x8f, 0xe3, 0x0d, 0x1f, 0x87, 0xe9, 0xa6, 0x16, 0xfb, 0xf7, 0xf1, 0x87, 0xb5, 0x7f, 0xff,
+	0xdf, 0xb9, 0x15, 0xff, 0x0e, 0xdb, 0x9f, 0xee, 0xd0, 0x30, 0x75, 0x6f, 0x58, 0x16, 0xcc, 0x8f,
+	0x67, 0x0e, 0xea, 0xde, 0x3a, 0x2c, 0x7e, 0xff, 0x2e, 0x52, 0x98, 0x65, 0x24, 0x0c, 0x63, 0xe7,
+	0xb3, 0x0e, 0x99, 0xf6, 0x2d, 0x4e, 0x85, 0x05, 0xb8, 0x9b, 0x4e, 0xd6, 0xc7, 0x84, 0x94, 0x99,
+	0xe3, 0x7c, 0xc8, 0xf0, 0xf6, 0xee, 0x57, 0x89, 0x65, 0xe1, 0xf1, 0x91, 0x80, 0x69, 0x01, 0xb4,
+	0x17, 0xbd, 0x08, 0x4b, 0x0d, 0xc7, 0x3e, 0x59, 0x04, 0xde, 0x0c, 0x12, 0x8e, 0xab, 0x60, 0xcf,
+	0x4f, 0xb7, 0x1a, 0x25, 0x7b, 0x15, 0x5c, 0xf1, 0xd3, 0x2d, 0x60, 0x10, 0xf7, 0x7d, 0x64, 0x3a,
+	0xf5, 0xe3, 0x4d, 0xdc, 0x09, 0xec, 0xb0, 0x01, 0x27, 0xce, 0x03, 0x95, 0x88, 0x6b, 0x16, 0x14,
+	0x32, 0xd8, 0xee, 0xab, 0xa4, 0xb2, 0x45, 0x3b, 0x5d, 0x31, 0x18, 0x56, 0x8b, 0xeb, 0x26, 0xf6,
+	0xae, 0xd7, 0x69, 0xa7, 0xcb, 0x75, 0x23, 0xfe, 0x07, 0x8c, 0x15, 0xce, 0x84, 0xfa, 0x76, 0x3f,
+	0x49, 0xa3, 0x6e, 0xf0, 0x9a, 0x74, 0x83, 0xbd, 0xbf, 0x60, 0xc6, 0x37, 0x24, 0x7d, 0xee, 0xb4,
+	0x50, 0x3f, 0x41, 0x73, 0x66, 0x72, 0xb4, 0x83, 0x98, 0xb9, 0xb5, 0xf6, 0x1a, 0xe4, 0x44, 0xe4,
+	0x58, 0x90, 0xf4, 0xb9, 0x1c, 0xea, 0x27, 0x68, 0xce, 0xee, 0x9e, 0x9a, 0x91, 0x13, 0x17, 0x9d,
+	0x62, 0xb7, 0x43, 0x4c, 0x06, 0x3e, 0x1b, 0x07, 0xcd, 0x4c, 0xf7, 0x19, 0x52, 0x6d, 0x6d, 0xf9,
+	0x71, 0xda, 0x98, 0x64, 0x83, 0x46, 0x39, 0x4f, 0xe6, 0xb1, 0x11, 0x38, 0x0c, 0x03, 0x65, 0x62,
+	0xba, 0xd1, 0x98, 0xb2, 0x03, 0x65, 0x80, 0x6e, 0x00, 0xb6, 0x7b, 0xbf, 0x52, 0x22, 0x17, 0x72,
+	0x3c, 0xd5, 0x8b, 0xf2, 0xd1, 0xde, 0xea, 0xc7, 0x89, 0x74, 0xb0, 0x18, 0xa3, 0x9d, 0x35, 0x83,
+	0x84, 0xbb, 0x9f, 0x74, 0xc8, 0x38, 0x3a, 0xdd, 0x42, 0x35, 0x6d, 0x6f, 0x17, 0xdc, 0x15, 0xcf,
+	0x73, 0xea, 0x5a, 0x06, 0xd1, 0x00, 0x92, 0x2f, 0x8a, 0x4b, 0x77, 0x5b, 0x9d, 0x7e, 0x3b, 0x17,
+	0x70, 0x71, 0x85, 0x37, 0x83, 0x84, 0x23, 0x6a, 0x10, 0x72, 0xd4, 0x8a, 0x8d, 0xba, 0x18, 0x0a,
+	0x54, 0x01, 0xf7, 0xfe, 0xef, 0x18, 0x39, 0x9f, 0x13, 0x06, 0xa7, 0x04, 0x9a, 0x58, 0xcc, 0x88,
+	0xb9, 0x1a, 0x74, 0x28, 0xdf, 0x0f, 0x0b, 0x13, 0xeb, 0xb6, 0x6a, 0x05, 0x03, 0xc3, 0xfd, 0x39,
+	0x42, 0x7a, 0x7e, 0xec, 0x77, 0xa9, 0xf2, 0x5d, 0x1e, 0xdb, 0x92, 0x41, 0x39, 0x56, 0x24, 0x4d,
+	0xbd, 0x6b, 0x56, 0x4d, 0x09, 0x18, 0x2c, 0x31, 0x78, 0x26, 0xa6, 0x1d, 0xea, 0x27, 0x2c, 0x98,
+	0x39, 0x9b, 0x99, 0x01, 0x1a, 0x04, 0x26, 0x1e, 0x86, 0x19, 0x88, 0x00, 0xa9, 0x4c, 0x74, 0x8a,
+	0x1d, 0x24, 0xe5, 0x7e, 0xc1, 0x21, 0xd3, 0x1b, 0x41, 0x87, 0x6a, 0xee, 0x22, 0x8f, 0xe2, 0xd6,
+	0xf1, 0x5f, 0xf2, 0xaa, 0x49, 0x57, 0x6b, 0x48, 0xab, 0x39, 0x81, 0x0c, 0x7b, 0xfc, 0xcc, 0x3b,
+	0x34, 0x66, 0xaa, 0x75, 0xcc, 0xfe, 0xcc, 0xb7, 0x79, 0x33, 0x48, 0xb8, 0x3b, 0x47, 0x4e, 0xf5,
+	0xfc, 0x24, 0x99, 0x8f, 0x69, 0x9b, 0x86, 0x69, 0xe0, 0x77, 0x78, 0x96, 0x43, 0x4d, 0xc7, 0x28,
+	0xaf, 0xd8, 0x60, 0xc8, 0xe2, 0xbb, 0x1f, 0x20, 0x8f, 0x73, 0x97, 0xcc, 0x72, 0x90, 0x24, 0x41,
+	0xb8, 0xa9, 0x87, 0x01, 0xd3, 0x94, 0xb5, 0xe6, 0x8c, 0x20, 0xf5, 0xf8, 0xe2, 0x60, 0x34, 0x18,
+	0xf6, 0x3c, 0x46, 0xb4, 0x25, 0xdb, 0x41, 0x6f, 0x3e, 0x6e, 0x27, 0xec, 0x60, 0xa0, 0xa6, 0xdd,
+	0x7a, 0xab, 0xa2, 0x1d, 0x14, 0x86, 0xdb, 0x22, 0x93, 0xfc, 0x93, 0xf0, 0xb0, 0x32, 0xa1, 0x1f,
+	0x9f, 0x1d, 0xea, 0x34, 0x16, 0x89, 0x78, 0xb3, 0xe0, 0xdf, 0xbd, 0x22, 0x8f, 0x29, 0xb8, 0x57,
+	0xfd, 0xb6, 0x41, 0x06, 0x2c, 0xa2, 0x38, 0x98, 0xb6, 0xfb, 0xeb, 0x54, 0x74, 0x64, 0x63, 0xc2,
+	0x1e, 0x4c, 0x37, 0x34, 0x08, 0x4c, 0x3c, 0xcc, 0x70, 0xf2, 0x7b, 0x81, 0xf8, 0x25, 0x03, 0xef,
+	0x99, 0xd9, 0x33, 0xb7, 0xb2, 0x28, 0x9b, 0xc1, 0xc4, 0xf1, 0xbe, 0x5a, 0x22, 0x8d, 0xdc, 0x0c,
+	0x14, 0xb3, 0xdf, 0x4d, 0x70, 0xd2, 0xa7, 0xb7, 0xfd, 0x58, 0x7a, 0xa4, 0x8e, 0x99, 0xf6, 0x21,
+	0xe8, 0xde, 0xf6, 0x63, 0x53, 0x7d, 0x30, 0x06, 0x20, 0x39, 0xb9, 0xaf, 0x90, 0x4a, 0xda, 0xf1,
+	0x0b, 0xca, 0x13, 0x33, 0x38, 0x6a, 0x27, 0xd0, 0xd2, 0x5c, 0x02, 0x8c, 0x87, 0xfb, 0x66, 0xdc,
+	0xf9, 0xac, 0xcb, 0xe0, 0x44, 0xb1, 0x59, 0x59, 0x4f, 0x80, 0xb5, 0x7a, 0x9f, 0x26, 0x03, 0x34,
+	0xb8, 0x5a, 0x32, 0xd1, 0x4b, 0x8e, 0x9b, 0xe8, 0x95, 0x98, 0x6e, 0x04, 0xbb, 0xc2, 0x64, 0x51,
+	0x5a, 0xe2, 0xa6, 0x82, 0x80, 0x81, 0x25, 0x9f, 0x59, 0xed, 0x6f, 0xe0, 0x33, 0xa5, 0xfc, 0x33,
+	0x1c, 0x02, 0x06, 0x96, 0xfb, 0x4e, 0x32, 0x16, 0x74, 0xfd, 0x4d, 0x15, 0x43, 0xf9, 0x66, 0x54,
+	0x0f, 0x8b, 0xac, 0x05, 0x43, 0xc0, 0x94, 0x40, 0xac, 0x09, 0x04, 0xae, 0xfb, 0x6b, 0x0e, 0x99,
+	0x6c, 0x45, 0xdd, 0x6e, 0x14, 0xf2, 0xad, 0xa7, 0xd8, 0x47, 0xbf, 0x72, 0x52, 0x06, 0xc5, 0xec,
+	0xbc, 0xc1, 0x8c, 0x6f, 0xa4, 0x55, 0x42, 0x9b, 0x09, 0x02, 0x4b, 0x2a, 0x53, 0x8b, 0x54, 0x0f,
+	0xd0, 0x22, 0xbf, 0xe1, 0x90, 0x33, 0xfc, 0x59, 0x63, 0x47, 0x2c, 0x9c, 0xc7, 0xd1, 0x09, 0xbf,
+	0x56, 0xce, 0x49, 0xa0, 0x3c, 0x95, 0x39, 0x38, 0xe4, 0x85, 0x74, 0xaf, 0x91, 0x33, 0x1b, 0x51,
+	0xdc, 0xa2, 0x66, 0x47, 0x08, 0x15, 0xa8, 0x08, 0x5d, 0xcd, 0x22, 0x40, 0xfe, 0x19, 0xf7, 0x36,
+	0x79, 0xcc, 0x68, 0x34, 0xfb, 0x81, 0x6b, 0x41, 0x19, 0x20, 0xf8, 0xd8, 0xd5, 0x81, 0x58, 0x30,
+	0xe4, 0x69, 0x34, 0x97, 0x19, 0x44, 0x39, 0x88, 0x84, 0x26, 0xd4, 0x8b, 0x81, 0x05, 0x85, 0x0c,
+	0x36, 0x2e, 0xd7, 0xad, 0xa8, 0xdb, 0x8b, 0x42, 0x1a, 0xa6, 0x3c, 0x1b, 0x4a, 0x2c, 0xd7, 0xf3,
+	0xaa, 0x15, 0x0c, 0x0c, 0xdb, 0x49, 0x35, 0x31, 0x82, 0x93, 0xea, 0xa3, 0xa4, 0x16, 0x53, 0xf6,
+	0x91, 0xb8, 0x5e, 0x3b, 0xb6, 0xe3, 0x40, 0x9b, 0xbe, 0x9c, 0xac, 0x56, 0xfa, 0xa2, 0x21, 0x01,
+	0xc5, 0xd1, 0xbd, 0x4b, 0xc6, 0x7b, 0xb8, 0xb1, 0xa2, 0x98, 0x7c, 0x54, 0x40, 0x70, 0x8a, 0x62,
+	0xce, 0x8e, 0x05, 0xf4, 0x98, 0x5f, 0xe1, 0x4c, 0x40, 0x72, 0xbb, 0xf0, 0x53, 0xe4, 0x4c, 0x6e,
+	0x5e, 0x1d, 0xca, 0x7f, 0xb4, 0x40, 0x1e, 0x1b, 0x3c, 0x82, 0x0f, 0xe5, 0x45, 0xfa, 0xf5, 0x4c,
+	0x44, 0xa9, 0x61, 0x3f, 0x8f, 0xe0, 0x91, 0xf4, 0x49, 0x99, 0x86, 0x3b, 0x42, 0xa1, 0x5f, 0x3d,
+	0x5e, 0xcf, 0x5d, 0x09, 0x77, 0xf8, 0x04, 0x64, 0x6e, 0x97, 0x2b, 0xe1, 0x0e, 0x20, 0x6d, 0xf7,
+	0x4b, 0x8e, 0x65, 0xff, 0x71, 0x3f, 0xe6, 0x87, 0x4e, 0x64, 0xc3, 0x30, 0xb2, 0x49, 0xe8, 0xfd,
+	0x4e, 0x89, 0x5c, 0x3c, 0x88, 0xc8, 0x08, 0xdd, 0xf7, 0x0c, 0x86, 0xb4, 0xe2, 0x41, 0xb3, 0xd0,
+	0x90, 0x13, 0x38, 0x52, 0xf8, 0xd1, 0xf3, 0x87, 0x41, 0x80, 0xdc, 0x0e, 0x29, 0x77, 0xfd, 0x9e,
+	0x70, 0x6f, 0x2d, 0x1e, 0x37, 0x63, 0x06, 0x7f, 0xfb, 0x9d, 0x65, 0xbf, 0xc7, 0xad, 0x07, 0xa3,
+	0x01, 0x90, 0x8d, 0x9b, 0x92, 0xaa, 0x1f, 0xc7, 0xbe, 0x3c, 0xd5, 0xbc, 0x51, 0x0c, 0xbf, 0x39,
+	0x24, 0xc9, 0x4f, 0xd1, 0xac, 0x26, 0xe0, 0xcc, 0xbc, 0x5f, 0xac, 0x59, 0xa9, 0x19, 0xab, 0x32,
+	0x51, 0x89, 0x7b, 0xb5, 0x9c, 0xa2, 0x13, 0x95, 0x18, 0x59, 0x23, 0x5c, 0x9c, 0xfd, 0x06, 0xc1,
+	0xce, 0xfd, 0x8c, 0xc3, 0x52, 0xb1, 0x65, 0xca, 0x4a, 0xa3, 0x54, 0xf0, 0xc9, 0xaa, 0x99, 0x19,
+	0x6e, 0x26, 0x78, 0xcb, 0x46, 0x30, 0xb9, 0xe3, 0x32, 0xda, 0xe3, 0x29, 0x81, 0xd9, 0xed, 0x99,
+	0x4c, 0xd6, 0x96, 0x70, 0x77, 0x77, 0xc0, 0xb1, 0x74, 0x01, 0xe9, 0xbc, 0x23, 0x1c, 0x44, 0x7f,
+	0xcd, 0x21, 0x67, 0x82, 0xec, 0x81, 0x6c, 0xa3, 0x5a, 0x44, 0xe0, 0xc3, 0xf0, 0xf3, 0x5e, 0xb5,
+	0xbe, 0xe6, 0x40, 0x90, 0x17, 0xc6, 0x6d, 0x93, 0x4a, 0x10, 0x6e, 0x44, 0xc2, 0xaa, 0x68, 0x1e,
+	0x4f, 0xa8, 0xc5, 0x70, 0x23, 0xd2, 0x33, 0x1a, 0x7f, 0x01, 0xa3, 0xee, 0x2e, 0x91, 0x73, 0xb1,
+	0x70, 0x34, 0x5d, 0x0f, 0x12, 0x74, 0x07, 0x2c, 0x05, 0xdd, 0x20, 0x65, 0x16, 0x41, 0xb9, 0xd9,
+	0xb8, 0x7f, 0x6f, 0xe6, 0x1c, 0x0c, 0x80, 0xc3, 0xc0, 0xa7, 0xdc, 0xd7, 0xc8, 0xb8, 0xcc, 0x1d,
+	0xaf, 0x15, 0xb1, 0x25, 0xcc, 0xcf, 0x01, 0x35, 0x98, 0xf8, 0xef, 0x04, 0x24, 0x43, 0xf7, 0xaf,
+	0xa2, 0xaf, 0x88, 0xe5, 0x94, 0x25, 0xb7, 0x42, 0x91, 0x8e, 0xbd, 0x5a, 0xe0, 0x1c, 0x90, 0xd9,
+	0x6a, 0xda, 0x3a, 0x58, 0x90, 0xdc, 0x40, 0x33, 0xf6, 0xfe, 0xa2, 0x4e, 0xf2, 0x47, 0xc6, 0xee,
+	0xc7, 0x48, 0x3d, 0x56, 0x69, 0xf5, 0x4e, 0x11, 0xeb, 0xb6, 0x1c, 0x66, 0xe2, 0xb8, 0x5a, 0x09,
+	0xa5, 0x13, 0xe8, 0x35, 0x47, 0xdc, 0xc8, 0x24, 0xfa, 0x64, 0xb9, 0x80, 0x29, 0x26, 0xb8, 0x4e,
+	0x9a, 0x49, 0x20, 0x3c, 0xe5, 0xc3, 0x48, 0x4d, 0x29, 0x3f, 0xb4, 0xd4, 0x94, 0x5d, 0x32, 0xbe,
+	0xc5, 0xc7, 0xa1, 0xd8, 0x5b, 0x2c, 0x1f, 0xb7, 0x73, 0xad, 0xc1, 0xad, 0x47, 0x9d, 0x68, 0x00,
+	0xc9, 0x8e, 0x85, 0xd6, 0x18, 0xd1, 0x12, 0x5c, 0x83, 0x14, 0x97, 0x4d, 0x35, 0x7a, 0xa8, 0xc4,
+	0x47, 0xc8, 0x64, 0x4c, 0x5b, 0x51, 0xd8, 0x0a, 0x3a, 0xb4, 0x3d, 0x27, 0x0f, 0x2f, 0x0e, 0x93,
+	0xdb, 0xc2, 0x3c, 0x01, 0x60, 0xd0, 0x00, 0x8b, 0xa2, 0xfb, 0x0b, 0x0e, 0x99, 0x56, 0x79, 0xaa,
+	0xf8, 0x41, 0xa8, 0x70, 0x49, 0x2f, 0x15, 0x94, 0x15, 0xcb, 0x68, 0x36, 0x5d, 0xb4, 0xf1, 0xed,
+	0x36, 0xc8, 0xf0, 0x75, 0x3f, 0x48, 0x48, 0xb4, 0xce, 0x42, 0x07, 0xf0, 0x55, 0x6b, 0x87, 0x7e,
+	0xd5, 0x69, 0x9e, 0x8c, 0x27, 0x29, 0x80, 0x41, 0xcd, 0xbd, 0x41, 0x08, 0x9f, 0x36, 0x78, 0x68,
+	0xd1, 0xa8, 0x5b, 0xc9, 0x49, 0x64, 0x55, 0x41, 0x1e, 0xdc, 0x9b, 0xc9, 0xfb, 0x0b, 0x11, 0x00,
+	0xc6, 0xe3, 0xee, 0xcf, 0x92, 0xf1, 0xa4, 0xdf, 0xed, 0xfa, 0xca, 0x7b, 0x5d, 0x60, 0x7a, 0x1f,
+	0xa7, 0x6b, 0x68, 0x44, 0xde, 0x00, 0x92, 0xa3, 0xfb, 0x0a, 0xea, 0xf6, 0x44, 0x38, 0x32, 0xd9,
+	0x2c, 0x62, 0xff, 0x8b, 0x4d, 0xce, 0xbb, 0xc5, 0x73, 0xe7, 0x60, 0x00, 0x0e, 0x86, 0x53, 0xd8,
+	0xed, 0x4b, 0x11, 0x67, 0x0b, 0x03, 0x69, 0x7a, 0xa1, 0x1d, 0xbd, 0x27, 0x24, 0x78, 0x27, 0x99,
+	0xc4, 0x80, 0xd8, 0x38, 0xf4, 0x3b, 0x2f, 0xc2, 0x92, 0x74, 0x9e, 0xb2, 0x81, 0x76, 0xc5, 0x68,
+	0x07, 0x0b, 0x0b, 0x33, 0x35, 0x85, 0x97, 0xa1, 0xa4, 0x33, 0x35, 0xb9, 0x97, 0x41, 0xfa, 0x14,
+	0xbc, 0xff, 0x53, 0xb2, 0x0c, 0xb0, 0xb5, 0x98, 0x52, 0x37, 0x22, 0xd5, 0x30, 0x6a, 0x2b, 0x05,
+	0xfb, 0x7c, 0x31, 0x0a, 0xf6, 0x66, 0xd4, 0x36, 0x6a, 0xcb, 0xe0, 0xaf, 0x04, 0x38, 0x1f, 0x56,
+	0x7c, 0x43, 0x56, 0x29, 0x61, 0x80, 0x46, 0xa9, 0x70, 0xce, 0xaa, 0xf8, 0xc6, 0x2d, 0x93, 0x11,
+	0xd8, 0x7c, 0xdd, 0x6d, 0x52, 0xdd, 0x8a, 0x92, 0x54, 0x6e, 0x37, 0x8e, 0xb9, 0xb3, 0xb9, 0x1e,
+	0x25, 0x29, 0xb3, 0x18, 0xd4, 0x6b, 0x63, 0x4b, 0x02, 0x9c, 0x87, 0xf7, 0xc7, 0x76, 0x22, 0xf7,
+	0x49, 0x9d, 0x14, 0x7e, 0xc2, 0xb1, 0x93, 0x40, 0x4b, 0x45, 0x6c, 0x28, 0xcc, 0x9c, 0xe4, 0x03,
+	0xf3, 0x49, 0xbd, 0x2f, 0x39, 0x64, 0xbc, 0xe9, 0xb7, 0xb6, 0xa3, 0x8d, 0x0d, 0xf4, 0xcd, 0xb6,
+	0xfb, 0xb1, 0x99, 0x8f, 0xaa, 0xb6, 0xe9, 0x0b, 0xa2, 0x1d, 0x14, 0x06, 0x8e, 0xe1, 0x0d, 0x5f,
+	0xa5, 0xab, 0x97, 0xf9, 0x18, 0xbe, 0xca, 0x5a, 0x40, 0x40, 0xd0, 0xb5, 0xda, 0xf5, 0x77, 0xe5,
+	0xc3, 0x59, 0x3f, 0xfd, 0xb2, 0x06, 0x81, 0x89, 0xe7, 0xfd, 0x2b, 0x87, 0x34, 0x9a, 0x7e, 0x12,
+	0xb4, 0xb0, 0xfc, 0x59, 0x33, 0x48, 0xd7, 0xfb, 0xad, 0x6d, 0x9a, 0xf2, 0x74, 0x74, 0x94, 0xb2,
+	0x9f, 0xd0, 0xd8, 0xd8, 0xc7, 0x29, 0x29, 0x5f, 0x14, 0xed, 0xa0, 0x30, 0xdc, 0xd7, 0xc8, 0x04,
+	0x7a, 0xb7, 0xef, 0x46, 0x71, 0x1b, 0xe8, 0x46, 0x31, 0x75, 0x2a, 0x56, 0x69, 0x2b, 0xa6, 0x29,
+	0xd0, 0x0d, 0x71, 0xca, 0xad, 0xe9, 0x83, 0xc9, 0xcc, 0xfb, 0xbc, 0x43, 0x9e, 0x68, 0x52, 0x3f,
+	0xa6, 0x31, 0x2b, 0x6b, 0xa1, 0x5e, 0x64, 0xbe, 0x13, 0xf5, 0xdb, 0xee, 0xab, 0xa4, 0x96, 0x62,
+	0x33, 0x8a, 0xe5, 0x14, 0x2b, 0x16, 0x0b, 0xcb, 0x58, 0x13, 0xc4, 0x41, 0xb1, 0xf1, 0x7e, 0xab,
+	0x4e, 0xc6, 0x45, 0xcc, 0xc0, 0xc8, 0x59, 0xff, 0x72, 0xcb, 0x5c, 0x1a, 0xba, 0x65, 0x4e, 0xc8,
+	0x58, 0x8b, 0x95, 0xa8, 0x6b, 0x94, 0x8b, 0xd8, 0xa0, 0x0a, 0x01, 0x79, 0xd5, 0x3b, 0x2d, 0x16,
+	0xff, 0x0d, 0x82, 0x95, 0xfb, 0x45, 0x87, 0x9c, 0x6a, 0x45, 0x61, 0x48, 0x5b, 0x7a, 0xad, 0xae,
+	0x14, 0x11, 0x4b, 0x30, 0x6f, 0x13, 0xd5, 0xa7, 0x26, 0x19, 0x00, 0x64, 0xd9, 0xbb, 0xef, 0x25,
+	0x53, 0xbc, 0xcf, 0x6e, 0x5b, 0x3e, 0x56, 0x5d, 0x5b, 0xc8, 0x04, 0x82, 0x8d, 0x8b, 0x3e, 0xbd,
+	0x50, 0x57, 0xf1, 0x19, 0xd3, 0x3e, 0x3d, 0xa3, 0x7e, 0x8f, 0x81, 0x81, 0xe9, 0xbe, 0x31, 0xdd,
+	0x88, 0x69, 0xb2, 0x25, 0x62, 0x2a, 0x98, 0x9d, 0x30, 0x7e, 0xb4, 0x74, 0x5f, 0xc8, 0x51, 0x82,
+	0x01, 0xd4, 0xdd, 0x6d, 0xb1, 0x5f, 0xab, 0x15, 0xa1, 0xa6, 0xc4, 0x67, 0x1e, 0xba, 0x6d, 0x9b,
+	0x21, 0xd5, 0x64, 0xcb, 0x8f, 0xdb, 0xcc, 0x3e, 0x29, 0xf3, 0x14, 0x93, 0x55, 0x6c, 0x00, 0xde,
+	0xee, 0x2e, 0x90, 0xd3, 0x99, 0xca, 0x48, 0x09, 0xb3, 0x40, 0x6a, 0x3a, 0x27, 0x21, 0x53, 0x53,
+	0x09, 0x8b, 0x09, 0x65, 0x5a, 0xcc, 0xbd, 0xfc, 0xc4, 0x01, 0x7b, 0xf9, 0x3d, 0x15, 0xb9, 0xc7,
+	0x7d, 0xa2, 0x2f, 0x14, 0xd2, 0x01, 0x23, 0x85, 0xe9, 0x7d, 0x2e, 0x13, 0xa6, 0xc7, 0xfd, 0xa2,
+	0xb7, 0x8b, 0x11, 0xe0, 0xf0, 0x31, 0x79, 0x8f, 0x32, 0xc6, 0xee, 0x2f, 0x1c, 0x22, 0xbf, 0xeb,
+	0xbc, 0xdf, 0xda, 0xa2, 0x38, 0x64, 0xd0, 0xa3, 0xae, 0xb6, 0x82, 0xf3, 0x51, 0x3f, 0xe4, 0xe1,
+	0x75, 0x65, 0xed, 0x51, 0x07, 0x0b, 0x0a, 0x19, 0x6c, 0xf4, 0x90, 0x63, 0x3f, 0xf1, 0x47, 0xf9,
+	0x72, 0xa6, 0xb6, 0x9b, 0x73, 0x2b, 0x8b, 0xe2, 0x29, 0x8d, 0xe3, 0x46, 0xe4, 0x4c, 0xc7, 0x4f,
+	0x52, 0x26, 0x01, 0xee, 0x0c, 0x8f, 0x98, 0x6c, 0xcf, 0x0a, 0xc3, 0x2d, 0x65, 0x09, 0x41, 0x9e,
+	0xb6, 0xf7, 0x9d, 0x0a, 0x99, 0xb2, 0x34, 0xe3, 0x21, 0xd7, 0xc1, 0xb7, 0x93, 0x9a, 0x5c, 0x9a,
+	0xb2, 0x95, 0x44, 0xd4, 0xfa, 0xa5, 0x30, 0x70, 0xdd, 0x5e, 0xd7, 0x0b, 0x57, 0x76, 0xdd, 0x36,
+	0xd6, 0x34, 0x30, 0xf1, 0x98, 0x52, 0x4e, 0x3b, 0xc9, 0x7c, 0x27, 0xa0, 0x61, 0xca, 0xc5, 0x2c,
+	0x46, 0x29, 0xaf, 0x2d, 0xad, 0x9a, 0x44, 0xb5, 0x52, 0xce, 0x00, 0x20, 0xcb, 0x1e, 0x7d, 0x26,
+	0x53, 0xfe, 0xdd, 0x44, 0xd7, 0x51, 0x6d, 0x54, 0x8b, 0x58, 0xa4, 0xac, 0xd2, 0xac, 0xdc, 0x8b,
+	0x6a, 0x35, 0x81, 0xcd, 0x14, 0x83, 0xae, 0x5d, 0xba, 0x4b, 0x5b, 0x32, 0x64, 0x50, 0xc8, 0x32,
+	0x56, 0xc4, 0x8e, 0xe9, 0x4a, 0x8e, 0x2e, 0xd7, 0xea, 0xf9, 0x76, 0x18, 0x20, 0x83, 0xf7, 0x2f,
+	0xca, 0x6a, 0x42, 0xe9, 0x28, 0x55, 0xdf, 0xc8, 0xdf, 0x73, 0x8e, 0x9e, 0xbf, 0xa7, 0xcf, 0xf6,
+	0xf3, 0x39, 0x7c, 0x56, 0xde, 0x50, 0xe9, 0x11, 0xe5, 0x0d, 0x7d, 0xca, 0xb1, 0x6a, 0xe6, 0x4c,
+	0x5c, 0xfe, 0x60, 0xb1, 0x11, 0xb2, 0xb3, 0x3c, 0xee, 0x20, 0xa3, 0xdd, 0xed, 0x70, 0x13, 0xd4,
+	0xa6, 0x06, 0xda, 0xa1, 0xb4, 0xe1, 0x7f, 0x28, 0x93, 0x09, 0x63, 0x25, 0x1d, 0x68, 0x16, 0x39,
+	0xaf, 0x33, 0xb3, 0xa8, 0x74, 0x08, 0xb3, 0xe8, 0xe7, 0x48, 0xbd, 0x25, 0xb5, 0x7c, 0x31, 0x45,
+	0x7b, 0xb3, 0x6b, 0x87, 0x56, 0xf4, 0xaa, 0x09, 0x34, 0x4f, 0x3c, 0x4c, 0x36, 0xc8, 0x88, 0x15,
+	0xa2, 0xc2, 0x56, 0x88, 0x41, 0xf9, 0x33, 0x62, 0xa5, 0xc8, 0x3f, 0x93, 0x0d, 0x17, 0xa9, 0x8e,
+	0x10, 0x2e, 0xf2, 0x1d, 0x47, 0x7d, 0xdc, 0x87, 0x50, 0x11, 0xe0, 0x15, 0xbb, 0x22, 0xc0, 0x95,
+	0x42, 0xba, 0x79, 0x48, 0x29, 0x80, 0x9b, 0x64, 0x1c, 0x0f, 0x4a, 0xfd, 0xb0, 0xed, 0xfe, 0x10,
+	0x19, 0x6f, 0xf1, 0x7f, 0x85, 0xef, 0x84, 0x9d, 0xb8, 0x09, 0x28, 0x48, 0x18, 0x06, 0x8f, 0xf8,
+	0xf1, 0xa6, 0xf4, 0x97, 0xb0, 0xe0, 0x91, 0xb9, 0x78, 0x33, 0x01, 0xd6, 0xea, 0x7d, 0xa1, 0x4c,
+	0xd8, 0xe1, 0xb7, 0x1f, 0xd3, 0xf6, 0x5a, 0xf4, 0xc6, 0x39, 0x15, 0xfb, 0x61, 0x9e, 0x55, 0x94,
+	0x1f, 0xf2, 0x59, 0x85, 0xf7, 0x59, 0x87, 0xb8, 0x2a, 0x1c, 0x41, 0x1f, 0xc0, 0x5e, 0x22, 0x75,
+	0x15, 0x98, 0x20, 0xac, 0x16, 0x3d, 0xff, 0x24, 0x00, 0x34, 0xce, 0x08, 0xdb, 0xcf, 0x67, 0xa4,
+	0x72, 0x2c, 0xdb, 0xe1, 0xa3, 0x4c, 0xa5, 0x0a, 0x5d, 0xe9, 0xfd, 0x76, 0x89, 0x3c, 0xc6, 0xd7,
+	0xbb, 0x65, 0x3f, 0xf4, 0x37, 0x69, 0x17, 0xa5, 0x1a, 0xf5, 0x48, 0xbd, 0x85, 0xfb, 0x9e, 0x40,
+	0x86, 0x83, 0x1e, 0x77, 0x62, 0xf0, 0x01, 0xcd, 0x87, 0xf0, 0x62, 0x18, 0xa4, 0xc0, 0x88, 0xbb,
+	0x09, 0xa9, 0xc9, 0x12, 0xf0, 0x8d, 0x72, 0x91, 0x8c, 0xd4, 0x9c, 0x17, 0x8b, 0x12, 0x05, 0xc5,
+	0x08, 0xad, 0xc2, 0x4e, 0xd4, 0xda, 0x06, 0xda, 0x8b, 0x1a, 0x15, 0x3b, 0x1a, 0x6f, 0x49, 0xb4,
+	0x83, 0xc2, 0xf0, 0x7e, 0xdb, 0x21, 0x59, 0x75, 0x6f, 0x14, 0xfc, 0x72, 0xf6, 0x2d, 0xf8, 0x75,
+	0x88, 0x4a, 0x56, 0x3f, 0x43, 0x26, 0xfc, 0x14, 0x57, 0x68, 0xbe, 0xa7, 0x2d, 0x1f, 0xcd, 0xf7,
+	0xbd, 0x1c, 0xb5, 0x83, 0x8d, 0x80, 0xed, 0x65, 0x4d, 0x72, 0xde, 0xff, 0xac, 0x90, 0x33, 0xb9,
+	0x74, 0x0a, 0xf7, 0x39, 0x8c, 0xdf, 0xe2, 0xc3, 0xa3, 0x27, 0x1d, 0x32, 0x75, 0x33, 0xa6, 0x4a,
+	0xc3, 0xc0, 0xc2, 0x1c, 0x61, 0x80, 0x2e, 0x92, 0xb3, 0x31, 0xee, 0xa2, 0xfb, 0x74, 0x6e, 0x23,
+	0xa5, 0xf1, 0x2a, 0xc5, 0x33, 0x0d, 0x5e, 0x96, 0xae, 0xdc, 0x7c, 0x1c, 0x4b, 0xb3, 0x42, 0x1e,
+	0x0c, 0x83, 0x9e, 0x71, 0x7b, 0x64, 0xaa, 0x63, 0x1a, 0x58, 0x8d, 0xca, 0xd1, 0x6d, 0x33, 0xb5,
+	0x00, 0x5b, 0xcd, 0x60, 0x33, 0xb0, 0xad, 0xb4, 0xea, 0x23, 0xb2, 0xd2, 0x7e, 0x5e, 0x5b, 0x69,
+	0xfc, 0xac, 0xf8, 0xa5, 0x82, 0xd3, 0x69, 0x4e, 0xda, 0x4c, 0x7b, 0x81, 0xd4, 0x64, 0x2c, 0xcd,
+	0x48, 0x31, 0x28, 0x26, 0x9d, 0x21, 0x1a, 0xed, 0x41, 0x89, 0x0c, 0xb0, 0xf0, 0x71, 0x9e, 0xe9,
+	0xe5, 0xd4, 0x9a, 0x67, 0x87, 0x5b, 0x52, 0xdd, 0x5d, 0x1e, 0x47, 0xc4, 0x17, 0x8e, 0x0f, 0x14,
+	0xbd, 0x43, 0xd1, 0xa1, 0x45, 0x2a, 0x96, 0x5f, 0x85, 0x17, 0x5d, 0x26, 0x44, 0x5b, 0x41, 0x22,
+	0x52, 0x5b, 0x9d, 0x0d, 0x6a, 0x63, 0x09, 0x0c, 0x2c, 0xdc, 0xb0, 0x06, 0x61, 0x92, 0xfa, 0x9d,
+	0xce, 0xf5, 0x20, 0x4c, 0x85, 0xe7, 0x4d, 0xad, 0x90, 0x8b, 0x1a, 0x04, 0x26, 0xde, 0x85, 0x77,
+	0x1b, 0xdf, 0xe5, 0x30, 0xdf, 0x73, 0x8b, 0x3c, 0x71, 0x2d, 0x48, 0x55, 0x7e, 0x81, 0x1a, 0x47,
+	0x68, 0xe4, 0xa8, 0x7c, 0x19, 0x67, 0x68, 0xbe, 0x8c, 0x11, 0xdf, 0x5f, 0xb2, 0xd3, 0x11, 0xb2,
+	0xf1, 0xfd, 0xde, 0x73, 0xe4, 0xdc, 0xb5, 0x20, 0xc5, 0xd8, 0xe9, 0x43, 0x32, 0xf1, 0x7e, 0xab,
+	0x42, 0x26, 0xcd, 0xdc, 0xb9, 0xc3, 0xa4, 0xfc, 0x60, 0xbe, 0xb6, 0xcc, 0x0d, 0x09, 0xd4, 0xa1,
+	0xcf, 0x9d, 0x63, 0x27, 0xf2, 0x0d, 0xee, 0x31, 0xc3, 0x94, 0xd1, 0x3c, 0xc1, 0x14, 0xc0, 0xbd,
+	0x4b, 0xaa, 0x1b, 0x2c, 0xfe, 0xbc, 0x5c, 0xc4, 0xf1, 0xf3, 0xa0, 0x1e, 0xd5, 0xd3, 0x8c, 0x47,
+	0xb0, 0x73, 0x7e, 0xb8, 0x42, 0xc6, 0x76, 0x52, 0x93, 0x11, 0xba, 0xc8, 0xdb, 0x41, 0x61, 0x0c,
+	0x53, 0xf5, 0xd5, 0x23, 0xa8, 0x7a, 0x4b, 0xf1, 0x8e, 0x3d, 0x1a, 0xc5, 0xeb, 0x7d, 0xb6, 0x44,
+	0xa6, 0xaf, 0x85, 0xfd, 0x95, 0x6b, 0xaa, 0xfc, 0x3a, 0x2a, 0xa7, 0x6d, 0xba, 0xb7, 0xb8, 0x20,
+	0xc6, 0x90, 0xea, 0xb5, 0x1b, 0xd8, 0x08, 0x1c, 0x86, 0xd3, 0x71, 0x23, 0x08, 0x37, 0x69, 0xdc,
+	0x8b, 0x03, 0xe1, 0x51, 0x33, 0xa6, 0xe3, 0x55, 0x0d, 0x02, 0x13, 0x0f, 0x69, 0x47, 0x77, 0x43,
+	0x1a, 0x67, 0x4d, 0xb9, 0x5b, 0xd8, 0x08, 0x1c, 0x86, 0x48, 0x69, 0xdc, 0x4f, 0xd2, 0x46, 0xc5,
+	0x46, 0x5a, 0xc3, 0x46, 0xe0, 0x30, 0x1c, 0xeb, 0x49, 0x7f, 0x9d, 0x9d, 0x6f, 0x67, 0x22, 0x9d,
+	0x57, 0x79, 0x33, 0x48, 0x38, 0xa2, 0x6e, 0xd3, 0xbd, 0x05, 0xdc, 0x54, 0x65, 0x52, 0x2b, 0x6e,
+	0xf0, 0x66, 0x90, 0x70, 0x56, 0xa9, 0xcd, 0xee, 0x8e, 0xef, 0xbb, 0x4a, 0x6d, 0xb6, 0xf8, 0x43,
+	0xb6, 0x67, 0xbf, 0xea, 0x90, 0x49, 0x33, 0x2a, 0xc5, 0xdd, 0xcc, 0x58, 0x79, 0xb7, 0x72, 0x85,
+	0x3e, 0x7f, 0x72, 0xd0, 0x5d, 0x55, 0x9b, 0x41, 0x1a, 0xf5, 0x92, 0x67, 0x69, 0xb8, 0x19, 0x84,
+	0x94, 0x9d, 0x83, 0xf2, 0x68, 0x16, 0x2b, 0xe4, 0x85, 0xd5, 0x52, 0x3d, 0xbc, 0x99, 0xe8, 0xdd,
+	0x21, 0x67, 0x72, 0xf9, 0x34, 0x23, 0x2c, 0xae, 0x07, 0x66, 0x33, 0x7a, 0x40, 0x26, 0x90, 0x30,
+	0x8f, 0x8a, 0x4c, 0xdc, 0x79, 0x72, 0x86, 0x1b, 0x00, 0xc8, 0x69, 0x15, 0x6f, 0x78, 0x52, 0x39,
+	0x52, 0xcc, 0x7d, 0x7b, 0x3b, 0x0b, 0x84, 0x3c, 0x3e, 0x96, 0x81, 0x9e, 0xb2, 0x52, 0x9c, 0x0a,
+	0x32, 0x03, 0xd8, 0x4c, 0x8b, 0x58, 0x90, 0x14, 0x0b, 0x5a, 0x2d, 0xb3, 0xe5, 0x44, 0xcf, 0x34,
+	0x0d, 0x02, 0x13, 0xcf, 0xfb, 0x52, 0x89, 0xd4, 0xe4, 0x19, 0xf8, 0x08, 0xa2, 0x7c, 0xc6, 0x21,
+	0x53, 0xca, 0x65, 0x8e, 0xcf, 0x88, 0xc1, 0x78, 0xf3, 0xf8, 0xa7, 0xf0, 0x2a, 0x98, 0x10, 0x7d,
+	0x31, 0xca, 0x26, 0x05, 0x93, 0x19, 0xd8, 0xbc, 0xdd, 0xdb, 0x18, 0x54, 0x99, 0xa4, 0xb4, 0x6b,
+	0x78, 0x85, 0x3c, 0x63, 0xc6, 0xcd, 0xb6, 0xa2, 0x98, 0xe2, 0xfc, 0xc2, 0xc8, 0x81, 0x55, 0x85,
+	0xa9, 0x8d, 0x08, 0xdd, 0x06, 0x06, 0x25, 0xef, 0x1f, 0x97, 0xc8, 0xe9, 0xac, 0x48, 0xee, 0x4b,
+	0x18, 0x75, 0xa4, 0x2f, 0xce, 0xc8, 0x1c, 0xfc, 0x4f, 0x82, 0x01, 0x7b, 0x70, 0x6f, 0x66, 0x26,
+	0x7f, 0xef, 0xd9, 0xac, 0x89, 0x02, 0x16, 0x31, 0x7e, 0x6e, 0x21, 0x0e, 0xd8, 0x9a, 0x7b, 0x73,
+	0xbd, 0x5e, 0xa3, 0x94, 0x3d, 0xb7, 0x30, 0xa1, 0x90, 0xc1, 0x76, 0x57, 0xc8, 0x39, 0xa3, 0xe5,
+	0x26, 0x0d, 0x36, 0xb7, 0xd6, 0xa3, 0x58, 0xee, 0x2d, 0xde, 0xac, 0xe3, 0x5f, 0xf2, 0x38, 0x30,
+	0xf0, 0x49, 0x5c, 0xef, 0x5a, 0x7e, 0xcf, 0x6f, 0x05, 0xe9, 0x9e, 0x70, 0x73, 0x29, 0xdd, 0x34,
+	0x2f, 0xda, 0x41, 0x61, 0x78, 0xcb, 0xa4, 0x32, 0xe2, 0x08, 0x1a, 0xc9, 0xa6, 0x7d, 0x81, 0xd4,
+	0x90, 0x9c, 0x34, 0x70, 0x8a, 0x20, 0x19, 0x91, 0x9a, 0xbc, 0x56, 0xc2, 0xf5, 0x48, 0x39, 0xf0,
+	0xe5, 0xd1, 0x90, 0x7a, 0xad, 0xc5, 0x24, 0xe9, 0xb3, 0x6d, 0x22, 0x02, 0xdd, 0x67, 0x48, 0x99,
+	0xee, 0xf6, 0xb2, 0x67, 0x40, 0x57, 0x76, 0x7b, 0x41, 0x4c, 0x13, 0x44, 0xa2, 0xbb, 0x3d, 0xf7,
+	0x02, 0x29, 0x05, 0x6d, 0xb1, 0x48, 0x11, 0x81, 0x53, 0x5a, 0x5c, 0x80, 0x52, 0xd0, 0xf6, 0x76,
+	0x49, 0x5d, 0x32, 0x64, 0x41, 0x2b, 0x5c, 0x77, 0x3b, 0x45, 0x04, 0xad, 0x48, 0xba, 0x43, 0xb4,
+	0x76, 0x9f, 0x10, 0x9d, 0x81, 0x55, 0x94, 0x7e, 0xb9, 0x48, 0x2a, 0xad, 0x48, 0xe4, 0xa1, 0xd6,
+	0x34, 0x19, 0x5e, 0x00, 0x1b, 0x21, 0xde, 0x1d, 0x32, 0x7d, 0x23, 0x8c, 0xee, 0xb2, 0xb2, 0xd5,
+	0x57, 0x03, 0xda, 0x69, 0x23, 0xe1, 0x0d, 0xfc, 0x27, 0x6b, 0x22, 0x30, 0x28, 0x70, 0x98, 0x2a,
+	0x1a, 0x54, 0x1a, 0x56, 0x34, 0xc8, 0xfb, 0x84, 0x43, 0x4e, 0xab, 0xdc, 0x0d, 0xa9, 0x8d, 0x9f,
+	0x23, 0x93, 0xeb, 0xfd, 0xa0, 0xd3, 0x16, 0xbf, 0xb3, 0x1b, 0xf5, 0xa6, 0x01, 0x03, 0x0b, 0x13,
+	0xb7, 0x15, 0xeb, 0x41, 0xe8, 0xc7, 0x7b, 0x2b, 0x5a, 0xfd, 0x2b, 0x8d, 0xd0, 0x54, 0x10, 0x30,
+	0xb0, 0xd0, 0xaf, 0x38, 0x6d, 0xa7, 0x8f, 0x8c, 0x60, 0xdd, 0x3f, 0x43, 0xaa, 0x2c, 0xa3, 0x24,
+	0xdb, 0xaf, 0xec, 0x79, 0xe0, 0x30, 0x0c, 0x9a, 0xe0, 0x49, 0xf0, 0xc5, 0xdc, 0xf9, 0xa1, 0x84,
+	0x54, 0xdb, 0x7b, 0x16, 0x8e, 0x23, 0xf2, 0xee, 0x05, 0x2b, 0x3c, 0x0c, 0x1b, 0x8f, 0x7a, 0x66,
+	0xa5, 0x97, 0x0f, 0x14, 0x99, 0x5a, 0x23, 0x72, 0x0b, 0xc4, 0x36, 0x5a, 0xad, 0xdc, 0xf2, 0x73,
+	0x48, 0xd6, 0x17, 0xde, 0x43, 0x26, 0x4d, 0xcc, 0x83, 0x76, 0x5e, 0x35, 0x73, 0xe7, 0xf5, 0x19,
+	0x73, 0x50, 0x88, 0xe4, 0xa1, 0x11, 0xc6, 0xfa, 0x8b, 0xa4, 0xda, 0x52, 0x87, 0xbb, 0x47, 0x2a,
+	0x3b, 0xa8, 0xb2, 0xd2, 0x91, 0x0c, 0x70, 0x6a, 0xde, 0xf7, 0x4a, 0xe4, 0x4c, 0xae, 0xeb, 0x71,
+	0x00, 0x6c, 0xc6, 0x51, 0xbf, 0x97, 0x1d, 0xff, 0xec, 0xd6, 0x27, 0xe0, 0x30, 0x33, 0x17, 0xaf,
+	0x74, 0x40, 0x2e, 0xde, 0x45, 0x52, 0xd9, 0x0e, 0xc2, 0x76, 0xf6, 0x1e, 0x0d, 0xbc, 0x3f, 0x0a,
+	0x18, 0x44, 0x75, 0x40, 0x65, 0xb4, 0x42, 0x45, 0xd5, 0x11, 0x72, 0xc0, 0xde, 0x9b, 0x75, 0x35,
+	0x8d, 0xd9, 0xc7, 0x36, 0xfb, 0x7a, 0x8d, 0x9e, 0x27, 0xae, 0x0e, 0x17, 0x50, 0x14, 0x78, 0xe1,
+	0xb6, 0x0b, 0x82, 0x82, 0x3b, 0x97, 0xc3, 0x80, 0x01, 0x4f, 0x79, 0x9f, 0x2a, 0x91, 0x29, 0xab,
+	0xce, 0x8d, 0xdb, 0x21, 0x35, 0xda, 0x61, 0x2e, 0x5c, 0xa9, 0x58, 0x8f, 0x5b, 0xc0, 0x54, 0x2d,
+	0x06, 0x57, 0x04, 0x5d, 0x50, 0x1c, 0x5e, 0x17, 0xe7, 0x94, 0xde, 0xdf, 0x2f, 0x91, 0x53, 0x99,
+	0x62, 0xdc, 0x98, 0xa5, 0x6e, 0x16, 0x81, 0x74, 0x8a, 0xf0, 0x8c, 0xed, 0x5b, 0x9f, 0xf9, 0x70,
+	0xa5, 0x20, 0x1f, 0x55, 0x57, 0xfd, 0x5e, 0x89, 0x4c, 0xdb, 0x55, 0xc4, 0x5f, 0x87, 0x3d, 0xf5,
+	0x23, 0xa4, 0xce, 0x0a, 0xe5, 0xb2, 0xeb, 0xcf, 0xb8, 0x03, 0x8e, 0x17, 0x36, 0x95, 0x8d, 0xa0,
+	0xe1, 0xaf, 0x8b, 0x0a, 0x9b, 0xde, 0x3f, 0x74, 0xc8, 0x79, 0xfe, 0x96, 0xd9, 0x71, 0xf8, 0xb7,
+	0x06, 0xf5, 0xee, 0xcb, 0xc5, 0x0a, 0x98, 0xa9, 0xa2, 0x75, 0x50, 0xff, 0xb2, 0x3b, 0xa6, 0x84,
+	0xb4, 0xf6, 0x50, 0x78, 0x1d, 0x0a, 0x7b, 0xa8, 0xc1, 0xe0, 0xfd, 0x5e, 0x99, 0xe8, 0x6b, 0xb5,
+	0xb0, 0x9a, 0x18, 0x4b, 0x7b, 0x29, 0xa4, 0x9a, 0x18, 0x06, 0x1b, 0x29, 0xd2, 0xdc, 0x21, 0x6c,
+	0x64, 0xbd, 0x7c, 0xda, 0x41, 0x1f, 0x6b, 0x90, 0x06, 0x3e, 0xdb, 0x32, 0x14, 0x73, 0x99, 0x8f,
+	0x62, 0xb7, 0xc8, 0x29, 0x47, 0xb1, 0xe9, 0xb5, 0x55, 0xcc, 0xc0, 0xe4, 0xec, 0x7e, 0x44, 0xc4,
+	0x21, 0x96, 0x0b, 0xcb, 0x1b, 0xab, 0x65, 0x82, 0x0f, 0x7b, 0xa4, 0x1a, 0xd3, 0x34, 0x2e, 0x28,
+	0xe5, 0x12, 0x90, 0x94, 0x2a, 0x4c, 0xa9, 0x2f, 0x71, 0xc5, 0x66, 0xe0, 0x8c, 0xbc, 0x84, 0xb8,
+	0xf9, 0xbe, 0x38, 0x64, 0x8c, 0x17, 0x46, 0xb1, 0xf5, 0xd3, 0xa8, 0x8b, 0xdd, 0x24, 0x1c, 0xcb,
+	0x3a, 0x8a, 0x4d, 0x02, 0x40, 0xe3, 0x78, 0x5f, 0xa8, 0x92, 0x4c, 0x1e, 0x8a, 0xbb, 0x6b, 0x5e,
+	0x09, 0xe7, 0x14, 0x7b, 0x25, 0x9c, 0x12, 0x66, 0xd0, 0xb5, 0x70, 0xee, 0x26, 0xa9, 0xf6, 0xb6,
+	0xfc, 0x44, 0xee, 0x08, 0x5e, 0x50, 0x66, 0x33, 0x36, 0x3e, 0xb8, 0x37, 0xf3, 0xd3, 0xa3, 0x79,
+	0x98, 0x70, 0xac, 0x5e, 0xe2, 0x45, 0x01, 0x34, 0x6b, 0x46, 0x03, 0x38, 0xfd, 0xc3, 0x5c, 0x67,
+	0xf4, 0x49, 0x51, 0x56, 0x18, 0x68, 0xd2, 0xef, 0xa4, 0x62, 0x34, 0xbc, 0x50, 0xe0, 0x2c, 0xe3,
+	0x84, 0x75, 0x22, 0x27, 0xff, 0x0d, 0x06, 0x53, 0xf7, 0x25, 0x52, 0x4f, 0x52, 0x3f, 0x4e, 0x8f,
+	0x98, 0xf3, 0xa4, 0x3a, 0x7d, 0x55, 0x12, 0x01, 0x4d, 0x0f, 0xd3, 0x8c, 0x36, 0x82, 0x30, 0x48,
+	0xb6, 0x8e, 0x18, 0x3e, 0x2c, 0x0b, 0x31, 0x0a, 0x0a, 0x60, 0x50, 0xc3, 0x0d, 0x17, 0x1b, 0xdb,
+	0x3c, 0x66, 0xa6, 0xc6, 0x76, 0xd4, 0x4a, 0x15, 0x82, 0x82, 0x80, 0x81, 0xe5, 0xfd, 0x28, 0xb1,
+	0xb3, 0x91, 0x31, 0x0c, 0x98, 0x27, 0x3f, 0x73, 0x8f, 0x1b, 0x0b, 0x03, 0xb6, 0xf2, 0x94, 0x7f,
+	0xc3, 0x21, 0x66, 0xca, 0xb4, 0xfb, 0x2a, 0xcf, 0xcd, 0x76, 0x8a, 0x38, 0x27, 0x30, 0xe8, 0xce,
+	0x2e, 0xfb, 0xbd, 0xcc, 0x81, 0x95, 0x4c, 0xd0, 0xc6, 0x53, 0x24, 0x09, 0x3d, 0xd4, 0x29, 0xd2,
+	0xc7, 0xc9, 0xd9, 0xec, 0xa5, 0xc0, 0xc2, 0xc3, 0x7e, 0xf0, 0xf6, 0x41, 0xee, 0x09, 0x4a, 0x07,
+	0xee, 0x09, 0x86, 0xdf, 0xbe, 0xf7, 0x9b, 0x0e, 0xb9, 0x78, 0xd0, 0xdd, 0xc5, 0x78, 0x4a, 0x78,
+	0xd7, 0x8f, 0x65, 0xd5, 0x5b, 0xa6, 0x28, 0xef, 0xf8, 0x71, 0x08, 0xac, 0x15, 0x63, 0xa2, 0x79,
+	0x5e, 0xaf, 0x70, 0x08, 0xbe, 0x50, 0xec, 0x4d, 0xca, 0x37, 0xa8, 0x71, 0x1c, 0xcb, 0x73, 0x8a,
+	0x41, 0x30, 0xf4, 0xbe, 0xeb, 0x10, 0x57, 0x5e, 0x5a, 0xaa, 0xd3, 0x8d, 0x59, 0x65, 0x7d, 0xa3,
+	0x82, 0xbe, 0x99, 0x90, 0x95, 0xa9, 0xac, 0x6f, 0xfc, 0x42, 0x27, 0xef, 0x2b, 0xaf, 0xe2, 0x1e,
+	0xde, 0xac, 0x9d, 0x5f, 0xd2, 0x4e, 0xde, 0xe7, 0x5f, 0xc8, 0x00, 0x21, 0x8f, 0xef, 0xde, 0x22,
+	0xe7, 0xbb, 0x2c, 0xba, 0xa4, 0xcd, 0x3c, 0x1d, 0x09, 0x0f, 0x35, 0x89, 0x65, 0x29, 0x99, 0x27,
+	0xee, 0xdf, 0x9b, 0x39, 0xbf, 0x3c, 0x08, 0x01, 0x06, 0x3f, 0xe7, 0xbd, 0x9b, 0xb8, 0x3c, 0x48,
+	0x65, 0x7e, 0x50, 0xc4, 0xc1, 0xd0, 0xdd, 0xae, 0xf7, 0x4b, 0x55, 0x72, 0x2a, 0x53, 0x13, 0xd1,
+	0xfd, 0x9b, 0xce, 0x80, 0x10, 0x87, 0x63, 0xaf, 0xdf, 0x79, 0xf1, 0x46, 0x0a, 0x9a, 0xc0, 0x5b,
+	0x18, 0xc3, 0x5e, 0x3f, 0x2d, 0x26, 0xe3, 0x89, 0x0b, 0xb1, 0x88, 0x04, 0x0d, 0xd7, 0x18, 0xfe,
+	0x04, 0xce, 0xa6, 0xc8, 0x10, 0x0c, 0xcb, 0x18, 0xaf, 0x3c, 0xa2, 0x80, 0x88, 0x4f, 0xea, 0x80,
+	0x88, 0x6a, 0x11, 0x7e, 0x9c, 0xcc, 0x60, 0x39, 0xe9, 0x70, 0x88, 0x7f, 0x52, 0x22, 0x13, 0xc6,
+	0x47, 0x73, 0x7f, 0xc5, 0x2e, 0x28, 0xe2, 0x14, 0xf7, 0x4a, 0x8c, 0xfe, 0xac, 0x2e, 0x19, 0xc2,
+	0x5f, 0xe9, 0x2d, 0xf9, 0x5a, 0x22, 0x0f, 0xee, 0xcd, 0x9c, 0xce, 0x54, 0x0b, 0xb1, 0xea, 0x8b,
+	0x5c, 0xf8, 0x18, 0x39, 0x95, 0x21, 0x33, 0xe0, 0x95, 0xd7, 0xec, 0xfb, 0x90, 0x8f, 0xe9, 0x96,
+	0x30, 0xbb, 0xec, 0x1b, 0xd8, 0x65, 0x22, 0x23, 0x25, 0xea, 0xd0, 0x11, 0x5c, 0x5e, 0xef, 0x62,
+	0xa1, 0x88, 0xad, 0x38, 0xe8, 0xa5, 0xda, 0xc9, 0x64, 0x06, 0x0d, 0x4a, 0x10, 0x98, 0x78, 0xee,
+	0xdb, 0x48, 0xad, 0x17, 0x75, 0x82, 0x56, 0xa0, 0x4a, 0x60, 0xb1, 0x6c, 0xb2, 0x15, 0xd1, 0x06,
+	0x0a, 0xea, 0xde, 0x25, 0x75, 0x75, 0xb9, 0x75, 0xa3, 0x52, 0xa8, 0x83, 0x5b, 0x19, 0x2d, 0xfa,
+	0x4a, 0x68, 0xcd, 0x0b, 0x33, 0x0f, 0xd9, 0x22, 0x28, 0xa3, 0x68, 0x99, 0xab, 0x93, 0xad, 0x8e,
+	0x09, 0x08, 0x88, 0xf7, 0xf5, 0x3a, 0x39, 0x37, 0xa8, 0x30, 0xad, 0xfb, 0x51, 0x32, 0xc6, 0x65,
+	0x2c, 0xa6, 0xf6, 0xf9, 0x20, 0x1e, 0xd7, 0x18, 0x41, 0x21, 0x16, 0xfb, 0x1f, 0x04, 0x4f, 0xc1,
+	0xbd, 0xe3, 0xaf, 0x37, 0x4a, 0x27, 0xc8, 0x7d, 0xc9, 0xd7, 0xdc, 0x97, 0x7c, 0xce, 0xbd, 0xe3,
+	0xaf, 0xbb, 0xbb, 0xa4, 0xba, 0x19, 0xa4, 0xd4, 0x17, 0x4e, 0x84, 0x3b, 0x27, 0xc2, 0x9c, 0xfa,
+	0xdc, 0x4a, 0x63, 0xff, 0x02, 0x67, 0x88, 0xd5, 0x48, 0x4e, 0xad, 0xdb, 0x89, 0x9c, 0x42, 0x79,
+	0xfa, 0xc5, 0x0b, 0x91, 0xc9, 0x18, 0x6d, 0x9e, 0xc5, 0x38, 0xf5, 0x4c, 0x23, 0x64, 0xc5, 0xc1,
+	0xe4, 0xff, 0xba, 0x6a, 0x13, 0x39, 0x6e, 0x2f, 0x9d, 0xa0, 0x70, 0x7c, 0x8f, 0xaf, 0x7e, 0x82,
+	0x66, 0x8e, 0x51, 0xfc, 0x13, 0xfe, 0x6b, 0xfd, 0x98, 0xb6, 0xe9, 0x4e, 0xd4, 0x4b, 0xc4, 0x0d,
+	0x4a, 0x2f, 0x17, 0x2f, 0xcc, 0x1c, 0x32, 0x59, 0xa0, 0x3b, 0xb7, 0x7a, 0x89, 0x88, 0x45, 0xd7,
+	0x0d, 0x60, 0x8a, 0x80, 0x21, 0x78, 0xe3, 0x1b, 0x41, 0xc7, 0xa8, 0x85, 0x79, 0x02, 0x43, 0xf7,
+	0x2a, 0x63, 0xa0, 0xf7, 0x63, 0xfc, 0x77, 0x02, 0x92, 0xf3, 0xb0, 0x75, 0x7c, 0xec, 0xb8, 0xeb,
+	0xf8, 0xf8, 0x23, 0x72, 0xaa, 0xdd, 0x2b, 0x91, 0x99, 0x03, 0xbe, 0x0b, 0x9e, 0x78, 0x45, 0xf1,
+	0xa6, 0x1f, 0x06, 0xaf, 0x99, 0x99, 0xd9, 0xca, 0xca, 0xba, 0x65, 0xc0, 0xc0, 0xc2, 0x34, 0x73,
+	0x1b, 0x4b, 0x07, 0xe4, 0x36, 0x5e, 0x24, 0x95, 0x18, 0x83, 0x80, 0x33, 0x9b, 0x05, 0x16, 0x00,
+	0xcc, 0x20, 0x58, 0x80, 0xd7, 0xef, 0x05, 0xe2, 0x84, 0x41, 0xed, 0x81, 0xe6, 0x56, 0x16, 0x01,
+	0xdb, 0xad, 0x6c, 0xe6, 0xea, 0x43, 0xc9, 0x66, 0xc6, 0x65, 0x40, 0xe4, 0x63, 0x8e, 0xe9, 0x65,
+	0xc0, 0x4e, 0x9c, 0xf4, 0xbe, 0x52, 0x26, 0x4f, 0xed, 0x3b, 0x0b, 0x75, 0xcc, 0x91, 0xb3, 0x4f,
+	0xcc, 0x91, 0xec, 0x9e, 0xd2, 0x41, 0xdd, 0x53, 0x1e, 0xd2, 0x3d, 0x3f, 0x8f, 0xca, 0x45, 0x66,
+	0xb4, 0x17, 0x73, 0x3d, 0xd1, 0xb0, 0x04, 0x79, 0xa1, 0x57, 0x24, 0x14, 0x34, 0x5f, 0xdc, 0x03,
+	0x58, 0x79, 0x7d, 0xd5, 0x22, 0x96, 0x81, 0xa1, 0x19, 0xee, 0x5c, 0xa3, 0x0c, 0x4b, 0x16, 0xf4,
+	0x7e, 0xb1, 0x44, 0x9e, 0x19, 0x41, 0x7b, 0x9b, 0xa3, 0xd8, 0x19, 0x71, 0x14, 0x7f, 0x7f, 0x7f,
+	0x26, 0xef, 0xef, 0x96, 0xc8, 0x85, 0xe1, 0xea, 0x11, 0x33, 0x89, 0xd6, 0x63, 0x3f, 0x6c, 0x6d,
+	0xb1, 0x2b, 0xd7, 0x64, 0xa7, 0xb0, 0xbe, 0xd6, 0xcd, 0x60, 0xe2, 0xe0, 0xf6, 0x96, 0x1f, 0x01,
+	0x1b, 0x18, 0x32, 0x0f, 0x0b, 0xb7, 0xb7, 0x6b, 0x59, 0x20, 0xe4, 0xf1, 0x31, 0x45, 0x3d, 0x0d,
+	0xd2, 0x0e, 0xe5, 0x4f, 0xf3, 0x2e, 0x64, 0xfe, 0x9f, 0x35, 0xd5, 0x0a, 0x06, 0x06, 0xce, 0x4f,
+	0xbf, 0x9f, 0x6e, 0x89, 0x28, 0x75, 0x31, 0x3f, 0xe7, 0x58, 0x0b, 0x08, 0x08, 0x9e, 0x32, 0x8a,
+	0x48, 0xd7, 0x85, 0xd8, 0xdf, 0x48, 0x79, 0xa8, 0x64, 0x4d, 0x9f, 0x32, 0x5e, 0x31, 0x81, 0x60,
+	0xe3, 0x7a, 0xff, 0x72, 0x48, 0x3f, 0x71, 0xab, 0xe7, 0x30, 0x03, 0x47, 0x0c, 0x8b, 0xd2, 0x08,
+	0xca, 0xad, 0xfc, 0xb0, 0x95, 0x5b, 0x65, 0x98, 0x72, 0xc3, 0x0c, 0x78, 0xe3, 0x52, 0x05, 0x9e,
+	0xec, 0xc7, 0x8f, 0x76, 0x55, 0x06, 0xfc, 0x4a, 0x06, 0x0e, 0xb9, 0x27, 0xbc, 0x5f, 0x2d, 0x91,
+	0x27, 0x86, 0x9a, 0x72, 0x0f, 0x49, 0x3d, 0x9a, 0x1d, 0x5c, 0x79, 0x38, 0x1d, 0xfc, 0x76, 0x52,
+	0x0b, 0xc2, 0x84, 0xb6, 0xfa, 0x31, 0x15, 0x83, 0x4e, 0x47, 0x04, 0x89, 0x76, 0x50, 0x18, 0xde,
+	0xef, 0x0f, 0x1f, 0x6a, 0x68, 0xd6, 0xff, 0xc0, 0xf6, 0xd2, 0x7b, 0xc9, 0x94, 0xdf, 0xeb, 0x71,
+	0x3c, 0x16, 0xfe, 0x96, 0xa9, 0x69, 0x31, 0x67, 0x02, 0xc1, 0xc6, 0x1d, 0x69, 0x81, 0xfe, 0x23,
+	0x87, 0xd4, 0x81, 0x6e, 0x70, 0x05, 0x84, 0x55, 0xdc, 0x58, 0x17, 0x39, 0x45, 0x54, 0x71, 0xc3,
+	0x8e, 0x4d, 0x02, 0x56, 0xdd, 0x6c, 0x50, 0x67, 0xe7, 0x2f, 0xad, 0x28, 0x1d, 0xea, 0xd2, 0x0a,
+	0x75, 0x6d, 0x41, 0x79, 0xf8, 0xb5, 0x05, 0xde, 0x9f, 0x54, 0xf1, 0xf5, 0x7a, 0x11, 0x56, 0x57,
+	0x4f, 0xf0, 0xfb, 0xf6, 0xe3, 0x4e, 0xc3, 0xb1, 0xbf, 0x2f, 0x46, 0xde, 0x63, 0xbb, 0x75, 0xda,
+	0x53, 0x3a, 0x54, 0x46, 0x7f, 0xf9, 0xc0, 0x8c, 0x7e, 0xcc, 0xc2, 0x4d, 0xb6, 0x56, 0xe2, 0x60,
+	0xc7, 0x4f, 0xd1, 0xad, 0xda, 0xa8, 0xd8, 0x1f, 0x72, 0x75, 0xf5, 0xba, 0x06, 0x82, 0x8d, 0x8b,
+	0x49, 0xb0, 0x3a, 0xaf, 0x9e, 0xc6, 0x29, 0x0b, 0x96, 0xe6, 0x23, 0x41, 0x25, 0xc1, 0xea, 0x4c,
+	0x7c, 0x81, 0x00, 0xf9, 0x67, 0x50, 0x63, 0x59, 0x8d, 0x28, 0xc8, 0x98, 0xad, 0xb1, 0x2c, 0x3a,
+	0x28, 0x4b, 0xee, 0x09, 0x77, 0x99, 0x9c, 0xe5, 0x03, 0x63, 0xae, 0xd7, 0x33, 0xde, 0x88, 0x87,
+	0x97, 0x3c, 0x29, 0x08, 0x9d, 0xbd, 0x96, 0x47, 0x81, 0x41, 0xcf, 0xa1, 0xa3, 0x44, 0x35, 0x2f,
+	0x2e, 0x88, 0x83, 0x0a, 0xe5, 0x28, 0x51, 0x64, 0x16, 0xdb, 0x60, 0xe2, 0x61, 0x91, 0x7c, 0xfd,
+	0x93, 0xe7, 0x94, 0xf0, 0xd3, 0xbb, 0x05, 0x51, 0xb2, 0x44, 0x15, 0xc9, 0xbf, 0x36, 0x10, 0xad,
+	0x0d, 0xc3, 0x9e, 0x77, 0xd7, 0xc9, 0x05, 0x05, 0xba, 0x12, 0xa6, 0x2c, 0x3c, 0x3e, 0xa1, 0x4d,
+	0x3f, 0xa1, 0x2f, 0xc6, 0x1d, 0x56, 0xe4, 0xa4, 0xae, 0x6f, 0x56, 0xbb, 0x16, 0xa4, 0xd7, 0x07,
+	0x61, 0xc2, 0x12, 0xec, 0x43, 0x05, 0x0f, 0x0b, 0x69, 0xe8, 0xaf, 0x77, 0xe8, 0xad, 0xf9, 0xc5,
+	0xc6, 0x84, 0x7d, 0x58, 0x78, 0x45, 0x02, 0x40, 0xe3, 0xa8, 0x80, 0xbd, 0xc9, 0xa1, 0x01, 0x7b,
+	0x7f, 0xe8, 0x90, 0x29, 0x35, 0xd8, 0x1f, 0x42, 0x64, 0x7c, 0xc7, 0x8e, 0x8c, 0xbf, 0x76, 0x7c,
+	0x75, 0xc1, 0x24, 0x1f, 0x12, 0x5e, 0xf9, 0xc7, 0x75, 0x42, 0xb4, 0x4a, 0x51, 0xda, 0xdc, 0x19,
+	0xaa, 0xcd, 0x5f, 0xb7, 0xd3, 0x79, 0x50, 0x91, 0x80, 0xea, 0xa3, 0x2d, 0x12, 0xb0, 0x4a, 0xce,
+	0xcb, 0xb5, 0x96, 0x9f, 0xe5, 0x60, 0x1c, 0xb6, 0xd4, 0x0e, 0xb5, 0xe6, 0x53, 0x82, 0xd0, 0xf9,
+	0xc5, 0x41, 0x48, 0x30, 0xf8, 0x59, 0x6b, 0x89, 0x1f, 0x3f, 0x68, 0x89, 0xd7, 0x13, 0x62, 0x69,
+	0x43, 0x16, 0x78, 0xcf, 0x4c, 0x88, 0xa5, 0xab, 0xab, 0xa0, 0x71, 0x06, 0x6b, 0xc5, 0x7a, 0x41,
+	0x5a, 0x91, 0x1c, 0x5a, 0x2b, 0xca, 0xf9, 0x39, 0x31, 0xf4, 0x16, 0x4e, 0xe9, 0x33, 0x9e, 0x1c,
+	0xea, 0x33, 0x7e, 0x1f, 0x99, 0x0e, 0xc2, 0x2d, 0x1a, 0x07, 0x29, 0x6d, 0xb3, 0xb9, 0xd0, 0x98,
+	0xb2, 0x2b, 0xd3, 0x2f, 0x5a, 0x50, 0xc8, 0x60, 0xdb, 0x4a, 0x65, 0x7a, 0x04, 0xa5, 0x32, 0x44,
+	0x95, 0x9f, 0x2a, 0x46, 0x95, 0x9f, 0x3e, 0xbe, 0x2a, 0x3f, 0x73, 0xa2, 0xaa, 0xdc, 0x2d, 0x44,
+	0x95, 0x63, 0xc0, 0x71, 0x1c, 0xed, 0xee, 0x35, 0xce, 0x66, 0x02, 0x8e, 0xb1, 0x11, 0x38, 0xcc,
+	0xdc, 0x0d, 0x9d, 0xdb, 0x7f, 0x37, 0xe4, 0xfd, 0x42, 0x89, 0x9c, 0xd7, 0x9a, 0x0e, 0xc7, 0x57,
+	0xb0, 0x81, 0x73, 0x9d, 0xdd, 0xc2, 0xc1, 0x4f, 0x2e, 0x8c, 0x54, 0x08, 0x9d, 0x55, 0xa1, 0x20,
+	0x60, 0x60, 0xb1, 0x8c, 0x02, 0x1a, 0xb3, 0xb2, 0x87, 0x59, 0x35, 0x38, 0x2f, 0xda, 0x41, 0x61,
+	0xe0, 0x17, 0xc4, 0xff, 0x45, 0x96, 0x56, 0xb6, 0xf2, 0xd0, 0xbc, 0x06, 0x81, 0x89, 0x87, 0xa7,
+	0x16, 0x2d, 0x39, 0x05, 0x51, 0x15, 0x4e, 0x8a, 0xab, 0x09, 0xe5, 0xac, 0x53, 0x50, 0x29, 0x0e,
+	0x4b, 0x1d, 0xa9, 0xe6, 0xc5, 0xc1, 0x76, 0x50, 0x18, 0xde, 0xff, 0x72, 0xc8, 0x13, 0x03, 0xbb,
+	0xe2, 0x21, 0x2c, 0x6f, 0xbb, 0xf6, 0xf2, 0xb6, 0x5a, 0x94, 0x35, 0x6c, 0xbc, 0xc5, 0x90, 0xa5,
+	0xee, 0xdf, 0x3b, 0x64, 0x5a, 0xe3, 0x3f, 0x84, 0x57, 0x0d, 0xec, 0x57, 0x2d, 0xce, 0xf0, 0xaf,
+	0xe7, 0xde, 0xed, 0x0f, 0xd9, 0xbb, 0xf1, 0xf0, 0x82, 0x39, 0xb6, 0x02, 0x8d, 0x70, 0x96, 0x86,
+	0xb7, 0xa3, 0xe1, 0xe1, 0x5f, 0x52, 0x4c, 0x98, 0x83, 0xcd, 0x9f, 0x1d, 0x2b, 0xea, 0x63, 0x56,
+	0xf6, 0x33, 0x01, 0xc1, 0x90, 0x15, 0xe5, 0x0c, 0x12, 0xd4, 0x97, 0x6d, 0x91, 0x84, 0xa1, 0x8b,
+	0x72, 0x8a, 0x76, 0x50, 0x18, 0x5e, 0x97, 0x34, 0x6c, 0xe2, 0x0b, 0x74, 0x83, 0x85, 0xce, 0x8d,
+	0xf4, 0x9a, 0x18, 0x40, 0xc6, 0x9e, 0x5a, 0xea, 0xfb, 0xd9, 0xdb, 0x6c, 0xe7, 0x24, 0x00, 0x34,
+	0x8e, 0xf7, 0x0f, 0x1c, 0x72, 0x76, 0xc0, 0xcb, 0x14, 0x98, 0x7c, 0x92, 0x6a, 0x2d, 0x30, 0x68,
+	0x49, 0xfb, 0x61, 0x32, 0xde, 0xa6, 0x1b, 0xbe, 0x0c, 0xce, 0x32, 0xb4, 0xda, 0x02, 0x6f, 0x06,
+	0x09, 0xf7, 0xfe, 0xcc, 0x21, 0xa7, 0x6c, 0x59, 0x13, 0x16, 0xa7, 0xce, 0xbb, 0x29, 0x48, 0x5a,
+	0xd1, 0x0e, 0x8d, 0xf7, 0xf0, 0xcd, 0x9d, 0x4c, 0x9c, 0x7a, 0x0e, 0x03, 0x06, 0x3c, 0xc5, 0x6a,
+	0xf4, 0xb5, 0x55, 0x6f, 0xcb, 0x91, 0x72, 0xbb, 0xc8, 0x91, 0xa2, 0x3f, 0xa6, 0x79, 0x90, 0xab,
+	0x58, 0x82, 0xc9, 0xdf, 0xfb, 0x6e, 0x85, 0xa8, 0xec, 0x34, 0x16, 0x19, 0x53, 0x50, 0x5c, 0x91,
+	0x95, 0x49, 0x50, 0x1e, 0x21, 0x93, 0xe0, 0xe0, 0xe4, 0x84, 0x77, 0x91, 0x09, 0xbe, 0xb9, 0x36,
+	0x7d, 0x58, 0xea, 0x0d, 0xd7, 0x34, 0x08, 0x4c, 0x3c, 0x94, 0xa4, 0x13, 0xec, 0x50, 0xfe, 0xd0,
+	0x98, 0x2d, 0xc9, 0x92, 0x04, 0x80, 0xc6, 0x41, 0x49, 0xda, 0xc1, 0xc6, 0x46, 0x63, 0xdc, 0x96,
+	0x04, 0x7b, 0x07, 0x18, 0x04, 0x31, 0xb6, 0xa2, 0x68, 0x5b, 0xd8, 0x7f, 0x0a, 0xe3, 0x7a, 0x14,
+	0x6d, 0x03, 0x83, 0xa0, 0xc5, 0x12, 0x46, 0x71, 0x97, 0xdd, 0x36, 0xdc, 0x56, 0x5c, 0x1a, 0x75,
+	0xdb, 0x62, 0xb9, 0x99, 0x47, 0x81, 0x41, 0xcf, 0xe1, 0x08, 0xec, 0xc5, 0xb4, 0x1d, 0xb4, 0x52,
+	0x93, 0x1a, 0xb1, 0x47, 0xe0, 0x4a, 0x0e, 0x03, 0x06, 0x3c, 0x85, 0x37, 0xbf, 0xc9, 0xec, 0x42,
+	0xfb, 0x32, 0x33, 0x65, 0x87, 0x83, 0x0d, 0x86, 0x2c, 0x3e, 0x6a, 0x9b, 0xae, 0x28, 0x9c, 0xd2,
+	0x98, 0xb4, 0xb5, 0x8d, 0x2c, 0xa8, 0x02, 0x0a, 0xc3, 0xfb, 0x64, 0x19, 0x57, 0xc7, 0x21, 0x97,
+	0x41, 0x3c, 0xb4, 0x38, 0x36, 0x7b, 0x44, 0x56, 0x46, 0x18, 0x91, 0x18, 0x23, 0x96, 0x44, 0xa1,
+	0x8a, 0x11, 0xab, 0x0e, 0x8d, 0x11, 0x33, 0xb0, 0x06, 0xc7, 0x88, 0x8d, 0x15, 0x15, 0x23, 0x36,
+	0x7e, 0xc4, 0x18, 0xb1, 0x6f, 0x55, 0x89, 0xaa, 0x63, 0x7e, 0x93, 0xa6, 0x77, 0xa3, 0x78, 0x3b,
+	0x08, 0x37, 0x59, 0x56, 0xe6, 0xd7, 0x1c, 0x32, 0xc9, 0xe7, 0x8b, 0xb8, 0x04, 0x8b, 0x07, 0xd6,
+	0x6c, 0x14, 0x54, 0xbb, 0xdb, 0x62, 0x36, 0xbb, 0x66, 0x30, 0xca, 0xdc, 0x48, 0x66, 0x82, 0xc0,
+	0x92, 0xc8, 0xfd, 0x18, 0x21, 0xd2, 0xad, 0xb6, 0x21, 0x55, 0xe6, 0x62, 0x31, 0xf2, 0xa1, 0x5b,
+	0x53, 0xd9, 0xa6, 0x6b, 0x8a, 0x09, 0x18, 0x0c, 0xf1, 0xcc, 0xdf, 0xbe, 0x8d, 0xfd, 0x23, 0x27,
+	0xd2, 0x37, 0xa3, 0x94, 0x78, 0x05, 0xbc, 0xc8, 0x73, 0x13, 0xc7, 0x89, 0x88, 0xa5, 0x79, 0xeb,
+	0xa0, 0x8c, 0xe6, 0xa5, 0xc8, 0x6f, 0x37, 0xfd, 0x8e, 0x1f, 0xb6, 0xb0, 0xe0, 0x1f, 0x43, 0x37,
+	0x6f, 0xfc, 0x64, 0x0d, 0x20, 0x09, 0xe5, 0x8a, 0xd3, 0x57, 0x47, 0x29, 0x4e, 0x8f, 0xd7, 0x60,
+	0xe5, 0x3e, 0xe6, 0xa1, 0x4a, 0xbc, 0x1e, 0xbd, 0x3a, 0xac, 0xf7, 0x3b, 0x75, 0xbd, 0x68, 0x61,
+	0xf6, 0x36, 0x2b, 0x91, 0x1e, 0xeb, 0x2f, 0x2a, 0x6c, 0xcf, 0x02, 0x87, 0x88, 0x71, 0x6b, 0xa8,
+	0x6a, 0x04, 0x93, 0x25, 0x8e, 0xd1, 0x9e, 0x1f, 0xd3, 0xf0, 0xa4, 0xc7, 0xe8, 0x8a, 0x62, 0x02,
+	0x06, 0x43, 0x77, 0xcb, 0xca, 0x76, 0xb8, 0x7a, 0xfc, 0x6c, 0x07, 0x56, 0xed, 0x64, 0x50, 0xc9,
+	0xe5, 0x2f, 0x3a, 0x64, 0x3a, 0xb4, 0x46, 0x6e, 0x31, 0x01, 0x8e, 0x83, 0x67, 0x05, 0xbf, 0x06,
+	0xc3, 0x6e, 0x83, 0x0c, 0xff, 0x41, 0x4b, 0x5a, 0xf5, 0x90, 0x4b, 0x9a, 0xbe, 0x6b, 0x61, 0x6c,
+	0xd8, 0x5d, 0x0b, 0x6e, 0xa8, 0x6e, 0x74, 0x19, 0x2f, 0xfc, 0x46, 0x17, 0x32, 0xe0, 0x36, 0x97,
+	0x3b, 0xa4, 0xde, 0x8a, 0xa9, 0x9f, 0x1e, 0xf1, 0x72, 0x0f, 0x76, 0x74, 0x3c, 0x2f, 0x09, 0x80,
+	0xa6, 0xe5, 0x7e, 0x5c, 0xe9, 0xb3, 0x7a, 0x91, 0xe6, 0x27, 0x4e, 0xc5, 0x91, 0xb4, 0xd8, 0x97,
+	0x32, 0x85, 0xaa, 0x49, 0x11, 0xa9, 0x76, 0x96, 0x14, 0xdf, 0x5f, 0xd5, 0xaa, 0xff, 0x5d, 0x99,
+	0x9c, 0x96, 0xe2, 0xcb, 0x60, 0x75, 0xb4, 0x57, 0xf8, 0x38, 0xd0, 0x9b, 0x0d, 0x65, 0xaf, 0x5c,
+	0x97, 0x00, 0xd0, 0x38, 0x68, 0x1f, 0xf7, 0x13, 0x7a, 0xab, 0x47, 0x43, 0xbc, 0x30, 0x55, 0x1c,
+	0x57, 0xaa, 0xf7, 0x7e, 0x51, 0x83, 0xc0, 0xc4, 0xc3, 0xcd, 0x11, 0xdf, 0xa7, 0x24, 0xd9, 0x44,
+	0x17, 0xb1, 0xff, 0x01, 0x09, 0x77, 0xbf, 0x3a, 0xf0, 0xb6, 0xb0, 0x62, 0x52, 0xbc, 0x72, 0x31,
+	0xfa, 0x87, 0xbc, 0x26, 0xec, 0x0b, 0x0e, 0x39, 0xb5, 0x6d, 0x55, 0x18, 0x90, 0x4b, 0xe4, 0x71,
+	0x2f, 0x86, 0xb4, 0x88, 0x6a, 0x95, 0x62, 0xb7, 0x27, 0x90, 0xe5, 0xee, 0xfd, 0x0f, 0x87, 0x98,
+	0xcb, 0xc5, 0x23, 0x48, 0xf8, 0x3e, 0xb4, 0xc9, 0x2b, 0xad, 0xe8, 0xea, 0x50, 0x2b, 0x1a, 0x0f,
+	0x27, 0x83, 0x76, 0x63, 0x2c, 0x73, 0x38, 0xb9, 0xb8, 0x00, 0xd8, 0xee, 0xfd, 0xf3, 0xaa, 0xf6,
+	0x9b, 0x88, 0xcc, 0xa4, 0x1f, 0x88, 0xd7, 0xde, 0x50, 0xa5, 0x8d, 0xf8, 0x9b, 0xdf, 0xcc, 0x95,
+	0x36, 0xfa, 0x89, 0xc3, 0x27, 0x9e, 0xf1, 0x0e, 0x1a, 0x56, 0xd9, 0x68, 0xfc, 0x80, 0xac, 0xb3,
+	0x57, 0x48, 0x0d, 0xb7, 0x9a, 0xcc, 0x01, 0x5a, 0xb3, 0x84, 0xaa, 0x5d, 0x17, 0xed, 0x0f, 0xee,
+	0xcd, 0xbc, 0xe7, 0xf0, 0x62, 0xc9, 0xa7, 0x41, 0xd1, 0x77, 0x13, 0x52, 0xc7, 0xff, 0x59, 0x82,
+	0x9c, 0xd8, 0xc4, 0xbe, 0xa8, 0x74, 0x91, 0x04, 0x14, 0x92, 0x7d, 0xa7, 0xf9, 0xb8, 0x21, 0xa9,
+	0x23, 0x22, 0x67, 0xca, 0xf7, 0xba, 0x2b, 0x92, 0xe9, 0xaa, 0x04, 0x3c, 0xb8, 0x37, 0xf3, 0xde,
+	0xc3, 0x33, 0x55, 0x8f, 0x83, 0x66, 0xe1, 0x7d, 0xa9, 0xa2, 0xc7, 0x2e, 0xff, 0xac, 0x3f, 0x18,
+	0x63, 0xf7, 0xb9, 0xcc, 0xd8, 0xbd, 0x98, 0x1b, 0xbb, 0xd3, 0xfa, 0x2a, 0x3b, 0x6b, 0x34, 0x3e,
+	0x6c, 0x83, 0xe7, 0x60, 0xbf, 0x0a, 0xb3, 0xf4, 0x5e, 0xed, 0x07, 0x31, 0x4d, 0x56, 0xe2, 0x7e,
+	0x88, 0xc5, 0xac, 0xea, 0xf6, 0xb5, 0xf5, 0x60, 0x83, 0x21, 0x8b, 0xcf, 0xee, 0x96, 0xdf, 0x0b,
+	0x5b, 0x77, 0xfc, 0x1d, 0x3e, 0xaa, 0x8c, 0x22, 0x3f, 0xab, 0xa2, 0x1d, 0x14, 0x86, 0xf7, 0x0d,
+	0x76, 0x5a, 0x6d, 0x64, 0xe6, 0xe2, 0x98, 0xe8, 0xb0, 0xab, 0x21, 0x79, 0x85, 0x20, 0x35, 0x26,
+	0xf8, 0x7d, 0x90, 0x1c, 0x86, 0xb7, 0x13, 0xaf, 0xf3, 0xfb, 0x92, 0x8a, 0xa9, 0x07, 0x2c, 0x2e,
+	0x5f, 0x62, 0x25, 0xfb, 0xe5, 0x4d, 0x4c, 0x0f, 0xf4, 0xbf, 0x20, 0xb9, 0x79, 0xff, 0xbd, 0x42,
+	0x4e, 0xc9, 0xe0, 0x13, 0x71, 0x49, 0x9f, 0x55, 0x9d, 0xb0, 0x74, 0x60, 0x75, 0xc2, 0x0f, 0x11,
+	0xd2, 0xa6, 0xbd, 0x4e, 0xb4, 0xc7, 0xcc, 0xce, 0xca, 0xa1, 0xcd, 0x4e, 0xb5, 0x53, 0x59, 0x50,
+	0x54, 0xc0, 0xa0, 0x28, 0xca, 0x22, 0xf1, 0x62, 0x87, 0x99, 0xb2, 0x48, 0x46, 0x49, 0xee, 0xb1,
+	0x87, 0x5b, 0x92, 0x3b, 0x20, 0xa7, 0xb8, 0x88, 0x2a, 0xff, 0xf5, 0x08, 0x69, 0xae, 0x2c, 0x83,
+	0x60, 0xc1, 0x26, 0x03, 0x59, 0xba, 0x8f, 0xf4, 0x6e, 0xd0, 0x1f, 0x21, 0x75, 0xf9, 0x9d, 0xb9,
+	0xed, 0x2f, 0x6a, 0x08, 0xc8, 0x61, 0xc0, 0x2e, 0xcb, 0x14, 0xff, 0x62, 0xd1, 0xe6, 0x98, 0xfa,
+	0x49, 0x14, 0x0a, 0xe5, 0xab, 0xfa, 0x0e, 0x58, 0x2b, 0x08, 0xa8, 0xf7, 0xf9, 0x12, 0x5a, 0xaf,
+	0xfc, 0xa9, 0x65, 0x79, 0x56, 0xf3, 0x16, 0x15, 0xd6, 0x99, 0xa9, 0xf8, 0x9c, 0x09, 0xed, 0x5c,
+	0x22, 0x95, 0xb6, 0xae, 0x03, 0x72, 0x98, 0xde, 0xd6, 0x8e, 0x59, 0x3f, 0xa5, 0xc0, 0xa8, 0x60,
+	0x1a, 0x6a, 0xea, 0x6f, 0xca, 0x94, 0x24, 0x96, 0x86, 0xba, 0xe6, 0x63, 0xb1, 0x5a, 0x6c, 0x35,
+	0x17, 0xd7, 0xca, 0x01, 0x8b, 0x2b, 0x46, 0x4e, 0x04, 0x9b, 0xa1, 0x9f, 0x62, 0xb8, 0x80, 0x3e,
+	0x04, 0xd4, 0x91, 0x13, 0x26, 0x10, 0x6c, 0x5c, 0xef, 0xbb, 0x75, 0x72, 0x6e, 0x75, 0x7e, 0x59,
+	0xd6, 0xb2, 0x3d, 0xb1, 0xac, 0xa2, 0x41, 0x3c, 0x1e, 0x5e, 0x56, 0xd1, 0x10, 0xee, 0x1d, 0x23,
+	0xab, 0xa8, 0x63, 0x64, 0x15, 0xd9, 0x89, 0x33, 0xe5, 0x22, 0x12, 0x67, 0x06, 0x49, 0x30, 0x4a,
+	0xe2, 0xcc, 0x89, 0xa5, 0x19, 0xed, 0x2b, 0xd0, 0xa1, 0xd2, 0x8c, 0x54, 0x0e, 0x56, 0x21, 0xc1,
+	0xf7, 0x43, 0x3e, 0xd5, 0xc0, 0x1c, 0x2c, 0x95, 0x55, 0xc4, 0x13, 0x4b, 0x1a, 0x63, 0x45, 0x64,
+	0x15, 0x0d, 0x12, 0x60, 0x84, 0xac, 0x22, 0xfe, 0xc3, 0xca, 0x2a, 0x1a, 0x2f, 0x22, 0xab, 0x68,
+	0x90, 0x38, 0x07, 0x66, 0x15, 0xbd, 0x97, 0x4c, 0xb5, 0x3a, 0x51, 0x48, 0x57, 0xe2, 0x28, 0x8d,
+	0x5a, 0x51, 0xa7, 0x51, 0xb3, 0x55, 0xc2, 0xbc, 0x09, 0x04, 0x1b, 0x77, 0x58, 0x4a, 0x52, 0xfd,
+	0xb8, 0x29, 0x49, 0xe4, 0x11, 0xa5, 0x24, 0xfd, 0x79, 0x89, 0xcc, 0x1c, 0xf0, 0x51, 0x73, 0x29,
+	0x49, 0xd5, 0x91, 0x53, 0x92, 0x44, 0x84, 0xf3, 0xd8, 0x90, 0x08, 0x67, 0x3c, 0x08, 0xa4, 0x7e,
+	0x57, 0x44, 0xa4, 0x88, 0x8d, 0x92, 0x3e, 0x08, 0xd4, 0x20, 0x30, 0xf1, 0x70, 0x18, 0x4d, 0xfb,
+	0xad, 0x16, 0x4d, 0x12, 0x19, 0xc2, 0x2c, 0x9c, 0x6a, 0x85, 0xc5, 0x47, 0x33, 0x5f, 0xe5, 0x9c,
+	0xc5, 0x02, 0x32, 0x2c, 0x51, 0x78, 0xbf, 0xd3, 0xe1, 0x19, 0x13, 0x34, 0x11, 0xd6, 0xab, 0xf6,
+	0x4e, 0x69, 0x10, 0x98, 0x78, 0xde, 0xd7, 0x4b, 0xe4, 0xa9, 0x7d, 0xd5, 0xcb, 0xc8, 0xd1, 0xe5,
+	0x18, 0x4b, 0x98, 0x3d, 0x48, 0xc3, 0x48, 0x43, 0x60, 0x10, 0xde, 0x4b, 0xbd, 0x9e, 0x71, 0x5d,
+	0x65, 0xa3, 0x7c, 0x12, 0xbd, 0x64, 0xb1, 0x80, 0x0c, 0xcb, 0x6c, 0x2f, 0x55, 0x46, 0xec, 0xa5,
+	0x7f, 0x54, 0x22, 0xcf, 0x8c, 0xa0, 0x84, 0x0b, 0x4c, 0xfa, 0xb0, 0x73, 0x81, 0xca, 0x8f, 0x28,
+	0x65, 0xeb, 0x88, 0xdd, 0xf5, 0x8d, 0x12, 0xb9, 0x30, 0x5c, 0x17, 0xba, 0x3f, 0x89, 0x9b, 0x2d,
+	0x19, 0x24, 0x63, 0xa6, 0x11, 0x9d, 0xe5, 0x1b, 0x2d, 0x0b, 0x04, 0x59, 0x5c, 0xcc, 0x04, 0xea,
+	0xf9, 0xe9, 0x56, 0x72, 0x65, 0x37, 0x48, 0x52, 0x51, 0x26, 0x63, 0x9a, 0x1f, 0x61, 0xc8, 0x56,
+	0x30, 0x30, 0x90, 0x1d, 0xfb, 0xb5, 0x10, 0xdd, 0x8c, 0x52, 0xfe, 0x10, 0xb7, 0xe3, 0x18, 0xbb,
+	0x15, 0x1b, 0x04, 0x59, 0x5c, 0x64, 0xc7, 0xdc, 0xcb, 0x5c, 0xd0, 0x8a, 0x4e, 0x3c, 0x5a, 0x52,
+	0xad, 0x60, 0x60, 0x64, 0x13, 0xa4, 0xaa, 0x07, 0x27, 0x48, 0x79, 0xff, 0xac, 0x44, 0x9e, 0x18,
+	0xba, 0x96, 0x8e, 0x36, 0x01, 0x5f, 0x7f, 0x39, 0x44, 0x47, 0x1b, 0x3b, 0x87, 0xcc, 0x8c, 0xf9,
+	0xa3, 0x21, 0x23, 0x4d, 0x64, 0xc6, 0x1c, 0x3d, 0x7b, 0xf5, 0xf5, 0xd7, 0x9f, 0xb9, 0x64, 0x98,
+	0xca, 0x21, 0x92, 0x61, 0x32, 0x1f, 0xa3, 0x3a, 0xe2, 0x44, 0xfe, 0xf6, 0xf0, 0xee, 0x45, 0xdb,
+	0x7b, 0x24, 0x37, 0xd6, 0x02, 0x39, 0x1d, 0x84, 0x2c, 0x71, 0x6e, 0xb5, 0xbf, 0x2e, 0x2a, 0x27,
+	0x94, 0xec, 0x9b, 0x52, 0x17, 0x33, 0x70, 0xc8, 0x3d, 0xf1, 0x3a, 0x4c, 0x4e, 0x3a, 0x62, 0x97,
+	0x7e, 0x88, 0xd4, 0x15, 0x6d, 0x1e, 0xd1, 0xaa, 0x3e, 0x68, 0x2e, 0xa2, 0x55, 0x7d, 0x4d, 0x03,
+	0xcb, 0x7d, 0x8a, 0x1f, 0x00, 0x65, 0x46, 0x26, 0x06, 0x25, 0x63, 0xbb, 0xf7, 0x63, 0x64, 0x52,
+	0x6d, 0x22, 0x47, 0xbd, 0x31, 0xc1, 0xfb, 0xf2, 0x38, 0x99, 0xb2, 0x2a, 0x83, 0x59, 0xbe, 0x1d,
+	0xe7, 0x40, 0xdf, 0x0e, 0x8b, 0x01, 0xee, 0x87, 0xf2, 0x42, 0x11, 0x23, 0x06, 0xb8, 0x1f, 0x62,
+	0xe5, 0x33, 0xfc, 0x83, 0x5b, 0xf7, 0x76, 0xbc, 0x07, 0xfd, 0x50, 0x44, 0x12, 0xaa, 0xad, 0xfb,
+	0x02, 0x6b, 0x05, 0x01, 0xc5, 0x43, 0xf7, 0xc9, 0x84, 0x39, 0x0e, 0xb9, 0x67, 0xac, 0x51, 0x29,
+	0xc2, 0x49, 0xb8, 0x6a, 0x50, 0xe4, 0x41, 0x08, 0x66, 0x0b, 0x58, 0x1c, 0xb1, 0x54, 0x71, 0x5d,
+	0x55, 0x7d, 0x6f, 0x8c, 0x15, 0x11, 0x01, 0x9b, 0x2d, 0xbc, 0xc6, 0x5d, 0x2a, 0xca, 0x07, 0xab,
+	0x6f, 0x09, 0xd6, 0x8c, 0xb1, 0x4c, 0x33, 0xff, 0x57, 0x38, 0x8d, 0x0a, 0xf7, 0xe8, 0x90, 0x01,
+	0x2e, 0x2b, 0xac, 0x07, 0xe9, 0x87, 0xc1, 0x06, 0x4d, 0x52, 0xee, 0x49, 0x92, 0xf5, 0x20, 0x65,
+	0x23, 0x68, 0x38, 0x2e, 0x76, 0x09, 0x7b, 0xb1, 0xd4, 0x70, 0xfd, 0xb0, 0xc5, 0x6e, 0x55, 0x37,
+	0x83, 0x89, 0x63, 0xfa, 0xa9, 0xc8, 0x23, 0xf5, 0x53, 0x4d, 0x1c, 0xe0, 0xa7, 0xba, 0x43, 0xea,
+	0x61, 0x94, 0x36, 0xe9, 0x46, 0x14, 0xf3, 0xac, 0x85, 0x23, 0x1c, 0x93, 0xdf, 0x94, 0x04, 0x40,
+	0xd3, 0x32, 0x1c, 0x60, 0x53, 0xfb, 0x3a, 0xc0, 0xfe, 0xa9, 0x43, 0xce, 0x0f, 0x1c, 0x36, 0xaf,
+	0xdf, 0xe0, 0x36, 0xef, 0x5f, 0x57, 0xc8, 0xd9, 0x01, 0x35, 0x06, 0xdd, 0x3d, 0x73, 0x42, 0x39,
+	0x45, 0x9c, 0x9f, 0xda, 0xc7, 0x81, 0xf2, 0x3b, 0x0e, 0x98, 0x45, 0x87, 0x73, 0x53, 0x6b, 0x57,
+	0x71, 0xf9, 0xe1, 0xba, 0x8a, 0x8d, 0x79, 0x51, 0x79, 0xa4, 0xf3, 0xa2, 0x7a, 0xc0, 0xbc, 0x68,
+	0x91, 0xa9, 0xbb, 0xfe, 0x0e, 0x55, 0x9e, 0xe7, 0xa3, 0x94, 0x85, 0x44, 0x83, 0xe5, 0x8e, 0x49,
+	0x04, 0x6c, 0x9a, 0xb8, 0x85, 0x60, 0x25, 0x29, 0x59, 0xe5, 0xa7, 0x3d, 0xf7, 0xe3, 0x66, 0x71,
+	0x51, 0xa7, 0xa8, 0x42, 0x98, 0x9c, 0xb8, 0x2a, 0x4e, 0xca, 0xdf, 0x79, 0x50, 0xad, 0xd2, 0xac,
+	0x9e, 0x2b, 0x8d, 0xa0, 0xe7, 0x3a, 0xb2, 0x8a, 0x6b, 0xb9, 0xf8, 0x2a, 0xae, 0xf5, 0x5c, 0x05,
+	0xd7, 0xfb, 0x0e, 0x39, 0x3b, 0xe0, 0x95, 0xf4, 0xca, 0xec, 0xec, 0xb3, 0x32, 0xbf, 0x9d, 0xdd,
+	0xb7, 0xbc, 0x81, 0xa7, 0x66, 0x62, 0x05, 0x37, 0xaf, 0x4e, 0x66, 0xed, 0xa0, 0x30, 0xd8, 0x0d,
+	0x69, 0x9d, 0x4e, 0x74, 0xf7, 0x4a, 0xb7, 0x97, 0xee, 0x89, 0xb5, 0x5c, 0xdf, 0x90, 0xa6, 0x20,
+	0x60, 0x60, 0xa1, 0x11, 0xc8, 0xe4, 0xbc, 0xea, 0x07, 0x1d, 0xda, 0x66, 0x7e, 0x27, 0xa1, 0x4e,
+	0x94, 0x11, 0x08, 0x19, 0x38, 0xe4, 0x9e, 0xf0, 0x7e, 0x59, 0x0c, 0x0a, 0x71, 0x8a, 0xfa, 0x5c,
+	0xe6, 0x5e, 0xa0, 0xd1, 0x0f, 0x20, 0x3f, 0x4a, 0x48, 0x4b, 0x5d, 0xd8, 0x2a, 0xdc, 0xd6, 0xd7,
+	0x8f, 0x7d, 0xe1, 0xa5, 0xa0, 0xa7, 0x3b, 0x43, 0xb7, 0x81, 0xc1, 0xcf, 0x52, 0x48, 0xe5, 0x03,
+	0x15, 0x92, 0x35, 0x37, 0x2b, 0xfb, 0xcf, 0x4d, 0xef, 0xcf, 0x1d, 0x62, 0xd9, 0x35, 0x58, 0x4b,
+	0x18, 0xc5, 0xdd, 0x2b, 0xe6, 0x2e, 0x5a, 0x93, 0x34, 0xea, 0x17, 0x31, 0x12, 0xd9, 0xbf, 0xc0,
+	0x19, 0xb9, 0x1d, 0x71, 0xd8, 0x5a, 0x2a, 0xe2, 0xbe, 0x64, 0x93, 0x21, 0x1e, 0xd7, 0xf2, 0xb3,
+	0x17, 0x7d, 0x70, 0xeb, 0x3d, 0x47, 0xce, 0xe4, 0x84, 0x62, 0x57, 0x80, 0x44, 0x71, 0x2b, 0x37,
+	0xe8, 0xd9, 0x85, 0x44, 0xc0, 0x61, 0x78, 0x02, 0x7b, 0x3a, 0x4b, 0x1e, 0x6f, 0x4a, 0x3f, 0x93,
+	0x64, 0xe9, 0x9d, 0x54, 0xdf, 0xa9, 0x40, 0xa4, 0x1c, 0x08, 0xf2, 0x42, 0x78, 0xbf, 0x5e, 0xe1,
+	0x83, 0xff, 0x4e, 0x10, 0xb6, 0xa3, 0xbb, 0x6a, 0x75, 0x77, 0x86, 0xae, 0xee, 0x38, 0xab, 0x5b,
+	0x5b, 0xb4, 0xdd, 0xef, 0xe4, 0x52, 0xdf, 0x56, 0x45, 0x3b, 0x28, 0x0c, 0xc4, 0x6e, 0xf7, 0x45,
+	0xe5, 0xe5, 0xcc, 0xa0, 0x5c, 0x10, 0xed, 0xa0, 0x30, 0x30, 0xb6, 0xd7, 0x78, 0x49, 0x39, 0x2e,
+	0x99, 0x59, 0x6d, 0xde, 0x47, 0x0d, 0x16, 0x16, 0xba, 0x52, 0x94, 0xa5, 0x20, 0xd7, 0x19, 0xe6,
+	0x4a, 0x51, 0xa6, 0x44, 0x02, 0x06, 0x06, 0xcb, 0xab, 0xe3, 0x37, 0x39, 0xcb, 0xf0, 0x49, 0x9e,
+	0x57, 0x27, 0xda, 0x40, 0x41, 0x51, 0x27, 0x75, 0xfd, 0xb0, 0xef, 0x77, 0xb0, 0x87, 0x44, 0xba,
+	0xad, 0x9a, 0x86, 0xcb, 0x0a, 0x02, 0x06, 0x16, 0xbe, 0x71, 0x1a, 0x74, 0xe9, 0x07, 0xa3, 0x50,
+	0x06, 0xba, 0x68, 0xcf, 0xb4, 0x68, 0x07, 0x85, 0xe1, 0x7e, 0x9c, 0x9c, 0xf5, 0x4d, 0x7f, 0xb6,
+	0xb8, 0x55, 0xa2, 0x7e, 0xf4, 0x2b, 0x50, 0x99, 0x7b, 0x7e, 0x2e, 0x4f, 0x13, 0x06, 0x31, 0x62,
+	0xdb, 0xcd, 0xb0, 0xcd, 0xcd, 0xaa, 0x28, 0x6e, 0x90, 0xcc, 0x76, 0x53, 0x83, 0xc0, 0xc4, 0xf3,
+	0x3e, 0x51, 0x26, 0xae, 0x1e, 0x35, 0x2a, 0x08, 0x10, 0xa9, 0x69, 0x26, 0xd2, 0xfd, 0xa6, 0xa8,
+	0x69, 0x10, 0x98, 0x78, 0xb6, 0x39, 0x58, 0x1a, 0x21, 0x8a, 0x44, 0x9b, 0xba, 0xe5, 0xfd, 0x4c,
+	0x5d, 0xe5, 0x3f, 0xae, 0x0c, 0xf5, 0x1f, 0xbf, 0x64, 0x06, 0xad, 0x56, 0x8f, 0x5e, 0x88, 0x7a,
+	0x60, 0xe0, 0xea, 0x4b, 0xa4, 0x4e, 0xe5, 0x25, 0x4b, 0xc7, 0xa9, 0x72, 0xad, 0x6f, 0x6a, 0xd2,
+	0xf4, 0xbc, 0xff, 0xea, 0x90, 0x53, 0x3a, 0x83, 0x9a, 0x5f, 0x94, 0x6a, 0x7a, 0xb9, 0x9c, 0x03,
+	0x93, 0xc3, 0xed, 0xc4, 0xd7, 0xd2, 0x48, 0x89, 0xaf, 0x66, 0x4e, 0x6a, 0x79, 0xdf, 0x9c, 0xd4,
+	0x1f, 0xd2, 0x57, 0x10, 0xf2, 0xe4, 0xd5, 0x89, 0x41, 0xd7, 0x0f, 0x62, 0x24, 0x73, 0xcb, 0x57,
+	0xb5, 0x37, 0x26, 0xf9, 0xde, 0x71, 0x7e, 0x8e, 0x21, 0x09, 0x48, 0x73, 0xfd, 0x9b, 0xdf, 0x7b,
+	0xfa, 0x4d, 0xdf, 0xfe, 0xde, 0xd3, 0x6f, 0xfa, 0x83, 0xef, 0x3d, 0xfd, 0xa6, 0x4f, 0xdc, 0x7f,
+	0xda, 0xf9, 0xe6, 0xfd, 0xa7, 0x9d, 0x6f, 0xdf, 0x7f, 0xda, 0xf9, 0x83, 0xfb, 0x4f, 0x3b, 0xdf,
+	0xbd, 0xff, 0xb4, 0xf3, 0xc5, 0xff, 0xfc, 0xf4, 0x9b, 0x3e, 0x38, 0x30, 0xa4, 0x0d, 0xff, 0x79,
+	0xb6, 0xd5, 0xbe, 0xb4, 0x73, 0x99, 0x45, 0x55, 0x61, 0x27, 0x5f, 0x32, 0x86, 0xde, 0x25, 0xa9,
+	0x47, 0xff, 0xdf, 0x00, 0x0e, 0x0d, 0x89, 0x0d, 0x98, 0xda, 0x00, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -4404,6 +5149,15 @@ func (m *AppProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CosignPublicKeys) > 0 {
+		for iNdEx := len(m.CosignPublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CosignPublicKeys[iNdEx])
+			copy(dAtA[i:], m.CosignPublicKeys[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.CosignPublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	i--
 	if m.PermitOnlyProjectScopedClusters {
 		dAtA[i] = 1
@@ -4582,6 +5336,20 @@ func (m *AppProjectStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SyncWindowOverrides) > 0 {
+		for iNdEx := len(m.SyncWindowOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SyncWindowOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.JWTTokensByRole) > 0 {
 		keysForJWTTokensByRole := make([]string, 0, len(m.JWTTokensByRole))
 		for k := range m.JWTTokensByRole {
@@ -4724,6 +5492,46 @@ func (m *ApplicationCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationDependency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDependency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationDependency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Selector != nil {
+		{
+			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationDestination) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4809,6 +5617,48 @@ func (m *ApplicationList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationMatchExpression) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationMatchExpression) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationMatchExpression) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Operator)
+	copy(dAtA[i:], m.Operator)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operator)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4862,6 +5712,76 @@ func (m *ApplicationSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetApplicationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetApplicationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetApplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Sync)
+	copy(dAtA[i:], m.Sync)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Sync)))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Step)
+	copy(dAtA[i:], m.Step)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Step)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Status)
+	copy(dAtA[i:], m.Status)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x1a
+	if m.LastTransitionTime != nil {
+		{
+			size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Application)
+	copy(dAtA[i:], m.Application)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Application)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSetCondition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4937,6 +5857,18 @@ func (m *ApplicationSetGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Plugin != nil {
+		{
+			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Selector != nil {
 		{
 			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
@@ -5115,6 +6047,18 @@ func (m *ApplicationSetNestedGenerator) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.Plugin != nil {
+		{
+			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Selector != nil {
 		{
 			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
@@ -5226,6 +6170,129 @@ func (m *ApplicationSetNestedGenerator) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetResourceIgnoreDifferences) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetResourceIgnoreDifferences) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetResourceIgnoreDifferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JSONPointers) > 0 {
+		for iNdEx := len(m.JSONPointers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JSONPointers[iNdEx])
+			copy(dAtA[i:], m.JSONPointers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.JSONPointers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSetRolloutStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetRolloutStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetRolloutStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxUpdate != nil {
+		{
+			size, err := m.MaxUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MatchExpressions) > 0 {
+		for iNdEx := len(m.MatchExpressions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MatchExpressions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSetRolloutStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetRolloutStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetRolloutStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSetSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5246,6 +6313,39 @@ func (m *ApplicationSetSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TemplatePatch != nil {
+		i -= len(*m.TemplatePatch)
+		copy(dAtA[i:], *m.TemplatePatch)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.TemplatePatch)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.IgnoreApplicationDifferences) > 0 {
+		for iNdEx := len(m.IgnoreApplicationDifferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IgnoreApplicationDifferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Strategy != nil {
+		{
+			size, err := m.Strategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.SyncPolicy != nil {
 		{
 			size, err := m.SyncPolicy.MarshalToSizedBuffer(dAtA[:i])
@@ -5313,6 +6413,20 @@ func (m *ApplicationSetStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ApplicationStatus) > 0 {
+		for iNdEx := len(m.ApplicationStatus) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ApplicationStatus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Conditions) > 0 {
 		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -5330,6 +6444,46 @@ func (m *ApplicationSetStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RollingSync != nil {
+		{
+			size, err := m.RollingSync.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSetSyncPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5350,6 +6504,13 @@ func (m *ApplicationSetSyncPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.ApplicationsSync != nil {
+		i -= len(*m.ApplicationsSync)
+		copy(dAtA[i:], *m.ApplicationsSync)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.ApplicationsSync)))
+		i--
+		dAtA[i] = 0x12
+	}
 	i--
 	if m.PreserveResourcesOnDeletion {
 		dAtA[i] = 1
@@ -5514,6 +6675,18 @@ func (m *ApplicationSetTerminalGenerator) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.Plugin != nil {
+		{
+			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.PullRequest != nil {
 		{
 			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
@@ -5589,6 +6762,44 @@ func (m *ApplicationSetTerminalGenerator) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetWatchEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetWatchEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetWatchEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ApplicationSet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5609,6 +6820,11 @@ func (m *ApplicationSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Ref)
+	copy(dAtA[i:], m.Ref)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Ref)))
+	i--
+	dAtA[i] = 0x6a
 	i -= len(m.Chart)
 	copy(dAtA[i:], m.Chart)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Chart)))
@@ -5751,6 +6967,32 @@ func (m *ApplicationSourceHelm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.APIVersions) > 0 {
+		for iNdEx := len(m.APIVersions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.APIVersions[iNdEx])
+			copy(dAtA[i:], m.APIVersions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.APIVersions[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	i -= len(m.KubeVersion)
+	copy(dAtA[i:], m.KubeVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KubeVersion)))
+	i--
+	dAtA[i] = 0x5a
+	if m.ValuesObject != nil {
+		{
+			size, err := m.ValuesObject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	i--
 	if m.SkipCrds {
 		dAtA[i] = 1
@@ -5910,6 +7152,39 @@ func (m *ApplicationSourceKustomize) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.Patches) > 0 {
+		for iNdEx := len(m.Patches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Patches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Replicas) > 0 {
+		for iNdEx := len(m.Replicas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Replicas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x5a
 	if len(m.Components) > 0 {
 		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Components[iNdEx])
@@ -6038,6 +7313,20 @@ func (m *ApplicationSourcePlugin) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Env) > 0 {
 		for iNdEx := len(m.Env) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -6060,6 +7349,65 @@ func (m *ApplicationSourcePlugin) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSourcePluginParameter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSourcePluginParameter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSourcePluginParameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.String_ != nil {
+		i -= len(*m.String_)
+		copy(dAtA[i:], *m.String_)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.String_)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.OptionalArray != nil {
+		{
+			size, err := m.OptionalArray.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OptionalMap != nil {
+		{
+			size, err := m.OptionalMap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6080,6 +7428,34 @@ func (m *ApplicationSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DependsOn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.RevisionHistoryLimit != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.RevisionHistoryLimit))
 		i--
@@ -6516,6 +7892,41 @@ func (m *BasicAuthBitbucketServer) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *BearerTokenBitbucketCloud) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BearerTokenBitbucketCloud) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BearerTokenBitbucketCloud) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TokenRef != nil {
+		{
+			size, err := m.TokenRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Cluster) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7019,6 +8430,20 @@ func (m *ComparedTo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -8081,6 +9506,174 @@ func (m *KustomizeOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KustomizePatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KustomizePatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KustomizePatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		keysForOptions := make([]string, 0, len(m.Options))
+		for k := range m.Options {
+			keysForOptions = append(keysForOptions, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForOptions)
+		for iNdEx := len(keysForOptions) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Options[string(keysForOptions[iNdEx])]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForOptions[iNdEx])
+			copy(dAtA[i:], keysForOptions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForOptions[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Target != nil {
+		{
+			size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Patch)
+	copy(dAtA[i:], m.Patch)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Patch)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *KustomizeReplica) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KustomizeReplica) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KustomizeReplica) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Count.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *KustomizeSelector) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KustomizeSelector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KustomizeSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.AnnotationSelector)
+	copy(dAtA[i:], m.AnnotationSelector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AnnotationSelector)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.LabelSelector)
+	copy(dAtA[i:], m.LabelSelector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LabelSelector)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ListGenerator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8499,6 +10092,85 @@ func (m *OperationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OptionalArray) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptionalArray) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptionalArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Array) > 0 {
+		for iNdEx := len(m.Array) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Array[iNdEx])
+			copy(dAtA[i:], m.Array[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Array[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OptionalMap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptionalMap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptionalMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Map) > 0 {
+		keysForMap := make([]string, 0, len(m.Map))
+		for k := range m.Map {
+			keysForMap = append(keysForMap, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMap)
+		for iNdEx := len(keysForMap) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Map[string(keysForMap[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMap[iNdEx])
+			copy(dAtA[i:], keysForMap[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMap[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OrphanedResourceKey) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8634,6 +10306,168 @@ func (m *OverrideIgnoreDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PluginConfigMapRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PluginConfigMapRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PluginConfigMapRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PluginGenerator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PluginGenerator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PluginGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		keysForValues := make([]string, 0, len(m.Values))
+		for k := range m.Values {
+			keysForValues = append(keysForValues, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+		for iNdEx := len(keysForValues) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Values[string(keysForValues[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForValues[iNdEx])
+			copy(dAtA[i:], keysForValues[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForValues[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.RequeueAfterSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RequeueAfterSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ConfigMapRef.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PluginInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PluginInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PluginInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		keysForParameters := make([]string, 0, len(m.Parameters))
+		for k := range m.Parameters {
+			keysForParameters = append(keysForParameters, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForParameters)
+		for iNdEx := len(keysForParameters) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Parameters[string(keysForParameters[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForParameters[iNdEx])
+			copy(dAtA[i:], keysForParameters[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForParameters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ProjectRole) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8719,6 +10553,30 @@ func (m *PullRequestGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AzureDevOps != nil {
+		{
+			size, err := m.AzureDevOps.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Bitbucket != nil {
+		{
+			size, err := m.Bitbucket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	{
 		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -8799,6 +10657,132 @@ func (m *PullRequestGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PullRequestGeneratorAzureDevOps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullRequestGeneratorAzureDevOps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullRequestGeneratorAzureDevOps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Labels[iNdEx])
+			copy(dAtA[i:], m.Labels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Labels[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.TokenRef != nil {
+		{
+			size, err := m.TokenRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.API)
+	copy(dAtA[i:], m.API)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.API)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Repo)
+	copy(dAtA[i:], m.Repo)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Repo)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Project)
+	copy(dAtA[i:], m.Project)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Project)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Organization)
+	copy(dAtA[i:], m.Organization)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Organization)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PullRequestGeneratorBitbucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullRequestGeneratorBitbucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullRequestGeneratorBitbucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BearerToken != nil {
+		{
+			size, err := m.BearerToken.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BasicAuth != nil {
+		{
+			size, err := m.BasicAuth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.API)
+	copy(dAtA[i:], m.API)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.API)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Repo)
+	copy(dAtA[i:], m.Repo)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Repo)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Owner)
+	copy(dAtA[i:], m.Owner)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Owner)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PullRequestGeneratorBitbucketServer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8869,6 +10853,35 @@ func (m *PullRequestGeneratorFilter) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.ExcludeDrafts {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	if m.Author != nil {
+		i -= len(*m.Author)
+		copy(dAtA[i:], *m.Author)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Author)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TitleMatch != nil {
+		i -= len(*m.TitleMatch)
+		copy(dAtA[i:], *m.TitleMatch)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.TitleMatch)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TargetBranchMatch != nil {
+		i -= len(*m.TargetBranchMatch)
+		copy(dAtA[i:], *m.TargetBranchMatch)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.TargetBranchMatch)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.BranchMatch != nil {
 		i -= len(*m.BranchMatch)
 		copy(dAtA[i:], *m.BranchMatch)
@@ -9060,6 +11073,49 @@ func (m *PullRequestGeneratorGithub) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *RefTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Chart)
+	copy(dAtA[i:], m.Chart)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Chart)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.TargetRevision)
+	copy(dAtA[i:], m.TargetRevision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TargetRevision)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RepoCreds) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10409,6 +12465,34 @@ func (m *RevisionHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x52
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Revisions[iNdEx])
+			copy(dAtA[i:], m.Revisions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revisions[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.DeployStartedAt != nil {
 		{
 			size, err := m.DeployStartedAt.MarshalToSizedBuffer(dAtA[:i])
@@ -11125,6 +13209,46 @@ func (m *SyncOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x6a
+	if m.NotBefore != nil {
+		{
+			size, err := m.NotBefore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Revisions[iNdEx])
+			copy(dAtA[i:], m.Revisions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revisions[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.SyncOptions) > 0 {
 		for iNdEx := len(m.SyncOptions) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.SyncOptions[iNdEx])
@@ -11268,6 +13392,41 @@ func (m *SyncOperationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WaveStartedAt != nil {
+		{
+			size, err := m.WaveStartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Revisions[iNdEx])
+			copy(dAtA[i:], m.Revisions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revisions[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -11376,6 +13535,11 @@ func (m *SyncPolicyAutomated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.RetryFailedAfter)
+	copy(dAtA[i:], m.RetryFailedAfter)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RetryFailedAfter)))
+	i--
+	dAtA[i] = 0x22
 	i--
 	if m.AllowEmpty {
 		dAtA[i] = 1
@@ -11423,6 +13587,15 @@ func (m *SyncStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Revisions[iNdEx])
+			copy(dAtA[i:], m.Revisions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revisions[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	i -= len(m.Revision)
 	copy(dAtA[i:], m.Revision)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
@@ -11577,6 +13750,26 @@ func (m *SyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.AndOperator {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x50
+	if m.ApplicationSelector != nil {
+		{
+			size, err := m.ApplicationSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	i -= len(m.TimeZone)
 	copy(dAtA[i:], m.TimeZone)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TimeZone)))
@@ -11635,6 +13828,69 @@ func (m *SyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncWindowOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindowOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWindowOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ExpiresAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.User)
+	copy(dAtA[i:], m.User)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.User)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Application)
+	copy(dAtA[i:], m.Application)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Application)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TLSClientConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11821,6 +14077,12 @@ func (m *AppProjectSpec) Size() (n int) {
 		}
 	}
 	n += 2
+	if len(m.CosignPublicKeys) > 0 {
+		for _, s := range m.CosignPublicKeys {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -11839,6 +14101,12 @@ func (m *AppProjectStatus) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if len(m.SyncWindowOverrides) > 0 {
+		for _, e := range m.SyncWindowOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -11878,6 +14146,21 @@ func (m *ApplicationCondition) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationDependency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Selector != nil {
+		l = m.Selector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ApplicationDestination) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11910,6 +14193,25 @@ func (m *ApplicationList) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationMatchExpression) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Operator)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ApplicationSet) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11925,6 +14227,31 @@ func (m *ApplicationSet) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationSetApplicationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Application)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.LastTransitionTime != nil {
+		l = m.LastTransitionTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Step)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Health.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Sync)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ApplicationSetCondition) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11988,6 +14315,10 @@ func (m *ApplicationSetGenerator) Size() (n int) {
 		l = m.Selector.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Plugin != nil {
+		l = m.Plugin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12050,6 +14381,61 @@ func (m *ApplicationSetNestedGenerator) Size() (n int) {
 		l = m.Selector.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Plugin != nil {
+		l = m.Plugin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationSetResourceIgnoreDifferences) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.JSONPointers) > 0 {
+		for _, s := range m.JSONPointers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApplicationSetRolloutStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MatchExpressions) > 0 {
+		for _, e := range m.MatchExpressions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.MaxUpdate != nil {
+		l = m.MaxUpdate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationSetRolloutStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -12072,6 +14458,20 @@ func (m *ApplicationSetSpec) Size() (n int) {
 		l = m.SyncPolicy.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Strategy != nil {
+		l = m.Strategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.IgnoreApplicationDifferences) > 0 {
+		for _, e := range m.IgnoreApplicationDifferences {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.TemplatePatch != nil {
+		l = len(*m.TemplatePatch)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12087,6 +14487,27 @@ func (m *ApplicationSetStatus) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.ApplicationStatus) > 0 {
+		for _, e := range m.ApplicationStatus {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApplicationSetStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.RollingSync != nil {
+		l = m.RollingSync.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12097,6 +14518,10 @@ func (m *ApplicationSetSyncPolicy) Size() (n int) {
 	var l int
 	_ = l
 	n += 2
+	if m.ApplicationsSync != nil {
+		l = len(*m.ApplicationsSync)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12178,6 +14603,23 @@ func (m *ApplicationSetTerminalGenerator) Size() (n int) {
 		l = m.PullRequest.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Plugin != nil {
+		l = m.Plugin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationSetWatchEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ApplicationSet.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -12211,6 +14653,8 @@ func (m *ApplicationSource) Size() (n int) {
 	}
 	l = len(m.Chart)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Ref)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -12263,6 +14707,18 @@ func (m *ApplicationSourceHelm) Size() (n int) {
 	n += 2
 	n += 2
 	n += 2
+	if m.ValuesObject != nil {
+		l = m.ValuesObject.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.KubeVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.APIVersions) > 0 {
+		for _, s := range m.APIVersions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -12336,6 +14792,20 @@ func (m *ApplicationSourceKustomize) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Replicas) > 0 {
+		for _, e := range m.Replicas {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Patches) > 0 {
+		for _, e := range m.Patches {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -12353,6 +14823,35 @@ func (m *ApplicationSourcePlugin) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApplicationSourcePluginParameter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.OptionalMap != nil {
+		l = m.OptionalMap.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.OptionalArray != nil {
+		l = m.OptionalArray.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.String_ != nil {
+		l = len(*m.String_)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12387,6 +14886,18 @@ func (m *ApplicationSpec) Size() (n int) {
 	if m.RevisionHistoryLimit != nil {
 		n += 1 + sovGenerated(uint64(*m.RevisionHistoryLimit))
 	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.DependsOn) > 0 {
+		for _, e := range m.DependsOn {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -12531,6 +15042,19 @@ func (m *BasicAuthBitbucketServer) Size() (n int) {
 	return n
 }
 
+func (m *BearerTokenBitbucketCloud) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TokenRef != nil {
+		l = m.TokenRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Cluster) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12715,6 +15239,12 @@ func (m *ComparedTo) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Destination.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -13111,6 +15641,67 @@ func (m *KustomizeOptions) Size() (n int) {
 	return n
 }
 
+func (m *KustomizePatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Patch)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for k, v := range m.Options {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *KustomizeReplica) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Count.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *KustomizeSelector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.LabelSelector)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AnnotationSelector)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ListGenerator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13265,6 +15856,38 @@ func (m *OperationState) Size() (n int) {
 	return n
 }
 
+func (m *OptionalArray) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Array) > 0 {
+		for _, s := range m.Array {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OptionalMap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Map) > 0 {
+		for k, v := range m.Map {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *OrphanedResourceKey) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13325,6 +15948,61 @@ func (m *OverrideIgnoreDiff) Size() (n int) {
 	return n
 }
 
+func (m *PluginConfigMapRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *PluginGenerator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ConfigMapRef.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Input.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.RequeueAfterSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.RequeueAfterSeconds))
+	}
+	l = m.Template.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Values) > 0 {
+		for k, v := range m.Values {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *PluginInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		for k, v := range m.Parameters {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *ProjectRole) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13389,6 +16067,64 @@ func (m *PullRequestGenerator) Size() (n int) {
 	}
 	l = m.Template.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Bitbucket != nil {
+		l = m.Bitbucket.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.AzureDevOps != nil {
+		l = m.AzureDevOps.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *PullRequestGeneratorAzureDevOps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Organization)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Project)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Repo)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.API)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TokenRef != nil {
+		l = m.TokenRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for _, s := range m.Labels {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PullRequestGeneratorBitbucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Repo)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.API)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.BasicAuth != nil {
+		l = m.BasicAuth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.BearerToken != nil {
+		l = m.BearerToken.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -13421,6 +16157,19 @@ func (m *PullRequestGeneratorFilter) Size() (n int) {
 		l = len(*m.BranchMatch)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.TargetBranchMatch != nil {
+		l = len(*m.TargetBranchMatch)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.TitleMatch != nil {
+		l = len(*m.TitleMatch)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Author != nil {
+		l = len(*m.Author)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
 	return n
 }
 
@@ -13496,6 +16245,21 @@ func (m *PullRequestGeneratorGithub) Size() (n int) {
 	return n
 }
 
+func (m *RefTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Repo.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TargetRevision)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Chart)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *RepoCreds) Size() (n int) {
 	if m == nil {
 		return 0
@@ -14004,6 +16768,20 @@ func (m *RevisionHistory) Size() (n int) {
 		l = m.DeployStartedAt.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -14283,6 +17061,24 @@ func (m *SyncOperation) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.NotBefore != nil {
+		l = m.NotBefore.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -14319,6 +17115,22 @@ func (m *SyncOperationResult) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Source.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.WaveStartedAt != nil {
+		l = m.WaveStartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -14354,6 +17166,8 @@ func (m *SyncPolicyAutomated) Size() (n int) {
 	n += 2
 	n += 2
 	n += 2
+	l = len(m.RetryFailedAfter)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -14369,6 +17183,12 @@ func (m *SyncStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Revision)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -14443,6 +17263,32 @@ func (m *SyncWindow) Size() (n int) {
 	n += 2
 	l = len(m.TimeZone)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.ApplicationSelector != nil {
+		l = m.ApplicationSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
+}
+
+func (m *SyncWindowOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Application)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.User)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.CreatedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ExpiresAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -14573,6 +17419,7 @@ func (this *AppProjectSpec) String() string {
 		`ClusterResourceBlacklist:` + repeatedStringForClusterResourceBlacklist + `,`,
 		`SourceNamespaces:` + fmt.Sprintf("%v", this.SourceNamespaces) + `,`,
 		`PermitOnlyProjectScopedClusters:` + fmt.Sprintf("%v", this.PermitOnlyProjectScopedClusters) + `,`,
+		`CosignPublicKeys:` + fmt.Sprintf("%v", this.CosignPublicKeys) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14581,6 +17428,11 @@ func (this *AppProjectStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForSyncWindowOverrides := "[]SyncWindowOverride{"
+	for _, f := range this.SyncWindowOverrides {
+		repeatedStringForSyncWindowOverrides += strings.Replace(strings.Replace(f.String(), "SyncWindowOverride", "SyncWindowOverride", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSyncWindowOverrides += "}"
 	keysForJWTTokensByRole := make([]string, 0, len(this.JWTTokensByRole))
 	for k := range this.JWTTokensByRole {
 		keysForJWTTokensByRole = append(keysForJWTTokensByRole, k)
@@ -14593,6 +17445,7 @@ func (this *AppProjectStatus) String() string {
 	mapStringForJWTTokensByRole += "}"
 	s := strings.Join([]string{`&AppProjectStatus{`,
 		`JWTTokensByRole:` + mapStringForJWTTokensByRole + `,`,
+		`SyncWindowOverrides:` + repeatedStringForSyncWindowOverrides + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14622,6 +17475,17 @@ func (this *ApplicationCondition) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationDependency) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationDependency{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationDestination) String() string {
 	if this == nil {
 		return "nil"
@@ -14650,6 +17514,18 @@ func (this *ApplicationList) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationMatchExpression) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationMatchExpression{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Operator:` + fmt.Sprintf("%v", this.Operator) + `,`,
+		`Values:` + fmt.Sprintf("%v", this.Values) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationSet) String() string {
 	if this == nil {
 		return "nil"
@@ -14662,6 +17538,22 @@ func (this *ApplicationSet) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationSetApplicationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetApplicationStatus{`,
+		`Application:` + fmt.Sprintf("%v", this.Application) + `,`,
+		`LastTransitionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Step:` + fmt.Sprintf("%v", this.Step) + `,`,
+		`Health:` + strings.Replace(strings.Replace(this.Health.String(), "HealthStatus", "HealthStatus", 1), `&`, ``, 1) + `,`,
+		`Sync:` + fmt.Sprintf("%v", this.Sync) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationSetCondition) String() string {
 	if this == nil {
 		return "nil"
@@ -14690,6 +17582,7 @@ func (this *ApplicationSetGenerator) String() string {
 		`Matrix:` + strings.Replace(this.Matrix.String(), "MatrixGenerator", "MatrixGenerator", 1) + `,`,
 		`Merge:` + strings.Replace(this.Merge.String(), "MergeGenerator", "MergeGenerator", 1) + `,`,
 		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginGenerator", "PluginGenerator", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14724,6 +17617,49 @@ func (this *ApplicationSetNestedGenerator) String() string {
 		`Matrix:` + strings.Replace(fmt.Sprintf("%v", this.Matrix), "JSON", "v11.JSON", 1) + `,`,
 		`Merge:` + strings.Replace(fmt.Sprintf("%v", this.Merge), "JSON", "v11.JSON", 1) + `,`,
 		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginGenerator", "PluginGenerator", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSetResourceIgnoreDifferences) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetResourceIgnoreDifferences{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`JSONPointers:` + fmt.Sprintf("%v", this.JSONPointers) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSetRolloutStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMatchExpressions := "[]ApplicationMatchExpression{"
+	for _, f := range this.MatchExpressions {
+		repeatedStringForMatchExpressions += strings.Replace(strings.Replace(f.String(), "ApplicationMatchExpression", "ApplicationMatchExpression", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMatchExpressions += "}"
+	s := strings.Join([]string{`&ApplicationSetRolloutStep{`,
+		`MatchExpressions:` + repeatedStringForMatchExpressions + `,`,
+		`MaxUpdate:` + strings.Replace(fmt.Sprintf("%v", this.MaxUpdate), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSetRolloutStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSteps := "[]ApplicationSetRolloutStep{"
+	for _, f := range this.Steps {
+		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "ApplicationSetRolloutStep", "ApplicationSetRolloutStep", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSteps += "}"
+	s := strings.Join([]string{`&ApplicationSetRolloutStrategy{`,
+		`Steps:` + repeatedStringForSteps + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14737,11 +17673,19 @@ func (this *ApplicationSetSpec) String() string {
 		repeatedStringForGenerators += strings.Replace(strings.Replace(f.String(), "ApplicationSetGenerator", "ApplicationSetGenerator", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForGenerators += "}"
+	repeatedStringForIgnoreApplicationDifferences := "[]ApplicationSetResourceIgnoreDifferences{"
+	for _, f := range this.IgnoreApplicationDifferences {
+		repeatedStringForIgnoreApplicationDifferences += strings.Replace(strings.Replace(f.String(), "ApplicationSetResourceIgnoreDifferences", "ApplicationSetResourceIgnoreDifferences", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForIgnoreApplicationDifferences += "}"
 	s := strings.Join([]string{`&ApplicationSetSpec{`,
 		`GoTemplate:` + fmt.Sprintf("%v", this.GoTemplate) + `,`,
 		`Generators:` + repeatedStringForGenerators + `,`,
 		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ApplicationSetTemplate", "ApplicationSetTemplate", 1), `&`, ``, 1) + `,`,
 		`SyncPolicy:` + strings.Replace(this.SyncPolicy.String(), "ApplicationSetSyncPolicy", "ApplicationSetSyncPolicy", 1) + `,`,
+		`Strategy:` + strings.Replace(this.Strategy.String(), "ApplicationSetStrategy", "ApplicationSetStrategy", 1) + `,`,
+		`IgnoreApplicationDifferences:` + repeatedStringForIgnoreApplicationDifferences + `,`,
+		`TemplatePatch:` + valueToStringGenerated(this.TemplatePatch) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14755,8 +17699,25 @@ func (this *ApplicationSetStatus) String() string {
 		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "ApplicationSetCondition", "ApplicationSetCondition", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForConditions += "}"
+	repeatedStringForApplicationStatus := "[]ApplicationSetApplicationStatus{"
+	for _, f := range this.ApplicationStatus {
+		repeatedStringForApplicationStatus += strings.Replace(strings.Replace(f.String(), "ApplicationSetApplicationStatus", "ApplicationSetApplicationStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForApplicationStatus += "}"
 	s := strings.Join([]string{`&ApplicationSetStatus{`,
 		`Conditions:` + repeatedStringForConditions + `,`,
+		`ApplicationStatus:` + repeatedStringForApplicationStatus + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSetStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetStrategy{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`RollingSync:` + strings.Replace(this.RollingSync.String(), "ApplicationSetRolloutStrategy", "ApplicationSetRolloutStrategy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14767,6 +17728,7 @@ func (this *ApplicationSetSyncPolicy) String() string {
 	}
 	s := strings.Join([]string{`&ApplicationSetSyncPolicy{`,
 		`PreserveResourcesOnDeletion:` + fmt.Sprintf("%v", this.PreserveResourcesOnDeletion) + `,`,
+		`ApplicationsSync:` + valueToStringGenerated(this.ApplicationsSync) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14827,6 +17789,18 @@ func (this *ApplicationSetTerminalGenerator) String() string {
 		`SCMProvider:` + strings.Replace(this.SCMProvider.String(), "SCMProviderGenerator", "SCMProviderGenerator", 1) + `,`,
 		`ClusterDecisionResource:` + strings.Replace(this.ClusterDecisionResource.String(), "DuckTypeGenerator", "DuckTypeGenerator", 1) + `,`,
 		`PullRequest:` + strings.Replace(this.PullRequest.String(), "PullRequestGenerator", "PullRequestGenerator", 1) + `,`,
+		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginGenerator", "PluginGenerator", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSetWatchEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetWatchEvent{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ApplicationSet:` + strings.Replace(strings.Replace(this.ApplicationSet.String(), "ApplicationSet", "ApplicationSet", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14844,6 +17818,7 @@ func (this *ApplicationSource) String() string {
 		`Directory:` + strings.Replace(this.Directory.String(), "ApplicationSourceDirectory", "ApplicationSourceDirectory", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "ApplicationSourcePlugin", "ApplicationSourcePlugin", 1) + `,`,
 		`Chart:` + fmt.Sprintf("%v", this.Chart) + `,`,
+		`Ref:` + fmt.Sprintf("%v", this.Ref) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14885,6 +17860,9 @@ func (this *ApplicationSourceHelm) String() string {
 		`PassCredentials:` + fmt.Sprintf("%v", this.PassCredentials) + `,`,
 		`IgnoreMissingValueFiles:` + fmt.Sprintf("%v", this.IgnoreMissingValueFiles) + `,`,
 		`SkipCrds:` + fmt.Sprintf("%v", this.SkipCrds) + `,`,
+		`ValuesObject:` + strings.Replace(fmt.Sprintf("%v", this.ValuesObject), "RawExtension", "runtime.RawExtension", 1) + `,`,
+		`KubeVersion:` + fmt.Sprintf("%v", this.KubeVersion) + `,`,
+		`APIVersions:` + fmt.Sprintf("%v", this.APIVersions) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14915,6 +17893,16 @@ func (this *ApplicationSourceKustomize) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForReplicas := "[]KustomizeReplica{"
+	for _, f := range this.Replicas {
+		repeatedStringForReplicas += strings.Replace(strings.Replace(f.String(), "KustomizeReplica", "KustomizeReplica", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForReplicas += "}"
+	repeatedStringForPatches := "[]KustomizePatch{"
+	for _, f := range this.Patches {
+		repeatedStringForPatches += strings.Replace(strings.Replace(f.String(), "KustomizePatch", "KustomizePatch", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPatches += "}"
 	keysForCommonLabels := make([]string, 0, len(this.CommonLabels))
 	for k := range this.CommonLabels {
 		keysForCommonLabels = append(keysForCommonLabels, k)
@@ -14946,6 +17934,9 @@ func (this *ApplicationSourceKustomize) String() string {
 		`ForceCommonAnnotations:` + fmt.Sprintf("%v", this.ForceCommonAnnotations) + `,`,
 		`ForceNamespace:` + fmt.Sprintf("%v", this.ForceNamespace) + `,`,
 		`Components:` + fmt.Sprintf("%v", this.Components) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Replicas:` + repeatedStringForReplicas + `,`,
+		`Patches:` + repeatedStringForPatches + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14959,9 +17950,28 @@ func (this *ApplicationSourcePlugin) String() string {
 		repeatedStringForEnv += strings.Replace(f.String(), "EnvEntry", "EnvEntry", 1) + ","
 	}
 	repeatedStringForEnv += "}"
+	repeatedStringForParameters := "[]ApplicationSourcePluginParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "ApplicationSourcePluginParameter", "ApplicationSourcePluginParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
 	s := strings.Join([]string{`&ApplicationSourcePlugin{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Env:` + repeatedStringForEnv + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSourcePluginParameter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSourcePluginParameter{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`OptionalMap:` + strings.Replace(this.OptionalMap.String(), "OptionalMap", "OptionalMap", 1) + `,`,
+		`OptionalArray:` + strings.Replace(this.OptionalArray.String(), "OptionalArray", "OptionalArray", 1) + `,`,
+		`String_:` + valueToStringGenerated(this.String_) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14980,6 +17990,16 @@ func (this *ApplicationSpec) String() string {
 		repeatedStringForInfo += strings.Replace(strings.Replace(f.String(), "Info", "Info", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForInfo += "}"
+	repeatedStringForSources := "[]ApplicationSource{"
+	for _, f := range this.Sources {
+		repeatedStringForSources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSources += "}"
+	repeatedStringForDependsOn := "[]ApplicationDependency{"
+	for _, f := range this.DependsOn {
+		repeatedStringForDependsOn += strings.Replace(strings.Replace(f.String(), "ApplicationDependency", "ApplicationDependency", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDependsOn += "}"
 	s := strings.Join([]string{`&ApplicationSpec{`,
 		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
 		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
@@ -14988,6 +18008,8 @@ func (this *ApplicationSpec) String() string {
 		`IgnoreDifferences:` + repeatedStringForIgnoreDifferences + `,`,
 		`Info:` + repeatedStringForInfo + `,`,
 		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
+		`Sources:` + repeatedStringForSources + `,`,
+		`DependsOn:` + repeatedStringForDependsOn + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15099,6 +18121,16 @@ func (this *BasicAuthBitbucketServer) String() string {
 	}, "")
 	return s
 }
+func (this *BearerTokenBitbucketCloud) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BearerTokenBitbucketCloud{`,
+		`TokenRef:` + strings.Replace(this.TokenRef.String(), "SecretRef", "SecretRef", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Cluster) String() string {
 	if this == nil {
 		return "nil"
@@ -15235,9 +18267,15 @@ func (this *ComparedTo) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForSources := "[]ApplicationSource{"
+	for _, f := range this.Sources {
+		repeatedStringForSources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSources += "}"
 	s := strings.Join([]string{`&ComparedTo{`,
 		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
 		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
+		`Sources:` + repeatedStringForSources + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15573,6 +18611,56 @@ func (this *KustomizeOptions) String() string {
 	}, "")
 	return s
 }
+func (this *KustomizePatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForOptions := make([]string, 0, len(this.Options))
+	for k := range this.Options {
+		keysForOptions = append(keysForOptions, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForOptions)
+	mapStringForOptions := "map[string]bool{"
+	for _, k := range keysForOptions {
+		mapStringForOptions += fmt.Sprintf("%v: %v,", k, this.Options[k])
+	}
+	mapStringForOptions += "}"
+	s := strings.Join([]string{`&KustomizePatch{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Patch:` + fmt.Sprintf("%v", this.Patch) + `,`,
+		`Target:` + strings.Replace(this.Target.String(), "KustomizeSelector", "KustomizeSelector", 1) + `,`,
+		`Options:` + mapStringForOptions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KustomizeReplica) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KustomizeReplica{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Count:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Count), "IntOrString", "intstr.IntOrString", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KustomizeSelector) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KustomizeSelector{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`LabelSelector:` + fmt.Sprintf("%v", this.LabelSelector) + `,`,
+		`AnnotationSelector:` + fmt.Sprintf("%v", this.AnnotationSelector) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ListGenerator) String() string {
 	if this == nil {
 		return "nil"
@@ -15698,6 +18786,36 @@ func (this *OperationState) String() string {
 	}, "")
 	return s
 }
+func (this *OptionalArray) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OptionalArray{`,
+		`Array:` + fmt.Sprintf("%v", this.Array) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OptionalMap) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForMap := make([]string, 0, len(this.Map))
+	for k := range this.Map {
+		keysForMap = append(keysForMap, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMap)
+	mapStringForMap := "map[string]string{"
+	for _, k := range keysForMap {
+		mapStringForMap += fmt.Sprintf("%v: %v,", k, this.Map[k])
+	}
+	mapStringForMap += "}"
+	s := strings.Join([]string{`&OptionalMap{`,
+		`Map:` + mapStringForMap + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *OrphanedResourceKey) String() string {
 	if this == nil {
 		return "nil"
@@ -15738,6 +18856,60 @@ func (this *OverrideIgnoreDiff) String() string {
 	}, "")
 	return s
 }
+func (this *PluginConfigMapRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PluginConfigMapRef{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PluginGenerator) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForValues := make([]string, 0, len(this.Values))
+	for k := range this.Values {
+		keysForValues = append(keysForValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+	mapStringForValues := "map[string]string{"
+	for _, k := range keysForValues {
+		mapStringForValues += fmt.Sprintf("%v: %v,", k, this.Values[k])
+	}
+	mapStringForValues += "}"
+	s := strings.Join([]string{`&PluginGenerator{`,
+		`ConfigMapRef:` + strings.Replace(strings.Replace(this.ConfigMapRef.String(), "PluginConfigMapRef", "PluginConfigMapRef", 1), `&`, ``, 1) + `,`,
+		`Input:` + strings.Replace(strings.Replace(this.Input.String(), "PluginInput", "PluginInput", 1), `&`, ``, 1) + `,`,
+		`RequeueAfterSeconds:` + valueToStringGenerated(this.RequeueAfterSeconds) + `,`,
+		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ApplicationSetTemplate", "ApplicationSetTemplate", 1), `&`, ``, 1) + `,`,
+		`Values:` + mapStringForValues + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PluginInput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForParameters := make([]string, 0, len(this.Parameters))
+	for k := range this.Parameters {
+		keysForParameters = append(keysForParameters, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForParameters)
+	mapStringForParameters := "PluginParameters{"
+	for _, k := range keysForParameters {
+		mapStringForParameters += fmt.Sprintf("%v: %v,", k, this.Parameters[k])
+	}
+	mapStringForParameters += "}"
+	s := strings.Join([]string{`&PluginInput{`,
+		`Parameters:` + mapStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ProjectRole) String() string {
 	if this == nil {
 		return "nil"
@@ -15774,6 +18946,37 @@ func (this *PullRequestGenerator) String() string {
 		`Filters:` + repeatedStringForFilters + `,`,
 		`RequeueAfterSeconds:` + valueToStringGenerated(this.RequeueAfterSeconds) + `,`,
 		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ApplicationSetTemplate", "ApplicationSetTemplate", 1), `&`, ``, 1) + `,`,
+		`Bitbucket:` + strings.Replace(this.Bitbucket.String(), "PullRequestGeneratorBitbucket", "PullRequestGeneratorBitbucket", 1) + `,`,
+		`AzureDevOps:` + strings.Replace(this.AzureDevOps.String(), "PullRequestGeneratorAzureDevOps", "PullRequestGeneratorAzureDevOps", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PullRequestGeneratorAzureDevOps) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PullRequestGeneratorAzureDevOps{`,
+		`Organization:` + fmt.Sprintf("%v", this.Organization) + `,`,
+		`Project:` + fmt.Sprintf("%v", this.Project) + `,`,
+		`Repo:` + fmt.Sprintf("%v", this.Repo) + `,`,
+		`API:` + fmt.Sprintf("%v", this.API) + `,`,
+		`TokenRef:` + strings.Replace(this.TokenRef.String(), "SecretRef", "SecretRef", 1) + `,`,
+		`Labels:` + fmt.Sprintf("%v", this.Labels) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PullRequestGeneratorBitbucket) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PullRequestGeneratorBitbucket{`,
+		`Owner:` + fmt.Sprintf("%v", this.Owner) + `,`,
+		`Repo:` + fmt.Sprintf("%v", this.Repo) + `,`,
+		`API:` + fmt.Sprintf("%v", this.API) + `,`,
+		`BasicAuth:` + strings.Replace(this.BasicAuth.String(), "BasicAuthBitbucketServer", "BasicAuthBitbucketServer", 1) + `,`,
+		`BearerToken:` + strings.Replace(this.BearerToken.String(), "BearerTokenBitbucketCloud", "BearerTokenBitbucketCloud", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15797,6 +19000,10 @@ func (this *PullRequestGeneratorFilter) String() string {
 	}
 	s := strings.Join([]string{`&PullRequestGeneratorFilter{`,
 		`BranchMatch:` + valueToStringGenerated(this.BranchMatch) + `,`,
+		`TargetBranchMatch:` + valueToStringGenerated(this.TargetBranchMatch) + `,`,
+		`TitleMatch:` + valueToStringGenerated(this.TitleMatch) + `,`,
+		`Author:` + valueToStringGenerated(this.Author) + `,`,
+		`ExcludeDrafts:` + fmt.Sprintf("%v", this.ExcludeDrafts) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15844,6 +19051,18 @@ func (this *PullRequestGeneratorGithub) String() string {
 	}, "")
 	return s
 }
+func (this *RefTarget) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RefTarget{`,
+		`Repo:` + strings.Replace(strings.Replace(this.Repo.String(), "Repository", "Repository", 1), `&`, ``, 1) + `,`,
+		`TargetRevision:` + fmt.Sprintf("%v", this.TargetRevision) + `,`,
+		`Chart:` + fmt.Sprintf("%v", this.Chart) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RepoCreds) String() string {
 	if this == nil {
 		return "nil"
@@ -16230,12 +19449,20 @@ func (this *RevisionHistory) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForSources := "[]ApplicationSource{"
+	for _, f := range this.Sources {
+		repeatedStringForSources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSources += "}"
 	s := strings.Join([]string{`&RevisionHistory{`,
 		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
 		`DeployedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DeployedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
 		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
 		`DeployStartedAt:` + strings.Replace(fmt.Sprintf("%v", this.DeployStartedAt), "Time", "v1.Time", 1) + `,`,
+		`Sources:` + repeatedStringForSources + `,`,
+		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16404,6 +19631,11 @@ func (this *SyncOperation) String() string {
 		repeatedStringForResources += strings.Replace(strings.Replace(f.String(), "SyncOperationResource", "SyncOperationResource", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForResources += "}"
+	repeatedStringForSources := "[]ApplicationSource{"
+	for _, f := range this.Sources {
+		repeatedStringForSources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSources += "}"
 	s := strings.Join([]string{`&SyncOperation{`,
 		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
 		`Prune:` + fmt.Sprintf("%v", this.Prune) + `,`,
@@ -16413,6 +19645,10 @@ func (this *SyncOperation) String() string {
 		`Source:` + strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1) + `,`,
 		`Manifests:` + fmt.Sprintf("%v", this.Manifests) + `,`,
 		`SyncOptions:` + fmt.Sprintf("%v", this.SyncOptions) + `,`,
+		`Sources:` + repeatedStringForSources + `,`,
+		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
+		`NotBefore:` + strings.Replace(fmt.Sprintf("%v", this.NotBefore), "Time", "v1.Time", 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16439,10 +19675,18 @@ func (this *SyncOperationResult) String() string {
 		repeatedStringForResources += strings.Replace(f.String(), "ResourceResult", "ResourceResult", 1) + ","
 	}
 	repeatedStringForResources += "}"
+	repeatedStringForSources := "[]ApplicationSource{"
+	for _, f := range this.Sources {
+		repeatedStringForSources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSources += "}"
 	s := strings.Join([]string{`&SyncOperationResult{`,
 		`Resources:` + repeatedStringForResources + `,`,
 		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
 		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
+		`Sources:` + repeatedStringForSources + `,`,
+		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
+		`WaveStartedAt:` + strings.Replace(fmt.Sprintf("%v", this.WaveStartedAt), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16467,6 +19711,7 @@ func (this *SyncPolicyAutomated) String() string {
 		`Prune:` + fmt.Sprintf("%v", this.Prune) + `,`,
 		`SelfHeal:` + fmt.Sprintf("%v", this.SelfHeal) + `,`,
 		`AllowEmpty:` + fmt.Sprintf("%v", this.AllowEmpty) + `,`,
+		`RetryFailedAfter:` + fmt.Sprintf("%v", this.RetryFailedAfter) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16479,6 +19724,7 @@ func (this *SyncStatus) String() string {
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`ComparedTo:` + strings.Replace(strings.Replace(this.ComparedTo.String(), "ComparedTo", "ComparedTo", 1), `&`, ``, 1) + `,`,
 		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16527,6 +19773,23 @@ func (this *SyncWindow) String() string {
 		`Clusters:` + fmt.Sprintf("%v", this.Clusters) + `,`,
 		`ManualSync:` + fmt.Sprintf("%v", this.ManualSync) + `,`,
 		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`ApplicationSelector:` + strings.Replace(fmt.Sprintf("%v", this.ApplicationSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`AndOperator:` + fmt.Sprintf("%v", this.AndOperator) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncWindowOverride) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncWindowOverride{`,
+		`Application:` + fmt.Sprintf("%v", this.Application) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`User:` + fmt.Sprintf("%v", this.User) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`ExpiresAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17386,6 +20649,38 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.PermitOnlyProjectScopedClusters = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosignPublicKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosignPublicKeys = append(m.CosignPublicKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17565,6 +20860,40 @@ func (m *AppProjectStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.JWTTokensByRole[mapkey] = *mapvalue
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWindowOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncWindowOverrides = append(m.SyncWindowOverrides, SyncWindowOverride{})
+			if err := m.SyncWindowOverrides[len(m.SyncWindowOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17921,6 +21250,124 @@ func (m *ApplicationCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationDependency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationDependency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationDependency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18184,7 +21631,7 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
+func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18207,17 +21654,17 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationMatchExpression: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationMatchExpression: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18227,30 +21674,29 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18260,28 +21706,175 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18333,6 +21926,285 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetApplicationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Application = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &v1.Time{}
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Step = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sync = SyncStatusCode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18900,6 +22772,42 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plugin == nil {
+				m.Plugin = &PluginGenerator{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19391,6 +23299,42 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plugin == nil {
+				m.Plugin = &PluginGenerator{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19412,7 +23356,7 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19435,17 +23379,17 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetResourceIgnoreDifferences: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoTemplate", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19455,17 +23399,29 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.GoTemplate = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19475,29 +23431,77 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetRolloutStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetRolloutStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchExpressions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19524,13 +23528,14 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MatchExpressions = append(m.MatchExpressions, ApplicationMatchExpression{})
+			if err := m.MatchExpressions[len(m.MatchExpressions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19557,10 +23562,10 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncPolicy == nil {
-				m.SyncPolicy = &ApplicationSetSyncPolicy{}
+			if m.MaxUpdate == nil {
+				m.MaxUpdate = &intstr.IntOrString{}
 			}
-			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19585,7 +23590,7 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetRolloutStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19608,15 +23613,15 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetRolloutStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetRolloutStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19643,8 +23648,8 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, ApplicationSetCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Steps = append(m.Steps, ApplicationSetRolloutStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19669,7 +23674,7 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19692,15 +23697,15 @@ func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetSyncPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetSyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreserveResourcesOnDeletion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GoTemplate", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -19717,7 +23722,213 @@ func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.PreserveResourcesOnDeletion = bool(v != 0)
+			m.GoTemplate = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Generators = append(m.Generators, ApplicationSetGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncPolicy == nil {
+				m.SyncPolicy = &ApplicationSetSyncPolicy{}
+			}
+			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Strategy == nil {
+				m.Strategy = &ApplicationSetStrategy{}
+			}
+			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreApplicationDifferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IgnoreApplicationDifferences = append(m.IgnoreApplicationDifferences, ApplicationSetResourceIgnoreDifferences{})
+			if err := m.IgnoreApplicationDifferences[len(m.IgnoreApplicationDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplatePatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TemplatePatch = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19739,7 +23950,7 @@ func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19762,15 +23973,15 @@ func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSetTemplateMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19797,13 +24008,14 @@ func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationSetTemplateMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, ApplicationSetCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19830,7 +24042,8 @@ func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ApplicationStatus = append(m.ApplicationStatus, ApplicationSetApplicationStatus{})
+			if err := m.ApplicationStatus[len(m.ApplicationStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19855,7 +24068,7 @@ func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19878,15 +24091,15 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetTemplateMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetTemplateMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19914,13 +24127,13 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollingSync", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19930,29 +24143,103 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.RollingSync == nil {
+				m.RollingSync = &ApplicationSetRolloutStrategy{}
+			}
+			if err := m.RollingSync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetSyncPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetSyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreserveResourcesOnDeletion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PreserveResourcesOnDeletion = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationsSync", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19962,68 +24249,331 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			s := ApplicationsSyncPolicy(dAtA[iNdEx:postIndex])
+			m.ApplicationsSync = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSetTemplateMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationSetTemplateMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetTemplateMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetTemplateMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
@@ -20500,6 +25050,157 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plugin == nil {
+				m.Plugin = &PluginGenerator{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetWatchEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetWatchEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = k8s_io_apimachinery_pkg_watch.EventType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20822,6 +25523,38 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Chart = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ref = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21295,6 +26028,106 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SkipCrds = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesObject", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValuesObject == nil {
+				m.ValuesObject = &runtime.RawExtension{}
+			}
+			if err := m.ValuesObject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21969,6 +26802,106 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			}
 			m.Components = append(m.Components, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replicas = append(m.Replicas, KustomizeReplica{})
+			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patches = append(m.Patches, KustomizePatch{})
+			if err := m.Patches[len(m.Patches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22085,6 +27018,40 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, ApplicationSourcePluginParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22106,7 +27073,7 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22129,15 +27096,202 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSourcePluginParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSourcePluginParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalMap == nil {
+				m.OptionalMap = &OptionalMap{}
+			}
+			if err := m.OptionalMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalArray", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalArray == nil {
+				m.OptionalArray = &OptionalArray{}
+			}
+			if err := m.OptionalArray.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.String_ = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22357,6 +27511,74 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RevisionHistoryLimit = &v
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, ApplicationDependency{})
+			if err := m.DependsOn[len(m.DependsOn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23434,7 +28656,7 @@ func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cluster) Unmarshal(dAtA []byte) error {
+func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23457,79 +28679,165 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+			return fmt.Errorf("proto: BearerTokenBitbucketCloud: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BearerTokenBitbucketCloud: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Server = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cluster) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24867,7 +30175,2262 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Cluster{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Command) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Command: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ComparedTo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComparedTo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComparedTo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComponentParameter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComponentParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Component = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigManagementPlugin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigManagementPlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Init", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Init == nil {
+				m.Init = &Command{}
+			}
+			if err := m.Init.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Generate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRepo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LockRepo = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ModifiedAt == nil {
+				m.ModifiedAt = &v1.Time{}
+			}
+			if err := m.ModifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DuckTypeGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DuckTypeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMapRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequeueAfterSeconds = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Values == nil {
+				m.Values = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Values[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnvEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnvEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnvEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecProviderConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Env == nil {
+				m.Env = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Env[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallHint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstallHint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitDirectoryGeneratorItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitDirectoryGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exclude = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitFileGeneratorItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitFileGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Directories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Directories = append(m.Directories, GitDirectoryGeneratorItem{})
+			if err := m.Directories[len(m.Directories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Files = append(m.Files, GitFileGeneratorItem{})
+			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequeueAfterSeconds = &v
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GnuPGPublicKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GnuPGPublicKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trust = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24890,7 +32453,7 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterList) Unmarshal(dAtA []byte) error {
+func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24913,10 +32476,10 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterList: wiretype end group for non-group")
+			return fmt.Errorf("proto: GnuPGPublicKeyList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GnuPGPublicKeyList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -24981,7 +32544,7 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Cluster{})
+			m.Items = append(m.Items, GnuPGPublicKey{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -25007,7 +32570,7 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Command) Unmarshal(dAtA []byte) error {
+func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25030,15 +32593,15 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Command: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25066,11 +32629,11 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			m.Status = github_com_argoproj_gitops_engine_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25098,7 +32661,7 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25121,7 +32684,7 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ComparedTo) Unmarshal(dAtA []byte) error {
+func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25144,17 +32707,17 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ComparedTo: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmFileParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComparedTo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmFileParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25164,30 +32727,29 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25197,24 +32759,23 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25237,7 +32798,7 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
+func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25260,79 +32821,15 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ComponentParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComponentParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Component = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesFileSchemes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25360,7 +32857,7 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ValuesFileSchemes = append(m.ValuesFileSchemes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25383,7 +32880,7 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
+func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25406,10 +32903,10 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigManagementPlugin: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigManagementPlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -25446,9 +32943,9 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Init", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25458,64 +32955,27 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Init == nil {
-				m.Init = &Command{}
-			}
-			if err := m.Init.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generate", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Generate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockRepo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceString", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -25532,7 +32992,7 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.LockRepo = bool(v != 0)
+			m.ForceString = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25554,7 +33014,7 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+func (m *HostInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25577,15 +33037,15 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25613,13 +33073,13 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25629,27 +33089,29 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.ResourcesInfo = append(m.ResourcesInfo, HostResourceInfo{})
+			if err := m.ResourcesInfo[len(m.ResourcesInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25676,10 +33138,7 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ModifiedAt == nil {
-				m.ModifiedAt = &v1.Time{}
-			}
-			if err := m.ModifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25704,7 +33163,7 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
+func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25727,15 +33186,15 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DuckTypeGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostResourceInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DuckTypeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25763,13 +33222,13 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigMapRef = string(dAtA[iNdEx:postIndex])
+			m.ResourceName = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByApp", wireType)
 			}
-			var stringLen uint64
+			m.RequestedByApp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25779,29 +33238,16 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RequestedByApp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByNeighbors", wireType)
 			}
-			var v int64
+			m.RequestedByNeighbors = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25811,17 +33257,16 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				m.RequestedByNeighbors |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequeueAfterSeconds = &v
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
-			var msglen int
+			m.Capacity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25831,30 +33276,66 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Capacity |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Info) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Info: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25864,30 +33345,29 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25897,118 +33377,23 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Values == nil {
-				m.Values = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Values[mapkey] = mapvalue
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26031,7 +33416,7 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EnvEntry) Unmarshal(dAtA []byte) error {
+func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26054,10 +33439,10 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EnvEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EnvEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -26145,7 +33530,7 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
+func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26168,17 +33553,17 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecProviderConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
 			}
-			var stringLen uint64
+			m.IssuedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26188,27 +33573,33 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.IssuedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Command = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26236,11 +33627,61 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JWTTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26267,107 +33708,64 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Env == nil {
-				m.Env = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Items = append(m.Items, JWTToken{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Env[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26395,11 +33793,11 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstallHint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26427,8 +33825,28 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InstallHint = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26450,7 +33868,7 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
+func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26473,15 +33891,15 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitDirectoryGeneratorItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitDirectoryGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26509,13 +33927,13 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26525,12 +33943,24 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Exclude = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26552,7 +33982,7 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
+func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26575,15 +34005,15 @@ func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitFileGeneratorItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitFileGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26611,7 +34041,39 @@ func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.BuildOptions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26634,7 +34096,7 @@ func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitGenerator) Unmarshal(dAtA []byte) error {
+func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26657,15 +34119,15 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizePatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizePatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26693,13 +34155,13 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directories", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26709,29 +34171,27 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Directories = append(m.Directories, GitDirectoryGeneratorItem{})
-			if err := m.Directories[len(m.Directories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Patch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26758,66 +34218,16 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Files = append(m.Files, GitFileGeneratorItem{})
-			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Target == nil {
+				m.Target = &KustomizeSelector{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RequeueAfterSeconds = &v
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26844,9 +34254,91 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Options == nil {
+				m.Options = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Options[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26869,7 +34361,7 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
+func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26892,15 +34384,15 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GnuPGPublicKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeReplica: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GnuPGPublicKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeReplica: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26928,13 +34420,13 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26944,59 +34436,78 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fingerprint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KustomizeSelector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KustomizeSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27024,11 +34535,11 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trust = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27056,11 +34567,11 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubType = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27088,63 +34599,13 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyData = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GnuPGPublicKeyList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GnuPGPublicKeyList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27154,30 +34615,29 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27187,79 +34647,27 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, GnuPGPublicKey{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HealthStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27287,11 +34695,11 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = github_com_argoproj_gitops_engine_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27319,7 +34727,7 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.AnnotationSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27342,7 +34750,7 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
+func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27365,17 +34773,17 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmFileParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmFileParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27385,29 +34793,31 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Elements = append(m.Elements, v11.JSON{})
+			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27417,23 +34827,24 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27456,7 +34867,7 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmOptions) Unmarshal(dAtA []byte) error {
+func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27479,17 +34890,17 @@ func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: MatrixGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValuesFileSchemes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27499,23 +34910,58 @@ func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValuesFileSchemes = append(m.ValuesFileSchemes, string(dAtA[iNdEx:postIndex]))
+			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27538,7 +34984,7 @@ func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmParameter) Unmarshal(dAtA []byte) error {
+func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27561,17 +35007,17 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27581,27 +35027,29 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27629,13 +35077,13 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceString", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27645,12 +35093,25 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceString = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27672,7 +35133,7 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostInfo) Unmarshal(dAtA []byte) error {
+func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27695,17 +35156,17 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: NestedMatrixGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NestedMatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27715,27 +35176,79 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NestedMergeGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NestedMergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27762,16 +35275,16 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourcesInfo = append(m.ResourcesInfo, HostResourceInfo{})
-			if err := m.ResourcesInfo[len(m.ResourcesInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27781,24 +35294,23 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27821,7 +35333,7 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
+func (m *Operation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27844,17 +35356,17 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostResourceInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27864,67 +35376,33 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceName = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByApp", wireType)
-			}
-			m.RequestedByApp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestedByApp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByNeighbors", wireType)
+			if m.Sync == nil {
+				m.Sync = &SyncOperation{}
 			}
-			m.RequestedByNeighbors = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestedByNeighbors |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
 			}
-			m.Capacity = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27934,66 +35412,30 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Capacity |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Info) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Info: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28003,29 +35445,31 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Info = append(m.Info, &Info{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28035,23 +35479,24 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28074,7 +35519,7 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfoItem) Unmarshal(dAtA []byte) error {
+func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28097,15 +35542,15 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationInitiator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationInitiator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28133,13 +35578,13 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28149,24 +35594,12 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Automated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28188,7 +35621,7 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTToken) Unmarshal(dAtA []byte) error {
+func (m *OperationState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28211,17 +35644,17 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			m.IssuedAt = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28231,16 +35664,30 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IssuedAt |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			m.ExpiresAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28250,14 +35697,27 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiresAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28285,61 +35745,11 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JWTTokens) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28366,66 +35776,18 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, JWTToken{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.SyncResult == nil {
+				m.SyncResult = &SyncOperationResult{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28435,29 +35797,30 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28467,29 +35830,33 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
 			}
-			var v int
+			m.RetryCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28499,12 +35866,11 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.RetryCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28526,7 +35892,7 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
+func (m *OptionalArray) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28549,47 +35915,15 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
+			return fmt.Errorf("proto: OptionalArray: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OptionalArray: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Field = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Array", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28617,7 +35951,7 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Array = append(m.Array, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28640,7 +35974,7 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
+func (m *OptionalMap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28663,17 +35997,17 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: OptionalMap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OptionalMap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28683,55 +36017,118 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildOptions = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
+			if m.Map == nil {
+				m.Map = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BinaryPath = string(dAtA[iNdEx:postIndex])
+			m.Map[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28754,7 +36151,7 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListGenerator) Unmarshal(dAtA []byte) error {
+func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28777,17 +36174,17 @@ func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrphanedResourceKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrphanedResourceKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28797,31 +36194,61 @@ func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Elements = append(m.Elements, v11.JSON{})
-			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28831,24 +36258,23 @@ func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28871,7 +36297,7 @@ func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
+func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28894,17 +36320,17 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MatrixGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warn", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28914,29 +36340,16 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.Warn = &b
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ignore", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28963,7 +36376,8 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ignore = append(m.Ignore, OrphanedResourceKey{})
+			if err := m.Ignore[len(m.Ignore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28988,7 +36402,7 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
+func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29011,17 +36425,17 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: OverrideIgnoreDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OverrideIgnoreDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29031,29 +36445,27 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29081,13 +36493,13 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
+			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedFieldsManagers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29097,24 +36509,23 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ManagedFieldsManagers = append(m.ManagedFieldsManagers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29137,7 +36548,7 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
+func (m *PluginConfigMapRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29160,17 +36571,17 @@ func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NestedMatrixGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: PluginConfigMapRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NestedMatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PluginConfigMapRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29180,25 +36591,23 @@ func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29221,7 +36630,7 @@ func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
+func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29244,15 +36653,15 @@ func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NestedMergeGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: PluginGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NestedMergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PluginGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29279,96 +36688,13 @@ func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConfigMapRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Operation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29395,18 +36721,15 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sync == nil {
-				m.Sync = &SyncOperation{}
-			}
-			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
 			}
-			var msglen int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29416,28 +36739,15 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+			m.RequeueAfterSeconds = &v
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29464,14 +36774,13 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, &Info{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29498,9 +36807,103 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Values == nil {
+				m.Values = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29523,7 +36926,7 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
+func (m *PluginInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29546,17 +36949,17 @@ func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperationInitiator: wiretype end group for non-group")
+			return fmt.Errorf("proto: PluginInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationInitiator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PluginInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29566,44 +36969,121 @@ func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
+			if m.Parameters == nil {
+				m.Parameters = make(PluginParameters)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			mapvalue := &v11.JSON{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v11.JSON{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			m.Automated = bool(v != 0)
+			m.Parameters[mapkey] = *mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29625,7 +37105,7 @@ func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperationState) Unmarshal(dAtA []byte) error {
+func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29642,54 +37122,21 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OperationState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29717,11 +37164,11 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29749,13 +37196,13 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29765,31 +37212,27 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncResult == nil {
-				m.SyncResult = &SyncOperationResult{}
-			}
-			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29816,15 +37259,16 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.JWTTokens = append(m.JWTTokens, JWTToken{})
+			if err := m.JWTTokens[len(m.JWTTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29834,47 +37278,24 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
-			}
-			m.RetryCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RetryCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29896,7 +37317,7 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29919,17 +37340,17 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrphanedResourceKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrphanedResourceKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Github", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29939,29 +37360,33 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			if m.Github == nil {
+				m.Github = &PullRequestGeneratorGithub{}
+			}
+			if err := m.Github.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitLab", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29971,29 +37396,33 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if m.GitLab == nil {
+				m.GitLab = &PullRequestGeneratorGitLab{}
+			}
+			if err := m.GitLab.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gitea", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30003,79 +37432,33 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Gitea == nil {
+				m.Gitea = &PullRequestGeneratorGitea{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Gitea.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Warn", wireType)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BitbucketServer", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30085,16 +37468,31 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Warn = &b
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BitbucketServer == nil {
+				m.BitbucketServer = &PullRequestGeneratorBitbucketServer{}
+			}
+			if err := m.BitbucketServer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ignore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30121,66 +37519,36 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ignore = append(m.Ignore, OrphanedResourceKey{})
-			if err := m.Ignore[len(m.Ignore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Filters = append(m.Filters, PullRequestGeneratorFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OverrideIgnoreDiff: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OverrideIgnoreDiff: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.RequeueAfterSeconds = &v
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30190,29 +37558,30 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitbucket", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30222,29 +37591,33 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
+			if m.Bitbucket == nil {
+				m.Bitbucket = &PullRequestGeneratorBitbucket{}
+			}
+			if err := m.Bitbucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedFieldsManagers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AzureDevOps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30254,23 +37627,27 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ManagedFieldsManagers = append(m.ManagedFieldsManagers, string(dAtA[iNdEx:postIndex]))
+			if m.AzureDevOps == nil {
+				m.AzureDevOps = &PullRequestGeneratorAzureDevOps{}
+			}
+			if err := m.AzureDevOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30293,7 +37670,7 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectRole) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30316,15 +37693,15 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectRole: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGeneratorAzureDevOps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGeneratorAzureDevOps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30352,11 +37729,11 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Organization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30384,11 +37761,11 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30416,11 +37793,43 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.API = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30447,14 +37856,16 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JWTTokens = append(m.JWTTokens, JWTToken{})
-			if err := m.JWTTokens[len(m.JWTTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30482,7 +37893,7 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30505,7 +37916,7 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30528,17 +37939,17 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGeneratorBitbucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGeneratorBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Github", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30548,33 +37959,29 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Github == nil {
-				m.Github = &PullRequestGeneratorGithub{}
-			}
-			if err := m.Github.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitLab", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30584,33 +37991,29 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GitLab == nil {
-				m.GitLab = &PullRequestGeneratorGitLab{}
-			}
-			if err := m.GitLab.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gitea", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30620,67 +38023,27 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gitea == nil {
-				m.Gitea = &PullRequestGeneratorGitea{}
-			}
-			if err := m.Gitea.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BitbucketServer", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BitbucketServer == nil {
-				m.BitbucketServer = &PullRequestGeneratorBitbucketServer{}
-			}
-			if err := m.BitbucketServer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30707,34 +38070,16 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filters = append(m.Filters, PullRequestGeneratorFilter{})
-			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BasicAuth == nil {
+				m.BasicAuth = &BasicAuthBitbucketServer{}
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RequeueAfterSeconds = &v
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30761,7 +38106,10 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BearerToken == nil {
+				m.BearerToken = &BearerTokenBitbucketCloud{}
+			}
+			if err := m.BearerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31030,6 +38378,125 @@ func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.BranchMatch = &s
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranchMatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TargetBranchMatch = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TitleMatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TitleMatch = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Author = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeDrafts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeDrafts = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31526,11 +38993,143 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.API = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppSecretName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppSecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31558,43 +39157,61 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31621,16 +39238,13 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppSecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31658,11 +39272,11 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppSecretName = string(dAtA[iNdEx:postIndex])
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31690,7 +39304,7 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			m.Chart = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36923,6 +44537,104 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39203,18 +46915,88 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncStrategy == nil {
-				m.SyncStrategy = &SyncStrategy{}
+			if m.SyncStrategy == nil {
+				m.SyncStrategy = &SyncStrategy{}
+			}
+			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, SyncOperationResource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Source == nil {
+				m.Source = &ApplicationSource{}
 			}
-			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39224,29 +47006,59 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, SyncOperationResource{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39273,16 +47085,14 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Source == nil {
-				m.Source = &ApplicationSource{}
-			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39310,11 +47120,47 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotBefore == nil {
+				m.NotBefore = &v1.Time{}
+			}
+			if err := m.NotBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39342,7 +47188,7 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39671,6 +47517,108 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaveStartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WaveStartedAt == nil {
+				m.WaveStartedAt = &v1.Time{}
+			}
+			if err := m.WaveStartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39935,6 +47883,38 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowEmpty = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryFailedAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryFailedAfter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -40080,7 +48060,39 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -40651,6 +48663,306 @@ func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 			}
 			m.TimeZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApplicationSelector == nil {
+				m.ApplicationSelector = &v1.LabelSelector{}
+			}
+			if err := m.ApplicationSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AndOperator", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AndOperator = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncWindowOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWindowOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWindowOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Application = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpiresAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25,7 +25,10 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	utilnet "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/rest"
@@ -34,6 +37,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/util/collections"
 	"github.com/argoproj/argo-cd/v2/util/helm"
+	"github.com/argoproj/argo-cd/v2/util/oci"
 )
 
 // Application is a definition of Application resource.
@@ -55,7 +59,8 @@ type Application struct {
 // ApplicationSpec represents desired application state. Contains link to repository with application definition and additional parameters link definition revision.
 type ApplicationSpec struct {
 	// Source is a reference to the location of the application's manifests or chart
-	Source ApplicationSource `json:"source" protobuf:"bytes,1,opt,name=source"`
+	// +optional
+	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,1,opt,name=source"`
 	// Destination is a reference to the target Kubernetes server and namespace
 	Destination ApplicationDestination `json:"destination" protobuf:"bytes,2,name=destination"`
 	// Project is a reference to the project this application belongs to.
@@ -73,6 +78,45 @@ type ApplicationSpec struct {
 	// Increasing will increase the space used to store the history, so we do not recommend increasing it.
 	// Default is 10.
 	RevisionHistoryLimit *int64 `json:"revisionHistoryLimit,omitempty" protobuf:"bytes,7,name=revisionHistoryLimit"`
+	// Sources is a reference to the location of the application's manifests or chart, when the application is made of
+	// more than one source. If set, Source is ignored.
+	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
+	// DependsOn is a list of the applications of the same project and namespace which must be synced and healthy
+	// before the application is synced
+	DependsOn []ApplicationDependency `json:"dependsOn,omitempty" protobuf:"bytes,9,opt,name=dependsOn"`
+}
+
+// ApplicationDependency refers to the applications an application depends on, either by name or by labels
+type ApplicationDependency struct {
+	// Name is the name of the application depended on
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Selector selects the applications depended on by labels
+	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,2,opt,name=selector"`
+}
+
+// Validate checks that the dependency refers to applications either by name or by labels
+func (d *ApplicationDependency) Validate() error {
+	if (d.Name == "") == (d.Selector == nil) {
+		return fmt.Errorf("dependency must have either a name or a selector")
+	}
+	if d.Selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(d.Selector); err != nil {
+			return fmt.Errorf("invalid dependency selector: %w", err)
+		}
+	}
+	return nil
+}
+
+// Matches returns whether the given application is referred to by the dependency
+func (d *ApplicationDependency) Matches(app *Application) bool {
+	if d.Name != "" {
+		return app.Name == d.Name
+	}
+	selector, err := metav1.LabelSelectorAsSelector(d.Selector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(app.Labels))
 }
 
 type TrackingMethod string
@@ -170,8 +214,46 @@ type ApplicationSource struct {
 	Plugin *ApplicationSourcePlugin `json:"plugin,omitempty" protobuf:"bytes,11,opt,name=plugin"`
 	// Chart is a Helm chart name, and must be specified for applications sourced from a Helm repo.
 	Chart string `json:"chart,omitempty" protobuf:"bytes,12,opt,name=chart"`
+	// Ref is a name by which the other sources of the application can reference the files of this source, e.g. as
+	// "$ref/path/values.yaml" in Helm value files. Only used for applications with multiple sources.
+	Ref string `json:"ref,omitempty" protobuf:"bytes,13,opt,name=ref"`
 }
 
+// ApplicationSources contains the list of the sources of an application
+type ApplicationSources []ApplicationSource
+
+// Equals returns true if the source list is equal to the other source list
+func (s ApplicationSources) Equals(other ApplicationSources) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	for i := range s {
+		if !s[i].Equals(other[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsRefOnly returns true if the source only provides files to the other sources of the application, by its ref, and
+// does not generate any manifests itself
+func (a *ApplicationSource) IsRefOnly() bool {
+	return a.Ref != "" && a.Path == "" && a.Chart == ""
+}
+
+// RefTarget is a source of an application with multiple sources, which the other sources reference by its ref
+type RefTarget struct {
+	// Repo is the repository of the source
+	Repo Repository `json:"repo" protobuf:"bytes,1,opt,name=repo"`
+	// TargetRevision is the revision of the source
+	TargetRevision string `json:"targetRevision,omitempty" protobuf:"bytes,2,opt,name=targetRevision"`
+	// Chart is the Helm chart of the source, if the source is a Helm repository
+	Chart string `json:"chart,omitempty" protobuf:"bytes,3,opt,name=chart"`
+}
+
+// RefTargetRevisionMapping maps the ref of the sources of an application, prefixed with "$", to the sources
+type RefTargetRevisionMapping map[string]*RefTarget
+
 // AllowsConcurrentProcessing returns true if given application source can be processed concurrently
 func (a *ApplicationSource) AllowsConcurrentProcessing() bool {
 	switch {
@@ -195,6 +277,11 @@ func (a *ApplicationSource) IsHelmOci() bool {
 	return helm.IsHelmOciRepo(a.RepoURL)
 }
 
+// IsOCI returns true when the application source is an OCI artifact, i.e. its repository URL has the oci:// scheme
+func (a *ApplicationSource) IsOCI() bool {
+	return a.Chart == "" && oci.IsOCIRepo(a.RepoURL)
+}
+
 // IsZero returns true if the application source is considered empty
 func (a *ApplicationSource) IsZero() bool {
 	return a == nil ||
@@ -245,6 +332,16 @@ type ApplicationSourceHelm struct {
 	IgnoreMissingValueFiles bool `json:"ignoreMissingValueFiles,omitempty" protobuf:"bytes,8,opt,name=ignoreMissingValueFiles"`
 	// SkipCrds skips custom resource definition installation step (Helm's --skip-crds)
 	SkipCrds bool `json:"skipCrds,omitempty" protobuf:"bytes,9,opt,name=skipCrds"`
+	// ValuesObject specifies Helm values to be passed to helm template, defined as a structured object. It takes
+	// precedence over Values, which take precedence over ValueFiles.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	ValuesObject *runtime.RawExtension `json:"valuesObject,omitempty" protobuf:"bytes,10,opt,name=valuesObject"`
+	// KubeVersion is the Kubernetes version to pass to helm template (Helm's --kube-version). If omitted, the version
+	// of the destination cluster is used
+	KubeVersion string `json:"kubeVersion,omitempty" protobuf:"bytes,11,opt,name=kubeVersion"`
+	// APIVersions are the Kubernetes resource API versions to pass to helm template (Helm's --api-versions), in the
+	// format [group/]version/kind. If omitted, the API versions of the destination cluster are used
+	APIVersions []string `json:"apiVersions,omitempty" protobuf:"bytes,12,opt,name=apiVersions"`
 }
 
 // HelmParameter is a parameter that's passed to helm template during manifest generation
@@ -326,7 +423,30 @@ func (in *ApplicationSourceHelm) AddFileParameter(p HelmFileParameter) {
 
 // IsZero Returns true if the Helm options in an application source are considered zero
 func (h *ApplicationSourceHelm) IsZero() bool {
-	return h == nil || (h.Version == "") && (h.ReleaseName == "") && len(h.ValueFiles) == 0 && len(h.Parameters) == 0 && len(h.FileParameters) == 0 && h.Values == "" && !h.PassCredentials && !h.IgnoreMissingValueFiles && !h.SkipCrds
+	return h == nil || (h.Version == "") && (h.ReleaseName == "") && len(h.ValueFiles) == 0 && len(h.Parameters) == 0 && len(h.FileParameters) == 0 && h.Values == "" && !h.PassCredentials && !h.IgnoreMissingValueFiles && !h.SkipCrds && h.ValuesObjectIsEmpty() && h.KubeVersion == "" && len(h.APIVersions) == 0
+}
+
+// ValuesObjectIsEmpty returns true if the Helm values object is not set or empty
+func (h *ApplicationSourceHelm) ValuesObjectIsEmpty() bool {
+	return h.ValuesObject == nil || len(h.ValuesObject.Raw) == 0 || string(h.ValuesObject.Raw) == "null" || string(h.ValuesObject.Raw) == "{}"
+}
+
+// SetValuesObject sets the Helm values object from the given YAML or JSON document, which must be an object
+func (h *ApplicationSourceHelm) SetValuesObject(values string) error {
+	data, err := yaml.YAMLToJSON([]byte(values))
+	if err != nil {
+		return fmt.Errorf("failed to parse values object: %w", err)
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return fmt.Errorf("values object must be a map: %w", err)
+	}
+	if obj == nil {
+		h.ValuesObject = nil
+		return nil
+	}
+	h.ValuesObject = &runtime.RawExtension{Raw: data}
+	return nil
 }
 
 // KustomizeImage represents a Kustomize image definition in the format [old_image_name=]<image_name>:<image_tag>
@@ -385,6 +505,88 @@ type ApplicationSourceKustomize struct {
 	ForceNamespace bool `json:"forceNamespace,omitempty" protobuf:"bytes,9,opt,name=forceNamespace"`
 	// Components specifies a list of kustomize components to add to the kustmization before building
 	Components []string `json:"components,omitempty" protobuf:"bytes,10,rep,name=components"`
+	// Namespace sets the namespace of the kustomization, which takes precedence over ForceNamespace
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,11,opt,name=namespace"`
+	// Replicas is a list of Kustomize replica count overrides
+	Replicas KustomizeReplicas `json:"replicas,omitempty" protobuf:"bytes,12,rep,name=replicas"`
+	// Patches is a list of Kustomize patches added to the kustomization before building
+	Patches KustomizePatches `json:"patches,omitempty" protobuf:"bytes,13,rep,name=patches"`
+}
+
+// KustomizeReplica is a replica count override of a Deployment, StatefulSet, ReplicaSet or ReplicationController
+type KustomizeReplica struct {
+	// Name is the name of the resource
+	Name string `json:"name" protobuf:"bytes,1,name=name"`
+	// Count is the number of replicas
+	Count intstr.IntOrString `json:"count" protobuf:"bytes,2,name=count"`
+}
+
+// KustomizeReplicas is a list of Kustomize replica count overrides
+type KustomizeReplicas []KustomizeReplica
+
+// FindByName returns the index of the replica count override of the resource with the given name, or -1
+func (kr KustomizeReplicas) FindByName(name string) int {
+	for i, replica := range kr {
+		if replica.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// GetIntCount returns the replica count as an integer, parsing it if it is a string
+func (kr KustomizeReplica) GetIntCount() (int, error) {
+	count := kr.Count.IntValue()
+	if kr.Count.Type == intstr.String {
+		var err error
+		count, err = strconv.Atoi(kr.Count.StrVal)
+		if err != nil {
+			return 0, fmt.Errorf("invalid replica count '%s' for '%s': expected an integer", kr.Count.StrVal, kr.Name)
+		}
+	}
+	if count < 0 {
+		return 0, fmt.Errorf("invalid replica count %d for '%s': must not be negative", count, kr.Name)
+	}
+	return count, nil
+}
+
+// KustomizePatch is a Kustomize patch, either a strategic merge patch or a JSON 6902 patch, defined inline or in a
+// file of the kustomization directory
+type KustomizePatch struct {
+	// Path is the path of the file containing the patch, relative to the kustomization
+	Path string `json:"path,omitempty" protobuf:"bytes,1,opt,name=path"`
+	// Patch is the content of the patch
+	Patch string `json:"patch,omitempty" protobuf:"bytes,2,opt,name=patch"`
+	// Target selects the resources the patch is applied to. If omitted, the patch is applied to the resource it
+	// identifies.
+	Target *KustomizeSelector `json:"target,omitempty" protobuf:"bytes,3,opt,name=target"`
+	// Options are the options of the patch, e.g. allowNameChange or allowKindChange
+	Options map[string]bool `json:"options,omitempty" protobuf:"bytes,4,opt,name=options"`
+}
+
+// KustomizePatches is a list of Kustomize patches
+type KustomizePatches []KustomizePatch
+
+// KustomizeSelector selects the resources a Kustomize patch is applied to
+type KustomizeSelector struct {
+	Group              string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Version            string `json:"version,omitempty" protobuf:"bytes,2,opt,name=version"`
+	Kind               string `json:"kind,omitempty" protobuf:"bytes,3,opt,name=kind"`
+	Name               string `json:"name,omitempty" protobuf:"bytes,4,opt,name=name"`
+	Namespace          string `json:"namespace,omitempty" protobuf:"bytes,5,opt,name=namespace"`
+	LabelSelector      string `json:"labelSelector,omitempty" protobuf:"bytes,6,opt,name=labelSelector"`
+	AnnotationSelector string `json:"annotationSelector,omitempty" protobuf:"bytes,7,opt,name=annotationSelector"`
+}
+
+// Validate checks that the patch is defined either inline or in a file
+func (kp *KustomizePatch) Validate() error {
+	if (kp.Path == "") == (kp.Patch == "") {
+		return fmt.Errorf("patch must have either a path or an inline patch")
+	}
+	if filepath.IsAbs(kp.Path) {
+		return fmt.Errorf("patch path '%s' must be relative to the kustomization", kp.Path)
+	}
+	return nil
 }
 
 // AllowsConcurrentProcessing returns true if multiple processes can run Kustomize builds on the same source at the same time
@@ -392,8 +594,11 @@ func (k *ApplicationSourceKustomize) AllowsConcurrentProcessing() bool {
 	return len(k.Images) == 0 &&
 		len(k.CommonLabels) == 0 &&
 		len(k.Components) == 0 &&
+		len(k.Replicas) == 0 &&
+		len(k.Patches) == 0 &&
 		k.NamePrefix == "" &&
-		k.NameSuffix == ""
+		k.NameSuffix == "" &&
+		k.Namespace == ""
 }
 
 // IsZero returns true when the Kustomize options are considered empty
@@ -406,9 +611,33 @@ func (k *ApplicationSourceKustomize) IsZero() bool {
 			len(k.CommonLabels) == 0 &&
 			len(k.CommonAnnotations) == 0 &&
 			len(k.Components) == 0 &&
+			len(k.Replicas) == 0 &&
+			len(k.Patches) == 0 &&
+			k.Namespace == "" &&
 			!k.ForceNamespace
 }
 
+// Validate checks that the replica counts and patches of the Kustomize options are valid
+func (k *ApplicationSourceKustomize) Validate() error {
+	if k == nil {
+		return nil
+	}
+	for _, replica := range k.Replicas {
+		if replica.Name == "" {
+			return fmt.Errorf("replica count override must have a name")
+		}
+		if _, err := replica.GetIntCount(); err != nil {
+			return err
+		}
+	}
+	for i := range k.Patches {
+		if err := k.Patches[i].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // MergeImage merges a new Kustomize image identifier in to a list of images
 func (k *ApplicationSourceKustomize) MergeImage(image KustomizeImage) {
 	i := k.Images.Find(image)
@@ -419,6 +648,17 @@ func (k *ApplicationSourceKustomize) MergeImage(image KustomizeImage) {
 	}
 }
 
+// MergeReplica merges a new Kustomize replica count override in to a list of overrides, replacing the override of the
+// resource with the same name if there is one
+func (k *ApplicationSourceKustomize) MergeReplica(replica KustomizeReplica) {
+	i := k.Replicas.FindByName(replica.Name)
+	if i >= 0 {
+		k.Replicas[i] = replica
+	} else {
+		k.Replicas = append(k.Replicas, replica)
+	}
+}
+
 // JsonnetVar represents a variable to be passed to jsonnet during manifest generation
 type JsonnetVar struct {
 	Name  string `json:"name" protobuf:"bytes,1,opt,name=name"`
@@ -472,11 +712,127 @@ func (d *ApplicationSourceDirectory) IsZero() bool {
 type ApplicationSourcePlugin struct {
 	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
 	Env  `json:"env,omitempty" protobuf:"bytes,2,opt,name=env"`
+	// Parameters are the parameters passed to the plugin, as announced by the plugin
+	Parameters ApplicationSourcePluginParameters `json:"parameters,omitempty" protobuf:"bytes,3,rep,name=parameters"`
+}
+
+// ApplicationSourcePluginParameter is a parameter of a config management plugin. Only one of string, map and array may
+// be set.
+type ApplicationSourcePluginParameter struct {
+	// Name is the name identifying a parameter.
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// String_ is the value of a string type parameter.
+	String_ *string `json:"string,omitempty" protobuf:"bytes,5,opt,name=string"`
+	// OptionalMap holds the value of a map type parameter.
+	*OptionalMap `json:",omitempty" protobuf:"bytes,3,opt,name=map"`
+	// OptionalArray holds the value of an array type parameter.
+	*OptionalArray `json:",omitempty" protobuf:"bytes,4,opt,name=array"`
+}
+
+// OptionalMap wraps the value of a map type plugin parameter, so that an empty map can be told apart from an unset one
+type OptionalMap struct {
+	// Map is the value of a map type parameter.
+	// +optional
+	Map map[string]string `json:"map" protobuf:"bytes,1,rep,name=map"`
+}
+
+// OptionalArray wraps the value of an array type plugin parameter, so that an empty array can be told apart from an
+// unset one
+type OptionalArray struct {
+	// Array is the value of an array type parameter.
+	// +optional
+	Array []string `json:"array" protobuf:"bytes,1,rep,name=array"`
+}
+
+// ApplicationSourcePluginParameters is a list of config management plugin parameters
+type ApplicationSourcePluginParameters []ApplicationSourcePluginParameter
+
+// Validate checks that the parameters have a unique name and a single value
+func (p ApplicationSourcePluginParameters) Validate() error {
+	names := map[string]bool{}
+	for _, param := range p {
+		if param.Name == "" {
+			return fmt.Errorf("plugin parameter must have a name")
+		}
+		if names[param.Name] {
+			return fmt.Errorf("plugin parameter '%s' is defined more than once", param.Name)
+		}
+		names[param.Name] = true
+		values := 0
+		if param.String_ != nil {
+			values++
+		}
+		if param.OptionalMap != nil {
+			values++
+		}
+		if param.OptionalArray != nil {
+			values++
+		}
+		if values > 1 {
+			return fmt.Errorf("plugin parameter '%s' must have only one of string, map or array", param.Name)
+		}
+	}
+	return nil
+}
+
+// Environ returns the environment variables passed to the plugin commands: ARGOCD_APP_PARAMETERS holding the
+// parameters as a JSON list, and a PARAM_ variable per string, array item or map entry. The PARAM_ variable names are
+// uppercased, with characters other than letters, digits and underscores replaced by underscores, e.g. PARAM_VALUES for
+// a string, PARAM_VALUES_FILES_0 for the first item of an array and PARAM_HELM_PARAMETERS_IMAGE_TAG for the image.tag
+// entry of a map.
+func (p ApplicationSourcePluginParameters) Environ() ([]string, error) {
+	if len(p) == 0 {
+		return nil, nil
+	}
+	// an empty map or array does not survive a protobuf round trip, so it is restored to be passed as {} or [] rather
+	// than null
+	params := make(ApplicationSourcePluginParameters, len(p))
+	for i, param := range p {
+		if param.OptionalMap != nil && param.OptionalMap.Map == nil {
+			param.OptionalMap = &OptionalMap{Map: map[string]string{}}
+		}
+		if param.OptionalArray != nil && param.OptionalArray.Array == nil {
+			param.OptionalArray = &OptionalArray{Array: []string{}}
+		}
+		params[i] = param
+	}
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal plugin parameters: %w", err)
+	}
+	env := []string{fmt.Sprintf("ARGOCD_APP_PARAMETERS=%s", data)}
+	for _, param := range params {
+		if param.String_ != nil {
+			env = append(env, fmt.Sprintf("%s=%s", escapedParamEnvName(param.Name), *param.String_))
+		}
+		if param.OptionalArray != nil {
+			for i, value := range param.Array {
+				env = append(env, fmt.Sprintf("%s=%s", escapedParamEnvName(fmt.Sprintf("%s_%d", param.Name, i)), value))
+			}
+		}
+		if param.OptionalMap != nil {
+			keys := make([]string, 0, len(param.Map))
+			for key := range param.Map {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				env = append(env, fmt.Sprintf("%s=%s", escapedParamEnvName(fmt.Sprintf("%s_%s", param.Name, key)), param.Map[key]))
+			}
+		}
+	}
+	return env, nil
+}
+
+var paramEnvNameInvalidChars = regexp.MustCompile("[^A-Z0-9_]")
+
+func escapedParamEnvName(name string) string {
+	return "PARAM_" + paramEnvNameInvalidChars.ReplaceAllString(strings.ToUpper(name), "_")
 }
 
 // IsZero returns true if the ApplicationSourcePlugin is considered empty
 func (c *ApplicationSourcePlugin) IsZero() bool {
-	return c == nil || c.Name == "" && c.Env.IsZero()
+	return c == nil || c.Name == "" && c.Env.IsZero() && len(c.Parameters) == 0
 }
 
 // AddEnvEntry merges an EnvEntry into a list of entries. If an entry with the same name already exists,
@@ -641,6 +997,17 @@ type SyncOperation struct {
 	Manifests []string `json:"manifests,omitempty" protobuf:"bytes,8,opt,name=manifests"`
 	// SyncOptions provide per-sync sync-options, e.g. Validate=false
 	SyncOptions SyncOptions `json:"syncOptions,omitempty" protobuf:"bytes,9,opt,name=syncOptions"`
+	// Sources overrides the source definitions set in an application with multiple sources.
+	// This is typically set in a Rollback operation and is nil during a Sync operation
+	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,10,opt,name=sources"`
+	// Revisions is the list of revisions (Git) or chart versions (Helm) which to sync each source of an application
+	// with multiple sources to. If omitted, will use the revisions specified in app spec.
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,11,opt,name=revisions"`
+	// NotBefore is the time before which the sync must not start. Until then, the operation is pending.
+	NotBefore *metav1.Time `json:"notBefore,omitempty" protobuf:"bytes,12,opt,name=notBefore"`
+	// Reason is a human readable description of why the sync was requested, e.g. of a rollback. It is recorded in the
+	// revision history of the application once the sync succeeds.
+	Reason string `json:"reason,omitempty" protobuf:"bytes,13,opt,name=reason"`
 }
 
 // IsApplyStrategy returns true if the sync strategy is "apply"
@@ -648,6 +1015,11 @@ func (o *SyncOperation) IsApplyStrategy() bool {
 	return o.SyncStrategy != nil && o.SyncStrategy.Apply != nil
 }
 
+// IsScheduled returns true if the sync must not start before a time later than the given time
+func (o *SyncOperation) IsScheduled(t time.Time) bool {
+	return o.NotBefore != nil && t.Before(o.NotBefore.Time)
+}
+
 // OperationState contains information about state of a running operation
 type OperationState struct {
 	// Operation is the original requested operation
@@ -791,6 +1163,24 @@ type SyncPolicyAutomated struct {
 	SelfHeal bool `json:"selfHeal,omitempty" protobuf:"bytes,2,opt,name=selfHeal"`
 	// AllowEmpty allows apps have zero live resources (default: false)
 	AllowEmpty bool `json:"allowEmpty,omitempty" protobuf:"bytes,3,opt,name=allowEmpty"`
+	// RetryFailedAfter is the amount of time after which a failed automated sync is re-attempted to the same revision. Default unit is seconds, but could also be a duration (e.g. "2m", "1h"). Failed automated syncs are not re-attempted if empty
+	RetryFailedAfter string `json:"retryFailedAfter,omitempty" protobuf:"bytes,4,opt,name=retryFailedAfter"`
+}
+
+// GetRetryFailedAfter returns the amount of time after which a failed automated sync is re-attempted, or zero if
+// failed automated syncs should not be re-attempted
+func (a *SyncPolicyAutomated) GetRetryFailedAfter() (time.Duration, error) {
+	if a == nil || a.RetryFailedAfter == "" {
+		return 0, nil
+	}
+	duration, err := parseStringToDuration(a.RetryFailedAfter)
+	if err != nil {
+		return 0, err
+	}
+	if duration < 0 {
+		return 0, fmt.Errorf("retryFailedAfter %s must not be negative", a.RetryFailedAfter)
+	}
+	return duration, nil
 }
 
 // SyncStrategy controls the manner in which a sync is performed
@@ -856,6 +1246,13 @@ type SyncOperationResult struct {
 	Revision string `json:"revision" protobuf:"bytes,2,opt,name=revision"`
 	// Source records the application source information of the sync, used for comparing auto-sync
 	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,3,opt,name=source"`
+	// Sources records the sources of the sync of an application with multiple sources, used for comparing auto-sync
+	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,4,opt,name=sources"`
+	// Revisions holds the revision of each source this sync operation was performed to, for an application with
+	// multiple sources
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,5,opt,name=revisions"`
+	// WaveStartedAt contains the time at which the resources of the current sync wave were applied
+	WaveStartedAt *metav1.Time `json:"waveStartedAt,omitempty" protobuf:"bytes,6,opt,name=waveStartedAt"`
 }
 
 // ResourceResult holds the operation result details of a specific resource
@@ -927,6 +1324,14 @@ type RevisionHistory struct {
 	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,6,opt,name=source"`
 	// DeployStartedAt holds the time the sync operation started
 	DeployStartedAt *metav1.Time `json:"deployStartedAt,omitempty" protobuf:"bytes,7,opt,name=deployStartedAt"`
+	// Sources is a reference to the application sources used for the sync operation of an application with multiple
+	// sources
+	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
+	// Revisions holds the revision of each source the sync was performed against, for an application with multiple
+	// sources
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,9,opt,name=revisions"`
+	// Reason holds the reason the sync was requested for, e.g. of a rollback
+	Reason string `json:"reason,omitempty" protobuf:"bytes,10,opt,name=reason"`
 }
 
 // ApplicationWatchEvent contains information about application change.
@@ -994,6 +1399,10 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionAutoSyncQueuedWarning indicates that the automated sync of the application has been delayed by the controller rate limits
+	ApplicationConditionAutoSyncQueuedWarning = "AutoSyncQueuedWarning"
+	// ApplicationConditionWaitingForDependencies indicates that the sync of the application is waiting for the applications it depends on
+	ApplicationConditionWaitingForDependencies = "WaitingForDependencies"
 )
 
 // ApplicationCondition contains details about an application condition, which is usally an error or warning
@@ -1012,6 +1421,8 @@ type ComparedTo struct {
 	Source ApplicationSource `json:"source" protobuf:"bytes,1,opt,name=source"`
 	// Destination is a reference to the application's destination used for comparison
 	Destination ApplicationDestination `json:"destination" protobuf:"bytes,2,opt,name=destination"`
+	// Sources is a reference to the application's multiple sources used for comparison
+	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,3,opt,name=sources"`
 }
 
 // SyncStatus contains information about the currently observed live and desired states of an application
@@ -1022,6 +1433,8 @@ type SyncStatus struct {
 	ComparedTo ComparedTo `json:"comparedTo,omitempty" protobuf:"bytes,2,opt,name=comparedTo"`
 	// Revision contains information about the revision the comparison has been performed to
 	Revision string `json:"revision,omitempty" protobuf:"bytes,3,opt,name=revision"`
+	// Revisions contains information about the revisions of the multiple sources the comparison has been performed to
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,4,opt,name=revisions"`
 }
 
 // HealthStatus contains information about the currently observed health state of an application or resource
@@ -1739,6 +2152,8 @@ type AppProjectSpec struct {
 	SourceNamespaces []string `json:"sourceNamespaces,omitempty" protobuf:"bytes,12,opt,name=sourceNamespaces"`
 	// PermitOnlyProjectScopedClusters determines whether destinations can only reference clusters which are project-scoped
 	PermitOnlyProjectScopedClusters bool `json:"permitOnlyProjectScopedClusters,omitempty" protobuf:"bytes,13,opt,name=permitOnlyProjectScopedClusters"`
+	// CosignPublicKeys contains a list of PEM encoded cosign public keys that OCI artifacts must be signed with in order to be allowed for sync
+	CosignPublicKeys []string `json:"cosignPublicKeys,omitempty" protobuf:"bytes,14,rep,name=cosignPublicKeys"`
 }
 
 // SyncWindows is a collection of sync windows in this project
@@ -1762,6 +2177,58 @@ type SyncWindow struct {
 	ManualSync bool `json:"manualSync,omitempty" protobuf:"bytes,7,opt,name=manualSync"`
 	//TimeZone of the sync that will be applied to the schedule
 	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,8,opt,name=timeZone"`
+	// ApplicationSelector selects the applications that the window will apply to by their labels
+	ApplicationSelector *metav1.LabelSelector `json:"applicationSelector,omitempty" protobuf:"bytes,9,opt,name=applicationSelector"`
+	// AndOperator requires an application to match all of the configured matchers instead of any of them
+	AndOperator bool `json:"andOperator,omitempty" protobuf:"bytes,10,opt,name=andOperator"`
+}
+
+// SyncWindowOverride temporarily allows an application to be synced regardless of the sync windows of its project
+type SyncWindowOverride struct {
+	// Application is the name of the application the override applies to
+	Application string `json:"application" protobuf:"bytes,1,opt,name=application"`
+	// Namespace is the namespace of the application the override applies to
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// Reason explains why the sync windows have been overridden
+	Reason string `json:"reason" protobuf:"bytes,3,opt,name=reason"`
+	// User is the name of the user who created the override
+	User string `json:"user,omitempty" protobuf:"bytes,4,opt,name=user"`
+	// CreatedAt is the time the override was created
+	CreatedAt metav1.Time `json:"createdAt" protobuf:"bytes,5,opt,name=createdAt"`
+	// ExpiresAt is the time the override expires
+	ExpiresAt metav1.Time `json:"expiresAt" protobuf:"bytes,6,opt,name=expiresAt"`
+}
+
+// Expired returns true if the override has expired at the given time
+func (o SyncWindowOverride) Expired(currentTime time.Time) bool {
+	return !currentTime.Before(o.ExpiresAt.Time)
+}
+
+// HasSyncWindowOverride returns true if the sync windows of the project are currently overridden for the given application
+func (proj *AppProject) HasSyncWindowOverride(app *Application) bool {
+	return proj.hasSyncWindowOverride(app, time.Now())
+}
+
+func (proj *AppProject) hasSyncWindowOverride(app *Application, currentTime time.Time) bool {
+	for _, o := range proj.Status.SyncWindowOverrides {
+		if o.Application == app.Name && o.Namespace == app.Namespace && !o.Expired(currentTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetSyncWindowOverride overrides the sync windows of the project for the given application, replacing any previous
+// override of the application. Expired overrides are removed.
+func (proj *AppProject) SetSyncWindowOverride(override SyncWindowOverride) {
+	var overrides []SyncWindowOverride
+	for _, o := range proj.Status.SyncWindowOverrides {
+		if o.Expired(override.CreatedAt.Time) || (o.Application == override.Application && o.Namespace == override.Namespace) {
+			continue
+		}
+		overrides = append(overrides, o)
+	}
+	proj.Status.SyncWindowOverrides = append(overrides, override)
 }
 
 // HasWindows returns true if SyncWindows has one or more SyncWindow
@@ -1848,18 +2315,20 @@ func (w *SyncWindow) scheduleOffsetByTimeZone() time.Duration {
 }
 
 // AddWindow adds a sync window with the given parameters to the AppProject
-func (s *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []string, ns []string, cl []string, ms bool, timeZone string) error {
+func (s *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []string, ns []string, cl []string, ms bool, timeZone string, appSelector *metav1.LabelSelector, andOperator bool) error {
 	if len(knd) == 0 || len(sch) == 0 || len(dur) == 0 {
-		return fmt.Errorf("cannot create window: require kind, schedule, duration and one or more of applications, namespaces and clusters")
+		return fmt.Errorf("cannot create window: require kind, schedule, duration and one or more of applications, namespaces, clusters and application selector")
 
 	}
 
 	window := &SyncWindow{
-		Kind:       knd,
-		Schedule:   sch,
-		Duration:   dur,
-		ManualSync: ms,
-		TimeZone:   timeZone,
+		Kind:                knd,
+		Schedule:            sch,
+		Duration:            dur,
+		ManualSync:          ms,
+		TimeZone:            timeZone,
+		ApplicationSelector: appSelector,
+		AndOperator:         andOperator,
 	}
 
 	if len(app) > 0 {
@@ -1904,32 +2373,8 @@ func (w *SyncWindows) Matches(app *Application) *SyncWindows {
 	if w.HasWindows() {
 		var matchingWindows SyncWindows
 		for _, w := range *w {
-			if len(w.Applications) > 0 {
-				for _, a := range w.Applications {
-					if globMatch(a, app.Name, false) {
-						matchingWindows = append(matchingWindows, w)
-						break
-					}
-				}
-			}
-			if len(w.Clusters) > 0 {
-				for _, c := range w.Clusters {
-					dst := app.Spec.Destination
-					dstNameMatched := dst.Name != "" && globMatch(c, dst.Name, false)
-					dstServerMatched := dst.Server != "" && globMatch(c, dst.Server, false)
-					if dstNameMatched || dstServerMatched {
-						matchingWindows = append(matchingWindows, w)
-						break
-					}
-				}
-			}
-			if len(w.Namespaces) > 0 {
-				for _, n := range w.Namespaces {
-					if globMatch(n, app.Spec.Destination.Namespace, false) {
-						matchingWindows = append(matchingWindows, w)
-						break
-					}
-				}
+			if w.matches(app) {
+				matchingWindows = append(matchingWindows, w)
 			}
 		}
 		if len(matchingWindows) > 0 {
@@ -1939,6 +2384,67 @@ func (w *SyncWindows) Matches(app *Application) *SyncWindows {
 	return nil
 }
 
+// matches returns true if the sync window applies to the given application. By default an application is matched
+// if any of the configured matchers match it; if AndOperator is set all of the configured matchers have to match.
+// A window without any matcher does not match any application.
+func (w *SyncWindow) matches(app *Application) bool {
+	var results []bool
+	if len(w.Applications) > 0 {
+		matched := false
+		for _, a := range w.Applications {
+			if globMatch(a, app.Name, false) {
+				matched = true
+				break
+			}
+		}
+		results = append(results, matched)
+	}
+	if len(w.Clusters) > 0 {
+		matched := false
+		for _, c := range w.Clusters {
+			dst := app.Spec.Destination
+			dstNameMatched := dst.Name != "" && globMatch(c, dst.Name, false)
+			dstServerMatched := dst.Server != "" && globMatch(c, dst.Server, false)
+			if dstNameMatched || dstServerMatched {
+				matched = true
+				break
+			}
+		}
+		results = append(results, matched)
+	}
+	if len(w.Namespaces) > 0 {
+		matched := false
+		for _, n := range w.Namespaces {
+			if globMatch(n, app.Spec.Destination.Namespace, false) {
+				matched = true
+				break
+			}
+		}
+		results = append(results, matched)
+	}
+	if w.ApplicationSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(w.ApplicationSelector)
+		if err != nil {
+			log.Warnf("Invalid application selector in sync window: %v", err)
+			results = append(results, false)
+		} else {
+			results = append(results, selector.Matches(labels.Set(app.Labels)))
+		}
+	}
+	if len(results) == 0 {
+		return false
+	}
+	for _, matched := range results {
+		if w.AndOperator && !matched {
+			return false
+		}
+		if !w.AndOperator && matched {
+			return true
+		}
+	}
+	return w.AndOperator
+}
+
 // CanSync returns true if a sync window currently allows a sync. isManual indicates whether the sync has been triggered manually.
 func (w *SyncWindows) CanSync(isManual bool) bool {
 	if !w.HasWindows() {
@@ -2098,6 +2604,11 @@ func (w *SyncWindow) Validate() error {
 	if err != nil {
 		return fmt.Errorf("cannot parse duration '%s': %s", w.Duration, err)
 	}
+	if w.ApplicationSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(w.ApplicationSelector); err != nil {
+			return fmt.Errorf("cannot parse application selector: %s", err)
+		}
+	}
 	return nil
 }
 
@@ -2368,6 +2879,28 @@ func (spec ApplicationSpec) GetRevisionHistoryLimit() int {
 	return RevisionHistoryLimit
 }
 
+// HasMultipleSources returns true if the application uses the sources field instead of the source field
+func (spec ApplicationSpec) HasMultipleSources() bool {
+	return len(spec.Sources) > 0
+}
+
+// GetSources returns the sources of the application. For an application with a single source, it is a list with
+// this source.
+func (spec ApplicationSpec) GetSources() ApplicationSources {
+	if spec.HasMultipleSources() {
+		return spec.Sources
+	}
+	return ApplicationSources{spec.Source}
+}
+
+// GetSource returns the source of the application. For an application with multiple sources, it is the first source.
+func (spec ApplicationSpec) GetSource() ApplicationSource {
+	if spec.HasMultipleSources() {
+		return spec.Sources[0]
+	}
+	return spec.Source
+}
+
 func isResourceInList(res metav1.GroupKind, list []metav1.GroupKind) bool {
 	for _, item := range list {
 		ok, err := filepath.Match(item.Kind, res.Kind)
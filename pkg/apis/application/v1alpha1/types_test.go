@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"encoding/json"
 	"errors"
 	fmt "fmt"
 	"os"
@@ -15,8 +16,10 @@ import (
 
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func TestAppProject_IsSourcePermitted(t *testing.T) {
@@ -636,6 +639,18 @@ func TestAppProject_ValidateRoleName(t *testing.T) {
 	}
 }
 
+func TestAppProject_ValidateCosignPublicKeys(t *testing.T) {
+	p := newTestProject()
+	p.Spec.CosignPublicKeys = []string{`-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEbfdK4ArT7gg/WNiSIuCLqMBRxYMT
+uEHQi4aGx3njikhJojtn4dSu/aN0bTFBOLcjJOZ8nT7jm/eJIHaK5848Xw==
+-----END PUBLIC KEY-----`}
+	assert.NoError(t, p.ValidateProject())
+
+	p.Spec.CosignPublicKeys = append(p.Spec.CosignPublicKeys, "not a key")
+	assert.EqualError(t, p.ValidateProject(), "rpc error: code = InvalidArgument desc = invalid cosign public key: no PEM encoded public key found")
+}
+
 // TestValidateGroupName tests for an invalid group name
 func TestAppProject_ValidateGroupName(t *testing.T) {
 	p := newTestProject()
@@ -1204,6 +1219,29 @@ func TestApplicationSource_IsZero(t *testing.T) {
 	}
 }
 
+func TestApplicationSource_IsOCI(t *testing.T) {
+	assert.True(t, (&ApplicationSource{RepoURL: "oci://registry.example.com/org/manifests"}).IsOCI())
+	assert.False(t, (&ApplicationSource{RepoURL: "oci://registry.example.com/charts", Chart: "foo"}).IsOCI())
+	assert.False(t, (&ApplicationSource{RepoURL: "registry.example.com/charts", Chart: "foo"}).IsOCI())
+	assert.False(t, (&ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps"}).IsOCI())
+}
+
+func TestApplicationSourceHelm_SetValuesObject(t *testing.T) {
+	src := ApplicationSourceHelm{}
+	assert.True(t, src.IsZero())
+	require.NoError(t, src.SetValuesObject("replicas: 3\nimage:\n  tag: v1\n"))
+	assert.JSONEq(t, `{"replicas": 3, "image": {"tag": "v1"}}`, string(src.ValuesObject.Raw))
+	assert.False(t, src.ValuesObjectIsEmpty())
+	assert.False(t, src.IsZero())
+
+	assert.Error(t, src.SetValuesObject("- a\n- b\n"))
+	assert.Error(t, src.SetValuesObject("foo: [bar"))
+
+	require.NoError(t, src.SetValuesObject(""))
+	assert.Nil(t, src.ValuesObject)
+	assert.True(t, src.ValuesObjectIsEmpty())
+}
+
 func TestApplicationSourceHelm_AddParameter(t *testing.T) {
 	src := ApplicationSourceHelm{}
 	t.Run("Add", func(t *testing.T) {
@@ -1335,6 +1373,7 @@ func TestApplicationSourcePlugin_IsZero(t *testing.T) {
 		{"Empty", &ApplicationSourcePlugin{}, true},
 		{"Name", &ApplicationSourcePlugin{Name: "foo"}, false},
 		{"Env", &ApplicationSourcePlugin{Env: Env{{}}}, false},
+		{"Parameters", &ApplicationSourcePlugin{Parameters: ApplicationSourcePluginParameters{{Name: "foo"}}}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1343,6 +1382,66 @@ func TestApplicationSourcePlugin_IsZero(t *testing.T) {
 	}
 }
 
+func TestApplicationSourcePluginParameters_Validate(t *testing.T) {
+	assert.NoError(t, ApplicationSourcePluginParameters{
+		{Name: "string", String_: pointer.String("foo")},
+		{Name: "array", OptionalArray: &OptionalArray{Array: []string{"foo"}}},
+		{Name: "map", OptionalMap: &OptionalMap{Map: map[string]string{"foo": "bar"}}},
+		{Name: "empty-array", OptionalArray: &OptionalArray{}},
+		{Name: "empty-map", OptionalMap: &OptionalMap{}},
+	}.Validate())
+	assert.EqualError(t, ApplicationSourcePluginParameters{{String_: pointer.String("foo")}}.Validate(), "plugin parameter must have a name")
+	assert.EqualError(t, ApplicationSourcePluginParameters{{Name: "foo"}, {Name: "foo"}}.Validate(), "plugin parameter 'foo' is defined more than once")
+	assert.EqualError(t, ApplicationSourcePluginParameters{{Name: "foo", String_: pointer.String("foo"), OptionalArray: &OptionalArray{}}}.Validate(), "plugin parameter 'foo' must have only one of string, map or array")
+	assert.EqualError(t, ApplicationSourcePluginParameters{{Name: "foo", OptionalMap: &OptionalMap{}, OptionalArray: &OptionalArray{}}}.Validate(), "plugin parameter 'foo' must have only one of string, map or array")
+}
+
+func TestApplicationSourcePluginParameters_EmptyValues(t *testing.T) {
+	var params ApplicationSourcePluginParameters
+	err := json.Unmarshal([]byte(`[{"name":"array","array":[]},{"name":"map","map":{}}]`), &params)
+	require.NoError(t, err)
+	require.Len(t, params, 2)
+	assert.NotNil(t, params[0].OptionalArray)
+	assert.Nil(t, params[0].OptionalMap)
+	assert.NotNil(t, params[1].OptionalMap)
+	assert.Nil(t, params[1].OptionalArray)
+
+	data, err := json.Marshal(params)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"name":"array","array":[]},{"name":"map","map":{}}]`, string(data))
+
+	source := ApplicationSourcePlugin{Parameters: params}
+	data, err = source.Marshal()
+	require.NoError(t, err)
+	var unmarshalled ApplicationSourcePlugin
+	require.NoError(t, unmarshalled.Unmarshal(data))
+	assert.NoError(t, unmarshalled.Parameters.Validate())
+	env, err := unmarshalled.Parameters.Environ()
+	require.NoError(t, err)
+	assert.Equal(t, []string{`ARGOCD_APP_PARAMETERS=[{"name":"array","array":[]},{"name":"map","map":{}}]`}, env)
+}
+
+func TestApplicationSourcePluginParameters_Environ(t *testing.T) {
+	env, err := ApplicationSourcePluginParameters{}.Environ()
+	assert.NoError(t, err)
+	assert.Empty(t, env)
+
+	env, err = ApplicationSourcePluginParameters{
+		{Name: "string", String_: pointer.String("foo")},
+		{Name: "array", OptionalArray: &OptionalArray{Array: []string{"foo", "bar"}}},
+		{Name: "helm-parameters", OptionalMap: &OptionalMap{Map: map[string]string{"image.tag": "v1", "image.repository": "nginx"}}},
+	}.Environ()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		`ARGOCD_APP_PARAMETERS=[{"name":"string","string":"foo"},{"name":"array","array":["foo","bar"]},{"name":"helm-parameters","map":{"image.repository":"nginx","image.tag":"v1"}}]`,
+		"PARAM_STRING=foo",
+		"PARAM_ARRAY_0=foo",
+		"PARAM_ARRAY_1=bar",
+		"PARAM_HELM_PARAMETERS_IMAGE_REPOSITORY=nginx",
+		"PARAM_HELM_PARAMETERS_IMAGE_TAG=v1",
+	}, env)
+}
+
 func TestEnvEntry_IsZero(t *testing.T) {
 	tests := []struct {
 		name string
@@ -1443,6 +1542,40 @@ func TestApplicationSourceKustomize_MergeImage(t *testing.T) {
 	})
 }
 
+func TestApplicationSourceKustomize_MergeReplica(t *testing.T) {
+	k := ApplicationSourceKustomize{}
+	k.MergeReplica(KustomizeReplica{Name: "foo", Count: intstr.FromInt(1)})
+	k.MergeReplica(KustomizeReplica{Name: "bar", Count: intstr.FromInt(2)})
+	k.MergeReplica(KustomizeReplica{Name: "foo", Count: intstr.FromInt(3)})
+	assert.Equal(t, KustomizeReplicas{{Name: "foo", Count: intstr.FromInt(3)}, {Name: "bar", Count: intstr.FromInt(2)}}, k.Replicas)
+}
+
+func TestKustomizeReplica_GetIntCount(t *testing.T) {
+	count, err := KustomizeReplica{Name: "foo", Count: intstr.FromInt(2)}.GetIntCount()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	count, err = KustomizeReplica{Name: "foo", Count: intstr.FromString("3")}.GetIntCount()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+	_, err = KustomizeReplica{Name: "foo", Count: intstr.FromString("50%")}.GetIntCount()
+	assert.EqualError(t, err, "invalid replica count '50%' for 'foo': expected an integer")
+	_, err = KustomizeReplica{Name: "foo", Count: intstr.FromInt(-1)}.GetIntCount()
+	assert.EqualError(t, err, "invalid replica count -1 for 'foo': must not be negative")
+}
+
+func TestApplicationSourceKustomize_Validate(t *testing.T) {
+	assert.NoError(t, (*ApplicationSourceKustomize)(nil).Validate())
+	assert.NoError(t, (&ApplicationSourceKustomize{
+		Replicas: KustomizeReplicas{{Name: "foo", Count: intstr.FromInt(1)}},
+		Patches:  KustomizePatches{{Path: "patch.yaml"}, {Patch: "- op: remove\n  path: /spec/replicas", Target: &KustomizeSelector{Kind: "Deployment"}}},
+	}).Validate())
+	assert.Error(t, (&ApplicationSourceKustomize{Replicas: KustomizeReplicas{{Count: intstr.FromInt(1)}}}).Validate())
+	assert.Error(t, (&ApplicationSourceKustomize{Replicas: KustomizeReplicas{{Name: "foo", Count: intstr.FromString("x")}}}).Validate())
+	assert.Error(t, (&ApplicationSourceKustomize{Patches: KustomizePatches{{}}}).Validate())
+	assert.Error(t, (&ApplicationSourceKustomize{Patches: KustomizePatches{{Path: "patch.yaml", Patch: "kind: Deployment"}}}).Validate())
+	assert.Error(t, (&ApplicationSourceKustomize{Patches: KustomizePatches{{Path: "/etc/passwd"}}}).Validate())
+}
+
 func TestSyncWindows_HasWindows(t *testing.T) {
 	t.Run("True", func(t *testing.T) {
 		proj := newTestProjectWithSyncWindows()
@@ -1816,9 +1949,9 @@ func TestAppProjectSpec_AddWindow(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			switch tt.want {
 			case "error":
-				assert.Error(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m, tt.t))
+				assert.Error(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m, tt.t, nil, false))
 			case "noError":
-				assert.NoError(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m, tt.t))
+				assert.NoError(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m, tt.t, nil, false))
 				assert.NoError(t, tt.p.Spec.DeleteWindow(0))
 			}
 		})
@@ -1875,12 +2008,89 @@ func TestSyncWindows_Matches(t *testing.T) {
 		assert.Equal(t, 1, len(*windows))
 		proj.Spec.SyncWindows[0].Applications = nil
 	})
+	t.Run("MatchApplicationSelector", func(t *testing.T) {
+		app := app.DeepCopy()
+		app.Labels = map[string]string{"tier": "critical"}
+		proj.Spec.SyncWindows[0].ApplicationSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "critical"}}
+		windows := proj.Spec.SyncWindows.Matches(app)
+		assert.Equal(t, 1, len(*windows))
+		proj.Spec.SyncWindows[0].ApplicationSelector = nil
+	})
+	t.Run("NoMatchApplicationSelector", func(t *testing.T) {
+		proj.Spec.SyncWindows[0].ApplicationSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "critical"}}
+		windows := proj.Spec.SyncWindows.Matches(app)
+		assert.Nil(t, windows)
+		proj.Spec.SyncWindows[0].ApplicationSelector = nil
+	})
+	t.Run("MatchMultipleMatchersOnce", func(t *testing.T) {
+		proj.Spec.SyncWindows[0].Applications = []string{"test-app"}
+		proj.Spec.SyncWindows[0].Namespaces = []string{"default"}
+		windows := proj.Spec.SyncWindows.Matches(app)
+		assert.Equal(t, 1, len(*windows))
+		proj.Spec.SyncWindows[0].Applications = nil
+		proj.Spec.SyncWindows[0].Namespaces = nil
+	})
+	t.Run("MatchAndOperator", func(t *testing.T) {
+		proj.Spec.SyncWindows[0].AndOperator = true
+		proj.Spec.SyncWindows[0].Applications = []string{"test-*"}
+		proj.Spec.SyncWindows[0].Namespaces = []string{"default"}
+		windows := proj.Spec.SyncWindows.Matches(app)
+		assert.Equal(t, 1, len(*windows))
+		proj.Spec.SyncWindows[0].AndOperator = false
+		proj.Spec.SyncWindows[0].Applications = nil
+		proj.Spec.SyncWindows[0].Namespaces = nil
+	})
+	t.Run("NoMatchAndOperator", func(t *testing.T) {
+		proj.Spec.SyncWindows[0].AndOperator = true
+		proj.Spec.SyncWindows[0].Applications = []string{"test-*"}
+		proj.Spec.SyncWindows[0].Namespaces = []string{"kube-system"}
+		windows := proj.Spec.SyncWindows.Matches(app)
+		assert.Nil(t, windows)
+		proj.Spec.SyncWindows[0].AndOperator = false
+		proj.Spec.SyncWindows[0].Applications = nil
+		proj.Spec.SyncWindows[0].Namespaces = nil
+	})
 	t.Run("NoMatch", func(t *testing.T) {
 		windows := proj.Spec.SyncWindows.Matches(app)
 		assert.Nil(t, windows)
 	})
 }
 
+func TestAppProject_HasSyncWindowOverride(t *testing.T) {
+	proj := newTestProjectWithSyncWindows()
+	app := newTestApp()
+	now := time.Now()
+	assert.False(t, proj.hasSyncWindowOverride(app, now))
+
+	proj.SetSyncWindowOverride(SyncWindowOverride{
+		Application: app.Name,
+		Reason:      "hotfix",
+		CreatedAt:   metav1.NewTime(now),
+		ExpiresAt:   metav1.NewTime(now.Add(time.Hour)),
+	})
+	assert.True(t, proj.hasSyncWindowOverride(app, now))
+	assert.False(t, proj.hasSyncWindowOverride(app, now.Add(time.Hour)))
+
+	otherNsApp := app.DeepCopy()
+	otherNsApp.Namespace = "other"
+	assert.False(t, proj.hasSyncWindowOverride(otherNsApp, now))
+}
+
+func TestAppProject_SetSyncWindowOverride(t *testing.T) {
+	proj := newTestProjectWithSyncWindows()
+	now := time.Now()
+	proj.Status.SyncWindowOverrides = []SyncWindowOverride{
+		{Application: "expired", CreatedAt: metav1.NewTime(now.Add(-2 * time.Hour)), ExpiresAt: metav1.NewTime(now.Add(-time.Hour))},
+		{Application: "other", CreatedAt: metav1.NewTime(now), ExpiresAt: metav1.NewTime(now.Add(time.Hour))},
+		{Application: "test-app", Reason: "old", CreatedAt: metav1.NewTime(now), ExpiresAt: metav1.NewTime(now.Add(time.Hour))},
+	}
+	proj.SetSyncWindowOverride(SyncWindowOverride{Application: "test-app", Reason: "new", CreatedAt: metav1.NewTime(now), ExpiresAt: metav1.NewTime(now.Add(time.Hour))})
+	if assert.Len(t, proj.Status.SyncWindowOverrides, 2) {
+		assert.Equal(t, "other", proj.Status.SyncWindowOverrides[0].Application)
+		assert.Equal(t, "new", proj.Status.SyncWindowOverrides[1].Reason)
+	}
+}
+
 func TestSyncWindows_CanSync(t *testing.T) {
 	t.Run("will allow manual sync if inactive-deny-window set with manual true", func(t *testing.T) {
 		// given
@@ -2381,6 +2591,11 @@ func TestSyncWindow_Validate(t *testing.T) {
 		window.Duration = "1000days"
 		assert.Error(t, window.Validate())
 	})
+	t.Run("IncorrectApplicationSelector", func(t *testing.T) {
+		window.Duration = "1h"
+		window.ApplicationSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Bogus"}}}
+		assert.Error(t, window.Validate())
+	})
 }
 
 func TestApplicationStatus_GetConditions(t *testing.T) {
@@ -2700,6 +2915,39 @@ func TestApplicationSpec_GetRevisionHistoryLimit(t *testing.T) {
 	assert.Equal(t, 11, ApplicationSpec{RevisionHistoryLimit: &n}.GetRevisionHistoryLimit())
 }
 
+func TestApplicationSpec_GetSources(t *testing.T) {
+	source := ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"}
+	refSource := ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-values", Ref: "values"}
+
+	spec := ApplicationSpec{Source: source}
+	assert.False(t, spec.HasMultipleSources())
+	assert.Equal(t, ApplicationSources{source}, spec.GetSources())
+	assert.Equal(t, source, spec.GetSource())
+
+	spec = ApplicationSpec{Sources: ApplicationSources{refSource, source}}
+	assert.True(t, spec.HasMultipleSources())
+	assert.Equal(t, ApplicationSources{refSource, source}, spec.GetSources())
+	assert.Equal(t, refSource, spec.GetSource())
+}
+
+func TestApplicationSource_IsRefOnly(t *testing.T) {
+	assert.False(t, (&ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"}).IsRefOnly())
+	assert.False(t, (&ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook", Ref: "repo"}).IsRefOnly())
+	assert.True(t, (&ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Ref: "repo"}).IsRefOnly())
+}
+
+func TestApplicationSources_Equals(t *testing.T) {
+	sources := ApplicationSources{
+		{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+		{RepoURL: "https://github.com/argoproj/argocd-example-values", Ref: "values"},
+	}
+	assert.True(t, sources.Equals(sources.DeepCopy()))
+	assert.False(t, sources.Equals(sources[:1]))
+	changed := sources.DeepCopy()
+	changed[1].TargetRevision = "main"
+	assert.False(t, sources.Equals(changed))
+}
+
 func TestProjectNormalize(t *testing.T) {
 	issuedAt := int64(1)
 	secondIssuedAt := issuedAt + 1
@@ -3156,3 +3404,43 @@ func Test_RBACName(t *testing.T) {
 		assert.Equal(t, "default/test-app", a.RBACName("argocd"))
 	})
 }
+
+func TestSyncPolicyAutomated_GetRetryFailedAfter(t *testing.T) {
+	var nilPolicy *SyncPolicyAutomated
+	duration, err := nilPolicy.GetRetryFailedAfter()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), duration)
+
+	duration, err = (&SyncPolicyAutomated{RetryFailedAfter: "10m"}).GetRetryFailedAfter()
+	assert.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, duration)
+
+	duration, err = (&SyncPolicyAutomated{RetryFailedAfter: "30"}).GetRetryFailedAfter()
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Second, duration)
+
+	_, err = (&SyncPolicyAutomated{RetryFailedAfter: "soon"}).GetRetryFailedAfter()
+	assert.Error(t, err)
+
+	_, err = (&SyncPolicyAutomated{RetryFailedAfter: "-1m"}).GetRetryFailedAfter()
+	assert.Error(t, err)
+}
+
+func TestApplicationDependency(t *testing.T) {
+	app := &Application{ObjectMeta: metav1.ObjectMeta{Name: "cert-manager", Labels: map[string]string{"tier": "platform"}}}
+
+	byName := ApplicationDependency{Name: "cert-manager"}
+	assert.NoError(t, byName.Validate())
+	assert.True(t, byName.Matches(app))
+
+	bySelector := ApplicationDependency{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "tenant"}}}
+	assert.NoError(t, bySelector.Validate())
+	assert.False(t, bySelector.Matches(app))
+
+	assert.Error(t, (&ApplicationDependency{}).Validate())
+	assert.Error(t, (&ApplicationDependency{Name: "cert-manager", Selector: &metav1.LabelSelector{}}).Validate())
+	invalidSelector := ApplicationDependency{Selector: &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "invalid"}},
+	}}
+	assert.Error(t, invalidSelector.Validate())
+}
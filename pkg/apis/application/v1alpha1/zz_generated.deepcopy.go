@@ -10,6 +10,7 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -155,6 +156,11 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CosignPublicKeys != nil {
+		in, out := &in.CosignPublicKeys, &out.CosignPublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -178,6 +184,13 @@ func (in *AppProjectStatus) DeepCopyInto(out *AppProjectStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.SyncWindowOverrides != nil {
+		in, out := &in.SyncWindowOverrides, &out.SyncWindowOverrides
+		*out = make([]SyncWindowOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -244,6 +257,27 @@ func (in *ApplicationCondition) DeepCopy() *ApplicationCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDependency) DeepCopyInto(out *ApplicationDependency) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDependency.
+func (in *ApplicationDependency) DeepCopy() *ApplicationDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationDestination) DeepCopyInto(out *ApplicationDestination) {
 	*out = *in
@@ -293,6 +327,27 @@ func (in *ApplicationList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationMatchExpression) DeepCopyInto(out *ApplicationMatchExpression) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationMatchExpression.
+func (in *ApplicationMatchExpression) DeepCopy() *ApplicationMatchExpression {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationMatchExpression)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSet) DeepCopyInto(out *ApplicationSet) {
 	*out = *in
@@ -321,6 +376,27 @@ func (in *ApplicationSet) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetApplicationStatus) DeepCopyInto(out *ApplicationSetApplicationStatus) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	out.Health = in.Health
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetApplicationStatus.
+func (in *ApplicationSetApplicationStatus) DeepCopy() *ApplicationSetApplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetApplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetCondition) DeepCopyInto(out *ApplicationSetCondition) {
 	*out = *in
@@ -389,6 +465,11 @@ func (in *ApplicationSetGenerator) DeepCopyInto(out *ApplicationSetGenerator) {
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(PluginGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -402,6 +483,28 @@ func (in *ApplicationSetGenerator) DeepCopy() *ApplicationSetGenerator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ApplicationSetIgnoreDifferences) DeepCopyInto(out *ApplicationSetIgnoreDifferences) {
+	{
+		in := &in
+		*out = make(ApplicationSetIgnoreDifferences, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetIgnoreDifferences.
+func (in ApplicationSetIgnoreDifferences) DeepCopy() ApplicationSetIgnoreDifferences {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetIgnoreDifferences)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetList) DeepCopyInto(out *ApplicationSetList) {
 	*out = *in
@@ -483,6 +586,11 @@ func (in *ApplicationSetNestedGenerator) DeepCopyInto(out *ApplicationSetNestedG
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(PluginGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -518,6 +626,78 @@ func (in ApplicationSetNestedGenerators) DeepCopy() ApplicationSetNestedGenerato
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetResourceIgnoreDifferences) DeepCopyInto(out *ApplicationSetResourceIgnoreDifferences) {
+	*out = *in
+	if in.JSONPointers != nil {
+		in, out := &in.JSONPointers, &out.JSONPointers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetResourceIgnoreDifferences.
+func (in *ApplicationSetResourceIgnoreDifferences) DeepCopy() *ApplicationSetResourceIgnoreDifferences {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetResourceIgnoreDifferences)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutStep) DeepCopyInto(out *ApplicationSetRolloutStep) {
+	*out = *in
+	if in.MatchExpressions != nil {
+		in, out := &in.MatchExpressions, &out.MatchExpressions
+		*out = make([]ApplicationMatchExpression, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaxUpdate != nil {
+		in, out := &in.MaxUpdate, &out.MaxUpdate
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutStep.
+func (in *ApplicationSetRolloutStep) DeepCopy() *ApplicationSetRolloutStep {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutStrategy) DeepCopyInto(out *ApplicationSetRolloutStrategy) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]ApplicationSetRolloutStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutStrategy.
+func (in *ApplicationSetRolloutStrategy) DeepCopy() *ApplicationSetRolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetSpec) DeepCopyInto(out *ApplicationSetSpec) {
 	*out = *in
@@ -532,6 +712,23 @@ func (in *ApplicationSetSpec) DeepCopyInto(out *ApplicationSetSpec) {
 	if in.SyncPolicy != nil {
 		in, out := &in.SyncPolicy, &out.SyncPolicy
 		*out = new(ApplicationSetSyncPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(ApplicationSetStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IgnoreApplicationDifferences != nil {
+		in, out := &in.IgnoreApplicationDifferences, &out.IgnoreApplicationDifferences
+		*out = make(ApplicationSetIgnoreDifferences, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TemplatePatch != nil {
+		in, out := &in.TemplatePatch, &out.TemplatePatch
+		*out = new(string)
 		**out = **in
 	}
 	return
@@ -557,6 +754,13 @@ func (in *ApplicationSetStatus) DeepCopyInto(out *ApplicationSetStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ApplicationStatus != nil {
+		in, out := &in.ApplicationStatus, &out.ApplicationStatus
+		*out = make([]ApplicationSetApplicationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -570,9 +774,35 @@ func (in *ApplicationSetStatus) DeepCopy() *ApplicationSetStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetStrategy) DeepCopyInto(out *ApplicationSetStrategy) {
+	*out = *in
+	if in.RollingSync != nil {
+		in, out := &in.RollingSync, &out.RollingSync
+		*out = new(ApplicationSetRolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetStrategy.
+func (in *ApplicationSetStrategy) DeepCopy() *ApplicationSetStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetSyncPolicy) DeepCopyInto(out *ApplicationSetSyncPolicy) {
 	*out = *in
+	if in.ApplicationsSync != nil {
+		in, out := &in.ApplicationsSync, &out.ApplicationsSync
+		*out = new(ApplicationsSyncPolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -672,6 +902,11 @@ func (in *ApplicationSetTerminalGenerator) DeepCopyInto(out *ApplicationSetTermi
 		*out = new(PullRequestGenerator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(PluginGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -707,6 +942,23 @@ func (in ApplicationSetTerminalGenerators) DeepCopy() ApplicationSetTerminalGene
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetWatchEvent) DeepCopyInto(out *ApplicationSetWatchEvent) {
+	*out = *in
+	in.ApplicationSet.DeepCopyInto(&out.ApplicationSet)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetWatchEvent.
+func (in *ApplicationSetWatchEvent) DeepCopy() *ApplicationSetWatchEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetWatchEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSource) DeepCopyInto(out *ApplicationSource) {
 	*out = *in
@@ -778,6 +1030,16 @@ func (in *ApplicationSourceHelm) DeepCopyInto(out *ApplicationSourceHelm) {
 		*out = make([]HelmFileParameter, len(*in))
 		copy(*out, *in)
 	}
+	if in.ValuesObject != nil {
+		in, out := &in.ValuesObject, &out.ValuesObject
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIVersions != nil {
+		in, out := &in.APIVersions, &out.APIVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -849,6 +1111,18 @@ func (in *ApplicationSourceKustomize) DeepCopyInto(out *ApplicationSourceKustomi
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = make(KustomizeReplicas, len(*in))
+		copy(*out, *in)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make(KustomizePatches, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -876,6 +1150,13 @@ func (in *ApplicationSourcePlugin) DeepCopyInto(out *ApplicationSourcePlugin) {
 			}
 		}
 	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(ApplicationSourcePluginParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -889,6 +1170,81 @@ func (in *ApplicationSourcePlugin) DeepCopy() *ApplicationSourcePlugin {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSourcePluginParameter) DeepCopyInto(out *ApplicationSourcePluginParameter) {
+	*out = *in
+	if in.String_ != nil {
+		in, out := &in.String_, &out.String_
+		*out = new(string)
+		**out = **in
+	}
+	if in.OptionalMap != nil {
+		in, out := &in.OptionalMap, &out.OptionalMap
+		*out = new(OptionalMap)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OptionalArray != nil {
+		in, out := &in.OptionalArray, &out.OptionalArray
+		*out = new(OptionalArray)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSourcePluginParameter.
+func (in *ApplicationSourcePluginParameter) DeepCopy() *ApplicationSourcePluginParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSourcePluginParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ApplicationSourcePluginParameters) DeepCopyInto(out *ApplicationSourcePluginParameters) {
+	{
+		in := &in
+		*out = make(ApplicationSourcePluginParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSourcePluginParameters.
+func (in ApplicationSourcePluginParameters) DeepCopy() ApplicationSourcePluginParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSourcePluginParameters)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ApplicationSources) DeepCopyInto(out *ApplicationSources) {
+	{
+		in := &in
+		*out = make(ApplicationSources, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSources.
+func (in ApplicationSources) DeepCopy() ApplicationSources {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSources)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 	*out = *in
@@ -916,6 +1272,20 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make(ApplicationSources, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ApplicationDependency, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1104,6 +1474,27 @@ func (in *BasicAuthBitbucketServer) DeepCopy() *BasicAuthBitbucketServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BearerTokenBitbucketCloud) DeepCopyInto(out *BearerTokenBitbucketCloud) {
+	*out = *in
+	if in.TokenRef != nil {
+		in, out := &in.TokenRef, &out.TokenRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BearerTokenBitbucketCloud.
+func (in *BearerTokenBitbucketCloud) DeepCopy() *BearerTokenBitbucketCloud {
+	if in == nil {
+		return nil
+	}
+	out := new(BearerTokenBitbucketCloud)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cluster) DeepCopyInto(out *Cluster) {
 	*out = *in
@@ -1301,6 +1692,13 @@ func (in *ComparedTo) DeepCopyInto(out *ComparedTo) {
 	*out = *in
 	in.Source.DeepCopyInto(&out.Source)
 	out.Destination = in.Destination
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make(ApplicationSources, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1756,64 +2154,167 @@ func (in *JsonnetVar) DeepCopyInto(out *JsonnetVar) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JsonnetVar.
-func (in *JsonnetVar) DeepCopy() *JsonnetVar {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JsonnetVar.
+func (in *JsonnetVar) DeepCopy() *JsonnetVar {
+	if in == nil {
+		return nil
+	}
+	out := new(JsonnetVar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KnownTypeField) DeepCopyInto(out *KnownTypeField) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KnownTypeField.
+func (in *KnownTypeField) DeepCopy() *KnownTypeField {
+	if in == nil {
+		return nil
+	}
+	out := new(KnownTypeField)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in KustomizeImages) DeepCopyInto(out *KustomizeImages) {
+	{
+		in := &in
+		*out = make(KustomizeImages, len(*in))
+		copy(*out, *in)
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizeImages.
+func (in KustomizeImages) DeepCopy() KustomizeImages {
+	if in == nil {
+		return nil
+	}
+	out := new(KustomizeImages)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KustomizeOptions) DeepCopyInto(out *KustomizeOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizeOptions.
+func (in *KustomizeOptions) DeepCopy() *KustomizeOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(KustomizeOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KustomizePatch) DeepCopyInto(out *KustomizePatch) {
+	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(KustomizeSelector)
+		**out = **in
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizePatch.
+func (in *KustomizePatch) DeepCopy() *KustomizePatch {
+	if in == nil {
+		return nil
+	}
+	out := new(KustomizePatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in KustomizePatches) DeepCopyInto(out *KustomizePatches) {
+	{
+		in := &in
+		*out = make(KustomizePatches, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizePatches.
+func (in KustomizePatches) DeepCopy() KustomizePatches {
 	if in == nil {
 		return nil
 	}
-	out := new(JsonnetVar)
+	out := new(KustomizePatches)
 	in.DeepCopyInto(out)
-	return out
+	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KnownTypeField) DeepCopyInto(out *KnownTypeField) {
+func (in *KustomizeReplica) DeepCopyInto(out *KustomizeReplica) {
 	*out = *in
+	out.Count = in.Count
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KnownTypeField.
-func (in *KnownTypeField) DeepCopy() *KnownTypeField {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizeReplica.
+func (in *KustomizeReplica) DeepCopy() *KustomizeReplica {
 	if in == nil {
 		return nil
 	}
-	out := new(KnownTypeField)
+	out := new(KustomizeReplica)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in KustomizeImages) DeepCopyInto(out *KustomizeImages) {
+func (in KustomizeReplicas) DeepCopyInto(out *KustomizeReplicas) {
 	{
 		in := &in
-		*out = make(KustomizeImages, len(*in))
+		*out = make(KustomizeReplicas, len(*in))
 		copy(*out, *in)
 		return
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizeImages.
-func (in KustomizeImages) DeepCopy() KustomizeImages {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizeReplicas.
+func (in KustomizeReplicas) DeepCopy() KustomizeReplicas {
 	if in == nil {
 		return nil
 	}
-	out := new(KustomizeImages)
+	out := new(KustomizeReplicas)
 	in.DeepCopyInto(out)
 	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KustomizeOptions) DeepCopyInto(out *KustomizeOptions) {
+func (in *KustomizeSelector) DeepCopyInto(out *KustomizeSelector) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizeOptions.
-func (in *KustomizeOptions) DeepCopy() *KustomizeOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizeSelector.
+func (in *KustomizeSelector) DeepCopy() *KustomizeSelector {
 	if in == nil {
 		return nil
 	}
-	out := new(KustomizeOptions)
+	out := new(KustomizeSelector)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2023,6 +2524,50 @@ func (in *OperationState) DeepCopy() *OperationState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OptionalArray) DeepCopyInto(out *OptionalArray) {
+	*out = *in
+	if in.Array != nil {
+		in, out := &in.Array, &out.Array
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionalArray.
+func (in *OptionalArray) DeepCopy() *OptionalArray {
+	if in == nil {
+		return nil
+	}
+	out := new(OptionalArray)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OptionalMap) DeepCopyInto(out *OptionalMap) {
+	*out = *in
+	if in.Map != nil {
+		in, out := &in.Map, &out.Map
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionalMap.
+func (in *OptionalMap) DeepCopy() *OptionalMap {
+	if in == nil {
+		return nil
+	}
+	out := new(OptionalMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OrphanedResourceKey) DeepCopyInto(out *OrphanedResourceKey) {
 	*out = *in
@@ -2096,6 +2641,98 @@ func (in *OverrideIgnoreDiff) DeepCopy() *OverrideIgnoreDiff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginConfigMapRef) DeepCopyInto(out *PluginConfigMapRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginConfigMapRef.
+func (in *PluginConfigMapRef) DeepCopy() *PluginConfigMapRef {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginConfigMapRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginGenerator) DeepCopyInto(out *PluginGenerator) {
+	*out = *in
+	out.ConfigMapRef = in.ConfigMapRef
+	in.Input.DeepCopyInto(&out.Input)
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginGenerator.
+func (in *PluginGenerator) DeepCopy() *PluginGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginInput) DeepCopyInto(out *PluginInput) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(PluginParameters, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginInput.
+func (in *PluginInput) DeepCopy() *PluginInput {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in PluginParameters) DeepCopyInto(out *PluginParameters) {
+	{
+		in := &in
+		*out = make(PluginParameters, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginParameters.
+func (in PluginParameters) DeepCopy() PluginParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginParameters)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectRole) DeepCopyInto(out *ProjectRole) {
 	*out = *in
@@ -2150,6 +2787,16 @@ func (in *PullRequestGenerator) DeepCopyInto(out *PullRequestGenerator) {
 		*out = new(PullRequestGeneratorBitbucketServer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Bitbucket != nil {
+		in, out := &in.Bitbucket, &out.Bitbucket
+		*out = new(PullRequestGeneratorBitbucket)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AzureDevOps != nil {
+		in, out := &in.AzureDevOps, &out.AzureDevOps
+		*out = new(PullRequestGeneratorAzureDevOps)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Filters != nil {
 		in, out := &in.Filters, &out.Filters
 		*out = make([]PullRequestGeneratorFilter, len(*in))
@@ -2176,6 +2823,58 @@ func (in *PullRequestGenerator) DeepCopy() *PullRequestGenerator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullRequestGeneratorAzureDevOps) DeepCopyInto(out *PullRequestGeneratorAzureDevOps) {
+	*out = *in
+	if in.TokenRef != nil {
+		in, out := &in.TokenRef, &out.TokenRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequestGeneratorAzureDevOps.
+func (in *PullRequestGeneratorAzureDevOps) DeepCopy() *PullRequestGeneratorAzureDevOps {
+	if in == nil {
+		return nil
+	}
+	out := new(PullRequestGeneratorAzureDevOps)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullRequestGeneratorBitbucket) DeepCopyInto(out *PullRequestGeneratorBitbucket) {
+	*out = *in
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuthBitbucketServer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BearerToken != nil {
+		in, out := &in.BearerToken, &out.BearerToken
+		*out = new(BearerTokenBitbucketCloud)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequestGeneratorBitbucket.
+func (in *PullRequestGeneratorBitbucket) DeepCopy() *PullRequestGeneratorBitbucket {
+	if in == nil {
+		return nil
+	}
+	out := new(PullRequestGeneratorBitbucket)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PullRequestGeneratorBitbucketServer) DeepCopyInto(out *PullRequestGeneratorBitbucketServer) {
 	*out = *in
@@ -2205,6 +2904,21 @@ func (in *PullRequestGeneratorFilter) DeepCopyInto(out *PullRequestGeneratorFilt
 		*out = new(string)
 		**out = **in
 	}
+	if in.TargetBranchMatch != nil {
+		in, out := &in.TargetBranchMatch, &out.TargetBranchMatch
+		*out = new(string)
+		**out = **in
+	}
+	if in.TitleMatch != nil {
+		in, out := &in.TitleMatch, &out.TitleMatch
+		*out = new(string)
+		**out = **in
+	}
+	if in.Author != nil {
+		in, out := &in.Author, &out.Author
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -2291,6 +3005,53 @@ func (in *PullRequestGeneratorGithub) DeepCopy() *PullRequestGeneratorGithub {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RefTarget) DeepCopyInto(out *RefTarget) {
+	*out = *in
+	in.Repo.DeepCopyInto(&out.Repo)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RefTarget.
+func (in *RefTarget) DeepCopy() *RefTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(RefTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in RefTargetRevisionMapping) DeepCopyInto(out *RefTargetRevisionMapping) {
+	{
+		in := &in
+		*out = make(RefTargetRevisionMapping, len(*in))
+		for key, val := range *in {
+			var outVal *RefTarget
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(RefTarget)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RefTargetRevisionMapping.
+func (in RefTargetRevisionMapping) DeepCopy() RefTargetRevisionMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(RefTargetRevisionMapping)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepoCreds) DeepCopyInto(out *RepoCreds) {
 	*out = *in
@@ -2826,6 +3587,18 @@ func (in *RevisionHistory) DeepCopyInto(out *RevisionHistory) {
 		in, out := &in.DeployStartedAt, &out.DeployStartedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make(ApplicationSources, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3147,6 +3920,22 @@ func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 		*out = make(SyncOptions, len(*in))
 		copy(*out, *in)
 	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make(ApplicationSources, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotBefore != nil {
+		in, out := &in.NotBefore, &out.NotBefore
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -3191,6 +3980,22 @@ func (in *SyncOperationResult) DeepCopyInto(out *SyncOperationResult) {
 		}
 	}
 	in.Source.DeepCopyInto(&out.Source)
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make(ApplicationSources, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WaveStartedAt != nil {
+		in, out := &in.WaveStartedAt, &out.WaveStartedAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -3275,6 +4080,11 @@ func (in *SyncPolicyAutomated) DeepCopy() *SyncPolicyAutomated {
 func (in *SyncStatus) DeepCopyInto(out *SyncStatus) {
 	*out = *in
 	in.ComparedTo.DeepCopyInto(&out.ComparedTo)
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3365,6 +4175,11 @@ func (in *SyncWindow) DeepCopyInto(out *SyncWindow) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ApplicationSelector != nil {
+		in, out := &in.ApplicationSelector, &out.ApplicationSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3378,6 +4193,24 @@ func (in *SyncWindow) DeepCopy() *SyncWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWindowOverride) DeepCopyInto(out *SyncWindowOverride) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWindowOverride.
+func (in *SyncWindowOverride) DeepCopy() *SyncWindowOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWindowOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in SyncWindows) DeepCopyInto(out *SyncWindows) {
 	{
@@ -45,16 +45,20 @@ type ManifestRequest struct {
 	KustomizeOptions  *v1alpha1.KustomizeOptions         `protobuf:"bytes,13,opt,name=kustomizeOptions,proto3" json:"kustomizeOptions,omitempty"`
 	KubeVersion       string                             `protobuf:"bytes,14,opt,name=kubeVersion,proto3" json:"kubeVersion,omitempty"`
 	ApiVersions       []string                           `protobuf:"bytes,15,rep,name=apiVersions,proto3" json:"apiVersions,omitempty"`
-	// Request to verify the signature when generating the manifests (only for Git repositories)
-	VerifySignature      bool                  `protobuf:"varint,16,opt,name=verifySignature,proto3" json:"verifySignature,omitempty"`
-	HelmRepoCreds        []*v1alpha1.RepoCreds `protobuf:"bytes,17,rep,name=helmRepoCreds,proto3" json:"helmRepoCreds,omitempty"`
-	NoRevisionCache      bool                  `protobuf:"varint,18,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
-	TrackingMethod       string                `protobuf:"bytes,19,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
-	EnabledSourceTypes   map[string]bool       `protobuf:"bytes,20,rep,name=enabledSourceTypes,proto3" json:"enabledSourceTypes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	HelmOptions          *v1alpha1.HelmOptions `protobuf:"bytes,21,opt,name=helmOptions,proto3" json:"helmOptions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	// Request to verify the signature when generating the manifests (only for Git repositories and OCI artifacts)
+	VerifySignature    bool                  `protobuf:"varint,16,opt,name=verifySignature,proto3" json:"verifySignature,omitempty"`
+	HelmRepoCreds      []*v1alpha1.RepoCreds `protobuf:"bytes,17,rep,name=helmRepoCreds,proto3" json:"helmRepoCreds,omitempty"`
+	NoRevisionCache    bool                  `protobuf:"varint,18,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
+	TrackingMethod     string                `protobuf:"bytes,19,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
+	EnabledSourceTypes map[string]bool       `protobuf:"bytes,20,rep,name=enabledSourceTypes,proto3" json:"enabledSourceTypes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	HelmOptions        *v1alpha1.HelmOptions `protobuf:"bytes,21,opt,name=helmOptions,proto3" json:"helmOptions,omitempty"`
+	// Whether the application has multiple sources
+	HasMultipleSources bool `protobuf:"varint,22,opt,name=hasMultipleSources,proto3" json:"hasMultipleSources,omitempty"`
+	// The sources of the application with a ref, by "$" followed by the ref, which can be referenced by the source
+	RefSources           map[string]*v1alpha1.RefTarget `protobuf:"bytes,23,rep,name=refSources,proto3" json:"refSources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
 func (m *ManifestRequest) Reset()         { *m = ManifestRequest{} }
@@ -216,6 +220,20 @@ func (m *ManifestRequest) GetHelmOptions() *v1alpha1.HelmOptions {
 	return nil
 }
 
+func (m *ManifestRequest) GetHasMultipleSources() bool {
+	if m != nil {
+		return m.HasMultipleSources
+	}
+	return false
+}
+
+func (m *ManifestRequest) GetRefSources() map[string]*v1alpha1.RefTarget {
+	if m != nil {
+		return m.RefSources
+	}
+	return nil
+}
+
 type ManifestRequestWithFiles struct {
 	// Types that are valid to be assigned to Part:
 	//	*ManifestRequestWithFiles_Request
@@ -722,7 +740,7 @@ type ManifestResponse struct {
 	// resolved revision
 	Revision   string `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
 	SourceType string `protobuf:"bytes,6,opt,name=sourceType,proto3" json:"sourceType,omitempty"`
-	// Raw response of git verify-commit operation (always the empty string for Helm)
+	// Raw response of git verify-commit operation, or the JSON encoded cosign signatures of an OCI artifact (always the empty string for Helm)
 	VerifyResult         string   `protobuf:"bytes,7,opt,name=verifyResult,proto3" json:"verifyResult,omitempty"`
 	CommitMessage        string   `protobuf:"bytes,8,opt,name=commitMessage,proto3" json:"commitMessage,omitempty"`
 	CommitAuthor         string   `protobuf:"bytes,9,opt,name=commitAuthor,proto3" json:"commitAuthor,omitempty"`
@@ -1169,6 +1187,7 @@ type RepoAppDetailsResponse struct {
 	Helm                 *HelmAppSpec      `protobuf:"bytes,3,opt,name=helm,proto3" json:"helm,omitempty"`
 	Kustomize            *KustomizeAppSpec `protobuf:"bytes,4,opt,name=kustomize,proto3" json:"kustomize,omitempty"`
 	Directory            *DirectoryAppSpec `protobuf:"bytes,5,opt,name=directory,proto3" json:"directory,omitempty"`
+	Plugin               *PluginAppSpec    `protobuf:"bytes,6,opt,name=plugin,proto3" json:"plugin,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1235,6 +1254,13 @@ func (m *RepoAppDetailsResponse) GetDirectory() *DirectoryAppSpec {
 	return nil
 }
 
+func (m *RepoAppDetailsResponse) GetPlugin() *PluginAppSpec {
+	if m != nil {
+		return m.Plugin
+	}
+	return nil
+}
+
 type RepoServerRevisionMetadataRequest struct {
 	// the repo
 	Repo *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
@@ -1387,10 +1413,12 @@ func (m *HelmAppSpec) GetFileParameters() []*v1alpha1.HelmFileParameter {
 // KustomizeAppSpec contains kustomize images
 type KustomizeAppSpec struct {
 	// images is a list of available images.
-	Images               []string `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Images []string `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
+	// replicas is a list of the replica counts of the resources whose replicas can be overridden.
+	Replicas             []*v1alpha1.KustomizeReplica `protobuf:"bytes,4,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
 }
 
 func (m *KustomizeAppSpec) Reset()         { *m = KustomizeAppSpec{} }
@@ -1433,6 +1461,13 @@ func (m *KustomizeAppSpec) GetImages() []string {
 	return nil
 }
 
+func (m *KustomizeAppSpec) GetReplicas() []*v1alpha1.KustomizeReplica {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
 // DirectoryAppSpec contains directory
 type DirectoryAppSpec struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1473,6 +1508,179 @@ func (m *DirectoryAppSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DirectoryAppSpec proto.InternalMessageInfo
 
+// ParameterAnnouncement is a parameter of a config management plugin, as announced by the plugin
+type ParameterAnnouncement struct {
+	// name is the name identifying a parameter.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// title is a human-readable text of the parameter name.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// tooltip is a human-readable description of the parameter.
+	Tooltip string `protobuf:"bytes,3,opt,name=tooltip,proto3" json:"tooltip,omitempty"`
+	// required defines if this given parameter is mandatory.
+	Required bool `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	// itemType determines the primitive data type represented by the parameter. Parameters are always encoded as
+	// strings, but this field lets them be interpreted as other primitive types.
+	ItemType string `protobuf:"bytes,5,opt,name=itemType,proto3" json:"itemType,omitempty"`
+	// collectionType is the type of value this parameter holds - either a single value (a string) or a collection
+	// (array or map). If collectionType is set, only the field with that type will be used. If collectionType is not
+	// set, `string` is the default.
+	CollectionType string `protobuf:"bytes,6,opt,name=collectionType,proto3" json:"collectionType,omitempty"`
+	// string is the default value of the parameter if the parameter is a string.
+	String_ string `protobuf:"bytes,7,opt,name=string,proto3" json:"string,omitempty"`
+	// array is the default value of the parameter if the parameter is an array.
+	Array []string `protobuf:"bytes,8,rep,name=array,proto3" json:"array,omitempty"`
+	// map is the default value of the parameter if the parameter is a map.
+	Map                  map[string]string `protobuf:"bytes,9,rep,name=map,proto3" json:"map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ParameterAnnouncement) Reset()         { *m = ParameterAnnouncement{} }
+func (m *ParameterAnnouncement) String() string { return proto.CompactTextString(m) }
+func (*ParameterAnnouncement) ProtoMessage()    {}
+func (*ParameterAnnouncement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{20}
+}
+func (m *ParameterAnnouncement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParameterAnnouncement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParameterAnnouncement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParameterAnnouncement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParameterAnnouncement.Merge(m, src)
+}
+func (m *ParameterAnnouncement) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParameterAnnouncement) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParameterAnnouncement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParameterAnnouncement proto.InternalMessageInfo
+
+func (m *ParameterAnnouncement) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ParameterAnnouncement) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ParameterAnnouncement) GetTooltip() string {
+	if m != nil {
+		return m.Tooltip
+	}
+	return ""
+}
+
+func (m *ParameterAnnouncement) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
+func (m *ParameterAnnouncement) GetItemType() string {
+	if m != nil {
+		return m.ItemType
+	}
+	return ""
+}
+
+func (m *ParameterAnnouncement) GetCollectionType() string {
+	if m != nil {
+		return m.CollectionType
+	}
+	return ""
+}
+
+func (m *ParameterAnnouncement) GetString_() string {
+	if m != nil {
+		return m.String_
+	}
+	return ""
+}
+
+func (m *ParameterAnnouncement) GetArray() []string {
+	if m != nil {
+		return m.Array
+	}
+	return nil
+}
+
+func (m *ParameterAnnouncement) GetMap() map[string]string {
+	if m != nil {
+		return m.Map
+	}
+	return nil
+}
+
+// PluginAppSpec contains the details of a config management plugin application
+type PluginAppSpec struct {
+	// parametersAnnouncement is the list of the parameters announced by the plugin
+	ParametersAnnouncement []*ParameterAnnouncement `protobuf:"bytes,1,rep,name=parametersAnnouncement,proto3" json:"parametersAnnouncement,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                 `json:"-"`
+	XXX_unrecognized       []byte                   `json:"-"`
+	XXX_sizecache          int32                    `json:"-"`
+}
+
+func (m *PluginAppSpec) Reset()         { *m = PluginAppSpec{} }
+func (m *PluginAppSpec) String() string { return proto.CompactTextString(m) }
+func (*PluginAppSpec) ProtoMessage()    {}
+func (*PluginAppSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{21}
+}
+func (m *PluginAppSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PluginAppSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PluginAppSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PluginAppSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PluginAppSpec.Merge(m, src)
+}
+func (m *PluginAppSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *PluginAppSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_PluginAppSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PluginAppSpec proto.InternalMessageInfo
+
+func (m *PluginAppSpec) GetParametersAnnouncement() []*ParameterAnnouncement {
+	if m != nil {
+		return m.ParametersAnnouncement
+	}
+	return nil
+}
+
 type HelmChartsRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -1484,7 +1692,7 @@ func (m *HelmChartsRequest) Reset()         { *m = HelmChartsRequest{} }
 func (m *HelmChartsRequest) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsRequest) ProtoMessage()    {}
 func (*HelmChartsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{20}
+	return fileDescriptor_dd8723cfcc820480, []int{22}
 }
 func (m *HelmChartsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1532,7 +1740,7 @@ func (m *HelmChart) Reset()         { *m = HelmChart{} }
 func (m *HelmChart) String() string { return proto.CompactTextString(m) }
 func (*HelmChart) ProtoMessage()    {}
 func (*HelmChart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{21}
+	return fileDescriptor_dd8723cfcc820480, []int{23}
 }
 func (m *HelmChart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1586,7 +1794,7 @@ func (m *HelmChartsResponse) Reset()         { *m = HelmChartsResponse{} }
 func (m *HelmChartsResponse) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsResponse) ProtoMessage()    {}
 func (*HelmChartsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{22}
+	return fileDescriptor_dd8723cfcc820480, []int{24}
 }
 func (m *HelmChartsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1625,6 +1833,7 @@ func (m *HelmChartsResponse) GetItems() []*HelmChart {
 func init() {
 	proto.RegisterType((*ManifestRequest)(nil), "repository.ManifestRequest")
 	proto.RegisterMapType((map[string]bool)(nil), "repository.ManifestRequest.EnabledSourceTypesEntry")
+	proto.RegisterMapType((map[string]*v1alpha1.RefTarget)(nil), "repository.ManifestRequest.RefSourcesEntry")
 	proto.RegisterType((*ManifestRequestWithFiles)(nil), "repository.ManifestRequestWithFiles")
 	proto.RegisterType((*ManifestFileMetadata)(nil), "repository.ManifestFileMetadata")
 	proto.RegisterType((*ManifestFileChunk)(nil), "repository.ManifestFileChunk")
@@ -1647,6 +1856,9 @@ func init() {
 	proto.RegisterType((*HelmAppSpec)(nil), "repository.HelmAppSpec")
 	proto.RegisterType((*KustomizeAppSpec)(nil), "repository.KustomizeAppSpec")
 	proto.RegisterType((*DirectoryAppSpec)(nil), "repository.DirectoryAppSpec")
+	proto.RegisterType((*ParameterAnnouncement)(nil), "repository.ParameterAnnouncement")
+	proto.RegisterMapType((map[string]string)(nil), "repository.ParameterAnnouncement.MapEntry")
+	proto.RegisterType((*PluginAppSpec)(nil), "repository.PluginAppSpec")
 	proto.RegisterType((*HelmChartsRequest)(nil), "repository.HelmChartsRequest")
 	proto.RegisterType((*HelmChart)(nil), "repository.HelmChart")
 	proto.RegisterType((*HelmChartsResponse)(nil), "repository.HelmChartsResponse")
@@ -1657,114 +1869,129 @@ func init() {
 }
 
 var fileDescriptor_dd8723cfcc820480 = []byte{
-	// 1701 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xdd, 0x6e, 0x13, 0x47,
-	0x14, 0xce, 0xda, 0x8e, 0x63, 0x9f, 0x90, 0xc4, 0x19, 0x02, 0x2c, 0x2e, 0x44, 0x61, 0x4b, 0x51,
-	0x0a, 0x65, 0xad, 0x84, 0xb6, 0x20, 0x50, 0x91, 0x4c, 0x80, 0x84, 0x42, 0x48, 0xba, 0x89, 0x5a,
-	0xb5, 0x45, 0xad, 0x26, 0xeb, 0x89, 0x3d, 0xb5, 0x77, 0x77, 0xd8, 0xd9, 0x35, 0x32, 0x52, 0xa5,
-	0xf6, 0x15, 0x7a, 0xd1, 0x57, 0xe9, 0x65, 0xaf, 0x2a, 0x71, 0xd9, 0xf6, 0x09, 0x2a, 0x6e, 0x7a,
-	0xdf, 0x27, 0xa8, 0x66, 0xf6, 0xd7, 0xeb, 0x4d, 0xa0, 0x72, 0x08, 0xea, 0x0d, 0xcc, 0x9c, 0x39,
-	0x7f, 0x73, 0xf6, 0xfc, 0x7c, 0xe3, 0xc0, 0x25, 0x97, 0x30, 0x87, 0x13, 0xb7, 0x4f, 0xdc, 0x86,
-	0x5c, 0x52, 0xcf, 0x71, 0x07, 0xa9, 0xa5, 0xce, 0x5c, 0xc7, 0x73, 0x10, 0x24, 0x94, 0xba, 0xd6,
-	0xbd, 0xc1, 0x75, 0xea, 0x34, 0x30, 0xa3, 0x0d, 0xd3, 0x71, 0x49, 0xa3, 0xbf, 0xd2, 0x68, 0x13,
-	0x9b, 0xb8, 0xd8, 0x23, 0xad, 0x80, 0xbf, 0xfe, 0x61, 0xc2, 0x63, 0x61, 0xb3, 0x43, 0x6d, 0xe2,
-	0x0e, 0x1a, 0xac, 0xdb, 0x16, 0x04, 0xde, 0xb0, 0x88, 0x87, 0xf3, 0xa4, 0x1e, 0xb5, 0xa9, 0xd7,
-	0xf1, 0xf7, 0x74, 0xd3, 0xb1, 0x1a, 0xd8, 0x6d, 0x3b, 0xcc, 0x75, 0xbe, 0x93, 0x8b, 0xab, 0x66,
-	0xab, 0xd1, 0x5f, 0x4d, 0x14, 0x60, 0xc6, 0x7a, 0xd4, 0xc4, 0x1e, 0x75, 0xec, 0x46, 0x7f, 0x05,
-	0xf7, 0x58, 0x07, 0x8f, 0x68, 0xd3, 0x7e, 0x02, 0x98, 0xdb, 0xc4, 0x36, 0xdd, 0x27, 0xdc, 0x33,
-	0xc8, 0x53, 0x9f, 0x70, 0x0f, 0x3d, 0x81, 0x92, 0xb8, 0x89, 0xaa, 0x2c, 0x29, 0xcb, 0xd3, 0xab,
-	0x1b, 0x7a, 0x62, 0x50, 0x8f, 0x0c, 0xca, 0xc5, 0xb7, 0x66, 0x4b, 0xef, 0xaf, 0xea, 0xac, 0xdb,
-	0xd6, 0x85, 0x41, 0x3d, 0x65, 0x50, 0x8f, 0x0c, 0xea, 0x46, 0x1c, 0x13, 0x43, 0x6a, 0x45, 0x75,
-	0xa8, 0xb8, 0xa4, 0x4f, 0x39, 0x75, 0x6c, 0xb5, 0xb0, 0xa4, 0x2c, 0x57, 0x8d, 0x78, 0x8f, 0x54,
-	0x98, 0xb2, 0x9d, 0x35, 0x6c, 0x76, 0x88, 0x5a, 0x5c, 0x52, 0x96, 0x2b, 0x46, 0xb4, 0x45, 0x4b,
-	0x30, 0x8d, 0x19, 0x7b, 0x84, 0xf7, 0x48, 0xef, 0x21, 0x19, 0xa8, 0x25, 0x29, 0x98, 0x26, 0x09,
-	0x59, 0xcc, 0xd8, 0x63, 0x6c, 0x11, 0x75, 0x52, 0x9e, 0x46, 0x5b, 0x74, 0x0e, 0xaa, 0x36, 0xb6,
-	0x08, 0x67, 0xd8, 0x24, 0x6a, 0x45, 0x9e, 0x25, 0x04, 0xf4, 0x3d, 0xcc, 0xa7, 0x1c, 0xdf, 0x71,
-	0x7c, 0xd7, 0x24, 0x2a, 0xc8, 0xab, 0x6f, 0x8d, 0x77, 0xf5, 0x66, 0x56, 0xad, 0x31, 0x6a, 0x09,
-	0x7d, 0x03, 0x93, 0x32, 0x6d, 0xd4, 0xe9, 0xa5, 0xe2, 0x91, 0x46, 0x3b, 0x50, 0x8b, 0x6c, 0x98,
-	0x62, 0x3d, 0xbf, 0x4d, 0x6d, 0xae, 0x9e, 0x90, 0x16, 0x76, 0xc7, 0xb3, 0xb0, 0xe6, 0xd8, 0xfb,
-	0xb4, 0xbd, 0x89, 0x6d, 0xdc, 0x26, 0x16, 0xb1, 0xbd, 0x6d, 0xa9, 0xdc, 0x88, 0x8c, 0xa0, 0xe7,
-	0x50, 0xeb, 0xfa, 0xdc, 0x73, 0x2c, 0xfa, 0x9c, 0x6c, 0x31, 0x21, 0xcb, 0xd5, 0x19, 0x19, 0xcd,
-	0xc7, 0xe3, 0x19, 0x7e, 0x98, 0xd1, 0x6a, 0x8c, 0xd8, 0x11, 0x49, 0xd2, 0xf5, 0xf7, 0xc8, 0xe7,
-	0xc4, 0x95, 0xd9, 0x35, 0x1b, 0x24, 0x49, 0x8a, 0x14, 0xa4, 0x11, 0x0d, 0x77, 0x5c, 0x9d, 0x5b,
-	0x2a, 0x06, 0x69, 0x14, 0x93, 0xd0, 0x32, 0xcc, 0xf5, 0x89, 0x4b, 0xf7, 0x07, 0x3b, 0xb4, 0x6d,
-	0x63, 0xcf, 0x77, 0x89, 0x5a, 0x93, 0xa9, 0x98, 0x25, 0x23, 0x0b, 0x66, 0x3a, 0xa4, 0x67, 0x89,
-	0x90, 0xaf, 0xb9, 0xa4, 0xc5, 0xd5, 0x79, 0x19, 0xdf, 0xf5, 0xf1, 0xbf, 0xa0, 0x54, 0x67, 0x0c,
-	0x6b, 0x17, 0x8e, 0xd9, 0x8e, 0x11, 0x56, 0x4a, 0x50, 0x23, 0x28, 0x70, 0x2c, 0x43, 0x46, 0x97,
-	0x60, 0xd6, 0x73, 0xb1, 0xd9, 0xa5, 0x76, 0x7b, 0x93, 0x78, 0x1d, 0xa7, 0xa5, 0x9e, 0x94, 0x91,
-	0xc8, 0x50, 0x91, 0x09, 0x88, 0xd8, 0x78, 0xaf, 0x47, 0x5a, 0x41, 0x2e, 0xee, 0x0e, 0x18, 0xe1,
-	0xea, 0x82, 0xbc, 0xc5, 0x35, 0x3d, 0xd5, 0xde, 0x32, 0x0d, 0x42, 0xbf, 0x37, 0x22, 0x75, 0xcf,
-	0xf6, 0xdc, 0x81, 0x91, 0xa3, 0x0e, 0x75, 0x61, 0x5a, 0xdc, 0x23, 0x4a, 0x85, 0x53, 0x32, 0x15,
-	0x1e, 0x8c, 0x17, 0xa3, 0x8d, 0x44, 0xa1, 0x91, 0xd6, 0x5e, 0xbf, 0x07, 0x67, 0x0e, 0xf0, 0x0d,
-	0xd5, 0xa0, 0xd8, 0x25, 0x03, 0xd9, 0xd3, 0xaa, 0x86, 0x58, 0xa2, 0x05, 0x98, 0xec, 0xe3, 0x9e,
-	0x4f, 0x64, 0x17, 0xaa, 0x18, 0xc1, 0xe6, 0x66, 0xe1, 0x86, 0xa2, 0xfd, 0xa9, 0x80, 0x9a, 0xb9,
-	0xf3, 0x17, 0xd4, 0xeb, 0xdc, 0xa7, 0x3d, 0xc2, 0xd1, 0x75, 0x98, 0x72, 0x03, 0x5a, 0xd8, 0x20,
-	0xdf, 0x39, 0x24, 0x54, 0x1b, 0x13, 0x46, 0xc4, 0x8d, 0x6e, 0x43, 0x45, 0xf4, 0xf4, 0x16, 0xf6,
-	0xb0, 0x34, 0x39, 0xbd, 0xba, 0x94, 0x27, 0x29, 0xac, 0x6c, 0x86, 0x7c, 0x1b, 0x13, 0x46, 0x2c,
-	0x83, 0x3e, 0x82, 0x49, 0xb3, 0xe3, 0xdb, 0x5d, 0xd9, 0x1a, 0xa7, 0x57, 0xcf, 0x1f, 0x24, 0xbc,
-	0x26, 0x98, 0x36, 0x26, 0x8c, 0x80, 0xfb, 0x4e, 0x19, 0x4a, 0x0c, 0xbb, 0x9e, 0x76, 0x1f, 0x16,
-	0xf2, 0x4c, 0x88, 0x7e, 0x6c, 0x76, 0x88, 0xd9, 0xe5, 0xbe, 0x15, 0x46, 0x27, 0xde, 0x23, 0x04,
-	0x25, 0x4e, 0x9f, 0x07, 0x11, 0x2a, 0x1a, 0x72, 0xad, 0xbd, 0x0f, 0xf3, 0x23, 0xd6, 0x44, 0x2c,
-	0x03, 0xdf, 0x84, 0x86, 0x13, 0xa1, 0x69, 0xcd, 0x87, 0x53, 0xbb, 0x32, 0x16, 0x71, 0x53, 0x3a,
-	0x8e, 0x09, 0xa3, 0x6d, 0xc0, 0xe9, 0xac, 0x59, 0xce, 0x1c, 0x9b, 0x13, 0xa4, 0x03, 0x92, 0x55,
-	0x4c, 0x49, 0x2b, 0x39, 0x95, 0x5e, 0x54, 0x8c, 0x9c, 0x13, 0xed, 0xc7, 0x02, 0x9c, 0x36, 0x08,
-	0x77, 0x7a, 0x7d, 0x12, 0x95, 0xd8, 0xf1, 0x0c, 0xc9, 0xaf, 0xa1, 0x88, 0x19, 0x0b, 0xd3, 0xe4,
-	0xc1, 0x91, 0x8d, 0x21, 0x43, 0x68, 0x45, 0x1f, 0xc0, 0x3c, 0xb6, 0xf6, 0x68, 0xdb, 0x77, 0x7c,
-	0x1e, 0x5d, 0x4b, 0x26, 0x55, 0xd5, 0x18, 0x3d, 0xd0, 0x4c, 0x38, 0x33, 0x12, 0x82, 0x30, 0x9c,
-	0xe9, 0x51, 0xae, 0x64, 0x46, 0x79, 0xae, 0x91, 0xc2, 0x41, 0x46, 0x7e, 0x50, 0xa0, 0x12, 0x65,
-	0x15, 0xba, 0x0c, 0x35, 0xd3, 0xb1, 0x18, 0xed, 0x91, 0x56, 0x44, 0x0b, 0xd5, 0x8f, 0xd0, 0x45,
-	0x43, 0x77, 0xf1, 0xb3, 0x98, 0x2d, 0x30, 0x90, 0x26, 0x89, 0x1c, 0x66, 0xd8, 0xeb, 0x84, 0x17,
-	0x94, 0x6b, 0x41, 0xeb, 0x51, 0x9b, 0x48, 0x18, 0x31, 0x69, 0xc8, 0xb5, 0xf6, 0x4f, 0x01, 0x6a,
-	0x49, 0xf5, 0x86, 0x37, 0x5c, 0x85, 0xaa, 0x15, 0xd2, 0xb8, 0xaa, 0xc8, 0xce, 0xb8, 0x90, 0x5b,
-	0xee, 0x09, 0xdb, 0x30, 0xdc, 0x28, 0x64, 0xe1, 0xc6, 0x69, 0x28, 0x07, 0x50, 0x32, 0x74, 0x28,
-	0xdc, 0x0d, 0xc5, 0xb2, 0x94, 0x89, 0xe5, 0x22, 0x00, 0x8f, 0xfb, 0x99, 0x5a, 0x96, 0xa7, 0x29,
-	0x0a, 0xd2, 0xe0, 0x44, 0x30, 0x9c, 0x0c, 0xc2, 0xfd, 0x9e, 0xa7, 0x4e, 0x49, 0x8e, 0x21, 0x1a,
-	0xba, 0x08, 0x33, 0xa6, 0x63, 0x59, 0xd4, 0xdb, 0x24, 0x9c, 0xe3, 0x76, 0x04, 0x84, 0x86, 0x89,
-	0x42, 0x53, 0x40, 0x68, 0xfa, 0x5e, 0xc7, 0x71, 0xd5, 0x6a, 0xa0, 0x29, 0x4d, 0x43, 0x9f, 0x02,
-	0x04, 0xfb, 0xbb, 0xd8, 0x8b, 0x90, 0xd2, 0x65, 0x3d, 0xc0, 0xb2, 0x7a, 0x1a, 0xcb, 0x26, 0x79,
-	0x29, 0x7a, 0x98, 0xde, 0x5f, 0xd1, 0x77, 0xa9, 0x45, 0x8c, 0x94, 0xb4, 0xe6, 0xc0, 0xdc, 0x23,
-	0x2a, 0xe2, 0xbd, 0xcf, 0x8f, 0xa7, 0x37, 0x7c, 0x0c, 0x25, 0x61, 0x4c, 0x84, 0x7b, 0xcf, 0xc5,
-	0xb6, 0xd9, 0x21, 0xc1, 0x77, 0xad, 0x1a, 0xf1, 0x5e, 0x64, 0x87, 0x87, 0xdb, 0x5c, 0x2d, 0x48,
-	0xba, 0x5c, 0x6b, 0xbf, 0x14, 0x02, 0x4f, 0x9b, 0x8c, 0xf1, 0xb7, 0x8f, 0x93, 0xf3, 0x27, 0x77,
-	0x71, 0x74, 0x72, 0x67, 0x5c, 0xfe, 0x2f, 0x93, 0xfb, 0xa8, 0x86, 0xa9, 0x0f, 0x53, 0x4d, 0xc6,
-	0x84, 0x23, 0x68, 0x05, 0x4a, 0x98, 0xb1, 0xa8, 0x90, 0x86, 0x06, 0x58, 0xc8, 0x22, 0xfe, 0x0f,
-	0x5d, 0x92, 0xac, 0xf5, 0xeb, 0x50, 0x8d, 0x49, 0xaf, 0x32, 0x5b, 0x4d, 0x9b, 0xfd, 0xbb, 0x0c,
-	0x67, 0x45, 0x4c, 0x77, 0x64, 0x79, 0x35, 0x19, 0xbb, 0x4b, 0x3c, 0x4c, 0x7b, 0xfc, 0x33, 0x9f,
-	0xb8, 0x83, 0x37, 0xfc, 0xe9, 0xda, 0x50, 0x0e, 0xaa, 0x33, 0x6c, 0xe0, 0x47, 0xfe, 0x8e, 0x08,
-	0xd5, 0x27, 0x8f, 0x87, 0xe2, 0x9b, 0x79, 0x3c, 0xe4, 0x81, 0xf9, 0xd2, 0x31, 0x81, 0xf9, 0x83,
-	0xdf, 0x73, 0xa9, 0x57, 0x62, 0x79, 0xf8, 0x95, 0x98, 0x83, 0x91, 0xa7, 0x5e, 0x17, 0x23, 0x57,
-	0x72, 0x31, 0xb2, 0x95, 0x5b, 0x69, 0x55, 0x19, 0xee, 0x4f, 0xd2, 0x09, 0x7c, 0x60, 0xae, 0x8d,
-	0x83, 0x96, 0xe1, 0xff, 0x80, 0x96, 0xff, 0x50, 0x04, 0x48, 0x62, 0x4e, 0x72, 0xef, 0x78, 0x7c,
-	0x8a, 0x4e, 0x2a, 0x46, 0x56, 0xa0, 0x47, 0xae, 0xd1, 0x15, 0x28, 0x09, 0x27, 0x42, 0x14, 0x7b,
-	0x26, 0x1d, 0x43, 0xe1, 0x69, 0x93, 0xb1, 0x1d, 0x46, 0x4c, 0x43, 0x32, 0xa1, 0x9b, 0x50, 0x8d,
-	0x13, 0x23, 0xcc, 0xbc, 0x73, 0x69, 0x89, 0x38, 0x8f, 0x22, 0xb1, 0x84, 0x5d, 0xc8, 0xb6, 0xa8,
-	0x4b, 0x4c, 0x89, 0xf1, 0x26, 0x47, 0x65, 0xef, 0x46, 0x87, 0xb1, 0x6c, 0xcc, 0xae, 0xfd, 0xa6,
-	0xc0, 0x85, 0xe4, 0x8b, 0x46, 0xa9, 0x13, 0x61, 0xe6, 0xb7, 0x3f, 0x00, 0x2e, 0xc1, 0xac, 0x04,
-	0xe9, 0xc9, 0x23, 0x35, 0xf8, 0xbd, 0x24, 0x43, 0xd5, 0x7e, 0x2d, 0xc0, 0x74, 0x2a, 0xaa, 0xe2,
-	0x83, 0x08, 0x28, 0x12, 0x7d, 0x10, 0xb1, 0x16, 0xe8, 0x42, 0x7e, 0x4c, 0xf9, 0xbc, 0x91, 0x9d,
-	0xa4, 0x6a, 0xa4, 0x28, 0xa8, 0x0b, 0xc0, 0xb0, 0x8b, 0x2d, 0xe2, 0x11, 0x57, 0x94, 0xbf, 0x48,
-	0xfd, 0x87, 0xe3, 0xa7, 0xe4, 0x76, 0xa4, 0xd3, 0x48, 0xa9, 0x17, 0xf0, 0x48, 0x9a, 0xe6, 0x61,
-	0xd1, 0x87, 0x3b, 0xf4, 0x0c, 0x66, 0xf7, 0x69, 0x8f, 0x6c, 0x27, 0x8e, 0x94, 0xa5, 0x23, 0x5b,
-	0xe3, 0x3b, 0x72, 0x3f, 0xad, 0xd7, 0xc8, 0x98, 0xd1, 0x2e, 0x43, 0x2d, 0x9b, 0x64, 0xc2, 0x49,
-	0x6a, 0xe1, 0x76, 0x1c, 0xad, 0x70, 0xa7, 0x21, 0xa8, 0x65, 0x93, 0x4a, 0x7b, 0x0a, 0xf3, 0xc2,
-	0xc8, 0x5a, 0x07, 0xbb, 0xde, 0x31, 0x61, 0x9c, 0x5b, 0x50, 0x8d, 0x4d, 0xe6, 0x7e, 0xf1, 0x3a,
-	0x54, 0xfa, 0xd1, 0x4f, 0x20, 0x01, 0xc8, 0x89, 0xf7, 0x5a, 0x13, 0x50, 0xda, 0xdf, 0xb0, 0x90,
-	0xaf, 0xc0, 0x24, 0xf5, 0x88, 0x15, 0x8d, 0xee, 0x53, 0xd9, 0xaa, 0x95, 0xec, 0x46, 0xc0, 0xb3,
-	0xfa, 0xa2, 0x0c, 0xf3, 0x49, 0xf1, 0x88, 0x7f, 0xa9, 0x49, 0xd0, 0x16, 0xd4, 0xd6, 0xc3, 0x1f,
-	0x1f, 0x63, 0x6c, 0x7e, 0xd8, 0xd3, 0xb9, 0x7e, 0x2e, 0xff, 0x30, 0xf0, 0x48, 0x9b, 0x40, 0x26,
-	0x9c, 0xcd, 0x2a, 0x4c, 0x5e, 0xe9, 0x17, 0x0f, 0xd1, 0x1c, 0x73, 0xbd, 0xca, 0xc4, 0xb2, 0x82,
-	0xbe, 0x84, 0xd9, 0xe1, 0xb7, 0x24, 0xba, 0x90, 0x96, 0xc9, 0x7d, 0xde, 0xd6, 0xb5, 0xc3, 0x58,
-	0x62, 0xff, 0x9f, 0xc0, 0x5c, 0xe6, 0x61, 0x85, 0xb4, 0xe1, 0x89, 0x92, 0xf7, 0xf0, 0xac, 0xbf,
-	0x7b, 0x28, 0x4f, 0xac, 0xfd, 0x16, 0x54, 0x22, 0x64, 0x3d, 0x1c, 0xe6, 0x0c, 0xde, 0xae, 0xd7,
-	0x86, 0xf5, 0xed, 0x73, 0x6d, 0x02, 0xdd, 0x0e, 0x84, 0x05, 0xf2, 0x1a, 0x15, 0x4e, 0xe1, 0xc9,
-	0xfa, 0xc9, 0x1c, 0x0c, 0x27, 0xaf, 0x36, 0xb3, 0x4e, 0xbc, 0x64, 0x20, 0xa0, 0xf7, 0x5e, 0x6b,
-	0x54, 0xd6, 0xb5, 0x2c, 0xdb, 0xe8, 0x4c, 0xd1, 0x26, 0xd0, 0xcf, 0x0a, 0x9c, 0x5c, 0x27, 0x5e,
-	0xb6, 0x2b, 0xa3, 0xab, 0xf9, 0x46, 0x0e, 0xe8, 0xde, 0xf5, 0xc7, 0xe3, 0x96, 0xdd, 0xb0, 0x5a,
-	0x6d, 0x02, 0x6d, 0xcb, 0x6b, 0x27, 0xe5, 0x83, 0xce, 0xe7, 0xd6, 0x49, 0x1c, 0xbd, 0xc5, 0x83,
-	0x8e, 0xa3, 0xab, 0xde, 0x69, 0xbe, 0x78, 0xb9, 0xa8, 0xfc, 0xfe, 0x72, 0x51, 0xf9, 0xeb, 0xe5,
-	0xa2, 0xf2, 0xd5, 0xb5, 0x57, 0xfc, 0xf4, 0x9f, 0xfa, 0x3b, 0x05, 0x66, 0xd4, 0xec, 0x51, 0x62,
-	0x7b, 0x7b, 0x65, 0xf9, 0x43, 0xff, 0xb5, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb1, 0x57, 0x07,
-	0xbc, 0xc6, 0x18, 0x00, 0x00,
+	// 1950 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x19, 0xdb, 0x6e, 0x1b, 0xc7,
+	0x55, 0x24, 0x25, 0x8a, 0x3c, 0xb2, 0x24, 0x6a, 0x2c, 0xcb, 0x6b, 0xd6, 0x11, 0x94, 0x6d, 0x6a,
+	0xa8, 0x76, 0xb3, 0x84, 0xe4, 0x36, 0x09, 0x92, 0xb6, 0x00, 0x23, 0x5f, 0xd4, 0xda, 0xb2, 0xdd,
+	0xb1, 0xd0, 0x22, 0x6d, 0xda, 0x62, 0xb4, 0x1c, 0x2d, 0xc7, 0xdc, 0xcb, 0x78, 0x77, 0x96, 0x01,
+	0x0d, 0x14, 0x68, 0x81, 0xa2, 0x9f, 0x10, 0xf4, 0x4f, 0xfa, 0xd8, 0xa7, 0x02, 0x79, 0x2c, 0xfa,
+	0x03, 0x2d, 0xfc, 0xd2, 0xf7, 0x7e, 0x41, 0x31, 0x33, 0x7b, 0xe3, 0x72, 0x25, 0x27, 0xa0, 0xac,
+	0xbc, 0x48, 0x7b, 0xce, 0x9c, 0xdb, 0x9c, 0x39, 0x73, 0x2e, 0x43, 0xb8, 0x15, 0x52, 0x1e, 0x44,
+	0x34, 0x1c, 0xd3, 0xb0, 0xa7, 0x3e, 0x99, 0x08, 0xc2, 0x49, 0xe1, 0xd3, 0xe2, 0x61, 0x20, 0x02,
+	0x04, 0x39, 0xa6, 0x6b, 0x8e, 0x3e, 0x8a, 0x2c, 0x16, 0xf4, 0x08, 0x67, 0x3d, 0x3b, 0x08, 0x69,
+	0x6f, 0xbc, 0xd7, 0x73, 0xa8, 0x4f, 0x43, 0x22, 0xe8, 0x40, 0xd3, 0x77, 0x7f, 0x98, 0xd3, 0x78,
+	0xc4, 0x1e, 0x32, 0x9f, 0x86, 0x93, 0x1e, 0x1f, 0x39, 0x12, 0x11, 0xf5, 0x3c, 0x2a, 0x48, 0x15,
+	0xd7, 0x63, 0x87, 0x89, 0x61, 0x7c, 0x62, 0xd9, 0x81, 0xd7, 0x23, 0xa1, 0x13, 0xf0, 0x30, 0x78,
+	0xa1, 0x3e, 0xde, 0xb7, 0x07, 0xbd, 0xf1, 0x7e, 0x2e, 0x80, 0x70, 0xee, 0x32, 0x9b, 0x08, 0x16,
+	0xf8, 0xbd, 0xf1, 0x1e, 0x71, 0xf9, 0x90, 0xcc, 0x48, 0x33, 0xff, 0x7a, 0x05, 0xd6, 0x8f, 0x88,
+	0xcf, 0x4e, 0x69, 0x24, 0x30, 0x7d, 0x19, 0xd3, 0x48, 0xa0, 0xcf, 0x61, 0x51, 0xee, 0xc4, 0xa8,
+	0xed, 0xd4, 0x76, 0x57, 0xf6, 0x0f, 0xad, 0x5c, 0xa1, 0x95, 0x2a, 0x54, 0x1f, 0xbf, 0xb7, 0x07,
+	0xd6, 0x78, 0xdf, 0xe2, 0x23, 0xc7, 0x92, 0x0a, 0xad, 0x82, 0x42, 0x2b, 0x55, 0x68, 0xe1, 0xcc,
+	0x27, 0x58, 0x49, 0x45, 0x5d, 0x68, 0x85, 0x74, 0xcc, 0x22, 0x16, 0xf8, 0x46, 0x7d, 0xa7, 0xb6,
+	0xdb, 0xc6, 0x19, 0x8c, 0x0c, 0x58, 0xf6, 0x83, 0x03, 0x62, 0x0f, 0xa9, 0xd1, 0xd8, 0xa9, 0xed,
+	0xb6, 0x70, 0x0a, 0xa2, 0x1d, 0x58, 0x21, 0x9c, 0x3f, 0x26, 0x27, 0xd4, 0x7d, 0x44, 0x27, 0xc6,
+	0xa2, 0x62, 0x2c, 0xa2, 0x24, 0x2f, 0xe1, 0xfc, 0x09, 0xf1, 0xa8, 0xb1, 0xa4, 0x56, 0x53, 0x10,
+	0xdd, 0x84, 0xb6, 0x4f, 0x3c, 0x1a, 0x71, 0x62, 0x53, 0xa3, 0xa5, 0xd6, 0x72, 0x04, 0xfa, 0x03,
+	0x6c, 0x14, 0x0c, 0x7f, 0x1e, 0xc4, 0xa1, 0x4d, 0x0d, 0x50, 0x5b, 0x7f, 0x3a, 0xdf, 0xd6, 0xfb,
+	0x65, 0xb1, 0x78, 0x56, 0x13, 0xfa, 0x1d, 0x2c, 0xa9, 0xb0, 0x31, 0x56, 0x76, 0x1a, 0x17, 0xea,
+	0x6d, 0x2d, 0x16, 0xf9, 0xb0, 0xcc, 0xdd, 0xd8, 0x61, 0x7e, 0x64, 0x5c, 0x51, 0x1a, 0x8e, 0xe7,
+	0xd3, 0x70, 0x10, 0xf8, 0xa7, 0xcc, 0x39, 0x22, 0x3e, 0x71, 0xa8, 0x47, 0x7d, 0xf1, 0x4c, 0x09,
+	0xc7, 0xa9, 0x12, 0xf4, 0x0a, 0x3a, 0xa3, 0x38, 0x12, 0x81, 0xc7, 0x5e, 0xd1, 0xa7, 0x5c, 0xf2,
+	0x46, 0xc6, 0xaa, 0xf2, 0xe6, 0x93, 0xf9, 0x14, 0x3f, 0x2a, 0x49, 0xc5, 0x33, 0x7a, 0x64, 0x90,
+	0x8c, 0xe2, 0x13, 0xfa, 0x4b, 0x1a, 0xaa, 0xe8, 0x5a, 0xd3, 0x41, 0x52, 0x40, 0xe9, 0x30, 0x62,
+	0x09, 0x14, 0x19, 0xeb, 0x3b, 0x0d, 0x1d, 0x46, 0x19, 0x0a, 0xed, 0xc2, 0xfa, 0x98, 0x86, 0xec,
+	0x74, 0xf2, 0x9c, 0x39, 0x3e, 0x11, 0x71, 0x48, 0x8d, 0x8e, 0x0a, 0xc5, 0x32, 0x1a, 0x79, 0xb0,
+	0x3a, 0xa4, 0xae, 0x27, 0x5d, 0x7e, 0x10, 0xd2, 0x41, 0x64, 0x6c, 0x28, 0xff, 0x3e, 0x9c, 0xff,
+	0x04, 0x95, 0x38, 0x3c, 0x2d, 0x5d, 0x1a, 0xe6, 0x07, 0x38, 0xb9, 0x29, 0xfa, 0x8e, 0x20, 0x6d,
+	0x58, 0x09, 0x8d, 0x6e, 0xc1, 0x9a, 0x08, 0x89, 0x3d, 0x62, 0xbe, 0x73, 0x44, 0xc5, 0x30, 0x18,
+	0x18, 0x57, 0x95, 0x27, 0x4a, 0x58, 0x64, 0x03, 0xa2, 0x3e, 0x39, 0x71, 0xe9, 0x40, 0xc7, 0xe2,
+	0xf1, 0x84, 0xd3, 0xc8, 0xd8, 0x54, 0xbb, 0xb8, 0x6b, 0x15, 0xd2, 0x5b, 0x29, 0x41, 0x58, 0xf7,
+	0x67, 0xb8, 0xee, 0xfb, 0x22, 0x9c, 0xe0, 0x0a, 0x71, 0x68, 0x04, 0x2b, 0x72, 0x1f, 0x69, 0x28,
+	0x5c, 0x53, 0xa1, 0xf0, 0xb3, 0xf9, 0x7c, 0x74, 0x98, 0x0b, 0xc4, 0x45, 0xe9, 0xc8, 0x02, 0x34,
+	0x24, 0xd1, 0x51, 0xec, 0x0a, 0xc6, 0x5d, 0xaa, 0xcd, 0x88, 0x8c, 0x2d, 0xe5, 0xa6, 0x8a, 0x15,
+	0xf4, 0x08, 0x20, 0xa4, 0xa7, 0x29, 0xdd, 0x75, 0xb5, 0xf3, 0x3b, 0xe7, 0xed, 0x1c, 0x67, 0xd4,
+	0x7a, 0xc7, 0x05, 0xf6, 0xee, 0x7d, 0xb8, 0x7e, 0x86, 0x63, 0x50, 0x07, 0x1a, 0x23, 0x3a, 0x51,
+	0x09, 0xb5, 0x8d, 0xe5, 0x27, 0xda, 0x84, 0xa5, 0x31, 0x71, 0x63, 0xaa, 0x52, 0x60, 0x0b, 0x6b,
+	0xe0, 0xe3, 0xfa, 0x47, 0xb5, 0xee, 0x5f, 0x6a, 0xb0, 0x5e, 0x52, 0x53, 0xc1, 0xff, 0xdb, 0x22,
+	0xff, 0x05, 0x04, 0xdd, 0xe9, 0x31, 0x09, 0x1d, 0x2a, 0x0a, 0x86, 0x98, 0xff, 0xaa, 0x81, 0x51,
+	0xda, 0xff, 0xaf, 0x98, 0x18, 0x3e, 0x60, 0x2e, 0x8d, 0xd0, 0x87, 0xb0, 0x1c, 0x6a, 0x5c, 0x52,
+	0x26, 0xbe, 0x73, 0x8e, 0xdb, 0x0e, 0x17, 0x70, 0x4a, 0x8d, 0x7e, 0x0a, 0x2d, 0x59, 0xd9, 0x06,
+	0x44, 0x90, 0xc4, 0xf6, 0x9d, 0x2a, 0x4e, 0xa9, 0xe5, 0x28, 0xa1, 0x3b, 0x5c, 0xc0, 0x19, 0x0f,
+	0xfa, 0x11, 0x2c, 0xd9, 0xc3, 0xd8, 0x1f, 0xa9, 0x02, 0xb1, 0xb2, 0xff, 0xce, 0x59, 0xcc, 0x07,
+	0x92, 0xe8, 0x70, 0x01, 0x6b, 0xea, 0x4f, 0x9b, 0xb0, 0xc8, 0x49, 0x28, 0xcc, 0x07, 0xb0, 0x59,
+	0xa5, 0x42, 0x56, 0x25, 0x7b, 0x48, 0xed, 0x51, 0x14, 0x7b, 0x89, 0x9b, 0x33, 0x18, 0x21, 0x58,
+	0x8c, 0xd8, 0x2b, 0xed, 0xea, 0x06, 0x56, 0xdf, 0xe6, 0xf7, 0x61, 0x63, 0x46, 0x9b, 0x3c, 0x54,
+	0x6d, 0x9b, 0x94, 0x70, 0x25, 0x51, 0x6d, 0xc6, 0x70, 0xed, 0x58, 0xf9, 0x22, 0x4b, 0xcd, 0x97,
+	0x51, 0x67, 0xcd, 0x43, 0xd8, 0x2a, 0xab, 0x8d, 0x78, 0xe0, 0x47, 0x54, 0xde, 0x12, 0x95, 0xcb,
+	0x18, 0x1d, 0xe4, 0xab, 0xca, 0x8a, 0x16, 0xae, 0x58, 0x31, 0xff, 0x54, 0x87, 0x2d, 0x4c, 0xa3,
+	0xc0, 0x1d, 0xd3, 0x34, 0xd1, 0x5c, 0x4e, 0xab, 0xf0, 0x1b, 0x68, 0x10, 0xce, 0x8d, 0xfa, 0x45,
+	0xe4, 0x8c, 0x42, 0x31, 0xc6, 0x52, 0x2a, 0xfa, 0x01, 0x6c, 0x10, 0xef, 0x84, 0x39, 0x71, 0x10,
+	0x47, 0xe9, 0xb6, 0x54, 0x50, 0xb5, 0xf1, 0xec, 0x82, 0x69, 0xc3, 0xf5, 0x19, 0x17, 0x24, 0xee,
+	0x2c, 0x36, 0x34, 0xb5, 0x52, 0x43, 0x53, 0xa9, 0xa4, 0x7e, 0x96, 0x92, 0x3f, 0xd6, 0xa0, 0x95,
+	0x46, 0x15, 0xba, 0x0d, 0x1d, 0x3b, 0xf0, 0x38, 0x73, 0xe9, 0x20, 0xc5, 0x25, 0xe2, 0x67, 0xf0,
+	0xb2, 0xac, 0x85, 0xe4, 0x8b, 0x8c, 0x4c, 0x2b, 0x28, 0xa2, 0x64, 0x0c, 0x73, 0x22, 0x86, 0xc9,
+	0x06, 0xd5, 0xb7, 0xc4, 0xb9, 0xcc, 0xa7, 0xaa, 0x99, 0x5a, 0xc2, 0xea, 0xdb, 0xfc, 0x5f, 0x1d,
+	0x3a, 0xf9, 0xed, 0x4d, 0x76, 0xb8, 0x0f, 0x6d, 0x2f, 0xc1, 0x45, 0x46, 0x4d, 0x65, 0xc9, 0xcd,
+	0xca, 0xeb, 0x9e, 0x93, 0x4d, 0x37, 0x5d, 0xf5, 0x72, 0xd3, 0xb5, 0x05, 0x4d, 0xdd, 0x50, 0x27,
+	0x06, 0x25, 0xd0, 0x94, 0x2f, 0x17, 0x4b, 0xbe, 0xdc, 0x06, 0x88, 0xb2, 0xc4, 0x6a, 0x34, 0xd5,
+	0x6a, 0x01, 0x83, 0x4c, 0xb8, 0xa2, 0x4b, 0x34, 0xa6, 0x51, 0xec, 0x0a, 0x63, 0x59, 0x51, 0x4c,
+	0xe1, 0xd0, 0x7b, 0xb0, 0x6a, 0x07, 0x9e, 0xc7, 0xc4, 0x11, 0x8d, 0x22, 0xe2, 0xa4, 0xed, 0xe0,
+	0x34, 0x52, 0x4a, 0xd2, 0x88, 0x7e, 0x2c, 0x86, 0x41, 0x68, 0xb4, 0xb5, 0xa4, 0x22, 0x0e, 0xfd,
+	0x1c, 0x40, 0xc3, 0xf7, 0x88, 0x48, 0xfb, 0xc5, 0xdb, 0x96, 0xee, 0xe8, 0xad, 0x62, 0x47, 0x9f,
+	0xc7, 0xa5, 0xcc, 0x61, 0xd6, 0x78, 0xcf, 0x3a, 0x66, 0x1e, 0xc5, 0x05, 0x6e, 0x33, 0x80, 0xf5,
+	0xc7, 0x4c, 0xfa, 0xfb, 0x34, 0xba, 0x9c, 0xdc, 0xf0, 0x01, 0x2c, 0x4a, 0x65, 0xd2, 0xdd, 0x27,
+	0x21, 0xf1, 0xed, 0x21, 0xd5, 0xe7, 0xda, 0xc6, 0x19, 0x2c, 0xa3, 0x43, 0x10, 0x27, 0x32, 0xea,
+	0x0a, 0xaf, 0xbe, 0xcd, 0xbf, 0xd5, 0xb5, 0xa5, 0x7d, 0xce, 0xa3, 0x6f, 0x7f, 0x5a, 0xa8, 0xee,
+	0x5f, 0x1a, 0xb3, 0xfd, 0x4b, 0xc9, 0xe4, 0x6f, 0xd2, 0xbf, 0x5c, 0x50, 0x55, 0x37, 0x63, 0x58,
+	0xee, 0x73, 0x2e, 0x0d, 0x41, 0x7b, 0xb0, 0x48, 0x38, 0x4f, 0x2f, 0xd2, 0x54, 0x01, 0x4b, 0x48,
+	0xe4, 0xff, 0xc4, 0x24, 0x45, 0xda, 0xfd, 0x10, 0xda, 0x19, 0xea, 0x4d, 0x6a, 0xdb, 0x45, 0xb5,
+	0xff, 0x6d, 0xc2, 0x0d, 0xe9, 0xd3, 0xe7, 0xea, 0x7a, 0xf5, 0x39, 0xbf, 0x47, 0x05, 0x61, 0x6e,
+	0xf4, 0x8b, 0x98, 0x86, 0x93, 0xb7, 0x7c, 0x74, 0x0e, 0x34, 0xf5, 0xed, 0x34, 0xea, 0x6f, 0x67,
+	0x9a, 0x6a, 0x46, 0xa5, 0x11, 0xaa, 0xf1, 0x76, 0x46, 0xa8, 0xaa, 0x91, 0x66, 0xf1, 0x92, 0x46,
+	0x9a, 0xb3, 0xa7, 0xda, 0xc2, 0xac, 0xdc, 0x9c, 0x9e, 0x95, 0x2b, 0x26, 0x85, 0xe5, 0xaf, 0x3b,
+	0x29, 0xb4, 0x2a, 0x27, 0x05, 0xaf, 0xf2, 0xa6, 0xb5, 0x95, 0xbb, 0x7f, 0x52, 0x0c, 0xe0, 0x33,
+	0x63, 0x6d, 0x9e, 0x99, 0x01, 0xde, 0xe6, 0xcc, 0x70, 0x51, 0x17, 0xfc, 0xcf, 0xaa, 0x49, 0xe2,
+	0x41, 0xbe, 0xef, 0xac, 0x7c, 0xca, 0x4c, 0x2a, 0x4b, 0x96, 0x96, 0xa3, 0xbe, 0xd1, 0x1d, 0x58,
+	0x94, 0x46, 0x24, 0x5d, 0xec, 0xf5, 0xa2, 0x0f, 0xa5, 0xa5, 0x7d, 0xce, 0x9f, 0x73, 0x6a, 0x63,
+	0x45, 0x84, 0x3e, 0x86, 0x76, 0x16, 0x18, 0x49, 0xe4, 0xdd, 0x2c, 0x72, 0x64, 0x71, 0x94, 0xb2,
+	0xe5, 0xe4, 0x92, 0x77, 0xc0, 0x42, 0x6a, 0x4b, 0x42, 0x63, 0x69, 0x96, 0xf7, 0x5e, 0xba, 0x98,
+	0xf1, 0x66, 0xe4, 0x68, 0x0f, 0x9a, 0x7a, 0xac, 0x57, 0x11, 0xb6, 0xb2, 0x7f, 0xa3, 0xc8, 0xa8,
+	0x07, 0xff, 0x94, 0x2b, 0x21, 0x34, 0xff, 0x51, 0x83, 0x77, 0xf3, 0x20, 0x48, 0xa3, 0x2d, 0x6d,
+	0xb3, 0xbf, 0xfd, 0x9a, 0x71, 0x0b, 0xd6, 0x54, 0x5f, 0x9f, 0x4f, 0xf7, 0xfa, 0xa1, 0xa9, 0x84,
+	0x35, 0xff, 0x5e, 0x87, 0x95, 0xc2, 0x41, 0xc8, 0x33, 0x94, 0xdd, 0x4b, 0x7a, 0x86, 0xf2, 0x5b,
+	0x36, 0x24, 0xea, 0xfc, 0xd5, 0x44, 0xa4, 0x92, 0x4f, 0x1b, 0x17, 0x30, 0x68, 0x04, 0xc0, 0x49,
+	0x48, 0x3c, 0x2a, 0x68, 0x28, 0x33, 0x86, 0xbc, 0x2d, 0x8f, 0xe6, 0x8f, 0xe2, 0x67, 0xa9, 0x4c,
+	0x5c, 0x10, 0x2f, 0x3b, 0x2a, 0xa5, 0x3a, 0x4a, 0xf2, 0x44, 0x02, 0xa1, 0x2f, 0x60, 0xed, 0x94,
+	0xb9, 0xf4, 0x59, 0x6e, 0x48, 0x73, 0xa7, 0x31, 0x7f, 0x36, 0x96, 0x86, 0x3c, 0x28, 0xca, 0xc5,
+	0x25, 0x35, 0xe6, 0x97, 0x35, 0xe8, 0x94, 0x03, 0x53, 0x5a, 0xc9, 0x3c, 0xe2, 0x64, 0xee, 0x4a,
+	0x20, 0xf4, 0x42, 0x1e, 0x99, 0x52, 0x93, 0x3a, 0xea, 0xa2, 0x52, 0x2b, 0xd6, 0x62, 0x71, 0x26,
+	0xdf, 0x44, 0xd0, 0x29, 0x07, 0xbd, 0xf9, 0xef, 0x3a, 0x5c, 0xcb, 0x6c, 0xef, 0xfb, 0x7e, 0x10,
+	0xfb, 0xb6, 0x7a, 0xde, 0xaa, 0x3c, 0xf8, 0x4d, 0x58, 0x12, 0x4c, 0xb8, 0x59, 0xbd, 0x55, 0x80,
+	0x4c, 0xc8, 0x22, 0x08, 0xe4, 0x03, 0x43, 0xd2, 0xd4, 0xa6, 0xa0, 0x0e, 0xc8, 0x97, 0x31, 0x0b,
+	0xe9, 0x40, 0x5d, 0xdf, 0x16, 0xce, 0x60, 0xb9, 0xc6, 0x04, 0xf5, 0x54, 0x4f, 0xab, 0x4f, 0x2e,
+	0x83, 0x55, 0xb0, 0x06, 0xae, 0x4b, 0x6d, 0xb9, 0xb7, 0x42, 0xd7, 0x5b, 0xc2, 0xaa, 0x6e, 0x5a,
+	0x84, 0xcc, 0x77, 0x92, 0x9e, 0x37, 0x81, 0xa4, 0x9d, 0x24, 0x0c, 0xc9, 0xc4, 0x68, 0x29, 0x67,
+	0x6b, 0x00, 0xfd, 0x18, 0x1a, 0x1e, 0xe1, 0x49, 0xf6, 0xbe, 0x3d, 0x75, 0xa5, 0xab, 0x3c, 0x60,
+	0x1d, 0x11, 0xae, 0x53, 0xb5, 0x64, 0xeb, 0x7e, 0x00, 0xad, 0x14, 0xf1, 0x8d, 0x3a, 0x91, 0x17,
+	0xb0, 0x3a, 0x95, 0x31, 0xd0, 0x67, 0xb0, 0x95, 0x87, 0x6f, 0x51, 0x61, 0xd2, 0x18, 0xbd, 0xfb,
+	0x46, 0xcb, 0xf0, 0x19, 0x02, 0xcc, 0x97, 0xb0, 0x21, 0xe3, 0xf3, 0x60, 0x48, 0x42, 0x71, 0x49,
+	0x1d, 0xf5, 0x27, 0xd0, 0xce, 0x54, 0x56, 0xc6, 0x4c, 0x17, 0x5a, 0xe3, 0xf4, 0xd9, 0x51, 0xb7,
+	0xd4, 0x19, 0x6c, 0xf6, 0x01, 0x15, 0xed, 0x4d, 0xca, 0xc6, 0x1d, 0x58, 0x92, 0x91, 0x90, 0x36,
+	0x8a, 0xd7, 0xca, 0x35, 0x42, 0x91, 0x63, 0x4d, 0xb3, 0xff, 0x55, 0x13, 0x36, 0xf2, 0xbc, 0x2b,
+	0xff, 0x32, 0x9b, 0xa2, 0xa7, 0xd0, 0x79, 0x98, 0x3c, 0xf8, 0x67, 0x93, 0xe0, 0x79, 0x0f, 0x35,
+	0xdd, 0x9b, 0xd5, 0x8b, 0xda, 0x22, 0x73, 0x01, 0xd9, 0x70, 0xa3, 0x2c, 0x30, 0x7f, 0x13, 0x7a,
+	0xef, 0x1c, 0xc9, 0x19, 0xd5, 0x9b, 0x54, 0xec, 0xd6, 0xd0, 0x67, 0xb0, 0x36, 0xfd, 0x72, 0x81,
+	0xa6, 0x62, 0xa1, 0xf2, 0x31, 0xa5, 0x6b, 0x9e, 0x47, 0x92, 0xd9, 0xff, 0x39, 0xac, 0x97, 0xc6,
+	0x78, 0x64, 0x4e, 0xf7, 0x2f, 0x55, 0xcf, 0x1c, 0xdd, 0xef, 0x9e, 0x4b, 0x93, 0x49, 0xff, 0x04,
+	0x5a, 0xe9, 0x1c, 0x37, 0xed, 0xe6, 0xd2, 0x74, 0xd7, 0xed, 0x4c, 0xcb, 0x3b, 0x8d, 0xcc, 0x05,
+	0xf9, 0x30, 0x96, 0xce, 0x29, 0xb3, 0xcc, 0x85, 0xe9, 0xa5, 0x7b, 0xb5, 0x62, 0x62, 0x50, 0x5b,
+	0x5b, 0x7d, 0x48, 0x45, 0xde, 0x7e, 0xa0, 0xef, 0x7d, 0xad, 0xc6, 0xac, 0x6b, 0x96, 0xc9, 0x66,
+	0x3b, 0x18, 0x73, 0x01, 0x7d, 0x59, 0x83, 0xab, 0x0f, 0xa9, 0x28, 0x17, 0x74, 0xf4, 0x7e, 0xb5,
+	0x92, 0x33, 0x0a, 0x7f, 0xf7, 0xc9, 0xbc, 0xd7, 0x6e, 0x5a, 0xac, 0xb9, 0x80, 0x9e, 0xa9, 0x6d,
+	0xe7, 0xd7, 0x07, 0xbd, 0x53, 0x79, 0x4f, 0x32, 0xef, 0x6d, 0x9f, 0xb5, 0x9c, 0x6e, 0xf5, 0xd3,
+	0xfe, 0x57, 0xaf, 0xb7, 0x6b, 0xff, 0x7c, 0xbd, 0x5d, 0xfb, 0xcf, 0xeb, 0xed, 0xda, 0xaf, 0xef,
+	0xbe, 0xe1, 0xe7, 0xb6, 0xc2, 0x6f, 0x83, 0x84, 0x33, 0xdb, 0x65, 0xd4, 0x17, 0x27, 0x4d, 0xf5,
+	0xe3, 0xda, 0xdd, 0xff, 0x0f, 0x00, 0x99, 0x59, 0x91, 0x9b, 0x3a, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2212,6 +2439,46 @@ func (m *ManifestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RefSources) > 0 {
+		for k := range m.RefSources {
+			v := m.RefSources[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRepository(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRepository(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRepository(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if m.HasMultipleSources {
+		i--
+		if m.HasMultipleSources {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
 	if m.HelmOptions != nil {
 		{
 			size, err := m.HelmOptions.MarshalToSizedBuffer(dAtA[:i])
@@ -3289,6 +3556,18 @@ func (m *RepoAppDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Plugin != nil {
+		{
+			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Directory != nil {
 		{
 			size, err := m.Directory.MarshalToSizedBuffer(dAtA[:i])
@@ -3493,6 +3772,20 @@ func (m *KustomizeAppSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Replicas) > 0 {
+		for iNdEx := len(m.Replicas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Replicas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Images) > 0 {
 		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Images[iNdEx])
@@ -3532,7 +3825,7 @@ func (m *DirectoryAppSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *HelmChartsRequest) Marshal() (dAtA []byte, err error) {
+func (m *ParameterAnnouncement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3542,12 +3835,12 @@ func (m *HelmChartsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HelmChartsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ParameterAnnouncement) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HelmChartsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ParameterAnnouncement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3556,22 +3849,90 @@ func (m *HelmChartsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Repo != nil {
-		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRepository(dAtA, i, uint64(size))
+	if len(m.Map) > 0 {
+		for k := range m.Map {
+			v := m.Map[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRepository(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRepository(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRepository(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Array) > 0 {
+		for iNdEx := len(m.Array) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Array[iNdEx])
+			copy(dAtA[i:], m.Array[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Array[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.String_) > 0 {
+		i -= len(m.String_)
+		copy(dAtA[i:], m.String_)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.String_)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CollectionType) > 0 {
+		i -= len(m.CollectionType)
+		copy(dAtA[i:], m.CollectionType)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.CollectionType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ItemType) > 0 {
+		i -= len(m.ItemType)
+		copy(dAtA[i:], m.ItemType)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.ItemType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Required {
+		i--
+		if m.Required {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Tooltip) > 0 {
+		i -= len(m.Tooltip)
+		copy(dAtA[i:], m.Tooltip)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Tooltip)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *HelmChart) Marshal() (dAtA []byte, err error) {
+func (m *PluginAppSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3581,12 +3942,12 @@ func (m *HelmChart) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HelmChart) MarshalTo(dAtA []byte) (int, error) {
+func (m *PluginAppSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HelmChart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PluginAppSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3595,26 +3956,106 @@ func (m *HelmChart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Versions) > 0 {
-		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Versions[iNdEx])
-			copy(dAtA[i:], m.Versions[iNdEx])
-			i = encodeVarintRepository(dAtA, i, uint64(len(m.Versions[iNdEx])))
+	if len(m.ParametersAnnouncement) > 0 {
+		for iNdEx := len(m.ParametersAnnouncement) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParametersAnnouncement[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *HelmChartsResponse) Marshal() (dAtA []byte, err error) {
+func (m *HelmChartsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmChartsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HelmChartsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HelmChart) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmChart) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HelmChart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Versions[iNdEx])
+			copy(dAtA[i:], m.Versions[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Versions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HelmChartsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3753,6 +4194,22 @@ func (m *ManifestRequest) Size() (n int) {
 		l = m.HelmOptions.Size()
 		n += 2 + l + sovRepository(uint64(l))
 	}
+	if m.HasMultipleSources {
+		n += 3
+	}
+	if len(m.RefSources) > 0 {
+		for k, v := range m.RefSources {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRepository(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRepository(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovRepository(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4163,6 +4620,10 @@ func (m *RepoAppDetailsResponse) Size() (n int) {
 		l = m.Directory.Size()
 		n += 1 + l + sovRepository(uint64(l))
 	}
+	if m.Plugin != nil {
+		l = m.Plugin.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4242,6 +4703,12 @@ func (m *KustomizeAppSpec) Size() (n int) {
 			n += 1 + l + sovRepository(uint64(l))
 		}
 	}
+	if len(m.Replicas) > 0 {
+		for _, e := range m.Replicas {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4260,6 +4727,77 @@ func (m *DirectoryAppSpec) Size() (n int) {
 	return n
 }
 
+func (m *ParameterAnnouncement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Tooltip)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Required {
+		n += 2
+	}
+	l = len(m.ItemType)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.CollectionType)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.String_)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.Array) > 0 {
+		for _, s := range m.Array {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.Map) > 0 {
+		for k, v := range m.Map {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRepository(uint64(len(k))) + 1 + len(v) + sovRepository(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRepository(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PluginAppSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ParametersAnnouncement) > 0 {
+		for _, e := range m.ParametersAnnouncement {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *HelmChartsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4996,62 +5534,11 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ManifestRequestWithFiles) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRepository
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ManifestRequestWithFiles: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManifestRequestWithFiles: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMultipleSources", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -5061,30 +5548,15 @@ func (m *ManifestRequestWithFiles) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &ManifestRequest{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Part = &ManifestRequestWithFiles_Request{v}
-			iNdEx = postIndex
-		case 2:
+			m.HasMultipleSources = bool(v != 0)
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefSources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5111,32 +5583,247 @@ func (m *ManifestRequestWithFiles) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ManifestFileMetadata{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Part = &ManifestRequestWithFiles_Metadata{v}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.RefSources == nil {
+				m.RefSources = make(map[string]*v1alpha1.RefTarget)
 			}
-			if msglen < 0 {
+			var mapkey string
+			var mapvalue *v1alpha1.RefTarget
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v1alpha1.RefTarget{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RefSources[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManifestRequestWithFiles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManifestRequestWithFiles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManifestRequestWithFiles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ManifestRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Part = &ManifestRequestWithFiles_Request{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ManifestFileMetadata{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Part = &ManifestRequestWithFiles_Metadata{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
 			postIndex := iNdEx + msglen
@@ -7531,17 +8218,53 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plugin == nil {
+				m.Plugin = &PluginAppSpec{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
@@ -7968,6 +8691,40 @@ func (m *KustomizeAppSpec) Unmarshal(dAtA []byte) error {
 			}
 			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replicas = append(m.Replicas, &v1alpha1.KustomizeReplica{})
+			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -8041,6 +8798,513 @@ func (m *DirectoryAppSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ParameterAnnouncement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParameterAnnouncement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParameterAnnouncement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tooltip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tooltip = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Required = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.String_ = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Array", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Array = append(m.Array, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Map == nil {
+				m.Map = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Map[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PluginAppSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PluginAppSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PluginAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParametersAnnouncement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParametersAnnouncement = append(m.ParametersAnnouncement, &ParameterAnnouncement{})
+			if err := m.ParametersAnnouncement[len(m.ParametersAnnouncement)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *HelmChartsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -15,6 +15,7 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -62,6 +63,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/io"
 	pathutil "github.com/argoproj/argo-cd/v2/util/io/path"
 	"github.com/argoproj/argo-cd/v2/util/kustomize"
+	"github.com/argoproj/argo-cd/v2/util/oci"
 	"github.com/argoproj/argo-cd/v2/util/text"
 )
 
@@ -91,6 +93,7 @@ type Service struct {
 	resourceTracking          argo.ResourceTracking
 	newGitClient              func(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, proxy string, opts ...git.ClientOpts) (git.Client, error)
 	newHelmClient             func(repoURL string, creds helm.Creds, enableOci bool, proxy string, opts ...helm.ClientOpts) helm.Client
+	newOCIClient              func(repoURL string, creds oci.Creds, proxy string, opts ...oci.ClientOpts) oci.Client
 	initConstants             RepoServerInitConstants
 	// now is usually just time.Now, but may be replaced by unit tests for testing purposes
 	now func() time.Time
@@ -126,6 +129,7 @@ func NewService(metricsServer *metrics.MetricsServer, cache *reposervercache.Cac
 		newHelmClient: func(repoURL string, creds helm.Creds, enableOci bool, proxy string, opts ...helm.ClientOpts) helm.Client {
 			return helm.NewClientWithLock(repoURL, creds, sync.NewKeyLock(), enableOci, proxy, opts...)
 		},
+		newOCIClient:       oci.NewClient,
 		initConstants:      initConstants,
 		now:                time.Now,
 		gitCredsStore:      gitCredsStore,
@@ -281,6 +285,7 @@ func (s *Service) runRepoOperation(
 
 	var gitClient git.Client
 	var helmClient helm.Client
+	var ociClient oci.Client
 	var err error
 	revision = textutils.FirstNonEmpty(revision, source.TargetRevision)
 	if source.IsHelm() {
@@ -288,6 +293,11 @@ func (s *Service) runRepoOperation(
 		if err != nil {
 			return err
 		}
+	} else if source.IsOCI() {
+		ociClient, revision, err = s.newOCIClientResolveRevision(ctx, repo, revision)
+		if err != nil {
+			return err
+		}
 	} else {
 		gitClient, revision, err = s.newClientResolveRevision(repo, revision, git.WithCache(s.cache, !settings.noRevisionCache && !settings.noCache))
 		if err != nil {
@@ -297,7 +307,7 @@ func (s *Service) runRepoOperation(
 
 	cacheKey := revision
 
-	if source.Kustomize != nil && len(source.Kustomize.Components) > 0 {
+	if source.Kustomize != nil && len(source.Kustomize.Components) > 0 && gitClient != nil {
 		cacheKey, err = s.getCacheKeyWithKustomizeComponents(
 			revision,
 			repo,
@@ -366,6 +376,44 @@ func (s *Service) runRepoOperation(
 		return operation(chartPath, revision, revision, func() (*operationContext, error) {
 			return &operationContext{chartPath, ""}, nil
 		})
+	} else if source.IsOCI() {
+		artifactPath, closer, err := ociClient.Extract(ctx, revision)
+		if err != nil {
+			return err
+		}
+		defer io.Close(closer)
+		if !s.initConstants.AllowOutOfBoundsSymlinks {
+			err := argopath.CheckOutOfBoundsSymlinks(artifactPath)
+			if err != nil {
+				oobError := &argopath.OutOfBoundsSymlinkError{}
+				if errors.As(err, &oobError) {
+					log.WithFields(log.Fields{
+						common.SecurityField: common.SecurityHigh,
+						"repo":               repo.Repo,
+						"revision":           revision,
+						"file":               oobError.File,
+					}).Warn("OCI artifact contains out-of-bounds symlink")
+					return fmt.Errorf("OCI artifact contains out-of-bounds symlinks. file: %s", oobError.File)
+				} else {
+					return err
+				}
+			}
+		}
+		// The revision is the digest of the artifact, which identifies its content like a commit SHA
+		return operation(artifactPath, revision, cacheKey, func() (*operationContext, error) {
+			var signatures string
+			if verifyCommit {
+				signatures, err = getOCISignatures(ctx, ociClient, revision)
+				if err != nil {
+					return nil, err
+				}
+			}
+			appPath, err := argopath.Path(artifactPath, source.Path)
+			if err != nil {
+				return nil, err
+			}
+			return &operationContext{appPath, signatures}, nil
+		})
 	} else {
 		closer, err := s.repoLock.Lock(gitClient.Root(), revision, settings.allowConcurrent, func() (goio.Closer, error) {
 			return s.checkoutRevision(gitClient, revision, s.initConstants.SubmoduleEnabled)
@@ -429,6 +477,7 @@ type GenerateManifestOpt func(*generateManifestOpt)
 type generateManifestOpt struct {
 	cmpTarDoneCh        chan<- bool
 	cmpTarExcludedGlobs []string
+	refSourcePaths      map[string]string
 }
 
 func newGenerateManifestOpt(opts ...GenerateManifestOpt) *generateManifestOpt {
@@ -456,6 +505,14 @@ func WithCMPTarExcludedGlobs(excludedGlobs []string) GenerateManifestOpt {
 	}
 }
 
+// WithRefSourcePaths defines the local paths of the checked out repositories referenced
+// by the application source, keyed by their ref (e.g. "$values").
+func WithRefSourcePaths(refSourcePaths map[string]string) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.refSourcePaths = refSourcePaths
+	}
+}
+
 func (s *Service) getCacheKeyWithKustomizeComponents(
 	revision string,
 	repo *v1alpha1.Repository,
@@ -494,8 +551,29 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 	var res *apiclient.ManifestResponse
 	var err error
 
+	// A source which is only referenced by the other sources of the application does not generate any
+	// manifests, only its revision is resolved.
+	if q.HasMultipleSources && q.ApplicationSource.IsRefOnly() {
+		_, revision, err := s.newClientResolveRevision(q.Repo, textutils.FirstNonEmpty(q.Revision, q.ApplicationSource.TargetRevision), git.WithCache(s.cache, !q.NoRevisionCache && !q.NoCache))
+		if err != nil {
+			return nil, err
+		}
+		return &apiclient.ManifestResponse{Revision: revision}, nil
+	}
+
+	var refSourceRevisions map[string]string
+	if q.HasMultipleSources {
+		refSourceRevisions, err = s.resolveReferencedSources(q)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// The revisions of the referenced sources are part of the cache key, so that a change in a
+	// referenced repository invalidates the cached manifests.
+	refSourcesCacheKey := refSourcesCacheKeySuffix(refSourceRevisions)
+
 	cacheFn := func(cacheKey string, firstInvocation bool) (bool, error) {
-		ok, resp, err := s.getManifestCacheEntry(cacheKey, q, firstInvocation)
+		ok, resp, err := s.getManifestCacheEntry(cacheKey+refSourcesCacheKey, q, firstInvocation)
 		res = resp
 		return ok, err
 	}
@@ -504,7 +582,7 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 	var promise *ManifestResponsePromise
 
 	operation := func(repoRoot, commitSHA, cacheKey string, ctxSrc operationContextSrc) error {
-		promise = s.runManifestGen(ctx, repoRoot, commitSHA, cacheKey, ctxSrc, q)
+		promise = s.runManifestGen(ctx, repoRoot, commitSHA, cacheKey+refSourcesCacheKey, ctxSrc, q, refSourceRevisions)
 		// The fist channel to send the message will resume this operation.
 		// The main purpose for using channels here is to be able to unlock
 		// the repository as soon as the lock in not required anymore. In
@@ -540,6 +618,119 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 	return res, err
 }
 
+// resolveReferencedSources resolves the commit SHA of every source referenced by the helm value files of the
+// application source, and returns them keyed by their ref (e.g. "$values").
+func (s *Service) resolveReferencedSources(q *apiclient.ManifestRequest) (map[string]string, error) {
+	refSourceRevisions := map[string]string{}
+	if q.ApplicationSource.Helm == nil {
+		return refSourceRevisions, nil
+	}
+
+	// Maps the normalized URL of the referenced repositories to their resolved revision, a repository
+	// can only be checked out at one revision at a time.
+	repoRevisions := map[string]string{}
+	for _, valueFile := range q.ApplicationSource.Helm.ValueFiles {
+		if !strings.HasPrefix(valueFile, "$") {
+			continue
+		}
+		refVar := strings.Split(valueFile, "/")[0]
+		if _, ok := refSourceRevisions[refVar]; ok {
+			continue
+		}
+
+		refSourceMapping, ok := q.RefSources[refVar]
+		if !ok {
+			return nil, fmt.Errorf("source referenced %q, but no source has a 'ref' field defined", refVar)
+		}
+		if refSourceMapping.Chart != "" {
+			return nil, fmt.Errorf("source has a 'chart' field defined, but Helm charts are not yet supported for 'ref' sources")
+		}
+
+		normalizedRepoURL := git.NormalizeGitURL(refSourceMapping.Repo.Repo)
+		_, revision, err := s.newClientResolveRevision(&refSourceMapping.Repo, textutils.FirstNonEmpty(refSourceMapping.TargetRevision, "HEAD"), git.WithCache(s.cache, !q.NoRevisionCache && !q.NoCache))
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve revision of referenced source %q: %w", refVar, err)
+		}
+		if otherRevision, ok := repoRevisions[normalizedRepoURL]; ok && otherRevision != revision {
+			return nil, fmt.Errorf("cannot reference multiple revisions for the same repository (%s)", refSourceMapping.Repo.Repo)
+		}
+		repoRevisions[normalizedRepoURL] = revision
+		refSourceRevisions[refVar] = revision
+	}
+	return refSourceRevisions, nil
+}
+
+// refSourcesCacheKeySuffix returns a deterministic suffix for the manifest cache key from the revisions of the
+// referenced sources.
+func refSourcesCacheKeySuffix(refSourceRevisions map[string]string) string {
+	if len(refSourceRevisions) == 0 {
+		return ""
+	}
+	var refs []string
+	for refVar, revision := range refSourceRevisions {
+		refs = append(refs, fmt.Sprintf("%s:%s", refVar, revision))
+	}
+	sort.Strings(refs)
+	return "|" + strings.Join(refs, ",")
+}
+
+// checkoutRefSources checks out the repositories referenced by the application source at their resolved revision
+// and returns their local paths, keyed by their ref. The returned closer releases the locks held on the
+// repositories and must always be closed.
+func (s *Service) checkoutRefSources(q *apiclient.ManifestRequest, repoRoot, commitSHA string, refSourceRevisions map[string]string) (map[string]string, goio.Closer, error) {
+	var closers []goio.Closer
+	closer := io.NewCloser(func() error {
+		for _, c := range closers {
+			io.Close(c)
+		}
+		return nil
+	})
+
+	refSourcePaths := map[string]string{}
+	for refVar, revision := range refSourceRevisions {
+		refSourceMapping := q.RefSources[refVar]
+		if !q.ApplicationSource.IsHelm() && git.NormalizeGitURL(refSourceMapping.Repo.Repo) == git.NormalizeGitURL(q.Repo.Repo) {
+			// The referenced repository is the one of the application source, which is already checked out
+			if revision != commitSHA {
+				return nil, closer, fmt.Errorf("cannot reference a different revision of the same repository (%s references %q which resolves to %q while the application references %q which resolves to %q)", refVar, refSourceMapping.TargetRevision, revision, q.Revision, commitSHA)
+			}
+			refSourcePaths[refVar] = repoRoot
+			continue
+		}
+
+		gitClient, err := s.newClient(&refSourceMapping.Repo)
+		if err != nil {
+			return nil, closer, fmt.Errorf("failed to initialize git client of referenced source %q: %w", refVar, err)
+		}
+		refCloser, err := s.repoLock.Lock(gitClient.Root(), revision, true, func() (goio.Closer, error) {
+			return s.checkoutRevision(gitClient, revision, s.initConstants.SubmoduleEnabled)
+		})
+		if err != nil {
+			return nil, closer, fmt.Errorf("failed to checkout revision %s of referenced source %q: %w", revision, refVar, err)
+		}
+		closers = append(closers, refCloser)
+
+		if !s.initConstants.AllowOutOfBoundsSymlinks {
+			err := argopath.CheckOutOfBoundsSymlinks(gitClient.Root())
+			if err != nil {
+				oobError := &argopath.OutOfBoundsSymlinkError{}
+				if errors.As(err, &oobError) {
+					log.WithFields(log.Fields{
+						common.SecurityField: common.SecurityHigh,
+						"repo":               refSourceMapping.Repo.Repo,
+						"revision":           revision,
+						"file":               oobError.File,
+					}).Warn("repository contains out-of-bounds symlink")
+					return nil, closer, fmt.Errorf("repository contains out-of-bounds symlinks. file: %s", oobError.File)
+				}
+				return nil, closer, err
+			}
+		}
+		refSourcePaths[refVar] = gitClient.Root()
+	}
+	return refSourcePaths, closer, nil
+}
+
 type ManifestResponsePromise struct {
 	responseCh <-chan *apiclient.ManifestResponse
 	tarDoneCh  <-chan bool
@@ -565,7 +756,7 @@ type generateManifestCh struct {
 // - or, the cache does contain a value for this key, but it is an expired manifest generation entry
 // - or, NoCache is true
 // Returns a ManifestResponse, or an error, but not both
-func (s *Service) runManifestGen(ctx context.Context, repoRoot, commitSHA, cacheKey string, opContextSrc operationContextSrc, q *apiclient.ManifestRequest) *ManifestResponsePromise {
+func (s *Service) runManifestGen(ctx context.Context, repoRoot, commitSHA, cacheKey string, opContextSrc operationContextSrc, q *apiclient.ManifestRequest, refSourceRevisions map[string]string) *ManifestResponsePromise {
 
 	responseCh := make(chan *apiclient.ManifestResponse)
 	tarDoneCh := make(chan bool)
@@ -577,11 +768,11 @@ func (s *Service) runManifestGen(ctx context.Context, repoRoot, commitSHA, cache
 		tarDoneCh:  tarDoneCh,
 		errCh:      errCh,
 	}
-	go s.runManifestGenAsync(ctx, repoRoot, commitSHA, cacheKey, opContextSrc, q, channels)
+	go s.runManifestGenAsync(ctx, repoRoot, commitSHA, cacheKey, opContextSrc, q, refSourceRevisions, channels)
 	return responsePromise
 }
 
-func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA, cacheKey string, opContextSrc operationContextSrc, q *apiclient.ManifestRequest, ch *generateManifestCh) {
+func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA, cacheKey string, opContextSrc operationContextSrc, q *apiclient.ManifestRequest, refSourceRevisions map[string]string, ch *generateManifestCh) {
 	defer func() {
 		close(ch.errCh)
 		close(ch.responseCh)
@@ -598,9 +789,15 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 		log.Errorf("failed to build git client, application: %s: %s", q.AppName, err.Error())
 	}
 
-	opContext, err := opContextSrc()
+	refSourcePaths, refSourcesCloser, err := s.checkoutRefSources(q, repoRoot, commitSHA, refSourceRevisions)
+	defer io.Close(refSourcesCloser)
+
+	var opContext *operationContext
 	if err == nil {
-		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, gitClient, s.initConstants.MaxCombinedDirectoryManifestsSize, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs))
+		opContext, err = opContextSrc()
+	}
+	if err == nil {
+		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, gitClient, s.initConstants.MaxCombinedDirectoryManifestsSize, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithRefSourcePaths(refSourcePaths))
 	}
 	if err != nil {
 		// If manifest generation error caching is enabled
@@ -871,7 +1068,7 @@ func populateRequestRepos(appPath string, q *apiclient.ManifestRequest) error {
 	return nil
 }
 
-func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool) ([]manifest, error) {
+func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, refSourcePaths map[string]string) ([]manifest, error) {
 	concurrencyAllowed := isConcurrencyAllowed(appPath)
 	if !concurrencyAllowed {
 		manifestGenerateLock.Lock(appPath)
@@ -906,9 +1103,22 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 		}
 
 		for _, val := range appHelm.ValueFiles {
-
-			// This will resolve val to an absolute path (or an URL)
-			path, isRemote, err := pathutil.ResolveValueFilePathOrUrl(appPath, repoRoot, env.Envsubst(val), q.GetValuesFileSchemes())
+			var path pathutil.ResolvedFilePath
+			var isRemote bool
+			var err error
+
+			if q.HasMultipleSources && strings.HasPrefix(val, "$") {
+				// The value file is relative to the root of a referenced source, e.g. "$values/path/values.yaml"
+				refVar, refPath, _ := strings.Cut(val, "/")
+				refSourceRoot, ok := refSourcePaths[refVar]
+				if !ok {
+					return nil, fmt.Errorf("source referenced %q, but no source has a 'ref' field defined", refVar)
+				}
+				path, isRemote, err = pathutil.ResolveValueFilePathOrUrl(refSourceRoot, refSourceRoot, env.Envsubst(refPath), q.GetValuesFileSchemes())
+			} else {
+				// This will resolve val to an absolute path (or an URL)
+				path, isRemote, err = pathutil.ResolveValueFilePathOrUrl(appPath, repoRoot, env.Envsubst(val), q.GetValuesFileSchemes())
+			}
 			if err != nil {
 				return nil, err
 			}
@@ -926,14 +1136,22 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 			templateOpts.Values = append(templateOpts.Values, path)
 		}
 
+		// The values are passed after the value files, and the values object last, so that they take precedence
+		var values [][]byte
 		if appHelm.Values != "" {
+			values = append(values, []byte(appHelm.Values))
+		}
+		if !appHelm.ValuesObjectIsEmpty() {
+			values = append(values, appHelm.ValuesObject.Raw)
+		}
+		for _, data := range values {
 			rand, err := uuid.NewRandom()
 			if err != nil {
 				return nil, err
 			}
 			p := path.Join(os.TempDir(), rand.String())
 			defer func() { _ = os.RemoveAll(p) }()
-			err = os.WriteFile(p, []byte(appHelm.Values), 0644)
+			err = os.WriteFile(p, data, 0644)
 			if err != nil {
 				return nil, err
 			}
@@ -956,6 +1174,12 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 		}
 		passCredentials = appHelm.PassCredentials
 		templateOpts.SkipCrds = appHelm.SkipCrds
+		if appHelm.KubeVersion != "" {
+			templateOpts.KubeVersion = text.SemVer(appHelm.KubeVersion)
+		}
+		if len(appHelm.APIVersions) > 0 {
+			templateOpts.APIVersions = appHelm.APIVersions
+		}
 	}
 	if templateOpts.Name == "" {
 		templateOpts.Name = q.AppName
@@ -1077,11 +1301,12 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 
 	switch appSourceType {
 	case v1alpha1.ApplicationSourceTypeHelm:
-		manifests, err = helmTemplate(appPath, repoRoot, env, q, isLocal)
+		manifests, err = helmTemplate(appPath, repoRoot, env, q, isLocal, opt.refSourcePaths)
 	case v1alpha1.ApplicationSourceTypeKustomize:
 		manifests, err = kustomizeBuild(repoURL, repoRoot, appPath, q.Repo.GetGitCreds(gitCredsStore), q.ApplicationSource.Kustomize, q.KustomizeOptions, env, q.Namespace)
 	case v1alpha1.ApplicationSourceTypePlugin:
-		if q.ApplicationSource.Plugin != nil && q.ApplicationSource.Plugin.Name != "" {
+		// plugins configured in argocd-cm take precedence over the sidecar plugins with the same name
+		if q.ApplicationSource.Plugin != nil && q.ApplicationSource.Plugin.Name != "" && findPlugin(q.Plugins, q.ApplicationSource.Plugin.Name) != nil {
 			manifests, err = runConfigManagementPlugin(appPath, repoRoot, env, q, q.Repo.GetGitCreds(gitCredsStore))
 		} else {
 			manifests, err = runConfigManagementPluginSidecars(ctx, appPath, repoRoot, env, q, q.Repo.GetGitCreds(gitCredsStore), opt.cmpTarDoneCh, opt.cmpTarExcludedGlobs)
@@ -1765,6 +1990,11 @@ func getPluginEnvs(envVars *v1alpha1.Env, q *apiclient.ManifestRequest, creds gi
 			newValue := parsedEnv.Envsubst(entry.Value)
 			env = append(env, fmt.Sprintf("ARGOCD_ENV_%s=%s", entry.Name, newValue))
 		}
+		paramEnv, err := q.ApplicationSource.Plugin.Parameters.Environ()
+		if err != nil {
+			return nil, err
+		}
+		env = append(env, paramEnv...)
 	}
 	return env, nil
 }
@@ -1777,7 +2007,11 @@ func runConfigManagementPluginSidecars(ctx context.Context, appPath, repoPath st
 	}
 
 	// detect config management plugin server (sidecar)
-	conn, cmpClient, err := discovery.DetectConfigManagementPlugin(ctx, appPath, env, tarExcludedGlobs)
+	pluginName := ""
+	if q.ApplicationSource.Plugin != nil {
+		pluginName = q.ApplicationSource.Plugin.Name
+	}
+	conn, cmpClient, err := discovery.DetectConfigManagementPlugin(ctx, appPath, pluginName, env, tarExcludedGlobs)
 	if err != nil {
 		return nil, err
 	}
@@ -1864,6 +2098,10 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 			if err := populateKustomizeAppDetails(res, q, opContext.appPath, commitSHA, s.gitCredsStore); err != nil {
 				return err
 			}
+		case v1alpha1.ApplicationSourceTypePlugin:
+			if err := populatePluginAppDetails(ctx, res, opContext.appPath, repoRoot, commitSHA, q, s.gitCredsStore, s.initConstants.CMPTarExcludedGlobs); err != nil {
+				return fmt.Errorf("failed to populate plugin app details: %w", err)
+			}
 		}
 		_ = s.cache.SetAppDetails(revision, q.Source, res, v1alpha1.TrackingMethod(q.TrackingMethod))
 		return nil
@@ -2009,11 +2247,68 @@ func populateKustomizeAppDetails(res *apiclient.RepoAppDetailsResponse, q *apicl
 		ApplicationSource: q.Source,
 	}
 	env := newEnv(&fakeManifestRequest, reversion)
-	_, images, err := k.Build(q.Source.Kustomize, q.KustomizeOptions, env, "")
+	objs, images, err := k.Build(q.Source.Kustomize, q.KustomizeOptions, env, "")
 	if err != nil {
 		return err
 	}
 	res.Kustomize.Images = images
+	replicas := kustomize.GetReplicas(objs)
+	for i := range replicas {
+		res.Kustomize.Replicas = append(res.Kustomize.Replicas, &replicas[i])
+	}
+	return nil
+}
+
+func populatePluginAppDetails(ctx context.Context, res *apiclient.RepoAppDetailsResponse, appPath string, repoPath string, revision string, q *apiclient.RepoServerAppDetailsQuery, credsStore git.CredsStore, tarExcludedGlobs []string) error {
+	res.Plugin = &apiclient.PluginAppSpec{}
+	pluginName := ""
+	if q.Source.Plugin != nil {
+		pluginName = q.Source.Plugin.Name
+	}
+	if pluginName != "" && !discovery.HasConfigManagementPlugin(pluginName) {
+		// plugins configured in argocd-cm do not announce parameters
+		return nil
+	}
+
+	fakeManifestRequest := apiclient.ManifestRequest{
+		AppName:           q.AppName,
+		Namespace:         "", // FIXME: omit it for now
+		Repo:              q.Repo,
+		ApplicationSource: q.Source,
+	}
+	env, err := getPluginEnvs(newEnv(&fakeManifestRequest, revision), &fakeManifestRequest, q.Repo.GetGitCreds(credsStore), true)
+	if err != nil {
+		return err
+	}
+
+	conn, cmpClient, err := discovery.DetectConfigManagementPlugin(ctx, appPath, pluginName, env, tarExcludedGlobs)
+	if err != nil {
+		return err
+	}
+	defer io.Close(conn)
+
+	parametersAnnouncementStream, err := cmpClient.GetParametersAnnouncement(ctx, grpc_retry.Disable())
+	if err != nil {
+		return fmt.Errorf("error getting parametersAnnouncementStream: %w", err)
+	}
+	err = cmp.SendRepoStream(parametersAnnouncementStream.Context(), appPath, repoPath, parametersAnnouncementStream, env, tarExcludedGlobs)
+	if err != nil {
+		// the stream is aborted by the plugins which do not implement the announcement of parameters, whose
+		// status is only returned when receiving the response
+		if _, recvErr := parametersAnnouncementStream.CloseAndRecv(); status.Code(recvErr) == codes.Unimplemented {
+			return nil
+		}
+		return fmt.Errorf("error sending file to cmp-server: %w", err)
+	}
+	announcement, err := parametersAnnouncementStream.CloseAndRecv()
+	if status.Code(err) == codes.Unimplemented {
+		// plugins running an older cmp-server do not announce parameters
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get parameter announcement: %w", err)
+	}
+	res.Plugin.ParametersAnnouncement = announcement.ParameterAnnouncements
 	return nil
 }
 
@@ -2150,6 +2445,35 @@ func (s *Service) newHelmClientResolveRevision(repo *v1alpha1.Repository, revisi
 	return helmClient, version.String(), nil
 }
 
+// newOCIClientResolveRevision is a helper to perform the common task of instantiating an OCI client and resolving a
+// tag to the digest of the artifact
+func (s *Service) newOCIClientResolveRevision(ctx context.Context, repo *v1alpha1.Repository, revision string) (oci.Client, string, error) {
+	ociClient := s.newOCIClient(repo.Repo, repo.GetOCICreds(), repo.Proxy)
+	digest, err := ociClient.ResolveRevision(ctx, revision)
+	if err != nil {
+		return nil, "", fmt.Errorf("error resolving OCI revision '%s': %w", revision, err)
+	}
+	return ociClient, digest, nil
+}
+
+// getOCISignatures returns the JSON encoded cosign signatures of the OCI artifact with the given digest, which are
+// verified against the public keys of the project by the application controller, or an empty string if the artifact
+// is not signed
+func getOCISignatures(ctx context.Context, ociClient oci.Client, digest string) (string, error) {
+	signatures, err := ociClient.GetSignatures(ctx, digest)
+	if err != nil {
+		return "", fmt.Errorf("error getting signatures of OCI artifact %s: %w", digest, err)
+	}
+	if len(signatures) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(signatures)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
 // directoryPermissionInitializer ensures the directory has read/write/execute permissions and returns
 // a function that can be used to remove all permissions.
 func directoryPermissionInitializer(rootPath string) goio.Closer {
@@ -2240,6 +2564,9 @@ func (s *Service) TestRepository(ctx context.Context, q *apiclient.TestRepositor
 		"git": func() error {
 			return git.TestRepo(repo.Repo, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy)
 		},
+		"oci": func() error {
+			return s.newOCIClient(repo.Repo, repo.GetOCICreds(), repo.Proxy).TestRepository(ctx)
+		},
 		"helm": func() error {
 			if repo.EnableOCI {
 				if !helm.IsHelmOciRepo(repo.Repo) {
@@ -2268,18 +2595,19 @@ func (s *Service) ResolveRevision(ctx context.Context, q *apiclient.ResolveRevis
 	repo := q.Repo
 	app := q.App
 	ambiguousRevision := q.AmbiguousRevision
+	source := app.Spec.GetSource()
 	var revision string
-	if app.Spec.Source.IsHelm() {
+	if source.IsHelm() {
 
 		if helm.IsVersion(ambiguousRevision) {
 			return &apiclient.ResolveRevisionResponse{Revision: ambiguousRevision, AmbiguousRevision: ambiguousRevision}, nil
 		}
-		client := helm.NewClient(repo.Repo, repo.GetHelmCreds(), repo.EnableOCI || app.Spec.Source.IsHelmOci(), repo.Proxy, helm.WithChartPaths(s.chartPaths))
+		client := helm.NewClient(repo.Repo, repo.GetHelmCreds(), repo.EnableOCI || source.IsHelmOci(), repo.Proxy, helm.WithChartPaths(s.chartPaths))
 		index, err := client.GetIndex(false)
 		if err != nil {
 			return &apiclient.ResolveRevisionResponse{Revision: "", AmbiguousRevision: ""}, err
 		}
-		entries, err := index.GetEntries(app.Spec.Source.Chart)
+		entries, err := index.GetEntries(source.Chart)
 		if err != nil {
 			return &apiclient.ResolveRevisionResponse{Revision: "", AmbiguousRevision: ""}, err
 		}
@@ -2295,6 +2623,15 @@ func (s *Service) ResolveRevision(ctx context.Context, q *apiclient.ResolveRevis
 			Revision:          version.String(),
 			AmbiguousRevision: fmt.Sprintf("%v (%v)", ambiguousRevision, version.String()),
 		}, nil
+	} else if source.IsOCI() {
+		revision, err := s.newOCIClient(repo.Repo, repo.GetOCICreds(), repo.Proxy).ResolveRevision(ctx, ambiguousRevision)
+		if err != nil {
+			return &apiclient.ResolveRevisionResponse{Revision: "", AmbiguousRevision: ""}, err
+		}
+		return &apiclient.ResolveRevisionResponse{
+			Revision:          revision,
+			AmbiguousRevision: fmt.Sprintf("%s (%s)", ambiguousRevision, revision),
+		}, nil
 	} else {
 		gitClient, err := git.NewClient(repo.Repo, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy)
 		if err != nil {
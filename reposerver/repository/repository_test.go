@@ -0,0 +1,219 @@
+package repository
+
+import (
+	goio "io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/util/git"
+	gitmocks "github.com/argoproj/argo-cd/v2/util/git/mocks"
+	"github.com/argoproj/argo-cd/v2/util/io"
+)
+
+// helmTemplateData renders the helm-values-object chart and returns the data of its ConfigMap
+func helmTemplateData(t *testing.T, appHelm *v1alpha1.ApplicationSourceHelm) map[string]string {
+	t.Helper()
+	appPath := "./testdata/helm-values-object"
+	q := &apiclient.ManifestRequest{
+		AppName:           "test",
+		Namespace:         "default",
+		KubeVersion:       "1.20.0",
+		ApplicationSource: &v1alpha1.ApplicationSource{Helm: appHelm},
+	}
+	manifests, err := helmTemplate(appPath, appPath, &v1alpha1.Env{}, q, false, nil)
+	require.NoError(t, err)
+	require.Len(t, manifests, 1)
+	data, ok, err := unstructured.NestedStringMap(manifests[0].obj.Object, "data")
+	require.NoError(t, err)
+	require.True(t, ok)
+	return data
+}
+
+func TestHelmTemplateValuesPrecedence(t *testing.T) {
+	t.Run("ValueFilesAndValues", func(t *testing.T) {
+		data := helmTemplateData(t, &v1alpha1.ApplicationSourceHelm{
+			ValueFiles: []string{"values-file.yaml"},
+			Values:     "foo: literal\nbar: literal\n",
+		})
+		assert.Equal(t, "literal", data["foo"])
+		assert.Equal(t, "literal", data["bar"])
+		assert.Equal(t, "file", data["baz"])
+	})
+	t.Run("ValuesObject", func(t *testing.T) {
+		data := helmTemplateData(t, &v1alpha1.ApplicationSourceHelm{
+			ValueFiles:   []string{"values-file.yaml"},
+			Values:       "foo: literal\nbar: literal\n",
+			ValuesObject: &runtime.RawExtension{Raw: []byte(`{"foo": "object"}`)},
+		})
+		assert.Equal(t, "object", data["foo"])
+		assert.Equal(t, "literal", data["bar"])
+		assert.Equal(t, "file", data["baz"])
+	})
+	t.Run("ValuesObjectOnly", func(t *testing.T) {
+		data := helmTemplateData(t, &v1alpha1.ApplicationSourceHelm{
+			ValuesObject: &runtime.RawExtension{Raw: []byte(`{"baz": "object"}`)},
+		})
+		assert.Equal(t, "chart", data["foo"])
+		assert.Equal(t, "object", data["baz"])
+	})
+}
+
+func TestHelmTemplateKubeVersionAndAPIVersions(t *testing.T) {
+	t.Run("Cluster", func(t *testing.T) {
+		data := helmTemplateData(t, &v1alpha1.ApplicationSourceHelm{})
+		assert.Equal(t, "v1.20.0", data["kubeVersion"])
+		assert.Equal(t, "false", data["sampleV2"])
+	})
+	t.Run("Source", func(t *testing.T) {
+		data := helmTemplateData(t, &v1alpha1.ApplicationSourceHelm{
+			KubeVersion: "1.24.0",
+			APIVersions: []string{"sample/v2"},
+		})
+		assert.Equal(t, "v1.24.0", data["kubeVersion"])
+		assert.Equal(t, "true", data["sampleV2"])
+	})
+}
+
+const (
+	appRepoURL    = "https://github.com/argoproj/app.git"
+	valuesRepoURL = "https://github.com/argoproj/values.git"
+)
+
+// newRefSourcesTestService returns a service whose git clients are the given mocks, keyed by repository URL
+func newRefSourcesTestService(t *testing.T, gitClients map[string]*gitmocks.Client) *Service {
+	return &Service{
+		repoLock:           NewRepositoryLock(),
+		gitRepoPaths:       io.NewTempPaths(t.TempDir()),
+		gitRepoInitializer: func(rootPath string) goio.Closer { return io.NopCloser },
+		newGitClient: func(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, proxy string, opts ...git.ClientOpts) (git.Client, error) {
+			return gitClients[rawRepoURL], nil
+		},
+	}
+}
+
+// newRefSourcesTestGitClient returns a mock of a git client of a repository checked out in a temporary directory,
+// which resolves the given revisions
+func newRefSourcesTestGitClient(t *testing.T, revisions map[string]string) *gitmocks.Client {
+	gitClient := &gitmocks.Client{}
+	root := t.TempDir()
+	gitClient.On("Root").Return(root)
+	gitClient.On("Init").Return(nil)
+	gitClient.On("Fetch", "").Return(nil)
+	for revision, commitSHA := range revisions {
+		gitClient.On("LsRemote", revision).Return(commitSHA, nil)
+		gitClient.On("Checkout", commitSHA, false).Return(nil)
+	}
+	return gitClient
+}
+
+func newRefSourcesManifestRequest(refSources map[string]*v1alpha1.RefTarget, valueFiles ...string) *apiclient.ManifestRequest {
+	return &apiclient.ManifestRequest{
+		Repo:               &v1alpha1.Repository{Repo: appRepoURL},
+		Revision:           "main",
+		HasMultipleSources: true,
+		RefSources:         refSources,
+		ApplicationSource: &v1alpha1.ApplicationSource{
+			RepoURL: appRepoURL,
+			Path:    "chart",
+			Helm:    &v1alpha1.ApplicationSourceHelm{ValueFiles: valueFiles},
+		},
+	}
+}
+
+func TestResolveAndCheckoutRefSources(t *testing.T) {
+	valuesClient := newRefSourcesTestGitClient(t, map[string]string{"release": "values-sha"})
+	s := newRefSourcesTestService(t, map[string]*gitmocks.Client{valuesRepoURL: valuesClient})
+	q := newRefSourcesManifestRequest(map[string]*v1alpha1.RefTarget{
+		"$values": {Repo: v1alpha1.Repository{Repo: valuesRepoURL}, TargetRevision: "release"},
+	}, "values.yaml", "$values/env/prod.yaml", "$values/env/common.yaml")
+
+	refSourceRevisions, err := s.resolveReferencedSources(q)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"$values": "values-sha"}, refSourceRevisions)
+
+	refSourcePaths, closer, err := s.checkoutRefSources(q, t.TempDir(), "app-sha", refSourceRevisions)
+	require.NoError(t, err)
+	defer io.Close(closer)
+	assert.Equal(t, map[string]string{"$values": valuesClient.Root()}, refSourcePaths)
+	valuesClient.AssertCalled(t, "Checkout", "values-sha", false)
+}
+
+func TestResolveReferencedSourcesUnknownRef(t *testing.T) {
+	s := newRefSourcesTestService(t, nil)
+	q := newRefSourcesManifestRequest(map[string]*v1alpha1.RefTarget{
+		"$values": {Repo: v1alpha1.Repository{Repo: valuesRepoURL}},
+	}, "$other/values.yaml")
+
+	_, err := s.resolveReferencedSources(q)
+	assert.ErrorContains(t, err, `source referenced "$other", but no source has a 'ref' field defined`)
+
+	_, err = helmTemplate(t.TempDir(), t.TempDir(), &v1alpha1.Env{}, q, false, map[string]string{"$values": t.TempDir()})
+	assert.ErrorContains(t, err, `source referenced "$other", but no source has a 'ref' field defined`)
+}
+
+func TestRefSourcesCacheKeySuffix(t *testing.T) {
+	refSources := map[string]*v1alpha1.RefTarget{
+		"$values": {Repo: v1alpha1.Repository{Repo: valuesRepoURL}, TargetRevision: "release"},
+	}
+	cacheKeySuffix := func(commitSHA string) string {
+		valuesClient := newRefSourcesTestGitClient(t, map[string]string{"release": commitSHA})
+		s := newRefSourcesTestService(t, map[string]*gitmocks.Client{valuesRepoURL: valuesClient})
+		refSourceRevisions, err := s.resolveReferencedSources(newRefSourcesManifestRequest(refSources, "$values/values.yaml"))
+		require.NoError(t, err)
+		return refSourcesCacheKeySuffix(refSourceRevisions)
+	}
+
+	assert.Equal(t, "|$values:values-sha", cacheKeySuffix("values-sha"))
+	assert.Equal(t, cacheKeySuffix("values-sha"), cacheKeySuffix("values-sha"))
+	assert.NotEqual(t, cacheKeySuffix("values-sha"), cacheKeySuffix("other-values-sha"))
+	assert.Equal(t, "", refSourcesCacheKeySuffix(nil))
+	assert.Equal(t, "|$a:sha-a,$b:sha-b", refSourcesCacheKeySuffix(map[string]string{"$b": "sha-b", "$a": "sha-a"}))
+}
+
+func TestCheckoutRefSourcesSameRepository(t *testing.T) {
+	appClient := newRefSourcesTestGitClient(t, map[string]string{"main": "app-sha"})
+	s := newRefSourcesTestService(t, map[string]*gitmocks.Client{appRepoURL: appClient})
+	q := newRefSourcesManifestRequest(map[string]*v1alpha1.RefTarget{
+		"$values": {Repo: v1alpha1.Repository{Repo: appRepoURL}, TargetRevision: "main"},
+	}, "$values/values.yaml")
+
+	refSourceRevisions, err := s.resolveReferencedSources(q)
+	require.NoError(t, err)
+
+	// the repository of the application is locked while the manifests are generated, as done by runRepoOperation
+	repoRoot := appClient.Root()
+	appCloser, err := s.repoLock.Lock(repoRoot, "app-sha", false, func() (goio.Closer, error) {
+		return io.NopCloser, nil
+	})
+	require.NoError(t, err)
+	defer io.Close(appCloser)
+
+	type result struct {
+		refSourcePaths map[string]string
+		err            error
+	}
+	done := make(chan result, 1)
+	go func() {
+		refSourcePaths, closer, err := s.checkoutRefSources(q, repoRoot, "app-sha", refSourceRevisions)
+		io.Close(closer)
+		done <- result{refSourcePaths, err}
+	}()
+	select {
+	case res := <-done:
+		require.NoError(t, res.err)
+		assert.Equal(t, map[string]string{"$values": repoRoot}, res.refSourcePaths)
+	case <-time.After(10 * time.Second):
+		t.Fatal("checking out a source referencing the repository of the application is deadlocked")
+	}
+	appClient.AssertNotCalled(t, "Checkout", "app-sha", false)
+
+	_, _, err = s.checkoutRefSources(q, repoRoot, "other-sha", refSourceRevisions)
+	assert.ErrorContains(t, err, "cannot reference a different revision of the same repository")
+}
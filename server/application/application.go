@@ -288,7 +288,8 @@ func (s *Server) queryRepoServer(ctx context.Context, a *v1alpha1.Application, a
 		return fmt.Errorf("error creating repo server client: %w", err)
 	}
 	defer ioutil.Close(closer)
-	repo, err := s.db.GetRepository(ctx, a.Spec.Source.RepoURL)
+	source := a.Spec.GetSource()
+	repo, err := s.db.GetRepository(ctx, source.RepoURL)
 	if err != nil {
 		return fmt.Errorf("error getting repository: %w", err)
 	}
@@ -296,7 +297,7 @@ func (s *Server) queryRepoServer(ctx context.Context, a *v1alpha1.Application, a
 	if err != nil {
 		return fmt.Errorf("error getting kustomize settings: %w", err)
 	}
-	kustomizeOptions, err := kustomizeSettings.GetOptions(a.Spec.Source)
+	kustomizeOptions, err := kustomizeSettings.GetOptions(source)
 	if err != nil {
 		return fmt.Errorf("error getting kustomize settings options: %w", err)
 	}
@@ -362,13 +363,43 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 		return nil, namespaceNotPermittedError(a.Namespace)
 	}
 
-	var manifestInfo *apiclient.ManifestResponse
-	err = s.queryRepoServer(ctx, a, func(
-		client apiclient.RepoServerServiceClient, repo *appv1.Repository, helmRepos []*appv1.Repository, helmCreds []*appv1.RepoCreds, helmOptions *appv1.HelmOptions, kustomizeOptions *appv1.KustomizeOptions, enableGenerateManifests map[string]bool) error {
-		revision := a.Spec.Source.TargetRevision
-		if q.GetRevision() != "" {
-			revision = q.GetRevision()
+	if a.Spec.HasMultipleSources() && q.GetRevision() != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "a revision cannot be specified for an application with multiple sources")
+	}
+
+	manifestInfo, err := s.generateManifests(ctx, a, q.GetRevision())
+	if err != nil {
+		return nil, err
+	}
+
+	for i, manifest := range manifestInfo.Manifests {
+		obj := &unstructured.Unstructured{}
+		err = json.Unmarshal([]byte(manifest.CompiledManifest), obj)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
 		}
+		if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
+			obj, _, err = diff.HideSecretData(obj, nil)
+			if err != nil {
+				return nil, fmt.Errorf("error hiding secret data: %w", err)
+			}
+			data, err := json.Marshal(obj)
+			if err != nil {
+				return nil, fmt.Errorf("error marshaling manifest: %w", err)
+			}
+			manifestInfo.Manifests[i].CompiledManifest = string(data)
+		}
+	}
+
+	return manifestInfo, nil
+}
+
+// generateManifests generates the manifests of all the sources of the application. If not empty, the given revision
+// overrides the target revision of an application with a single source.
+func (s *Server) generateManifests(ctx context.Context, a *appv1.Application, revision string) (*apiclient.ManifestResponse, error) {
+	manifestInfo := &apiclient.ManifestResponse{}
+	err := s.queryRepoServer(ctx, a, func(
+		client apiclient.RepoServerServiceClient, _ *appv1.Repository, helmRepos []*appv1.Repository, helmCreds []*appv1.RepoCreds, helmOptions *appv1.HelmOptions, _ *appv1.KustomizeOptions, enableGenerateManifests map[string]bool) error {
 		appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
 		if err != nil {
 			return fmt.Errorf("error getting app instance label key from settings: %w", err)
@@ -393,52 +424,67 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			return fmt.Errorf("error getting API resources: %w", err)
 		}
 
-		manifestInfo, err = client.GenerateManifest(ctx, &apiclient.ManifestRequest{
-			Repo:               repo,
-			Revision:           revision,
-			AppLabelKey:        appInstanceLabelKey,
-			AppName:            a.InstanceName(s.ns),
-			Namespace:          a.Spec.Destination.Namespace,
-			ApplicationSource:  &a.Spec.Source,
-			Repos:              helmRepos,
-			Plugins:            plugins,
-			KustomizeOptions:   kustomizeOptions,
-			KubeVersion:        serverVersion,
-			ApiVersions:        argo.APIResourcesToStrings(apiResources, true),
-			HelmRepoCreds:      helmCreds,
-			HelmOptions:        helmOptions,
-			TrackingMethod:     string(argoutil.GetTrackingMethod(s.settingsMgr)),
-			EnabledSourceTypes: enableGenerateManifests,
-		})
+		kustomizeSettings, err := s.settingsMgr.GetKustomizeSettings()
 		if err != nil {
-			return fmt.Errorf("error generating manifests: %w", err)
+			return fmt.Errorf("error getting kustomize settings: %w", err)
 		}
-		return nil
-	})
-
-	if err != nil {
-		return nil, err
-	}
 
-	for i, manifest := range manifestInfo.Manifests {
-		obj := &unstructured.Unstructured{}
-		err = json.Unmarshal([]byte(manifest.CompiledManifest), obj)
+		refSources, err := argo.GetRefSources(ctx, a.Spec.Sources, nil, s.db)
 		if err != nil {
-			return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+			return fmt.Errorf("error getting ref sources: %w", err)
 		}
-		if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
-			obj, _, err = diff.HideSecretData(obj, nil)
+
+		// The manifests of all the sources of the application are returned in a single response
+		for _, source := range a.Spec.GetSources() {
+			source := source
+			if a.Spec.HasMultipleSources() && source.IsRefOnly() {
+				continue
+			}
+			repo, err := s.db.GetRepository(ctx, source.RepoURL)
 			if err != nil {
-				return nil, fmt.Errorf("error hiding secret data: %w", err)
+				return fmt.Errorf("error getting repository: %w", err)
 			}
-			data, err := json.Marshal(obj)
+			kustomizeOptions, err := kustomizeSettings.GetOptions(source)
 			if err != nil {
-				return nil, fmt.Errorf("error marshaling manifest: %w", err)
+				return fmt.Errorf("error getting kustomize settings options: %w", err)
 			}
-			manifestInfo.Manifests[i].CompiledManifest = string(data)
+			sourceRevision := source.TargetRevision
+			if revision != "" {
+				sourceRevision = revision
+			}
+
+			sourceManifestInfo, err := client.GenerateManifest(ctx, &apiclient.ManifestRequest{
+				Repo:               repo,
+				Revision:           sourceRevision,
+				AppLabelKey:        appInstanceLabelKey,
+				AppName:            a.InstanceName(s.ns),
+				Namespace:          a.Spec.Destination.Namespace,
+				ApplicationSource:  &source,
+				Repos:              helmRepos,
+				Plugins:            plugins,
+				KustomizeOptions:   kustomizeOptions,
+				KubeVersion:        serverVersion,
+				ApiVersions:        argo.APIResourcesToStrings(apiResources, true),
+				HelmRepoCreds:      helmCreds,
+				HelmOptions:        helmOptions,
+				TrackingMethod:     string(argoutil.GetTrackingMethod(s.settingsMgr)),
+				EnabledSourceTypes: enableGenerateManifests,
+				HasMultipleSources: a.Spec.HasMultipleSources(),
+				RefSources:         refSources,
+			})
+			if err != nil {
+				return fmt.Errorf("error generating manifests: %w", err)
+			}
+			manifests := append(manifestInfo.Manifests, sourceManifestInfo.Manifests...)
+			manifestInfo = sourceManifestInfo
+			manifestInfo.Manifests = manifests
 		}
-	}
+		return nil
+	})
 
+	if err != nil {
+		return nil, err
+	}
 	return manifestInfo, nil
 }
 
@@ -503,9 +549,10 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*app
 			kustomizeOptions *appv1.KustomizeOptions,
 			enabledSourceTypes map[string]bool,
 		) error {
+			source := app.Spec.GetSource()
 			_, err := client.GetAppDetails(ctx, &apiclient.RepoServerAppDetailsQuery{
 				Repo:               repo,
-				Source:             &app.Spec.Source,
+				Source:             &source,
 				AppName:            appName,
 				KustomizeOptions:   kustomizeOptions,
 				Repos:              helmRepos,
@@ -1133,7 +1180,7 @@ func (s *Server) ValidateSrcAndDst(ctx context.Context, requset *application.App
 			Entity: &entity,
 		}, nil
 	}
-	kustomizeOptions, err := kustomizeSettings.GetOptions(app.Spec.Source)
+	kustomizeOptions, err := kustomizeSettings.GetOptions(app.Spec.GetSource())
 	if err != nil {
 		entity := sourceEntity
 		errMsg := err.Error()
@@ -1239,7 +1286,7 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *appv1.Applica
 	if err != nil {
 		return fmt.Errorf("error getting kustomize settings: %w", err)
 	}
-	kustomizeOptions, err := kustomizeSettings.GetOptions(app.Spec.Source)
+	kustomizeOptions, err := kustomizeSettings.GetOptions(app.Spec.GetSource())
 	if err != nil {
 		return fmt.Errorf("error getting kustomize options from settings: %w", err)
 	}
@@ -1527,7 +1574,15 @@ func (s *Server) RevisionMetadata(ctx context.Context, q *application.RevisionMe
 		}).Warnf(argocommon.ConfigMapPluginDeprecationWarning)
 	}
 
-	repo, err := s.db.GetRepository(ctx, a.Spec.Source.RepoURL)
+	source := a.Spec.GetSource()
+	if a.Spec.HasMultipleSources() {
+		sourceIndex := int(q.GetSourceIndex())
+		if sourceIndex < 0 || sourceIndex >= len(a.Spec.Sources) {
+			return nil, status.Errorf(codes.InvalidArgument, "source index %d is out of range for application with %d sources", sourceIndex, len(a.Spec.Sources))
+		}
+		source = a.Spec.Sources[sourceIndex]
+	}
+	repo, err := s.db.GetRepository(ctx, source.RepoURL)
 	if err != nil {
 		return nil, fmt.Errorf("error getting repository by URL: %w", err)
 	}
@@ -1849,7 +1904,10 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 
 	s.inferResourcesStatusHealth(a)
 
-	if !proj.Spec.SyncWindows.Matches(a).CanSync(true) {
+	// the sync windows of a scheduled sync are evaluated by the controller when the sync starts, and a preflight
+	// sync does not mutate the cluster
+	scheduled := syncReq.NotBefore != nil && time.Now().Before(syncReq.NotBefore.Time)
+	if !scheduled && !syncReq.GetPreflight() && !proj.Spec.SyncWindows.Matches(a).CanSync(true) && !proj.HasSyncWindowOverride(a) {
 		return a, status.Errorf(codes.PermissionDenied, "cannot sync: blocked by sync window")
 	}
 
@@ -1868,13 +1926,16 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionOverride, a.RBACName(s.ns)); err != nil {
 			return nil, err
 		}
-		if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.Automated != nil && !syncReq.GetDryRun() {
+		if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.Automated != nil && !syncReq.GetDryRun() && !syncReq.GetPreflight() {
 			return nil, status.Error(codes.FailedPrecondition, "cannot use local sync when Automatic Sync Policy is enabled unless for dry run")
 		}
 	}
 	if a.DeletionTimestamp != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
 	}
+	if a.Spec.HasMultipleSources() && syncReq.GetRevision() != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "a revision cannot be specified to sync an application with multiple sources")
+	}
 	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.Automated != nil {
 		if syncReq.GetRevision() != "" && syncReq.GetRevision() != text.FirstNonEmpty(a.Spec.Source.TargetRevision, "HEAD") {
 			return nil, status.Errorf(codes.FailedPrecondition, "Cannot sync to %s: auto-sync currently set to %s", syncReq.GetRevision(), a.Spec.Source.TargetRevision)
@@ -1898,8 +1959,8 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		syncOptions = syncReq.SyncOptions.Items
 	}
 
-	// We cannot use local manifests if we're only allowed to sync to signed commits
-	if syncReq.Manifests != nil && len(proj.Spec.SignatureKeys) > 0 {
+	// We cannot use local manifests if we're only allowed to sync to signed commits or artifacts
+	if syncReq.Manifests != nil && (len(proj.Spec.SignatureKeys) > 0 || len(proj.Spec.CosignPublicKeys) > 0) {
 		return nil, status.Errorf(codes.FailedPrecondition, "Cannot use local sync when signature keys are required.")
 	}
 
@@ -1927,6 +1988,18 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if retry != nil {
 		op.Retry = *retry
 	}
+	if scheduled {
+		op.Sync.NotBefore = syncReq.NotBefore
+	}
+
+	if syncReq.GetPreflight() {
+		a, err = s.preflightSync(ctx, a, proj, op)
+		if err != nil {
+			return nil, err
+		}
+		s.logAppEvent(a, ctx, argo.EventReasonResourceUpdated, fmt.Sprintf("ran preflight sync to %s: %s", displayRevision, a.Status.OperationState.Message))
+		return a, nil
+	}
 
 	a, err = argo.SetAppOperation(appIf, appName, &op)
 	if err != nil {
@@ -1940,6 +2013,9 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if syncReq.Manifests != nil {
 		reason = fmt.Sprintf("initiated %ssync locally", partial)
 	}
+	if scheduled {
+		reason = fmt.Sprintf("%s scheduled at %s", reason, syncReq.NotBefore.Format(time.RFC3339))
+	}
 	s.logAppEvent(a, ctx, argo.EventReasonOperationStarted, reason)
 	return a, nil
 }
@@ -1973,19 +2049,37 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 	}
 
 	var deploymentInfo *appv1.RevisionHistory
-	for _, info := range a.Status.History {
-		if info.ID == rollbackReq.GetId() {
-			deploymentInfo = &info
-			break
+	target := fmt.Sprintf("%d", rollbackReq.GetId())
+	if rollbackReq.GetRevision() != "" {
+		if rollbackReq.Id != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "either a deployment id or a revision can be specified, not both")
+		}
+		if a.Spec.HasMultipleSources() {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot rollback an application with multiple sources to a revision. rollback to a deployment id instead.")
+		}
+		// The revision is resolved like the revision of a sync, so that the rollback is performed to a concrete
+		// commit SHA even if the revision is a tag or a branch
+		revision, displayRevision, err := s.resolveRevision(ctx, a, &application.ApplicationSyncRequest{Revision: rollbackReq.Revision})
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+		}
+		deploymentInfo = &appv1.RevisionHistory{Revision: revision, Source: a.Spec.Source}
+		target = displayRevision
+	} else {
+		for _, info := range a.Status.History {
+			if info.ID == rollbackReq.GetId() {
+				deploymentInfo = &info
+				break
+			}
+		}
+		if deploymentInfo == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "application %s does not have deployment with id %v", a.QualifiedName(), rollbackReq.GetId())
+		}
+		if deploymentInfo.Source.IsZero() && len(deploymentInfo.Sources) == 0 {
+			// Since source type was introduced to history starting with v0.12, and is now required for
+			// rollback, we cannot support rollback to revisions deployed using Argo CD v0.11 or below
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot rollback to revision deployed with Argo CD v0.11 or lower. sync to revision instead.")
 		}
-	}
-	if deploymentInfo == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "application %s does not have deployment with id %v", a.QualifiedName(), rollbackReq.GetId())
-	}
-	if deploymentInfo.Source.IsZero() {
-		// Since source type was introduced to history starting with v0.12, and is now required for
-		// rollback, we cannot support rollback to revisions deployed using Argo CD v0.11 or below
-		return nil, status.Errorf(codes.FailedPrecondition, "cannot rollback to revision deployed with Argo CD v0.11 or lower. sync to revision instead.")
 	}
 
 	var syncOptions appv1.SyncOptions
@@ -1996,20 +2090,30 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 	// Rollback is just a convenience around Sync
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
-			Revision:     deploymentInfo.Revision,
 			DryRun:       rollbackReq.GetDryRun(),
 			Prune:        rollbackReq.GetPrune(),
 			SyncOptions:  syncOptions,
 			SyncStrategy: &appv1.SyncStrategy{Apply: &appv1.SyncStrategyApply{}},
-			Source:       &deploymentInfo.Source,
+			Reason:       rollbackReq.GetReason(),
 		},
 		InitiatedBy: appv1.OperationInitiator{Username: session.Username(ctx)},
 	}
+	if len(deploymentInfo.Sources) > 0 {
+		op.Sync.Revisions = deploymentInfo.Revisions
+		op.Sync.Sources = deploymentInfo.Sources
+	} else {
+		op.Sync.Revision = deploymentInfo.Revision
+		op.Sync.Source = &deploymentInfo.Source
+	}
 	a, err = argo.SetAppOperation(appIf, appName, &op)
 	if err != nil {
 		return nil, fmt.Errorf("error setting app operation: %w", err)
 	}
-	s.logAppEvent(a, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("initiated rollback to %d", rollbackReq.GetId()))
+	message := fmt.Sprintf("initiated rollback to %s", target)
+	if rollbackReq.GetReason() != "" {
+		message = fmt.Sprintf("%s: %s", message, rollbackReq.GetReason())
+	}
+	s.logAppEvent(a, ctx, argo.EventReasonOperationStarted, message)
 	return a, nil
 }
 
@@ -2019,6 +2123,10 @@ func (s *Server) resolveRevision(ctx context.Context, app *appv1.Application, sy
 	if syncReq.Manifests != nil {
 		return "", "", nil
 	}
+	// The revisions of applications with multiple sources are resolved by the controller
+	if app.Spec.HasMultipleSources() {
+		return "", "", nil
+	}
 	ambiguousRevision := syncReq.GetRevision()
 	if ambiguousRevision == "" {
 		ambiguousRevision = app.Spec.Source.TargetRevision
@@ -2341,7 +2449,7 @@ func (s *Server) GetApplicationSyncWindows(ctx context.Context, q *application.A
 	}
 
 	windows := proj.Spec.SyncWindows.Matches(a)
-	sync := windows.CanSync(true)
+	sync := windows.CanSync(true) || proj.HasSyncWindowOverride(a)
 
 	res := &application.ApplicationSyncWindowsResponse{
 		ActiveWindows:   convertSyncWindows(windows.Active()),
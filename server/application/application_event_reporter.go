@@ -572,7 +572,7 @@ func (s *applicationEventReporter) getApplicationEventPayload(ctx context.Contex
 		syncFinished = a.Status.OperationState.FinishedAt
 	}
 
-	if !a.Spec.Source.IsHelm() && (a.Status.Sync.Revision != "" || (a.Status.History != nil && len(a.Status.History) > 0)) {
+	if !a.Spec.HasMultipleSources() && !a.Spec.Source.IsHelm() && !a.Spec.Source.IsOCI() && (a.Status.Sync.Revision != "" || (a.Status.History != nil && len(a.Status.History) > 0)) {
 		revisionMetadata, err := s.getApplicationRevisionDetails(ctx, a, getOperationRevision(a))
 
 		if err != nil {
@@ -608,7 +608,7 @@ func (s *applicationEventReporter) getApplicationEventPayload(ctx context.Contex
 		DesiredManifest:       "",
 		GitManifest:           "",
 		ActualManifest:        actualManifest,
-		RepoURL:               a.Spec.Source.RepoURL,
+		RepoURL:               a.Spec.GetSource().RepoURL,
 		CommitMessage:         "",
 		CommitAuthor:          "",
 		Path:                  "",
@@ -0,0 +1,164 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	kubecache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/utils/pointer"
+
+	argocommon "github.com/argoproj/argo-cd/v2/common"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+)
+
+// preflightSync performs a server-side dry run apply of the target objects of the given sync operation against the
+// cluster, so that they are validated by the admission controllers of the cluster without being persisted. The
+// results are returned in the operation state of the application, which is not updated.
+func (s *Server) preflightSync(ctx context.Context, a *appv1.Application, proj *appv1.AppProject, op appv1.Operation) (*appv1.Application, error) {
+	startedAt := metav1.Now()
+	syncOp := *op.Sync
+	syncOp.DryRun = true
+	op.Sync = &syncOp
+
+	manifests := syncOp.Manifests
+	if len(manifests) == 0 {
+		manifestInfo, err := s.generateManifests(ctx, a, syncOp.Revision)
+		if err != nil {
+			return nil, fmt.Errorf("error generating manifests: %w", err)
+		}
+		for _, manifest := range manifestInfo.Manifests {
+			manifests = append(manifests, manifest.CompiledManifest)
+		}
+	}
+
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application cluster config: %w", err)
+	}
+	apiResources, err := s.kubectl.GetAPIResources(config, false, kubecache.NewNoopSettings())
+	if err != nil {
+		return nil, fmt.Errorf("error getting API resources: %w", err)
+	}
+	dynamicIf, err := s.kubectl.NewDynamicClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating dynamic client: %w", err)
+	}
+
+	syncRes := &appv1.SyncOperationResult{Revision: syncOp.Revision, Revisions: syncOp.Revisions}
+	phase := common.OperationSucceeded
+	message := "successfully validated all objects (server-side dry run)"
+	for _, manifest := range manifests {
+		obj, err := appv1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "error unmarshaling manifest: %v", err)
+		}
+		if obj == nil {
+			continue
+		}
+		if len(syncOp.Resources) > 0 && !argo.ContainsSyncResource(obj.GetName(), obj.GetNamespace(), obj.GroupVersionKind(), syncOp.Resources) {
+			continue
+		}
+		res := s.preflightResource(ctx, a, proj, obj, apiResources, dynamicIf)
+		if res.Status != common.ResultCodeSynced {
+			phase = common.OperationFailed
+			message = "one or more objects failed to apply (server-side dry run)"
+		}
+		syncRes.Resources = append(syncRes.Resources, res)
+	}
+
+	finishedAt := metav1.Now()
+	a = a.DeepCopy()
+	a.Status.OperationState = &appv1.OperationState{
+		Operation:  op,
+		Phase:      phase,
+		Message:    message,
+		SyncResult: syncRes,
+		StartedAt:  startedAt,
+		FinishedAt: &finishedAt,
+	}
+	return a, nil
+}
+
+// preflightResource performs a server-side dry run apply of the given target object and returns its result
+func (s *Server) preflightResource(ctx context.Context, a *appv1.Application, proj *appv1.AppProject, obj *unstructured.Unstructured, apiResources []kube.APIResourceInfo, dynamicIf dynamic.Interface) *appv1.ResourceResult {
+	gvk := obj.GroupVersionKind()
+	res := &appv1.ResourceResult{
+		Group:     gvk.Group,
+		Version:   gvk.Version,
+		Kind:      gvk.Kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		SyncPhase: common.SyncPhaseSync,
+		Status:    common.ResultCodeSyncFailed,
+	}
+
+	var apiResource *kube.APIResourceInfo
+	for i := range apiResources {
+		if apiResources[i].GroupVersionResource.GroupVersion() == gvk.GroupVersion() && apiResources[i].GroupKind.Kind == gvk.Kind {
+			apiResource = &apiResources[i]
+			break
+		}
+	}
+	if apiResource == nil {
+		res.Message = fmt.Sprintf("the server could not find the requested resource %s", gvk)
+		return res
+	}
+	if !proj.IsGroupKindPermitted(gvk.GroupKind(), apiResource.Meta.Namespaced) {
+		res.Message = fmt.Sprintf("resource %s:%s is not permitted in project %s", gvk.Group, gvk.Kind, proj.Name)
+		return res
+	}
+	if apiResource.Meta.Namespaced {
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(a.Spec.Destination.Namespace)
+			res.Namespace = obj.GetNamespace()
+		}
+		permitted, err := proj.IsDestinationPermitted(appv1.ApplicationDestination{Namespace: obj.GetNamespace(), Server: a.Spec.Destination.Server, Name: a.Spec.Destination.Name}, func(project string) ([]*appv1.Cluster, error) {
+			return s.db.GetProjectClusters(ctx, project)
+		})
+		if err != nil {
+			res.Message = err.Error()
+			return res
+		}
+		if !permitted {
+			res.Message = fmt.Sprintf("namespace %v is not permitted in project '%s'", obj.GetNamespace(), proj.Name)
+			return res
+		}
+	} else {
+		obj.SetNamespace("")
+		res.Namespace = ""
+	}
+
+	resIf := kube.ToResourceInterface(dynamicIf, &apiResource.Meta, apiResource.GroupVersionResource, obj.GetNamespace())
+	var err error
+	if obj.GetName() == "" {
+		// objects with a generated name, such as hooks, can only be created
+		_, err = resIf.Create(ctx, obj, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+	} else {
+		var data []byte
+		data, err = json.Marshal(obj)
+		if err == nil {
+			_, err = resIf.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+				DryRun:       []string{metav1.DryRunAll},
+				FieldManager: argocommon.ArgoCDSSAManager,
+				Force:        pointer.Bool(true),
+			})
+		}
+	}
+	if err != nil {
+		res.Message = err.Error()
+		return res
+	}
+	res.Status = common.ResultCodeSynced
+	res.Message = fmt.Sprintf("%s/%s validated (server-side dry run)", gvk.Kind, obj.GetName())
+	return res
+}
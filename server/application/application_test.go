@@ -10,6 +10,7 @@ import (
 
 	"github.com/argoproj/gitops-engine/pkg/health"
 	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
 	"github.com/argoproj/pkg/sync"
 	"github.com/ghodss/yaml"
@@ -22,8 +23,11 @@ import (
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/watch"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes/fake"
 	kubetesting "k8s.io/client-go/testing"
 	k8scache "k8s.io/client-go/tools/cache"
@@ -652,6 +656,96 @@ func TestSyncAndTerminate(t *testing.T) {
 	assert.Equal(t, synccommon.OperationTerminating, app.Status.OperationState.Phase)
 }
 
+func TestSyncScheduled(t *testing.T) {
+	ctx := context.Background()
+	appServer := newTestAppServer()
+	testApp := newTestApp()
+	testApp.Spec.Source.RepoURL = "https://github.com/argoproj/argo-cd.git"
+	app, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: testApp})
+	assert.NoError(t, err)
+
+	notBefore := metav1.NewTime(time.Now().Add(time.Hour).Truncate(time.Second))
+	app, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &app.Name, NotBefore: &notBefore})
+	assert.NoError(t, err)
+	assert.NotNil(t, app.Operation)
+	assert.True(t, app.Operation.Sync.IsScheduled(time.Now()))
+
+	events, err := appServer.kubeclientset.CoreV1().Events(appServer.ns).List(context.Background(), metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, events.Items[1].Message, fmt.Sprintf("scheduled at %s", notBefore.Format(time.RFC3339)))
+}
+
+func TestSyncNotBeforeInPast(t *testing.T) {
+	ctx := context.Background()
+	appServer := newTestAppServer()
+	testApp := newTestApp()
+	testApp.Spec.Source.RepoURL = "https://github.com/argoproj/argo-cd.git"
+	app, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: testApp})
+	assert.NoError(t, err)
+
+	notBefore := metav1.NewTime(time.Now().Add(-time.Hour))
+	app, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &app.Name, NotBefore: &notBefore})
+	assert.NoError(t, err)
+	assert.NotNil(t, app.Operation)
+	assert.Nil(t, app.Operation.Sync.NotBefore)
+}
+
+func TestSyncPreflight(t *testing.T) {
+	ctx := context.Background()
+	appServer := newTestAppServer()
+	testApp := newTestApp()
+	app, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: testApp})
+	require.NoError(t, err)
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	var dryRunPatches []string
+	dynamicClient.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		patchAction := action.(kubetesting.PatchActionImpl)
+		if patchAction.GetName() == "denied" {
+			return true, nil, coreerrors.New(`admission webhook "validation.gatekeeper.sh" denied the request`)
+		}
+		dryRunPatches = append(dryRunPatches, patchAction.GetName())
+		return true, &unstructured.Unstructured{}, nil
+	})
+	appServer.kubectl = &kubetest.MockKubectlCmd{
+		APIResources: []kube.APIResourceInfo{{
+			GroupKind:            schema.GroupKind{Kind: "ConfigMap"},
+			Meta:                 metav1.APIResource{Name: "configmaps", Kind: "ConfigMap", Namespaced: true},
+			GroupVersionResource: schema.GroupVersionResource{Version: "v1", Resource: "configmaps"},
+		}},
+		DynamicClient: dynamicClient,
+	}
+
+	app, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{
+		Name:      &app.Name,
+		Preflight: pointer.Bool(true),
+		Manifests: []string{
+			`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "allowed"}}`,
+			`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "denied"}}`,
+			`{"apiVersion": "example.com/v1", "kind": "Unknown", "metadata": {"name": "unknown"}}`,
+		},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, app.Operation)
+	require.NotNil(t, app.Status.OperationState)
+	assert.Equal(t, synccommon.OperationFailed, app.Status.OperationState.Phase)
+	assert.True(t, app.Status.OperationState.Operation.Sync.DryRun)
+	resources := app.Status.OperationState.SyncResult.Resources
+	require.Len(t, resources, 3)
+	assert.Equal(t, synccommon.ResultCodeSynced, resources[0].Status)
+	assert.Equal(t, testApp.Spec.Destination.Namespace, resources[0].Namespace)
+	assert.Equal(t, synccommon.ResultCodeSyncFailed, resources[1].Status)
+	assert.Contains(t, resources[1].Message, "denied the request")
+	assert.Equal(t, synccommon.ResultCodeSyncFailed, resources[2].Status)
+	assert.Equal(t, []string{"allowed"}, dryRunPatches)
+
+	// the preflight sync does not initiate an operation
+	app, err = appServer.appclientset.ArgoprojV1alpha1().Applications(appServer.ns).Get(ctx, app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, app.Operation)
+	assert.Nil(t, app.Status.OperationState)
+}
+
 func TestSyncHelm(t *testing.T) {
 	ctx := context.Background()
 	appServer := newTestAppServer()
@@ -726,6 +820,48 @@ func TestRollbackApp(t *testing.T) {
 	assert.Equal(t, "abc", updatedApp.Operation.Sync.Revision)
 }
 
+func TestRollbackAppToRevision(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(testApp)
+
+	updatedApp, err := appServer.Rollback(context.Background(), &application.ApplicationRollbackRequest{
+		Name:     &testApp.Name,
+		Revision: pointer.String("v1.0.0"),
+		Reason:   pointer.String("faulty release"),
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, updatedApp.Operation)
+	require.NotNil(t, updatedApp.Operation.Sync)
+	assert.Equal(t, fakeResolveRevesionResponse().Revision, updatedApp.Operation.Sync.Revision)
+	assert.Equal(t, testApp.Spec.Source, *updatedApp.Operation.Sync.Source)
+	assert.Equal(t, "faulty release", updatedApp.Operation.Sync.Reason)
+
+	events, err := appServer.kubeclientset.CoreV1().Events(appServer.ns).List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "Unknown user initiated rollback to HEAD (f9ba9e98119bf8c1176fbd65dbae26a71d044add): faulty release", events.Items[0].Message)
+}
+
+func TestRollbackAppInvalidRequest(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(testApp)
+
+	_, err := appServer.Rollback(context.Background(), &application.ApplicationRollbackRequest{
+		Name:     &testApp.Name,
+		Id:       pointer.Int64(1),
+		Revision: pointer.String("v1.0.0"),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	testApp.Spec.SyncPolicy = &appsv1.SyncPolicy{Automated: &appsv1.SyncPolicyAutomated{}}
+	appServer = newTestAppServer(testApp)
+	_, err = appServer.Rollback(context.Background(), &application.ApplicationRollbackRequest{
+		Name:     &testApp.Name,
+		Revision: pointer.String("v1.0.0"),
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
 func TestUpdateAppProject(t *testing.T) {
 	testApp := newTestApp()
 	ctx := context.Background()
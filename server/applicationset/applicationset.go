@@ -2,7 +2,9 @@ package applicationset
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"math"
 	"reflect"
 	"sort"
 	"strconv"
@@ -10,6 +12,7 @@ import (
 	"time"
 
 	"github.com/argoproj/pkg/sync"
+	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -17,10 +20,19 @@ import (
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/argoproj/argo-cd/v2/applicationset/controllers/template"
+	"github.com/argoproj/argo-cd/v2/applicationset/generators"
+	"github.com/argoproj/argo-cd/v2/applicationset/services"
 	appsetutils "github.com/argoproj/argo-cd/v2/applicationset/utils"
+	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/applicationset"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
@@ -31,65 +43,96 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	argoutil "github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/env"
+	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/github_app"
+	"github.com/argoproj/argo-cd/v2/util/glob"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
 	"github.com/argoproj/argo-cd/v2/util/session"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
+var (
+	watchAPIBufferSize = env.ParseNumFromEnv(common.EnvWatchAPIBufferSize, 1000, 0, math.MaxInt32)
+)
+
 type Server struct {
-	ns             string
-	db             db.ArgoDB
-	enf            *rbac.Enforcer
-	cache          *servercache.Cache
-	appclientset   appclientset.Interface
-	appLister      applisters.ApplicationLister
-	appsetInformer cache.SharedIndexInformer
-	appsetLister   applisters.ApplicationSetNamespaceLister
-	projLister     applisters.AppProjectNamespaceLister
-	auditLogger    *argo.AuditLogger
-	settings       *settings.SettingsManager
-	projectLock    sync.KeyLock
+	ns                string
+	db                db.ArgoDB
+	enf               *rbac.Enforcer
+	cache             *servercache.Cache
+	k8sClient         kubernetes.Interface
+	dynamicClientSet  dynamic.Interface
+	client            client.Client
+	gitCredStore      git.CredsStore
+	appclientset      appclientset.Interface
+	appLister         applisters.ApplicationLister
+	appsetInformer    cache.SharedIndexInformer
+	appsetBroadcaster *broadcasterHandler
+	appsetLister      applisters.ApplicationSetLister
+	projLister        applisters.AppProjectNamespaceLister
+	auditLogger       *argo.AuditLogger
+	settings          *settings.SettingsManager
+	projectLock       sync.KeyLock
+	enabledNamespaces []string
 }
 
 // NewServer returns a new instance of the ApplicationSet service
 func NewServer(
 	db db.ArgoDB,
 	kubeclientset kubernetes.Interface,
+	dynamicClientSet dynamic.Interface,
+	client client.Client,
+	gitCredStore git.CredsStore,
 	enf *rbac.Enforcer,
 	cache *servercache.Cache,
 	appclientset appclientset.Interface,
 	appLister applisters.ApplicationLister,
 	appsetInformer cache.SharedIndexInformer,
-	appsetLister applisters.ApplicationSetNamespaceLister,
+	appsetLister applisters.ApplicationSetLister,
 	projLister applisters.AppProjectNamespaceLister,
 	settings *settings.SettingsManager,
 	namespace string,
 	projectLock sync.KeyLock,
+	enabledNamespaces []string,
 ) applicationset.ApplicationSetServiceServer {
+	appsetBroadcaster := &broadcasterHandler{}
+	appsetInformer.AddEventHandler(appsetBroadcaster)
 	s := &Server{
-		ns:             namespace,
-		cache:          cache,
-		db:             db,
-		enf:            enf,
-		appclientset:   appclientset,
-		appLister:      appLister,
-		appsetInformer: appsetInformer,
-		appsetLister:   appsetLister,
-		projLister:     projLister,
-		settings:       settings,
-		projectLock:    projectLock,
-		auditLogger:    argo.NewAuditLogger(namespace, kubeclientset, "argocd-server"),
+		ns:                namespace,
+		cache:             cache,
+		db:                db,
+		enf:               enf,
+		k8sClient:         kubeclientset,
+		dynamicClientSet:  dynamicClientSet,
+		client:            client,
+		gitCredStore:      gitCredStore,
+		appclientset:      appclientset,
+		appLister:         appLister,
+		appsetInformer:    appsetInformer,
+		appsetBroadcaster: appsetBroadcaster,
+		appsetLister:      appsetLister,
+		projLister:        projLister,
+		settings:          settings,
+		projectLock:       projectLock,
+		auditLogger:       argo.NewAuditLogger(namespace, kubeclientset, "argocd-server"),
+		enabledNamespaces: enabledNamespaces,
 	}
 	return s
 }
 
 func (s *Server) Get(ctx context.Context, q *applicationset.ApplicationSetGetQuery) (*v1alpha1.ApplicationSet, error) {
-	a, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(s.ns).Get(ctx, q.GetName(), metav1.GetOptions{})
+	namespace := s.appsetNamespaceOrDefault(q.GetAppsetNamespace())
+	if !s.isNamespaceEnabled(namespace) {
+		return nil, namespaceNotPermittedError(namespace)
+	}
+
+	a, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Get(ctx, q.GetName(), metav1.GetOptions{})
 
 	if err != nil {
 		return nil, fmt.Errorf("error getting ApplicationSet: %w", err)
 	}
-	if err = s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionGet, apputil.AppSetRBACName(a)); err != nil {
+	if err = s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionGet, apputil.AppSetRBACName(s.ns, a)); err != nil {
 		return nil, err
 	}
 
@@ -103,7 +146,16 @@ func (s *Server) List(ctx context.Context, q *applicationset.ApplicationSetListQ
 		return nil, fmt.Errorf("error converting selector to labels map: %w", err)
 	}
 
-	appIf := s.appclientset.ArgoprojV1alpha1().ApplicationSets(s.ns)
+	// An empty namespace lists the ApplicationSets of all the enabled namespaces
+	namespace := q.GetAppsetNamespace()
+	if namespace == "" && len(s.enabledNamespaces) == 0 {
+		namespace = s.ns
+	}
+	if namespace != "" && !s.isNamespaceEnabled(namespace) {
+		return nil, namespaceNotPermittedError(namespace)
+	}
+
+	appIf := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace)
 	appsetList, err := appIf.List(ctx, metav1.ListOptions{LabelSelector: labelsMap.AsSelector().String()})
 	if err != nil {
 		return nil, fmt.Errorf("error listing ApplicationSets with selectors: %w", err)
@@ -111,7 +163,12 @@ func (s *Server) List(ctx context.Context, q *applicationset.ApplicationSetListQ
 
 	newItems := make([]v1alpha1.ApplicationSet, 0)
 	for _, a := range appsetList.Items {
-		if s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionGet, apputil.AppSetRBACName(&a)) {
+		// Skip any ApplicationSet that is neither in the control plane's namespace
+		// nor in the list of enabled namespaces.
+		if !s.isNamespaceEnabled(a.Namespace) {
+			continue
+		}
+		if s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionGet, apputil.AppSetRBACName(s.ns, &a)) {
 			newItems = append(newItems, a)
 		}
 	}
@@ -152,7 +209,7 @@ func (s *Server) Create(ctx context.Context, q *applicationset.ApplicationSetCre
 	s.projectLock.RLock(projectName)
 	defer s.projectLock.RUnlock(projectName)
 
-	created, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(s.ns).Create(ctx, appset, metav1.CreateOptions{})
+	created, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(appset.Namespace).Create(ctx, appset, metav1.CreateOptions{})
 	if err == nil {
 		s.logAppSetEvent(created, ctx, argo.EventReasonResourceCreated, "created ApplicationSet")
 		s.waitSync(created)
@@ -163,7 +220,7 @@ func (s *Server) Create(ctx context.Context, q *applicationset.ApplicationSetCre
 		return nil, fmt.Errorf("error creating ApplicationSet: %w", err)
 	}
 	// act idempotent if existing spec matches new spec
-	existing, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(s.ns).Get(ctx, appset.Name, metav1.GetOptions{
+	existing, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(appset.Namespace).Get(ctx, appset.Name, metav1.GetOptions{
 		ResourceVersion: "",
 	})
 	if err != nil {
@@ -182,7 +239,7 @@ func (s *Server) Create(ctx context.Context, q *applicationset.ApplicationSetCre
 	if !q.Upsert {
 		return nil, status.Errorf(codes.InvalidArgument, "existing ApplicationSet spec is different, use upsert flag to force update")
 	}
-	if err = s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionUpdate, apputil.AppSetRBACName(appset)); err != nil {
+	if err = s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionUpdate, apputil.AppSetRBACName(s.ns, appset)); err != nil {
 		return nil, err
 	}
 	updated, err := s.updateAppSet(existing, appset, ctx, true)
@@ -192,6 +249,112 @@ func (s *Server) Create(ctx context.Context, q *applicationset.ApplicationSetCre
 	return updated, nil
 }
 
+// Update updates an ApplicationSet
+func (s *Server) Update(ctx context.Context, q *applicationset.ApplicationSetUpdateRequest) (*v1alpha1.ApplicationSet, error) {
+	appset := q.GetApplicationset()
+
+	if appset == nil {
+		return nil, fmt.Errorf("error updating ApplicationSets: ApplicationSets is nil in request")
+	}
+
+	projectName, err := s.validateAppSet(ctx, appset)
+	if err != nil {
+		return nil, fmt.Errorf("error validating ApplicationSets: %w", err)
+	}
+
+	existing, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(appset.Namespace).Get(ctx, appset.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting ApplicationSets: %w", err)
+	}
+
+	if err = s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionUpdate, apputil.AppSetRBACName(s.ns, existing)); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkProjectPermitsNamespace(ctx, projectName, appset.Namespace); err != nil {
+		return nil, err
+	}
+
+	s.projectLock.RLock(projectName)
+	defer s.projectLock.RUnlock(projectName)
+
+	return s.updateAppSet(existing, appset, ctx, false)
+}
+
+// Patch patches an ApplicationSet
+func (s *Server) Patch(ctx context.Context, q *applicationset.ApplicationSetPatchRequest) (*v1alpha1.ApplicationSet, error) {
+	namespace := s.appsetNamespaceOrDefault(q.GetAppsetNamespace())
+	if !s.isNamespaceEnabled(namespace) {
+		return nil, namespaceNotPermittedError(namespace)
+	}
+
+	appset, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Get(ctx, q.GetName(), metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting ApplicationSets: %w", err)
+	}
+
+	if err = s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionUpdate, apputil.AppSetRBACName(s.ns, appset)); err != nil {
+		return nil, err
+	}
+
+	jsonAppSet, err := json.Marshal(appset)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling ApplicationSet: %w", err)
+	}
+
+	var patchAppSet []byte
+
+	switch q.GetPatchType() {
+	case "json", "":
+		patch, err := jsonpatch.DecodePatch([]byte(q.GetPatch()))
+		if err != nil {
+			return nil, fmt.Errorf("error decoding json patch: %w", err)
+		}
+		patchAppSet, err = patch.Apply(jsonAppSet)
+		if err != nil {
+			return nil, fmt.Errorf("error applying patch: %w", err)
+		}
+	case "merge":
+		patchAppSet, err = jsonpatch.MergePatch(jsonAppSet, []byte(q.GetPatch()))
+		if err != nil {
+			return nil, fmt.Errorf("error calculating merge patch: %w", err)
+		}
+	case "strategic":
+		patchAppSet, err = strategicpatch.StrategicMergePatch(jsonAppSet, []byte(q.GetPatch()), v1alpha1.ApplicationSet{})
+		if err != nil {
+			return nil, fmt.Errorf("error calculating strategic merge patch: %w", err)
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Patch type '%s' is not supported", q.GetPatchType()))
+	}
+
+	newAppSet := &v1alpha1.ApplicationSet{}
+	err = json.Unmarshal(patchAppSet, newAppSet)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling patched ApplicationSet: %w", err)
+	}
+	if newAppSet.Name != appset.Name {
+		return nil, status.Errorf(codes.InvalidArgument, "ApplicationSet name cannot be changed by a patch")
+	}
+	if newAppSet.Namespace != appset.Namespace {
+		return nil, status.Errorf(codes.InvalidArgument, "ApplicationSet namespace cannot be changed by a patch")
+	}
+
+	projectName, err := s.validateAppSet(ctx, newAppSet)
+	if err != nil {
+		return nil, fmt.Errorf("error validating ApplicationSets: %w", err)
+	}
+
+	if err := s.checkProjectPermitsNamespace(ctx, projectName, newAppSet.Namespace); err != nil {
+		return nil, err
+	}
+
+	s.projectLock.RLock(projectName)
+	defer s.projectLock.RUnlock(projectName)
+
+	return s.updateAppSet(appset, newAppSet, ctx, false)
+}
+
 func mergeStringMaps(items ...map[string]string) map[string]string {
 	res := make(map[string]string)
 	for _, m := range items {
@@ -210,11 +373,11 @@ func (s *Server) updateAppSet(appset *v1alpha1.ApplicationSet, newAppset *v1alph
 	if appset != nil && appset.Spec.Template.Spec.Project != newAppset.Spec.Template.Spec.Project {
 		// When changing projects, caller must have applicationset create and update privileges in new project
 		// NOTE: the update check was already verified in the caller to this function
-		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionCreate, apputil.AppSetRBACName(newAppset)); err != nil {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionCreate, apputil.AppSetRBACName(s.ns, newAppset)); err != nil {
 			return nil, err
 		}
 		// They also need 'update' privileges in the old project
-		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionUpdate, apputil.AppSetRBACName(appset)); err != nil {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionUpdate, apputil.AppSetRBACName(s.ns, appset)); err != nil {
 			return nil, err
 		}
 	}
@@ -229,7 +392,7 @@ func (s *Server) updateAppSet(appset *v1alpha1.ApplicationSet, newAppset *v1alph
 			appset.Annotations = newAppset.Annotations
 		}
 
-		res, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(s.ns).Update(ctx, appset, metav1.UpdateOptions{})
+		res, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(appset.Namespace).Update(ctx, appset, metav1.UpdateOptions{})
 		if err == nil {
 			s.logAppSetEvent(appset, ctx, argo.EventReasonResourceUpdated, "updated ApplicationSets spec")
 			s.waitSync(res)
@@ -239,7 +402,7 @@ func (s *Server) updateAppSet(appset *v1alpha1.ApplicationSet, newAppset *v1alph
 			return nil, err
 		}
 
-		appset, err = s.appclientset.ArgoprojV1alpha1().ApplicationSets(s.ns).Get(ctx, newAppset.Name, metav1.GetOptions{})
+		appset, err = s.appclientset.ArgoprojV1alpha1().ApplicationSets(appset.Namespace).Get(ctx, newAppset.Name, metav1.GetOptions{})
 		if err != nil {
 			return nil, fmt.Errorf("error getting ApplicationSets: %w", err)
 		}
@@ -247,21 +410,182 @@ func (s *Server) updateAppSet(appset *v1alpha1.ApplicationSet, newAppset *v1alph
 	return nil, status.Errorf(codes.Internal, "Failed to update ApplicationSets. Too many conflicts")
 }
 
+// Watch returns stream of ApplicationSet change events
+func (s *Server) Watch(q *applicationset.ApplicationSetWatchQuery, ws applicationset.ApplicationSetService_WatchServer) error {
+	appsetName := q.GetName()
+	appsetNs := q.GetAppsetNamespace()
+	logCtx := log.NewEntry(log.New())
+	if appsetName != "" {
+		logCtx = logCtx.WithField("applicationset", appsetName)
+	}
+	// A watch of a single ApplicationSet defaults to the control plane's namespace
+	if appsetName != "" {
+		appsetNs = s.appsetNamespaceOrDefault(appsetNs)
+	}
+	if appsetNs != "" && !s.isNamespaceEnabled(appsetNs) {
+		return namespaceNotPermittedError(appsetNs)
+	}
+	projects := map[string]bool{}
+	for i := range q.Projects {
+		projects[q.Projects[i]] = true
+	}
+	claims := ws.Context().Value("claims")
+	selector, err := labels.Parse(q.GetSelector())
+	if err != nil {
+		return fmt.Errorf("error parsing labels with selectors: %w", err)
+	}
+	minVersion := 0
+	if q.GetResourceVersion() != "" {
+		if minVersion, err = strconv.Atoi(q.GetResourceVersion()); err != nil {
+			minVersion = 0
+		}
+	}
+
+	// sendIfPermitted is a helper to send the applicationset to the client's streaming channel if the
+	// caller has RBAC privileges permissions to view it
+	sendIfPermitted := func(a v1alpha1.ApplicationSet, eventType watch.EventType) {
+		if len(projects) > 0 && !projects[a.Spec.Template.Spec.GetProject()] {
+			return
+		}
+
+		if appsetVersion, err := strconv.Atoi(a.ResourceVersion); err == nil && appsetVersion < minVersion {
+			return
+		}
+		if !s.isNamespaceEnabled(a.Namespace) {
+			return
+		}
+		matchedEvent := (appsetName == "" || a.Name == appsetName) && (appsetNs == "" || a.Namespace == appsetNs) && selector.Matches(labels.Set(a.Labels))
+		if !matchedEvent {
+			return
+		}
+
+		if !s.enf.Enforce(claims, rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionGet, apputil.AppSetRBACName(s.ns, &a)) {
+			// do not emit applicationsets user does not have accessing
+			return
+		}
+		err := ws.Send(&v1alpha1.ApplicationSetWatchEvent{
+			Type:           eventType,
+			ApplicationSet: a,
+		})
+		if err != nil {
+			logCtx.Warnf("Unable to send stream message: %v", err)
+			return
+		}
+	}
+
+	events := make(chan *v1alpha1.ApplicationSetWatchEvent, watchAPIBufferSize)
+	// Mimic watch API behavior: send ADDED events if no resource version provided
+	// If watch API is executed for one applicationset when emit event even if resource version is provided
+	if q.GetResourceVersion() == "" || appsetName != "" {
+		appsets, err := s.appsetLister.List(selector)
+		if err != nil {
+			return fmt.Errorf("error listing ApplicationSets with selector: %w", err)
+		}
+		sort.Slice(appsets, func(i, j int) bool {
+			return appsets[i].Name < appsets[j].Name
+		})
+		for i := range appsets {
+			sendIfPermitted(*appsets[i], watch.Added)
+		}
+	}
+	unsubscribe := s.appsetBroadcaster.Subscribe(events)
+	defer unsubscribe()
+	for {
+		select {
+		case event := <-events:
+			sendIfPermitted(event.ApplicationSet, event.Type)
+		case <-ws.Context().Done():
+			return nil
+		}
+	}
+}
+
+// Refresh sets the refresh annotation on an ApplicationSet, so that the ApplicationSet controller regenerates its
+// Applications immediately. If requested, it waits until the controller has processed the refresh and cleared the
+// annotation.
+func (s *Server) Refresh(ctx context.Context, q *applicationset.ApplicationSetRefreshRequest) (*v1alpha1.ApplicationSet, error) {
+	appsetName := q.GetName()
+	namespace := s.appsetNamespaceOrDefault(q.GetAppsetNamespace())
+	if !s.isNamespaceEnabled(namespace) {
+		return nil, namespaceNotPermittedError(namespace)
+	}
+	appsetIf := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace)
+
+	appset, err := appsetIf.Get(ctx, appsetName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting ApplicationSet: %w", err)
+	}
+
+	// Like the refresh of an Application, a refresh only requires the permission to get the ApplicationSet
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionGet, apputil.AppSetRBACName(s.ns, appset)); err != nil {
+		return nil, err
+	}
+
+	// subscribe early with buffered channel to ensure we don't miss events
+	events := make(chan *v1alpha1.ApplicationSetWatchEvent, watchAPIBufferSize)
+	unsubscribe := s.appsetBroadcaster.Subscribe(events, func(event *v1alpha1.ApplicationSetWatchEvent) bool {
+		return event.ApplicationSet.Name == appsetName && event.ApplicationSet.Namespace == namespace
+	})
+	defer unsubscribe()
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				common.AnnotationApplicationSetRefresh: "true",
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling refresh patch: %w", err)
+	}
+	appset, err = appsetIf.Patch(ctx, appsetName, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error refreshing ApplicationSet: %w", err)
+	}
+	s.logAppSetEvent(appset, ctx, argo.EventReasonResourceUpdated, "refreshed application set")
+
+	if !q.GetWait() {
+		return appset, nil
+	}
+
+	minVersion, err := strconv.Atoi(appset.ResourceVersion)
+	if err != nil {
+		minVersion = 0
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("applicationset refresh deadline exceeded")
+		case event := <-events:
+			if appsetVersion, err := strconv.Atoi(event.ApplicationSet.ResourceVersion); err == nil && appsetVersion > minVersion {
+				if !event.ApplicationSet.RefreshRequired() {
+					return &event.ApplicationSet, nil
+				}
+			}
+		}
+	}
+}
+
 func (s *Server) Delete(ctx context.Context, q *applicationset.ApplicationSetDeleteRequest) (*applicationset.ApplicationSetResponse, error) {
+	namespace := s.appsetNamespaceOrDefault(q.GetAppsetNamespace())
+	if !s.isNamespaceEnabled(namespace) {
+		return nil, namespaceNotPermittedError(namespace)
+	}
 
-	appset, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(s.ns).Get(ctx, q.Name, metav1.GetOptions{})
+	appset, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Get(ctx, q.Name, metav1.GetOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("error getting ApplicationSets: %w", err)
 	}
 
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionDelete, apputil.AppSetRBACName(appset)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionDelete, apputil.AppSetRBACName(s.ns, appset)); err != nil {
 		return nil, err
 	}
 
 	s.projectLock.RLock(appset.Spec.Template.Spec.Project)
 	defer s.projectLock.RUnlock(appset.Spec.Template.Spec.Project)
 
-	err = s.appclientset.ArgoprojV1alpha1().ApplicationSets(s.ns).Delete(ctx, q.Name, metav1.DeleteOptions{})
+	err = s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Delete(ctx, q.Name, metav1.DeleteOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("error deleting ApplicationSets: %w", err)
 	}
@@ -270,11 +594,148 @@ func (s *Server) Delete(ctx context.Context, q *applicationset.ApplicationSetDel
 
 }
 
+// Generate returns the Applications which the given ApplicationSet would generate, without creating them
+func (s *Server) Generate(ctx context.Context, q *applicationset.ApplicationSetGenerateRequest) (*applicationset.ApplicationSetGenerateResponse, error) {
+	appset := q.GetApplicationSet()
+
+	if appset == nil {
+		return nil, fmt.Errorf("error generating Applications: ApplicationSet is nil in request")
+	}
+
+	projectName, err := s.validateAppSet(ctx, appset)
+	if err != nil {
+		return nil, fmt.Errorf("error validating ApplicationSets: %w", err)
+	}
+
+	// Generating Applications runs the same generators as creating the ApplicationSet would, so it requires the same
+	// permissions
+	if err := s.checkCreatePermissions(ctx, appset, projectName); err != nil {
+		return nil, fmt.Errorf("error checking create permissions for ApplicationSets %s : %s", appset.Name, err)
+	}
+
+	// The generators run in the API server, so the generators calling the URLs chosen by the caller with the
+	// credentials of Argo CD are not allowed
+	if err := checkGenerateAllowedGenerators(appset); err != nil {
+		return nil, err
+	}
+
+	if s.client == nil || s.dynamicClientSet == nil {
+		return nil, status.Errorf(codes.Unimplemented, "generating Applications is not supported by this server")
+	}
+
+	scmAuth := generators.SCMAuthProviders{
+		GitHubApps: github_app.NewAuthCredentials(s.db.(db.RepoCredsDB)),
+	}
+	argoCDService := services.NewArgoCDService(s.db, s.gitCredStore, env.ParseBoolFromEnv(common.EnvGitSubmoduleEnabled, true))
+	appSetGenerators := generators.GetGenerators(ctx, s.client, s.k8sClient, s.ns, argoCDService, s.dynamicClientSet, scmAuth)
+
+	logCtx := log.WithField("applicationset", appset.Name)
+	apps, _, err := template.GenerateApplications(logCtx, *appset, appSetGenerators, &appsetutils.Render{})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error generating Applications: %v", err)
+	}
+
+	res := &applicationset.ApplicationSetGenerateResponse{}
+	for i := range apps {
+		// Applications are always generated in the namespace of their ApplicationSet
+		apps[i].Namespace = appset.Namespace
+		// The template patch can change the project, the sources and the destination of each Application
+		if err := s.checkGeneratedAppPermissions(ctx, appset, &apps[i]); err != nil {
+			return nil, err
+		}
+		res.Applications = append(res.Applications, &apps[i])
+	}
+	return res, nil
+}
+
+// checkGenerateAllowedGenerators returns an error if the ApplicationSet uses an SCM provider or a pull request
+// generator, including in matrix and merge generators
+func checkGenerateAllowedGenerators(appset *v1alpha1.ApplicationSet) error {
+	data, err := json.Marshal(appset.Spec.Generators)
+	if err != nil {
+		return fmt.Errorf("error marshaling generators: %w", err)
+	}
+	var generators interface{}
+	if err := json.Unmarshal(data, &generators); err != nil {
+		return fmt.Errorf("error unmarshaling generators: %w", err)
+	}
+	if name := findGenerator(generators, "scmProvider", "pullRequest"); name != "" {
+		return status.Errorf(codes.InvalidArgument, "generating Applications with the %s generator is not supported", name)
+	}
+	return nil
+}
+
+// findGenerator returns the first of the given generator names found in the generators, or an empty string
+func findGenerator(generators interface{}, names ...string) string {
+	switch v := generators.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if name := findGenerator(item, names...); name != "" {
+				return name
+			}
+		}
+	case map[string]interface{}:
+		for _, name := range names {
+			if v[name] != nil {
+				return name
+			}
+		}
+		for _, key := range []string{"matrix", "merge"} {
+			if nested, ok := v[key].(map[string]interface{}); ok {
+				if name := findGenerator(nested["generators"], names...); name != "" {
+					return name
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// checkGeneratedAppPermissions checks that the caller could create the ApplicationSet with the project of a generated
+// Application, and that the project permits its sources and destination
+func (s *Server) checkGeneratedAppPermissions(ctx context.Context, appset *v1alpha1.ApplicationSet, app *v1alpha1.Application) error {
+	projectName := app.Spec.GetProject()
+	projectAppSet := appset.DeepCopy()
+	projectAppSet.Spec.Template.Spec.Project = projectName
+	if err := s.checkCreatePermissions(ctx, projectAppSet, projectName); err != nil {
+		return fmt.Errorf("error checking create permissions for Application %s : %s", app.Name, err)
+	}
+
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, projectName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting Application's project %q: %w", projectName, err)
+	}
+	for _, source := range app.Spec.GetSources() {
+		if !proj.IsSourcePermitted(source) {
+			return status.Errorf(codes.PermissionDenied, "application repo %s is not permitted in project '%s'", source.RepoURL, projectName)
+		}
+	}
+	destination := app.Spec.Destination
+	if err := argo.ValidateDestination(ctx, &destination, s.db); err != nil {
+		return status.Errorf(codes.InvalidArgument, "application %s destination is invalid: %v", app.Name, err)
+	}
+	permitted, err := proj.IsDestinationPermitted(destination, func(project string) ([]*v1alpha1.Cluster, error) {
+		return s.db.GetProjectClusters(ctx, project)
+	})
+	if err != nil {
+		return fmt.Errorf("error checking Application's destination: %w", err)
+	}
+	if !permitted {
+		return status.Errorf(codes.PermissionDenied, "application destination {%s %s} is not permitted in project '%s'", destination.Server, destination.Namespace, projectName)
+	}
+	return nil
+}
+
 func (s *Server) validateAppSet(ctx context.Context, appset *v1alpha1.ApplicationSet) (string, error) {
 	if appset == nil {
 		return "", fmt.Errorf("ApplicationSet cannot be validated for nil value")
 	}
 
+	appset.Namespace = s.appsetNamespaceOrDefault(appset.Namespace)
+	if !s.isNamespaceEnabled(appset.Namespace) {
+		return "", namespaceNotPermittedError(appset.Namespace)
+	}
+
 	projectName := appset.Spec.Template.Spec.Project
 
 	if strings.Contains(projectName, "{{") {
@@ -290,11 +751,17 @@ func (s *Server) validateAppSet(ctx context.Context, appset *v1alpha1.Applicatio
 
 func (s *Server) checkCreatePermissions(ctx context.Context, appset *v1alpha1.ApplicationSet, projectName string) error {
 
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionCreate, apputil.AppSetRBACName(appset)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionCreate, apputil.AppSetRBACName(s.ns, appset)); err != nil {
 		return err
 	}
 
-	_, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, projectName, metav1.GetOptions{})
+	return s.checkProjectPermitsNamespace(ctx, projectName, appset.Namespace)
+}
+
+// checkProjectPermitsNamespace checks that the project exists and that it allows ApplicationSets, and thus the
+// Applications they generate, in the given namespace
+func (s *Server) checkProjectPermitsNamespace(ctx context.Context, projectName string, namespace string) error {
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, projectName, metav1.GetOptions{})
 	if err != nil {
 		if apierr.IsNotFound(err) {
 			return status.Errorf(codes.InvalidArgument, "ApplicationSet references project %s which does not exist", projectName)
@@ -302,9 +769,28 @@ func (s *Server) checkCreatePermissions(ctx context.Context, appset *v1alpha1.Ap
 		return fmt.Errorf("error getting ApplicationSet's project %q: %w", projectName, err)
 	}
 
+	if namespace != s.ns && !glob.MatchStringInList(proj.Spec.SourceNamespaces, namespace, false) {
+		return status.Errorf(codes.InvalidArgument, "ApplicationSet in namespace '%s' is not allowed to use project '%s'", namespace, projectName)
+	}
+
 	return nil
 }
 
+func (s *Server) appsetNamespaceOrDefault(appsetNs string) string {
+	if appsetNs == "" {
+		return s.ns
+	}
+	return appsetNs
+}
+
+func (s *Server) isNamespaceEnabled(namespace string) bool {
+	return namespace == s.ns || glob.MatchStringInList(s.enabledNamespaces, namespace, false)
+}
+
+func namespaceNotPermittedError(namespace string) error {
+	return status.Errorf(codes.InvalidArgument, "namespace '%s' is not permitted", namespace)
+}
+
 var informerSyncTimeout = 2 * time.Second
 
 // waitSync is a helper to wait until the application informer cache is synced after create/update.
@@ -324,7 +810,7 @@ func (s *Server) waitSync(appset *v1alpha1.ApplicationSet) {
 		return
 	}
 	for {
-		if currAppset, err := s.appsetLister.Get(appset.Name); err == nil {
+		if currAppset, err := s.appsetLister.ApplicationSets(appset.Namespace).Get(appset.Name); err == nil {
 			currVersion, err := strconv.Atoi(currAppset.ResourceVersion)
 			if err == nil && currVersion >= minVersion {
 				return
@@ -0,0 +1,538 @@
+package applicationset
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/argoproj/pkg/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8scache "k8s.io/client-go/tools/cache"
+	crtclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/applicationset"
+	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	apps "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
+	appinformer "github.com/argoproj/argo-cd/v2/pkg/client/informers/externalversions"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/assets"
+	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const testNamespace = "default"
+
+// return an ApplicationSetServiceServer which returns fake data
+func newTestAppSetServer(objects ...runtime.Object) *Server {
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		enf.SetDefaultRole("role:admin")
+	}
+	return newTestAppSetServerWithEnforcerConfigure(f, objects...)
+}
+
+func newTestAppSetServerWithEnforcerConfigure(f func(*rbac.Enforcer), objects ...runtime.Object) *Server {
+	kubeclientset := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "argocd-cm",
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+	}, &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-secret",
+			Namespace: testNamespace,
+		},
+		Data: map[string][]byte{
+			"admin.password":   []byte("test"),
+			"server.secretkey": []byte("test"),
+		},
+	})
+	ctx := context.Background()
+	settingsMgr := settings.NewSettingsManager(ctx, kubeclientset, testNamespace)
+	argoDB := db.NewDB(testNamespace, settingsMgr, kubeclientset)
+
+	defaultProj := &appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: testNamespace},
+		Spec: appsv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []appsv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+	objects = append(objects, defaultProj)
+
+	fakeAppsClientset := apps.NewSimpleClientset(objects...)
+	factory := appinformer.NewSharedInformerFactoryWithOptions(fakeAppsClientset, 0, appinformer.WithNamespace(""), appinformer.WithTweakListOptions(func(options *metav1.ListOptions) {}))
+	fakeProjLister := factory.Argoproj().V1alpha1().AppProjects().Lister().AppProjects(testNamespace)
+
+	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	f(enforcer)
+	enforcer.SetClaimsEnforcerFunc(rbacpolicy.NewRBACPolicyEnforcer(enforcer, fakeProjLister).EnforceClaims)
+
+	appsetInformer := factory.Argoproj().V1alpha1().ApplicationSets().Informer()
+	go appsetInformer.Run(ctx.Done())
+	if !k8scache.WaitForCacheSync(ctx.Done(), appsetInformer.HasSynced) {
+		panic("Timed out waiting for caches to sync")
+	}
+
+	scheme := runtime.NewScheme()
+	_ = v1.AddToScheme(scheme)
+	_ = appsv1.AddToScheme(scheme)
+
+	server := NewServer(
+		argoDB,
+		kubeclientset,
+		dynfake.NewSimpleDynamicClient(scheme),
+		crtclient.NewClientBuilder().WithScheme(scheme).Build(),
+		git.NoopCredsStore{},
+		enforcer,
+		nil,
+		fakeAppsClientset,
+		factory.Argoproj().V1alpha1().Applications().Lister(),
+		appsetInformer,
+		factory.Argoproj().V1alpha1().ApplicationSets().Lister(),
+		fakeProjLister,
+		settingsMgr,
+		testNamespace,
+		sync.NewKeyLock(),
+		[]string{"external-namespace"},
+	)
+	return server.(*Server)
+}
+
+func newTestAppSet(opts ...func(appset *appsv1.ApplicationSet)) *appsv1.ApplicationSet {
+	appset := appsv1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-appset",
+		},
+		Spec: appsv1.ApplicationSetSpec{
+			Generators: []appsv1.ApplicationSetGenerator{
+				{
+					List: &appsv1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"cluster": "engineering-dev", "url": "https://1.2.3.4"}`)},
+							{Raw: []byte(`{"cluster": "engineering-prod", "url": "https://2.4.6.8"}`)},
+						},
+					},
+				},
+			},
+			Template: appsv1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: appsv1.ApplicationSetTemplateMeta{
+					Name: "{{cluster}}-guestbook",
+				},
+				Spec: appsv1.ApplicationSpec{
+					Project: "default",
+					Source: appsv1.ApplicationSource{
+						RepoURL:        "https://github.com/argoproj/argocd-example-apps.git",
+						TargetRevision: "HEAD",
+						Path:           "guestbook",
+					},
+					Destination: appsv1.ApplicationDestination{
+						Server:    "{{url}}",
+						Namespace: "guestbook",
+					},
+				},
+			},
+		},
+	}
+	for i := range opts {
+		opts[i](&appset)
+	}
+	return &appset
+}
+
+func TestGenerateApplications(t *testing.T) {
+	appSetServer := newTestAppSetServer()
+
+	res, err := appSetServer.Generate(context.Background(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: newTestAppSet()})
+	require.NoError(t, err)
+	require.Len(t, res.Applications, 2)
+
+	assert.Equal(t, "engineering-dev-guestbook", res.Applications[0].Name)
+	assert.Equal(t, testNamespace, res.Applications[0].Namespace)
+	assert.Equal(t, "https://1.2.3.4", res.Applications[0].Spec.Destination.Server)
+	assert.Equal(t, "engineering-prod-guestbook", res.Applications[1].Name)
+	assert.Equal(t, "https://2.4.6.8", res.Applications[1].Spec.Destination.Server)
+
+	// Generating Applications must not create anything
+	apps, err := appSetServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, apps.Items)
+	appsets, err := appSetServer.appclientset.ArgoprojV1alpha1().ApplicationSets(testNamespace).List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, appsets.Items)
+}
+
+func TestGenerateApplicationsErrors(t *testing.T) {
+	t.Run("Nil ApplicationSet", func(t *testing.T) {
+		appSetServer := newTestAppSetServer()
+		_, err := appSetServer.Generate(context.Background(), &applicationset.ApplicationSetGenerateRequest{})
+		assert.EqualError(t, err, "error generating Applications: ApplicationSet is nil in request")
+	})
+
+	t.Run("Project does not exist", func(t *testing.T) {
+		appSetServer := newTestAppSetServer()
+		appset := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+			appset.Spec.Template.Spec.Project = "missing"
+		})
+		_, err := appSetServer.Generate(context.Background(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: appset})
+		assert.ErrorContains(t, err, "ApplicationSet references project missing which does not exist")
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		appSetServer := newTestAppSetServerWithEnforcerConfigure(func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("role:readonly")
+		})
+		_, err := appSetServer.Generate(context.Background(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: newTestAppSet()})
+		assert.ErrorContains(t, err, "permission denied")
+	})
+
+	t.Run("SCM provider and pull request generators", func(t *testing.T) {
+		appSetServer := newTestAppSetServer()
+		appset := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+			appset.Spec.Generators = []appsv1.ApplicationSetGenerator{{
+				SCMProvider: &appsv1.SCMProviderGenerator{Github: &appsv1.SCMProviderGeneratorGithub{Organization: "argoproj", API: "https://attacker.example.com"}},
+			}}
+		})
+		_, err := appSetServer.Generate(context.Background(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: appset})
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = generating Applications with the scmProvider generator is not supported")
+
+		appset = newTestAppSet(func(appset *appsv1.ApplicationSet) {
+			appset.Spec.Generators = []appsv1.ApplicationSetGenerator{{
+				Matrix: &appsv1.MatrixGenerator{Generators: []appsv1.ApplicationSetNestedGenerator{
+					{List: appset.Spec.Generators[0].List},
+					{PullRequest: &appsv1.PullRequestGenerator{Github: &appsv1.PullRequestGeneratorGithub{Owner: "argoproj", Repo: "argo-cd"}}},
+				}},
+			}}
+		})
+		_, err = appSetServer.Generate(context.Background(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: appset})
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = generating Applications with the pullRequest generator is not supported")
+	})
+
+	t.Run("Template patch changing the project", func(t *testing.T) {
+		restricted := &appsv1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "restricted", Namespace: testNamespace},
+			Spec: appsv1.AppProjectSpec{
+				SourceRepos:  []string{"https://github.com/argoproj/argocd-example-apps.git"},
+				Destinations: []appsv1.ApplicationDestination{{Server: "https://1.2.3.4", Namespace: "guestbook"}},
+			},
+		}
+		appSetServer := newTestAppSetServerWithEnforcerConfigure(func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("role:admin")
+		}, restricted)
+		appset := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+			appset.Spec.GoTemplate = true
+			appset.Spec.Template.Name = "{{ .cluster }}-guestbook"
+			appset.Spec.Template.Spec.Destination.Server = "{{ .url }}"
+			templatePatch := `spec:
+  project: restricted`
+			appset.Spec.TemplatePatch = &templatePatch
+		})
+		_, err := appSetServer.Generate(context.Background(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: appset})
+		assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = application destination {https://2.4.6.8 guestbook} is not permitted in project 'restricted'")
+
+		appSetServer = newTestAppSetServerWithEnforcerConfigure(func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			_ = enf.SetUserPolicy("p, role:default-only, applicationsets, create, default/*, allow")
+			enf.SetDefaultRole("role:default-only")
+		}, restricted)
+		_, err = appSetServer.Generate(context.Background(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: appset})
+		assert.ErrorContains(t, err, "error checking create permissions for Application engineering-dev-guestbook")
+		assert.ErrorContains(t, err, "permission denied")
+	})
+
+	t.Run("Invalid template", func(t *testing.T) {
+		appSetServer := newTestAppSetServer()
+		appset := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+			appset.Spec.GoTemplate = true
+			appset.Spec.Template.Name = "{{ .cluster"
+		})
+		_, err := appSetServer.Generate(context.Background(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: appset})
+		assert.ErrorContains(t, err, "error generating Applications")
+	})
+}
+
+func TestUpdateAppSet(t *testing.T) {
+	existing := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Namespace = testNamespace
+		appset.Labels = map[string]string{"label-key": "label-value"}
+	})
+
+	t.Run("Update spec and labels", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(existing)
+		updated := existing.DeepCopy()
+		updated.Spec.Template.Spec.Source.Path = "helm-guestbook"
+		updated.Labels = map[string]string{"other-key": "other-value"}
+
+		res, err := appSetServer.Update(context.Background(), &applicationset.ApplicationSetUpdateRequest{Applicationset: updated})
+		require.NoError(t, err)
+		assert.Equal(t, "helm-guestbook", res.Spec.Template.Spec.Source.Path)
+		assert.Equal(t, map[string]string{"other-key": "other-value"}, res.Labels)
+	})
+
+	t.Run("ApplicationSet does not exist", func(t *testing.T) {
+		appSetServer := newTestAppSetServer()
+		_, err := appSetServer.Update(context.Background(), &applicationset.ApplicationSetUpdateRequest{Applicationset: existing.DeepCopy()})
+		assert.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("Project does not exist", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(existing)
+		updated := existing.DeepCopy()
+		updated.Spec.Template.Spec.Project = "missing"
+		_, err := appSetServer.Update(context.Background(), &applicationset.ApplicationSetUpdateRequest{Applicationset: updated})
+		assert.ErrorContains(t, err, "ApplicationSet references project missing which does not exist")
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		appSetServer := newTestAppSetServerWithEnforcerConfigure(func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("role:readonly")
+		}, existing)
+		_, err := appSetServer.Update(context.Background(), &applicationset.ApplicationSetUpdateRequest{Applicationset: existing.DeepCopy()})
+		assert.ErrorContains(t, err, "permission denied")
+	})
+}
+
+func TestPatchAppSet(t *testing.T) {
+	existing := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Namespace = testNamespace
+	})
+
+	testCases := []struct {
+		name      string
+		patchType string
+		patch     string
+	}{
+		{
+			name:      "json",
+			patchType: "json",
+			patch:     `[{"op": "replace", "path": "/spec/template/spec/source/path", "value": "helm-guestbook"}]`,
+		},
+		{
+			name:  "default to json",
+			patch: `[{"op": "replace", "path": "/spec/template/spec/source/path", "value": "helm-guestbook"}]`,
+		},
+		{
+			name:      "merge",
+			patchType: "merge",
+			patch:     `{"spec": {"template": {"spec": {"source": {"path": "helm-guestbook"}}}}}`,
+		},
+		{
+			name:      "strategic",
+			patchType: "strategic",
+			patch:     `{"spec": {"template": {"spec": {"source": {"path": "helm-guestbook"}}}}}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCaseCopy := testCase
+		t.Run(testCaseCopy.name, func(t *testing.T) {
+			appSetServer := newTestAppSetServer(existing)
+			res, err := appSetServer.Patch(context.Background(), &applicationset.ApplicationSetPatchRequest{
+				Name:      existing.Name,
+				Patch:     testCaseCopy.patch,
+				PatchType: testCaseCopy.patchType,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "helm-guestbook", res.Spec.Template.Spec.Source.Path)
+			require.Len(t, res.Spec.Generators, 1)
+			assert.Len(t, res.Spec.Generators[0].List.Elements, 2)
+		})
+	}
+
+	t.Run("Unsupported patch type", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(existing)
+		_, err := appSetServer.Patch(context.Background(), &applicationset.ApplicationSetPatchRequest{
+			Name:      existing.Name,
+			Patch:     `{}`,
+			PatchType: "other",
+		})
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = Patch type 'other' is not supported")
+	})
+
+	t.Run("Name cannot be changed", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(existing)
+		_, err := appSetServer.Patch(context.Background(), &applicationset.ApplicationSetPatchRequest{
+			Name:      existing.Name,
+			Patch:     `{"metadata": {"name": "other-appset"}}`,
+			PatchType: "merge",
+		})
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = ApplicationSet name cannot be changed by a patch")
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		appSetServer := newTestAppSetServerWithEnforcerConfigure(func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("role:readonly")
+		}, existing)
+		_, err := appSetServer.Patch(context.Background(), &applicationset.ApplicationSetPatchRequest{
+			Name:      existing.Name,
+			Patch:     `{"spec": {"template": {"spec": {"source": {"path": "helm-guestbook"}}}}}`,
+			PatchType: "merge",
+		})
+		assert.ErrorContains(t, err, "permission denied")
+	})
+}
+
+func TestRefreshAppSet(t *testing.T) {
+	existing := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Namespace = testNamespace
+		appset.ResourceVersion = "1"
+	})
+
+	t.Run("Refresh without waiting", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(existing)
+		res, err := appSetServer.Refresh(context.Background(), &applicationset.ApplicationSetRefreshRequest{Name: existing.Name})
+		require.NoError(t, err)
+		assert.True(t, res.RefreshRequired())
+
+		events, err := appSetServer.k8sClient.CoreV1().Events(testNamespace).List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		if assert.Len(t, events.Items, 1) {
+			assert.Equal(t, argo.EventReasonResourceUpdated, events.Items[0].Reason)
+			assert.Contains(t, events.Items[0].Message, "refreshed application set")
+		}
+	})
+
+	t.Run("Refresh and wait for the controller", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(existing)
+		appsetIf := appSetServer.appclientset.ArgoprojV1alpha1().ApplicationSets(testNamespace)
+
+		// Simulate the ApplicationSet controller, which clears the annotation once it has regenerated the Applications
+		go func() {
+			for {
+				appset, err := appsetIf.Get(context.Background(), existing.Name, metav1.GetOptions{})
+				if err == nil && appset.RefreshRequired() {
+					delete(appset.Annotations, common.AnnotationApplicationSetRefresh)
+					appset.ResourceVersion = "2"
+					_, _ = appsetIf.Update(context.Background(), appset, metav1.UpdateOptions{})
+					return
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		res, err := appSetServer.Refresh(ctx, &applicationset.ApplicationSetRefreshRequest{Name: existing.Name, Wait: true})
+		require.NoError(t, err)
+		assert.False(t, res.RefreshRequired())
+		assert.Equal(t, "2", res.ResourceVersion)
+	})
+
+	t.Run("Deadline exceeded", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(existing)
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		_, err := appSetServer.Refresh(ctx, &applicationset.ApplicationSetRefreshRequest{Name: existing.Name, Wait: true})
+		assert.EqualError(t, err, "applicationset refresh deadline exceeded")
+	})
+
+	t.Run("ApplicationSet does not exist", func(t *testing.T) {
+		appSetServer := newTestAppSetServer()
+		_, err := appSetServer.Refresh(context.Background(), &applicationset.ApplicationSetRefreshRequest{Name: existing.Name})
+		assert.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		appSetServer := newTestAppSetServerWithEnforcerConfigure(func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("")
+		}, existing)
+		_, err := appSetServer.Refresh(context.Background(), &applicationset.ApplicationSetRefreshRequest{Name: existing.Name})
+		assert.ErrorContains(t, err, "permission denied")
+	})
+}
+
+func TestAppSetInAnyNamespace(t *testing.T) {
+	tenantProj := &appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: testNamespace},
+		Spec: appsv1.AppProjectSpec{
+			SourceRepos:      []string{"*"},
+			Destinations:     []appsv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			SourceNamespaces: []string{"external-namespace"},
+		},
+	}
+	newTenantAppSet := func(namespace string, project string) *appsv1.ApplicationSet {
+		return newTestAppSet(func(appset *appsv1.ApplicationSet) {
+			appset.Namespace = namespace
+			appset.Spec.Template.Spec.Project = project
+		})
+	}
+
+	t.Run("Create in enabled namespace", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(tenantProj)
+		created, err := appSetServer.Create(context.Background(), &applicationset.ApplicationSetCreateRequest{Applicationset: newTenantAppSet("external-namespace", "tenant")})
+		require.NoError(t, err)
+		assert.Equal(t, "external-namespace", created.Namespace)
+
+		appset, err := appSetServer.Get(context.Background(), &applicationset.ApplicationSetGetQuery{Name: created.Name, AppsetNamespace: "external-namespace"})
+		require.NoError(t, err)
+		assert.Equal(t, "external-namespace", appset.Namespace)
+
+		_, err = appSetServer.Get(context.Background(), &applicationset.ApplicationSetGetQuery{Name: created.Name})
+		assert.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("Create in namespace not enabled", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(tenantProj)
+		_, err := appSetServer.Create(context.Background(), &applicationset.ApplicationSetCreateRequest{Applicationset: newTenantAppSet("other-namespace", "tenant")})
+		assert.ErrorContains(t, err, "namespace 'other-namespace' is not permitted")
+	})
+
+	t.Run("Create with project not allowing the namespace", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(tenantProj)
+		_, err := appSetServer.Create(context.Background(), &applicationset.ApplicationSetCreateRequest{Applicationset: newTenantAppSet("external-namespace", "default")})
+		assert.ErrorContains(t, err, "ApplicationSet in namespace 'external-namespace' is not allowed to use project 'default'")
+	})
+
+	t.Run("Get in namespace not enabled", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(newTenantAppSet("other-namespace", "tenant"))
+		_, err := appSetServer.Get(context.Background(), &applicationset.ApplicationSetGetQuery{Name: "test-appset", AppsetNamespace: "other-namespace"})
+		assert.ErrorContains(t, err, "namespace 'other-namespace' is not permitted")
+	})
+
+	t.Run("List", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(tenantProj,
+			newTenantAppSet(testNamespace, "default"),
+			newTenantAppSet("external-namespace", "tenant"),
+			newTenantAppSet("other-namespace", "tenant"))
+
+		appsets, err := appSetServer.List(context.Background(), &applicationset.ApplicationSetListQuery{})
+		require.NoError(t, err)
+		var namespaces []string
+		for _, appset := range appsets.Items {
+			namespaces = append(namespaces, appset.Namespace)
+		}
+		assert.ElementsMatch(t, []string{testNamespace, "external-namespace"}, namespaces)
+
+		appsets, err = appSetServer.List(context.Background(), &applicationset.ApplicationSetListQuery{AppsetNamespace: "external-namespace"})
+		require.NoError(t, err)
+		require.Len(t, appsets.Items, 1)
+		assert.Equal(t, "external-namespace", appsets.Items[0].Namespace)
+	})
+
+	t.Run("Generate in enabled namespace", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(tenantProj)
+		res, err := appSetServer.Generate(context.Background(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: newTenantAppSet("external-namespace", "tenant")})
+		require.NoError(t, err)
+		require.Len(t, res.Applications, 2)
+		assert.Equal(t, "external-namespace", res.Applications[0].Namespace)
+	})
+}
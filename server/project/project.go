@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/argoproj/argo-cd/v2/util/db"
 
@@ -37,6 +38,8 @@ import (
 const (
 	// JWTTokenSubFormat format of the JWT token subject that Argo CD vends out.
 	JWTTokenSubFormat = "proj:%s:%s"
+	// MaxSyncWindowOverrideDuration is the maximum duration of a sync windows override
+	MaxSyncWindowOverrideDuration = 24 * time.Hour
 )
 
 // Server provides a Project service
@@ -325,6 +328,8 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 	if err != nil {
 		return nil, err
 	}
+	// sync window overrides can only be managed using OverrideSyncWindows, which audits them
+	q.Project.Status.SyncWindowOverrides = oldProj.Status.SyncWindowOverrides
 
 	for _, cluster := range difference(q.Project.Spec.DestinationClusters(), oldProj.Spec.DestinationClusters()) {
 		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionUpdate, cluster); err != nil {
@@ -362,7 +367,7 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 	}
 
 	for _, a := range argo.FilterByProjects(appsList.Items, []string{q.Project.Name}) {
-		if oldProj.IsSourcePermitted(a.Spec.Source) {
+		if isAppSourcesPermitted(oldProj, a) {
 			srcValidatedApps = append(srcValidatedApps, a)
 		}
 
@@ -380,7 +385,7 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 	invalidDstCount := 0
 
 	for _, a := range srcValidatedApps {
-		if !q.Project.IsSourcePermitted(a.Spec.Source) {
+		if !isAppSourcesPermitted(q.Project, a) {
 			invalidSrcCount++
 		}
 	}
@@ -493,6 +498,84 @@ func (s *Server) GetSyncWindowsState(ctx context.Context, q *project.SyncWindows
 	return res, nil
 }
 
+// OverrideSyncWindows temporarily allows an application to be synced regardless of the sync windows of its project
+func (s *Server) OverrideSyncWindows(ctx context.Context, q *project.SyncWindowsOverrideRequest) (*v1alpha1.AppProject, error) {
+	var res *v1alpha1.AppProject
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var overrideErr error
+		res, overrideErr = s.overrideSyncWindows(ctx, q)
+		return overrideErr
+	})
+	return res, err
+}
+
+func (s *Server) overrideSyncWindows(ctx context.Context, q *project.SyncWindowsOverrideRequest) (*v1alpha1.AppProject, error) {
+	if q.Application == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "application is required")
+	}
+	if strings.TrimSpace(q.Reason) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reason is required")
+	}
+	duration := time.Duration(q.Duration) * time.Second
+	if duration <= 0 || duration > MaxSyncWindowOverrideDuration {
+		return nil, status.Errorf(codes.InvalidArgument, "duration must be greater than 0 and at most %s", MaxSyncWindowOverrideDuration)
+	}
+	appNs := q.AppNamespace
+	if appNs == "" {
+		appNs = s.ns
+	}
+
+	// enforce RBAC before fetching the application, so that its existence is not disclosed to unauthorized users
+	requested := v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: q.Application, Namespace: appNs},
+		Spec:       v1alpha1.ApplicationSpec{Project: q.Name},
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionOverride, requested.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+	app, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, q.Application, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if app.Spec.GetProject() != q.Name {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionOverride, app.RBACName(s.ns)); err != nil {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "application '%s' does not belong to project '%s'", q.Application, q.Name)
+	}
+
+	s.projectLock.Lock(q.Name)
+	defer s.projectLock.Unlock(q.Name)
+
+	prj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	user := session.Username(ctx)
+	if user == "" {
+		user = "Unknown user"
+	}
+	now := time.Now()
+	prj.SetSyncWindowOverride(v1alpha1.SyncWindowOverride{
+		Application: app.Name,
+		Namespace:   app.Namespace,
+		Reason:      q.Reason,
+		User:        user,
+		CreatedAt:   metav1.NewTime(now),
+		ExpiresAt:   metav1.NewTime(now.Add(duration)),
+	})
+
+	res, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Update(ctx, prj, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	message := fmt.Sprintf("overrode sync windows of application '%s' for %s: %s", app.QualifiedName(), duration, q.Reason)
+	s.logEvent(res, ctx, argo.EventReasonResourceUpdated, message)
+	s.auditLogger.LogAppEvent(app, argo.EventInfo{Type: v1.EventTypeWarning, Reason: argo.EventReasonResourceUpdated}, fmt.Sprintf("%s %s", user, message))
+	return res, nil
+}
+
 func (s *Server) NormalizeProjs() error {
 	projList, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).List(context.Background(), metav1.ListOptions{})
 	if err != nil {
@@ -525,3 +608,13 @@ func (s *Server) NormalizeProjs() error {
 	}
 	return nil
 }
+
+// isAppSourcesPermitted returns whether all the sources of the application are permitted in the project
+func isAppSourcesPermitted(proj *v1alpha1.AppProject, app v1alpha1.Application) bool {
+	for _, source := range app.Spec.GetSources() {
+		if !proj.IsSourcePermitted(source) {
+			return false
+		}
+	}
+	return true
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/v2/util/db"
 
@@ -708,6 +709,76 @@ p, role:admin, projects, update, *, allow`)
 		assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: projects, get, test")
 	})
 
+	t.Run("TestOverrideSyncWindows", func(t *testing.T) {
+		enforcer = newEnforcer(kubeclientset)
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
+		projectWithSyncWindows := existingProj.DeepCopy()
+		win := &v1alpha1.SyncWindow{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Applications: []string{"*"}}
+		projectWithSyncWindows.Spec.SyncWindows = append(projectWithSyncWindows.Spec.SyncWindows, win)
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithSyncWindows, &existingApp), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB)
+
+		_, err := projectServer.OverrideSyncWindows(ctx, &project.SyncWindowsOverrideRequest{Name: "test", Application: "test", Duration: 3600})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = projectServer.OverrideSyncWindows(ctx, &project.SyncWindowsOverrideRequest{Name: "test", Application: "test", Duration: 48 * 3600, Reason: "hotfix"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = projectServer.OverrideSyncWindows(ctx, &project.SyncWindowsOverrideRequest{Name: "default", Application: "test", Duration: 3600, Reason: "hotfix"})
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = application 'test' does not belong to project 'default'")
+
+		res, err := projectServer.OverrideSyncWindows(ctx, &project.SyncWindowsOverrideRequest{Name: "test", Application: "test", Duration: 3600, Reason: "hotfix"})
+		assert.NoError(t, err)
+		if assert.Len(t, res.Status.SyncWindowOverrides, 1) {
+			override := res.Status.SyncWindowOverrides[0]
+			assert.Equal(t, "test", override.Application)
+			assert.Equal(t, "default", override.Namespace)
+			assert.Equal(t, "hotfix", override.Reason)
+			assert.Equal(t, time.Hour, override.ExpiresAt.Sub(override.CreatedAt.Time))
+		}
+		assert.True(t, res.HasSyncWindowOverride(&existingApp))
+
+		// the overrides cannot be modified by updating the project
+		updatedProj := res.DeepCopy()
+		updatedProj.Status.SyncWindowOverrides = nil
+		res, err = projectServer.Update(ctx, &project.ProjectUpdateRequest{Project: updatedProj})
+		assert.NoError(t, err)
+		assert.Len(t, res.Status.SyncWindowOverrides, 1)
+	})
+
+	t.Run("TestOverrideSyncWindowsDenied", func(t *testing.T) {
+		enforcer = newEnforcer(kubeclientset)
+		_ = enforcer.SetBuiltinPolicy(`p, *, *, *, *, deny`)
+		enforcer.SetClaimsEnforcerFunc(nil)
+		// nolint:staticcheck
+		ctx := context.WithValue(context.Background(), "claims", &jwt.MapClaims{"groups": []string{"my-group"}})
+
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(existingProj.DeepCopy(), &existingApp), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB)
+		_, err := projectServer.OverrideSyncWindows(ctx, &project.SyncWindowsOverrideRequest{Name: "test", Application: "test", Duration: 3600, Reason: "hotfix"})
+		assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: applications, override, test/test")
+
+		// the existence of the application is not disclosed
+		_, err = projectServer.OverrideSyncWindows(ctx, &project.SyncWindowsOverrideRequest{Name: "test", Application: "missing", Duration: 3600, Reason: "hotfix"})
+		assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: applications, override, test/missing")
+	})
+
+	t.Run("TestOverrideSyncWindowsOtherProjectDenied", func(t *testing.T) {
+		enforcer = newEnforcer(kubeclientset)
+		_ = enforcer.SetBuiltinPolicy(`p, role:override, applications, override, default/*, allow`)
+		enforcer.SetDefaultRole("role:override")
+		enforcer.SetClaimsEnforcerFunc(nil)
+		// nolint:staticcheck
+		ctx := context.WithValue(context.Background(), "claims", &jwt.MapClaims{"groups": []string{"my-group"}})
+
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(existingProj.DeepCopy(), &existingApp), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB)
+		_, err := projectServer.OverrideSyncWindows(ctx, &project.SyncWindowsOverrideRequest{Name: "default", Application: "test", Duration: 3600, Reason: "hotfix"})
+		assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: applications, override, test/test")
+	})
+
 }
 
 func newEnforcer(kubeclientset *fake.Clientset) *rbac.Enforcer {
@@ -552,14 +552,24 @@ func (s *Server) isRepoPermittedInProject(repo string, projName string) error {
 // isSourceInHistory checks if the supplied application source is either our current application
 // source, or was something which we synced to previously.
 func isSourceInHistory(app *v1alpha1.Application, source v1alpha1.ApplicationSource) bool {
-	if source.Equals(app.Spec.Source) {
-		return true
+	for _, appSource := range app.Spec.GetSources() {
+		if source.Equals(appSource) {
+			return true
+		}
 	}
 	// Iterate history. When comparing items in our history, use the actual synced revision to
 	// compare with the supplied source.targetRevision in the request. This is because
 	// history[].source.targetRevision is ambiguous (e.g. HEAD), whereas
 	// history[].revision will contain the explicit SHA
 	for _, h := range app.Status.History {
+		for i, historySource := range h.Sources {
+			if i < len(h.Revisions) {
+				historySource.TargetRevision = h.Revisions[i]
+			}
+			if source.Equals(historySource) {
+				return true
+			}
+		}
 		h.Source.TargetRevision = h.Revision
 		if source.Equals(h.Source) {
 			return true
@@ -2,6 +2,7 @@ package discovery
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -18,6 +19,10 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/kustomize"
 )
 
+// pluginSockFileSuffix is the suffix of the socket files of the config management plugin sidecars, which are named
+// after the name, or name-version, of the plugin
+const pluginSockFileSuffix = ".sock"
+
 func IsManifestGenerationEnabled(sourceType v1alpha1.ApplicationSourceType, enableGenerateManifests map[string]bool) bool {
 	if enableGenerateManifests == nil {
 		return true
@@ -33,10 +38,13 @@ func Discover(ctx context.Context, repoPath string, enableGenerateManifests map[
 	apps := make(map[string]string)
 
 	// Check if it is CMP
-	conn, _, err := DetectConfigManagementPlugin(ctx, repoPath, []string{}, tarExcludedGlobs)
-	if err == nil {
-		// Found CMP
-		io.Close(conn)
+	conn, _, err := DetectConfigManagementPlugin(ctx, repoPath, "", []string{}, tarExcludedGlobs)
+	var multipleErr *MultiplePluginsMatchError
+	if err == nil || errors.As(err, &multipleErr) {
+		// Found CMP. Conflicting plugins are reported when generating manifests.
+		if conn != nil {
+			io.Close(conn)
+		}
 
 		apps["."] = string(v1alpha1.ApplicationSourceTypePlugin)
 		return apps, nil
@@ -77,67 +85,112 @@ func AppType(ctx context.Context, path string, enableGenerateManifests map[strin
 	return "Directory", nil
 }
 
-// 1. list all plugins in /plugins folder
-// 2. foreach plugin setup connection with respective cmp-server
-// 3. check isSupported(path)?
-// 4.a if no then close connection
-// 4.b if yes then return conn for detected plugin
-func DetectConfigManagementPlugin(ctx context.Context, repoPath string, env []string, tarExcludedGlobs []string) (io.Closer, pluginclient.ConfigManagementPluginServiceClient, error) {
-	var conn io.Closer
-	var cmpClient pluginclient.ConfigManagementPluginServiceClient
+// MultiplePluginsMatchError is returned when more than one config management plugin sidecar supports an application
+// which does not select a plugin by name
+type MultiplePluginsMatchError struct {
+	Path    string
+	Plugins []string
+}
+
+func (e *MultiplePluginsMatchError) Error() string {
+	return fmt.Sprintf("multiple cmp-server plugins support repository %s: %s. Set the plugin name in the application source to select one of them", e.Path, strings.Join(e.Plugins, ", "))
+}
 
+// DetectConfigManagementPlugin returns a connection to the config management plugin sidecar to use for the given path:
+//  1. If pluginName is not empty, the plugin with this name, or name-version if the plugin has a version, is used
+//     without checking its discovery rules. An error is returned if there is no such plugin.
+//  2. Otherwise, each plugin in the plugin socket folder is asked whether it supports the path, and the single plugin
+//     supporting it is used. A MultiplePluginsMatchError listing the plugins in alphabetical order is returned if more
+//     than one plugin supports the path.
+func DetectConfigManagementPlugin(ctx context.Context, repoPath string, pluginName string, env []string, tarExcludedGlobs []string) (io.Closer, pluginclient.ConfigManagementPluginServiceClient, error) {
 	pluginSockFilePath := common.GetPluginSockFilePath()
 	log.WithFields(log.Fields{
 		common.SecurityField:    common.SecurityLow,
 		common.SecurityCWEField: 775,
 	}).Debugf("pluginSockFilePath is: %s", pluginSockFilePath)
 
+	if pluginName != "" {
+		if !HasConfigManagementPlugin(pluginName) {
+			return nil, nil, fmt.Errorf("couldn't find cmp-server plugin with name %q", pluginName)
+		}
+		address := filepath.Join(pluginSockFilePath, pluginName+pluginSockFileSuffix)
+		conn, cmpClient, err := pluginclient.NewConfigManagementPluginClientSet(address).NewConfigManagementPluginClient()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error dialing to cmp-server for plugin %s: %w", pluginName, err)
+		}
+		return conn, cmpClient, nil
+	}
+
+	// the entries are sorted by file name, so that the plugins are checked and reported in a deterministic order
 	files, err := os.ReadDir(pluginSockFilePath)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	var connFound bool
+	var conn io.Closer
+	var cmpClient pluginclient.ConfigManagementPluginServiceClient
+	var matchingPlugins []string
 	for _, file := range files {
-		if file.Type() == os.ModeSocket {
-			address := filepath.Join(pluginSockFilePath, file.Name())
-			cmpclientset := pluginclient.NewConfigManagementPluginClientSet(address)
-
-			conn, cmpClient, err = cmpclientset.NewConfigManagementPluginClient()
-			if err != nil {
-				log.WithFields(log.Fields{
-					common.SecurityField:    common.SecurityMedium,
-					common.SecurityCWEField: 775,
-				}).Errorf("error dialing to cmp-server for plugin %s, %v", file.Name(), err)
-				continue
-			}
-
-			isSupported, err := matchRepositoryCMP(ctx, repoPath, cmpClient, env, tarExcludedGlobs)
-			if err != nil {
-				log.WithFields(log.Fields{
-					common.SecurityField:    common.SecurityMedium,
-					common.SecurityCWEField: 775,
-				}).Errorf("repository %s is not the match because %v", repoPath, err)
-				continue
-			}
-
-			if !isSupported {
-				log.WithFields(log.Fields{
-					common.SecurityField:    common.SecurityLow,
-					common.SecurityCWEField: 775,
-				}).Debugf("Reponse from socket file %s is not supported", file.Name())
-				io.Close(conn)
-			} else {
-				connFound = true
-				break
-			}
+		if file.Type() != os.ModeSocket {
+			continue
+		}
+		address := filepath.Join(pluginSockFilePath, file.Name())
+		cmpclientset := pluginclient.NewConfigManagementPluginClientSet(address)
+
+		pluginConn, pluginClient, err := cmpclientset.NewConfigManagementPluginClient()
+		if err != nil {
+			log.WithFields(log.Fields{
+				common.SecurityField:    common.SecurityMedium,
+				common.SecurityCWEField: 775,
+			}).Errorf("error dialing to cmp-server for plugin %s, %v", file.Name(), err)
+			continue
+		}
+
+		isSupported, err := matchRepositoryCMP(ctx, repoPath, pluginClient, env, tarExcludedGlobs)
+		if err != nil {
+			log.WithFields(log.Fields{
+				common.SecurityField:    common.SecurityMedium,
+				common.SecurityCWEField: 775,
+			}).Errorf("repository %s is not the match because %v", repoPath, err)
+			io.Close(pluginConn)
+			continue
+		}
+
+		if !isSupported {
+			log.WithFields(log.Fields{
+				common.SecurityField:    common.SecurityLow,
+				common.SecurityCWEField: 775,
+			}).Debugf("Reponse from socket file %s is not supported", file.Name())
+			io.Close(pluginConn)
+			continue
+		}
+
+		matchingPlugins = append(matchingPlugins, strings.TrimSuffix(file.Name(), pluginSockFileSuffix))
+		if conn == nil {
+			conn, cmpClient = pluginConn, pluginClient
+		} else {
+			io.Close(pluginConn)
 		}
 	}
 
-	if !connFound {
+	if len(matchingPlugins) == 0 {
 		return nil, nil, fmt.Errorf("Couldn't find cmp-server plugin supporting repository %s", repoPath)
 	}
-	return conn, cmpClient, err
+	if len(matchingPlugins) > 1 {
+		io.Close(conn)
+		return nil, nil, &MultiplePluginsMatchError{Path: repoPath, Plugins: matchingPlugins}
+	}
+	return conn, cmpClient, nil
+}
+
+// HasConfigManagementPlugin returns true if there is a config management plugin sidecar with the given name, or
+// name-version if the plugin has a version
+func HasConfigManagementPlugin(pluginName string) bool {
+	if pluginName == "" || strings.ContainsRune(pluginName, filepath.Separator) {
+		return false
+	}
+	info, err := os.Stat(filepath.Join(common.GetPluginSockFilePath(), pluginName+pluginSockFileSuffix))
+	return err == nil && info.Mode().Type() == os.ModeSocket
 }
 
 // matchRepositoryCMP will send the repoPath to the cmp-server. The cmp-server will
@@ -2,11 +2,20 @@ package discovery
 
 import (
 	"context"
+	"net"
+	"os"
+	"path/filepath"
 	"testing"
 
-	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
-
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	pluginclient "github.com/argoproj/argo-cd/v2/cmpserver/apiclient"
+	"github.com/argoproj/argo-cd/v2/cmpserver/plugin"
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
 
 func TestDiscover(t *testing.T) {
@@ -49,3 +58,67 @@ func TestAppType_Disabled(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "Directory", appType)
 }
+
+// startPlugins starts a cmp-server for each of the given plugins, matching the applications containing the given file,
+// in a temporary plugin socket folder
+func startPlugins(t *testing.T, fileNames map[string]string) {
+	sockDir, err := os.MkdirTemp("", "plugins")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(sockDir) })
+	t.Setenv(common.EnvPluginSockFilePath, sockDir)
+	t.Setenv(common.EnvCMPWorkDir, t.TempDir())
+	for name, fileName := range fileNames {
+		service := plugin.NewService(plugin.CMPServerInitConstants{PluginConfig: plugin.PluginConfig{
+			TypeMeta: metav1.TypeMeta{Kind: plugin.ConfigManagementPluginKind},
+			Metadata: metav1.ObjectMeta{Name: name},
+			Spec:     plugin.PluginConfigSpec{Discover: plugin.Discover{FileName: fileName}},
+		}})
+		require.NoError(t, service.Init())
+		listener, err := net.Listen("unix", filepath.Join(sockDir, name+".sock"))
+		require.NoError(t, err)
+		server := grpc.NewServer()
+		pluginclient.RegisterConfigManagementPluginServiceServer(server, service)
+		go func() { _ = server.Serve(listener) }()
+		t.Cleanup(server.Stop)
+	}
+}
+
+func TestDetectConfigManagementPlugin(t *testing.T) {
+	startPlugins(t, map[string]string{"helm-plugin": "Chart.yaml", "kustomize-plugin-v1.0": "Kustomization", "any-plugin": "*"})
+
+	t.Run("MultiplePluginsMatch", func(t *testing.T) {
+		_, _, err := DetectConfigManagementPlugin(context.Background(), "./testdata/baz", "", []string{}, []string{})
+		var multipleErr *MultiplePluginsMatchError
+		require.ErrorAs(t, err, &multipleErr)
+		assert.Equal(t, []string{"any-plugin", "helm-plugin"}, multipleErr.Plugins)
+		assert.EqualError(t, err, "multiple cmp-server plugins support repository ./testdata/baz: any-plugin, helm-plugin. Set the plugin name in the application source to select one of them")
+	})
+	t.Run("NamedPlugin", func(t *testing.T) {
+		conn, client, err := DetectConfigManagementPlugin(context.Background(), "./testdata/baz", "kustomize-plugin-v1.0", []string{}, []string{})
+		require.NoError(t, err)
+		defer conn.Close()
+		assert.NotNil(t, client)
+	})
+	t.Run("NamedPluginMissing", func(t *testing.T) {
+		_, _, err := DetectConfigManagementPlugin(context.Background(), "./testdata/baz", "kustomize-plugin", []string{}, []string{})
+		assert.EqualError(t, err, `couldn't find cmp-server plugin with name "kustomize-plugin"`)
+		assert.False(t, HasConfigManagementPlugin("../plugins/helm-plugin"))
+	})
+	t.Run("Discover", func(t *testing.T) {
+		apps, err := Discover(context.Background(), "./testdata/baz", map[string]bool{}, []string{})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{".": "Plugin"}, apps)
+	})
+}
+
+func TestDetectConfigManagementPlugin_SingleMatch(t *testing.T) {
+	startPlugins(t, map[string]string{"helm-plugin": "Chart.yaml", "kustomize-plugin-v1.0": "Kustomization"})
+
+	conn, client, err := DetectConfigManagementPlugin(context.Background(), "./testdata/foo", "", []string{}, []string{})
+	require.NoError(t, err)
+	defer conn.Close()
+	assert.NotNil(t, client)
+
+	_, _, err = DetectConfigManagementPlugin(context.Background(), "./testdata", "", []string{}, []string{})
+	assert.ErrorContains(t, err, "Couldn't find cmp-server plugin supporting repository")
+}
@@ -6,7 +6,11 @@ import (
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
 
-// AppRBACName formats fully qualified application name for RBAC check
-func AppSetRBACName(appSet *v1alpha1.ApplicationSet) string {
-	return fmt.Sprintf("%s/%s", appSet.Spec.Template.Spec.GetProject(), appSet.ObjectMeta.Name)
+// AppSetRBACName formats fully qualified application set name for RBAC check, in a backwards-compatible way:
+// the namespace is only included if the application set is not in the default namespace.
+func AppSetRBACName(defaultNS string, appSet *v1alpha1.ApplicationSet) string {
+	if defaultNS != "" && appSet.Namespace != defaultNS && appSet.Namespace != "" {
+		return fmt.Sprintf("%s/%s/%s", appSet.Spec.Template.Spec.GetProject(), appSet.Namespace, appSet.Name)
+	}
+	return fmt.Sprintf("%s/%s", appSet.Spec.Template.Spec.GetProject(), appSet.Name)
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/oci"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
@@ -87,8 +88,11 @@ func FilterByRepo(apps []argoappv1.Application, repo string) []argoappv1.Applica
 	}
 	items := make([]argoappv1.Application, 0)
 	for i := 0; i < len(apps); i++ {
-		if apps[i].Spec.Source.RepoURL == repo {
-			items = append(items, apps[i])
+		for _, source := range apps[i].Spec.GetSources() {
+			if source.RepoURL == repo {
+				items = append(items, apps[i])
+				break
+			}
 		}
 	}
 	return items
@@ -156,6 +160,8 @@ func TestRepoWithKnownType(ctx context.Context, repoClient apiclient.RepoServerS
 	repo = repo.DeepCopy()
 	if isHelm {
 		repo.Type = "helm"
+	} else if oci.IsOCIRepo(repo.Repo) {
+		repo.Type = "oci"
 	} else {
 		repo.Type = "git"
 	}
@@ -195,27 +201,45 @@ func ValidateRepo(
 		return nil, fmt.Errorf("error instantiating new repo server client: %w", err)
 	}
 	defer io.Close(conn)
-	repo, err := db.GetRepository(ctx, spec.Source.RepoURL)
-	if err != nil {
-		return nil, fmt.Errorf("error getting repository: %w", err)
-	}
 
-	repoAccessible := false
+	repoAccessible := true
+	for _, source := range spec.GetSources() {
+		repo, err := db.GetRepository(ctx, source.RepoURL)
+		if err != nil {
+			return nil, fmt.Errorf("error getting repository: %w", err)
+		}
 
-	err = TestRepoWithKnownType(ctx, repoClient, repo, app.Spec.Source.IsHelm(), app.Spec.Source.IsHelmOci())
-	if err != nil {
-		conditions = append(conditions, argoappv1.ApplicationCondition{
-			Type:    argoappv1.ApplicationConditionInvalidSpecError,
-			Message: fmt.Sprintf("repository not accessible: %v", err),
-		})
-	} else {
-		repoAccessible = true
-	}
+		err = TestRepoWithKnownType(ctx, repoClient, repo, source.IsHelm(), source.IsHelmOci())
+		if err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("repository not accessible: %v", err),
+			})
+			repoAccessible = false
+		}
 
-	// Verify only one source type is defined
-	_, err = spec.Source.ExplicitType()
-	if err != nil {
-		return nil, fmt.Errorf("error verifying source type: %w", err)
+		// Verify only one source type is defined
+		_, err = source.ExplicitType()
+		if err != nil {
+			return nil, fmt.Errorf("error verifying source type: %w", err)
+		}
+
+		if source.Kustomize != nil {
+			if err := source.Kustomize.Validate(); err != nil {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("invalid kustomize options: %v", err),
+				})
+			}
+		}
+		if source.Plugin != nil {
+			if err := source.Plugin.Parameters.Validate(); err != nil {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("invalid plugin parameters: %v", err),
+				})
+			}
+		}
 	}
 
 	// is the repo inaccessible - abort now
@@ -266,9 +290,13 @@ func ValidateRepo(
 	if err != nil {
 		return nil, fmt.Errorf("error getting enabled source types: %w", err)
 	}
+	refSources, err := GetRefSources(ctx, spec.Sources, nil, db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting ref sources: %w", err)
+	}
 	conditions = append(conditions, verifyGenerateManifests(
 		ctx,
-		repo,
+		db,
 		permittedHelmRepos,
 		helmOptions,
 		app,
@@ -279,7 +307,8 @@ func ValidateRepo(
 		APIResourcesToStrings(apiGroups, true),
 		permittedHelmCredentials,
 		enabledSourceTypes,
-		settingsMgr)...)
+		settingsMgr,
+		refSources)...)
 
 	return conditions, nil
 }
@@ -331,26 +360,31 @@ func ValidateDestination(ctx context.Context, dest *argoappv1.ApplicationDestina
 // ValidatePermissions ensures that the referenced cluster has been added to Argo CD and the app source repo and destination namespace/cluster are permitted in app project
 func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject, db db.ArgoDB) ([]argoappv1.ApplicationCondition, error) {
 	conditions := make([]argoappv1.ApplicationCondition, 0)
-	if spec.Source.RepoURL == "" || (spec.Source.Path == "" && spec.Source.Chart == "") {
-		conditions = append(conditions, argoappv1.ApplicationCondition{
-			Type:    argoappv1.ApplicationConditionInvalidSpecError,
-			Message: "spec.source.repoURL and either spec.source.path or spec.source.chart are required",
-		})
-		return conditions, nil
-	}
-	if spec.Source.Chart != "" && spec.Source.TargetRevision == "" {
-		conditions = append(conditions, argoappv1.ApplicationCondition{
-			Type:    argoappv1.ApplicationConditionInvalidSpecError,
-			Message: "spec.source.targetRevision is required if the manifest source is a helm chart",
-		})
-		return conditions, nil
-	}
-
-	if !proj.IsSourcePermitted(spec.Source) {
-		conditions = append(conditions, argoappv1.ApplicationCondition{
-			Type:    argoappv1.ApplicationConditionInvalidSpecError,
-			Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.Source.RepoURL, spec.Project),
-		})
+	if spec.HasMultipleSources() {
+		refs := map[string]bool{}
+		for i, source := range spec.Sources {
+			if source.Ref != "" {
+				if refs[source.Ref] {
+					conditions = append(conditions, argoappv1.ApplicationCondition{
+						Type:    argoappv1.ApplicationConditionInvalidSpecError,
+						Message: fmt.Sprintf("spec.sources[%d].ref %q is used by more than one source", i, source.Ref),
+					})
+					return conditions, nil
+				}
+				refs[source.Ref] = true
+			}
+			sourceConditions, valid := validateSourcePermissions(source, fmt.Sprintf("spec.sources[%d]", i), true, spec.Project, proj)
+			conditions = append(conditions, sourceConditions...)
+			if !valid {
+				return conditions, nil
+			}
+		}
+	} else {
+		sourceConditions, valid := validateSourcePermissions(spec.Source, "spec.source", false, spec.Project, proj)
+		conditions = append(conditions, sourceConditions...)
+		if !valid {
+			return conditions, nil
+		}
 	}
 
 	// ValidateDestination will resolve the destination's server address from its name for us, if possible
@@ -393,6 +427,64 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 	return conditions, nil
 }
 
+// validateSourcePermissions validates a source of an application, and checks that its repository is permitted in the
+// project. fieldPath is the path of the source in the application spec, used in the messages of the conditions. It
+// returns false if the source is invalid, in which case the validation of the application should stop.
+func validateSourcePermissions(source argoappv1.ApplicationSource, fieldPath string, hasMultipleSources bool, projectName string, proj *argoappv1.AppProject) ([]argoappv1.ApplicationCondition, bool) {
+	if hasMultipleSources {
+		if source.RepoURL == "" || (source.Path == "" && source.Chart == "" && source.Ref == "") {
+			return []argoappv1.ApplicationCondition{{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("%[1]s.repoURL and either %[1]s.path, %[1]s.chart or %[1]s.ref are required", fieldPath),
+			}}, false
+		}
+	} else if source.RepoURL == "" || (source.Path == "" && source.Chart == "") {
+		return []argoappv1.ApplicationCondition{{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("%[1]s.repoURL and either %[1]s.path or %[1]s.chart are required", fieldPath),
+		}}, false
+	}
+	if source.Chart != "" && source.TargetRevision == "" {
+		return []argoappv1.ApplicationCondition{{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("%s.targetRevision is required if the manifest source is a helm chart", fieldPath),
+		}}, false
+	}
+
+	if !proj.IsSourcePermitted(source) {
+		return []argoappv1.ApplicationCondition{{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", source.RepoURL, projectName),
+		}}, true
+	}
+	return nil, true
+}
+
+// GetRefSources returns the sources with a ref of an application with multiple sources, by "$" followed by the ref.
+// If revisions is set, it overrides the target revision of the source at the same index.
+func GetRefSources(ctx context.Context, sources argoappv1.ApplicationSources, revisions []string, db db.ArgoDB) (argoappv1.RefTargetRevisionMapping, error) {
+	refSources := make(argoappv1.RefTargetRevisionMapping)
+	for i, source := range sources {
+		if source.Ref == "" {
+			continue
+		}
+		repo, err := db.GetRepository(ctx, source.RepoURL)
+		if err != nil {
+			return nil, fmt.Errorf("error getting repository %s: %w", source.RepoURL, err)
+		}
+		targetRevision := source.TargetRevision
+		if i < len(revisions) && revisions[i] != "" {
+			targetRevision = revisions[i]
+		}
+		refSources["$"+source.Ref] = &argoappv1.RefTarget{
+			Repo:           *repo,
+			TargetRevision: targetRevision,
+			Chart:          source.Chart,
+		}
+	}
+	return refSources, nil
+}
+
 // APIResourcesToStrings converts list of API Resources list into string list
 func APIResourcesToStrings(resources []kube.APIResourceInfo, includeKinds bool) []string {
 	resMap := map[string]bool{}
@@ -483,7 +575,7 @@ func GetAppProject(app *argoappv1.Application, projLister applicationsv1.AppProj
 }
 
 // verifyGenerateManifests verifies a repo path can generate manifests
-func verifyGenerateManifests(ctx context.Context, repoRes *argoappv1.Repository, helmRepos argoappv1.Repositories, helmOptions *argoappv1.HelmOptions, app *argoappv1.Application, repoClient apiclient.RepoServerServiceClient, kustomizeOptions *argoappv1.KustomizeOptions, plugins []*argoappv1.ConfigManagementPlugin, kubeVersion string, apiVersions []string, repositoryCredentials []*argoappv1.RepoCreds, enableGenerateManifests map[string]bool, settingsMgr *settings.SettingsManager) []argoappv1.ApplicationCondition {
+func verifyGenerateManifests(ctx context.Context, db db.ArgoDB, helmRepos argoappv1.Repositories, helmOptions *argoappv1.HelmOptions, app *argoappv1.Application, repoClient apiclient.RepoServerServiceClient, kustomizeOptions *argoappv1.KustomizeOptions, plugins []*argoappv1.ConfigManagementPlugin, kubeVersion string, apiVersions []string, repositoryCredentials []*argoappv1.RepoCreds, enableGenerateManifests map[string]bool, settingsMgr *settings.SettingsManager, refSources argoappv1.RefTargetRevisionMapping) []argoappv1.ApplicationCondition {
 	spec := &app.Spec
 	var conditions []argoappv1.ApplicationCondition
 	if spec.Destination.Server == "" {
@@ -493,39 +585,58 @@ func verifyGenerateManifests(ctx context.Context, repoRes *argoappv1.Repository,
 		})
 	}
 
-	req := apiclient.ManifestRequest{
-		Repo: &argoappv1.Repository{
-			Repo:  spec.Source.RepoURL,
-			Type:  repoRes.Type,
-			Name:  repoRes.Name,
-			Proxy: repoRes.Proxy,
-		},
-		Repos:              helmRepos,
-		Revision:           spec.Source.TargetRevision,
-		AppName:            app.Name,
-		Namespace:          spec.Destination.Namespace,
-		ApplicationSource:  &spec.Source,
-		Plugins:            plugins,
-		KustomizeOptions:   kustomizeOptions,
-		KubeVersion:        kubeVersion,
-		ApiVersions:        apiVersions,
-		HelmOptions:        helmOptions,
-		HelmRepoCreds:      repositoryCredentials,
-		TrackingMethod:     string(GetTrackingMethod(settingsMgr)),
-		EnabledSourceTypes: enableGenerateManifests,
-		NoRevisionCache:    true,
-	}
-	req.Repo.CopyCredentialsFromRepo(repoRes)
-	req.Repo.CopySettingsFrom(repoRes)
-
-	// Only check whether we can access the application's path,
-	// and not whether it actually contains any manifests.
-	_, err := repoClient.GenerateManifest(ctx, &req)
-	if err != nil {
-		conditions = append(conditions, argoappv1.ApplicationCondition{
-			Type:    argoappv1.ApplicationConditionInvalidSpecError,
-			Message: fmt.Sprintf("Unable to generate manifests in %s: %v", spec.Source.Path, err),
-		})
+	for _, source := range spec.GetSources() {
+		source := source
+		// Sources which are only referenced by other sources do not generate manifests
+		if spec.HasMultipleSources() && source.IsRefOnly() {
+			continue
+		}
+
+		repoRes, err := db.GetRepository(ctx, source.RepoURL)
+		if err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("Unable to get repository: %v", err),
+			})
+			continue
+		}
+
+		req := apiclient.ManifestRequest{
+			Repo: &argoappv1.Repository{
+				Repo:  source.RepoURL,
+				Type:  repoRes.Type,
+				Name:  repoRes.Name,
+				Proxy: repoRes.Proxy,
+			},
+			Repos:              helmRepos,
+			Revision:           source.TargetRevision,
+			AppName:            app.Name,
+			Namespace:          spec.Destination.Namespace,
+			ApplicationSource:  &source,
+			Plugins:            plugins,
+			KustomizeOptions:   kustomizeOptions,
+			KubeVersion:        kubeVersion,
+			ApiVersions:        apiVersions,
+			HelmOptions:        helmOptions,
+			HelmRepoCreds:      repositoryCredentials,
+			TrackingMethod:     string(GetTrackingMethod(settingsMgr)),
+			EnabledSourceTypes: enableGenerateManifests,
+			NoRevisionCache:    true,
+			HasMultipleSources: spec.HasMultipleSources(),
+			RefSources:         refSources,
+		}
+		req.Repo.CopyCredentialsFromRepo(repoRes)
+		req.Repo.CopySettingsFrom(repoRes)
+
+		// Only check whether we can access the application's path,
+		// and not whether it actually contains any manifests.
+		_, err = repoClient.GenerateManifest(ctx, &req)
+		if err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("Unable to generate manifests in %s: %v", source.Path, err),
+			})
+		}
 	}
 
 	return conditions
@@ -579,24 +690,31 @@ func NormalizeApplicationSpec(spec *argoappv1.ApplicationSpec) *argoappv1.Applic
 	// 3. If any app sources are their zero values, then nil out the pointers to the source spec.
 	// This makes it easier for users to switch between app source types if they are not using
 	// any of the source-specific parameters.
-	if spec.Source.Kustomize != nil && spec.Source.Kustomize.IsZero() {
-		spec.Source.Kustomize = nil
-	}
-	if spec.Source.Helm != nil && spec.Source.Helm.IsZero() {
-		spec.Source.Helm = nil
-	}
-	if spec.Source.Directory != nil && spec.Source.Directory.IsZero() {
-		if spec.Source.Directory.Exclude != "" && spec.Source.Directory.Include != "" {
-			spec.Source.Directory = &argoappv1.ApplicationSourceDirectory{Exclude: spec.Source.Directory.Exclude, Include: spec.Source.Directory.Include}
-		} else if spec.Source.Directory.Exclude != "" {
-			spec.Source.Directory = &argoappv1.ApplicationSourceDirectory{Exclude: spec.Source.Directory.Exclude}
-		} else if spec.Source.Directory.Include != "" {
-			spec.Source.Directory = &argoappv1.ApplicationSourceDirectory{Include: spec.Source.Directory.Include}
+	normalizeApplicationSource(&spec.Source)
+	for i := range spec.Sources {
+		normalizeApplicationSource(&spec.Sources[i])
+	}
+	return spec
+}
+
+func normalizeApplicationSource(source *argoappv1.ApplicationSource) {
+	if source.Kustomize != nil && source.Kustomize.IsZero() {
+		source.Kustomize = nil
+	}
+	if source.Helm != nil && source.Helm.IsZero() {
+		source.Helm = nil
+	}
+	if source.Directory != nil && source.Directory.IsZero() {
+		if source.Directory.Exclude != "" && source.Directory.Include != "" {
+			source.Directory = &argoappv1.ApplicationSourceDirectory{Exclude: source.Directory.Exclude, Include: source.Directory.Include}
+		} else if source.Directory.Exclude != "" {
+			source.Directory = &argoappv1.ApplicationSourceDirectory{Exclude: source.Directory.Exclude}
+		} else if source.Directory.Include != "" {
+			source.Directory = &argoappv1.ApplicationSourceDirectory{Include: source.Directory.Include}
 		} else {
-			spec.Source.Directory = nil
+			source.Directory = nil
 		}
 	}
-	return spec
 }
 
 func GetPermittedReposCredentials(proj *argoappv1.AppProject, repoCreds []*argoappv1.RepoCreds) ([]*argoappv1.RepoCreds, error) {
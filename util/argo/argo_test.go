@@ -165,6 +165,90 @@ func TestValidateChartWithoutRevision(t *testing.T) {
 	assert.Equal(t, "spec.source.targetRevision is required if the manifest source is a helm chart", conditions[0].Message)
 }
 
+func TestValidatePermissionsMultipleSources(t *testing.T) {
+	proj := &argoappv1.AppProject{
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:  []string{"https://github.com/argoproj/*"},
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+	destination := argoappv1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "default"}
+	db := &dbmocks.ArgoDB{}
+	db.On("GetCluster", context.Background(), destination.Server).Return(&argoappv1.Cluster{Server: destination.Server}, nil)
+
+	t.Run("Valid sources", func(t *testing.T) {
+		conditions, err := ValidatePermissions(context.Background(), &argoappv1.ApplicationSpec{
+			Sources: argoappv1.ApplicationSources{
+				{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "helm-guestbook"},
+				{RepoURL: "https://github.com/argoproj/argocd-example-values", Ref: "values"},
+			},
+			Destination: destination,
+		}, proj, db)
+		assert.NoError(t, err)
+		assert.Empty(t, conditions)
+	})
+
+	t.Run("Source without path, chart or ref", func(t *testing.T) {
+		conditions, err := ValidatePermissions(context.Background(), &argoappv1.ApplicationSpec{
+			Sources: argoappv1.ApplicationSources{
+				{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "helm-guestbook"},
+				{RepoURL: "https://github.com/argoproj/argocd-example-values"},
+			},
+			Destination: destination,
+		}, proj, db)
+		assert.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Equal(t, "spec.sources[1].repoURL and either spec.sources[1].path, spec.sources[1].chart or spec.sources[1].ref are required", conditions[0].Message)
+	})
+
+	t.Run("Duplicate refs", func(t *testing.T) {
+		conditions, err := ValidatePermissions(context.Background(), &argoappv1.ApplicationSpec{
+			Sources: argoappv1.ApplicationSources{
+				{RepoURL: "https://github.com/argoproj/argocd-example-apps", Ref: "values"},
+				{RepoURL: "https://github.com/argoproj/argocd-example-values", Ref: "values"},
+			},
+			Destination: destination,
+		}, proj, db)
+		assert.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Equal(t, `spec.sources[1].ref "values" is used by more than one source`, conditions[0].Message)
+	})
+
+	t.Run("Source not permitted", func(t *testing.T) {
+		conditions, err := ValidatePermissions(context.Background(), &argoappv1.ApplicationSpec{
+			Sources: argoappv1.ApplicationSources{
+				{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "helm-guestbook"},
+				{RepoURL: "https://github.com/other/values", Ref: "values"},
+			},
+			Destination: destination,
+		}, proj, db)
+		assert.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Equal(t, "application repo https://github.com/other/values is not permitted in project ''", conditions[0].Message)
+	})
+}
+
+func TestGetRefSources(t *testing.T) {
+	repo := &argoappv1.Repository{Repo: "https://github.com/argoproj/argocd-example-values"}
+	db := &dbmocks.ArgoDB{}
+	db.On("GetRepository", context.Background(), repo.Repo).Return(repo, nil)
+
+	sources := argoappv1.ApplicationSources{
+		{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "helm-guestbook"},
+		{RepoURL: repo.Repo, TargetRevision: "main", Ref: "values"},
+	}
+
+	refSources, err := GetRefSources(context.Background(), sources, nil, db)
+	assert.NoError(t, err)
+	assert.Equal(t, argoappv1.RefTargetRevisionMapping{
+		"$values": {Repo: *repo, TargetRevision: "main"},
+	}, refSources)
+
+	refSources, err = GetRefSources(context.Background(), sources, []string{"", "abc123"}, db)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc123", refSources["$values"].TargetRevision)
+}
+
 func TestAPIResourcesToStrings(t *testing.T) {
 	resources := []kube.APIResourceInfo{{
 		GroupVersionResource: schema.GroupVersionResource{Group: "apps", Version: "v1beta1"},
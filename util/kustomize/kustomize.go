@@ -1,6 +1,7 @@
 package kustomize
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/url"
@@ -17,6 +18,8 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	certutil "github.com/argoproj/argo-cd/v2/util/cert"
@@ -144,6 +147,15 @@ func (k *kustomize) Build(opts *v1alpha1.ApplicationSourceKustomize, kustomizeOp
 			}
 		}
 
+		if opts.Namespace != "" {
+			cmd := exec.Command(k.getBinaryPath(), "edit", "set", "namespace", "--", opts.Namespace)
+			cmd.Dir = k.path
+			_, err := executil.Run(cmd)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
 		if opts.NamePrefix != "" {
 			cmd := exec.Command(k.getBinaryPath(), "edit", "set", "nameprefix", "--", opts.NamePrefix)
 			cmd.Dir = k.path
@@ -175,6 +187,31 @@ func (k *kustomize) Build(opts *v1alpha1.ApplicationSourceKustomize, kustomizeOp
 			}
 		}
 
+		if len(opts.Replicas) > 0 {
+			// set replicas my-deployment=2 my-statefulset=4
+			args := []string{"edit", "set", "replicas"}
+			for _, replica := range opts.Replicas {
+				count, err := replica.GetIntCount()
+				if err != nil {
+					return nil, nil, err
+				}
+				args = append(args, fmt.Sprintf("%s=%d", replica.Name, count))
+			}
+			cmd := exec.Command(k.getBinaryPath(), args...)
+			cmd.Dir = k.path
+			_, err := executil.Run(cmd)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		if len(opts.Patches) > 0 {
+			err := k.addPatches(opts.Patches)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
 		if len(opts.CommonLabels) > 0 {
 			//  edit add label foo:bar
 			args := []string{"edit", "add", "label"}
@@ -322,6 +359,44 @@ func (k *kustomize) GetCacheKeyWithComponents(revision string, source *v1alpha1.
 	return fmt.Sprintf("%s|%s", revision, cacheKey), nil
 }
 
+// addPatches appends the given patches to the patches of the kustomization, since inline patches cannot be added
+// with kustomize edit
+func (k *kustomize) addPatches(patches v1alpha1.KustomizePatches) error {
+	kustomizationPath, err := k.findKustomization()
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(kustomizationPath)
+	if err != nil {
+		return err
+	}
+	kustomization := map[string]interface{}{}
+	if err := yaml.Unmarshal(data, &kustomization); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", kustomizationPath, err)
+	}
+	existing, _ := kustomization["patches"].([]interface{})
+	for i := range patches {
+		if err := patches[i].Validate(); err != nil {
+			return err
+		}
+		patchData, err := json.Marshal(patches[i])
+		if err != nil {
+			return err
+		}
+		var patch map[string]interface{}
+		if err := json.Unmarshal(patchData, &patch); err != nil {
+			return err
+		}
+		existing = append(existing, patch)
+	}
+	kustomization["patches"] = existing
+	data, err = yaml.Marshal(kustomization)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(kustomizationPath, data, 0644)
+}
+
 func parseKustomizeBuildOptions(path, buildOptions string) []string {
 	return append([]string{"build", path}, strings.Split(buildOptions, " ")...)
 }
@@ -438,6 +513,27 @@ func getImageParameters(objs []*unstructured.Unstructured) []Image {
 	return images
 }
 
+// GetReplicas returns the replica counts of the resources whose replicas can be overridden by Kustomize, sorted by name
+func GetReplicas(objs []*unstructured.Unstructured) v1alpha1.KustomizeReplicas {
+	var replicas v1alpha1.KustomizeReplicas
+	for _, obj := range objs {
+		switch obj.GetKind() {
+		case kube.DeploymentKind, kube.StatefulSetKind, kube.ReplicaSetKind, "ReplicationController":
+		default:
+			continue
+		}
+		count, found, err := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+		if err != nil || !found {
+			continue
+		}
+		replicas = append(replicas, v1alpha1.KustomizeReplica{Name: obj.GetName(), Count: intstr.FromInt(int(count))})
+	}
+	sort.Slice(replicas, func(i, j int) bool {
+		return replicas[i].Name < replicas[j].Name
+	})
+	return replicas
+}
+
 func getImages(object map[string]interface{}) []Image {
 	var images []Image
 	for k, v := range object {
@@ -9,6 +9,9 @@ import (
 
 	"github.com/argoproj/pkg/exec"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/git"
@@ -89,6 +92,35 @@ func TestKustomizeBuild(t *testing.T) {
 	}
 }
 
+func TestKustomizeBuildNamespaceReplicasPatches(t *testing.T) {
+	appPath, err := testDataDir(t, kustomization1)
+	assert.Nil(t, err)
+	kustomize := NewKustomizeApp(appPath, git.NopCreds{}, "", "")
+	kustomizeSource := v1alpha1.ApplicationSourceKustomize{
+		Namespace: "custom-namespace",
+		Replicas:  v1alpha1.KustomizeReplicas{{Name: "web", Count: intstr.FromString("5")}},
+		Patches: v1alpha1.KustomizePatches{{
+			Patch:  "- op: replace\n  path: /spec/template/spec/containers/0/image\n  value: nginx:1.23.0",
+			Target: &v1alpha1.KustomizeSelector{Kind: "Deployment", Name: "nginx-deployment"},
+		}},
+	}
+	objs, _, err := kustomize.Build(&kustomizeSource, nil, nil, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(objs))
+	for _, obj := range objs {
+		assert.Equal(t, "custom-namespace", obj.GetNamespace())
+		switch obj.GetKind() {
+		case "StatefulSet":
+			replicas, _, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+			assert.Equal(t, int64(5), replicas)
+		case "Deployment":
+			replicas, _, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+			assert.Equal(t, int64(3), replicas)
+			assert.Equal(t, []Image{"nginx:1.23.0"}, getImages(obj.Object))
+		}
+	}
+}
+
 func TestFindKustomization(t *testing.T) {
 	testFindKustomization(t, kustomization1, "kustomization.yaml")
 	testFindKustomization(t, kustomization2a, "kustomization.yml")
@@ -101,6 +133,49 @@ func testFindKustomization(t *testing.T, set string, expected string) {
 	assert.Equal(t, "testdata/"+set+"/"+expected, kustomization)
 }
 
+func TestAddPatches(t *testing.T) {
+	appPath, err := testDataDir(t, kustomization1)
+	assert.Nil(t, err)
+	k := &kustomize{path: appPath}
+	err = k.addPatches(v1alpha1.KustomizePatches{
+		{Path: "patch.yaml"},
+		{Patch: "- op: replace\n  path: /spec/replicas\n  value: 3", Target: &v1alpha1.KustomizeSelector{Kind: "Deployment", Name: "nginx-deployment"}},
+	})
+	assert.Nil(t, err)
+	data, err := os.ReadFile(filepath.Join(appPath, "kustomization.yaml"))
+	assert.Nil(t, err)
+	var kustomization map[string]interface{}
+	assert.Nil(t, yaml.Unmarshal(data, &kustomization))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"path": "patch.yaml"},
+		map[string]interface{}{"patch": "- op: replace\n  path: /spec/replicas\n  value: 3", "target": map[string]interface{}{"kind": "Deployment", "name": "nginx-deployment"}},
+	}, kustomization["patches"])
+	assert.Equal(t, []interface{}{"./deployment.yaml", "./statefulset.yaml"}, kustomization["resources"])
+
+	err = k.addPatches(v1alpha1.KustomizePatches{{}})
+	assert.Error(t, err)
+}
+
+func TestGetReplicas(t *testing.T) {
+	newObj := func(kind string, name string, replicas interface{}) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "apps/v1", "kind": kind, "metadata": map[string]interface{}{"name": name}}}
+		if replicas != nil {
+			obj.Object["spec"] = map[string]interface{}{"replicas": replicas}
+		}
+		return obj
+	}
+	replicas := GetReplicas([]*unstructured.Unstructured{
+		newObj("StatefulSet", "web", int64(3)),
+		newObj("Deployment", "api", int64(2)),
+		newObj("Deployment", "worker", nil),
+		newObj("HorizontalPodAutoscaler", "api", int64(5)),
+	})
+	assert.Equal(t, v1alpha1.KustomizeReplicas{
+		{Name: "api", Count: intstr.FromInt(2)},
+		{Name: "web", Count: intstr.FromInt(3)},
+	}, replicas)
+}
+
 func TestIsKustomization(t *testing.T) {
 	assert.True(t, IsKustomization("kustomization.yaml"))
 	assert.True(t, IsKustomization("kustomization.yml"))
@@ -0,0 +1,564 @@
+package oci
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/util/env"
+	argoio "github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/io/files"
+	"github.com/argoproj/argo-cd/v2/util/proxy"
+	"github.com/argoproj/argo-cd/v2/util/tgzstream"
+)
+
+const (
+	// Prefix is the scheme of the repository URLs of OCI artifacts
+	Prefix = "oci://"
+
+	MediaTypeImageManifest  = "application/vnd.oci.image.manifest.v1+json"
+	MediaTypeDockerManifest = "application/vnd.docker.distribution.manifest.v2+json"
+
+	// annotationTitle is the file name of a layer, as set by oras
+	annotationTitle = "org.opencontainers.image.title"
+	// annotationUnpack is set by oras on the layers containing a directory
+	annotationUnpack = "io.deis.oras.content.unpack"
+
+	// maxManifestSize is the maximum size of a manifest fetched from a registry
+	maxManifestSize = 4 * 1024 * 1024
+)
+
+var (
+	digestRegexp = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
+	// maxExtractedSize is the maximum total size of the layers of an artifact, and of the files extracted from them
+	maxExtractedSize int64 = 1024 * 1024 * 1024
+
+	// requestTimeout is the timeout of a request to a registry, including the download of its content
+	requestTimeout = env.ParseDurationFromEnv("ARGOCD_OCI_REQUEST_TIMEOUT", 5*time.Minute, 0, math.MaxInt64)
+
+	// httpClients caches the HTTP clients of the registries, by repository and credentials, so that their connections
+	// are reused across the clients created for each request
+	httpClients     = map[string]*http.Client{}
+	httpClientsLock sync.Mutex
+)
+
+type Creds struct {
+	Username           string
+	Password           string
+	CAPath             string
+	CertData           []byte
+	KeyData            []byte
+	InsecureSkipVerify bool
+}
+
+// Client is a client of an OCI registry, which retrieves the artifacts of a repository
+type Client interface {
+	// ResolveRevision resolves the given tag or digest into the digest of the manifest of the artifact
+	ResolveRevision(ctx context.Context, revision string) (string, error)
+	// Extract extracts the layers of the artifact with the given digest into a temporary directory, which is removed
+	// by the returned closer
+	Extract(ctx context.Context, digest string) (string, argoio.Closer, error)
+	// GetSignatures returns the cosign signatures of the artifact with the given digest
+	GetSignatures(ctx context.Context, digest string) ([]Signature, error)
+	// TestRepository checks that the repository can be accessed
+	TestRepository(ctx context.Context) error
+}
+
+// Descriptor describes the content of a manifest or a layer
+type Descriptor struct {
+	MediaType   string            `json:"mediaType,omitempty"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Manifest is an OCI image manifest
+type Manifest struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	MediaType     string            `json:"mediaType,omitempty"`
+	Config        Descriptor        `json:"config"`
+	Layers        []Descriptor      `json:"layers"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+}
+
+// IsOCIRepo returns whether the given repository URL is the URL of an OCI artifact, i.e. has the oci:// scheme
+func IsOCIRepo(repoURL string) bool {
+	return strings.HasPrefix(repoURL, Prefix)
+}
+
+// IsDigest returns whether the given revision is a sha256 digest
+func IsDigest(revision string) bool {
+	return digestRegexp.MatchString(revision)
+}
+
+// ParseRepoURL parses an OCI repository URL, such as oci://registry.example.com/org/manifests, into the host of the
+// registry and the name of the repository
+func ParseRepoURL(repoURL string) (string, string, error) {
+	if !IsOCIRepo(repoURL) {
+		return "", "", fmt.Errorf("invalid OCI repository URL '%s': must start with %s", repoURL, Prefix)
+	}
+	host, repository, _ := strings.Cut(strings.TrimPrefix(repoURL, Prefix), "/")
+	repository = strings.Trim(repository, "/")
+	if host == "" || repository == "" {
+		return "", "", fmt.Errorf("invalid OCI repository URL '%s': must include the registry host and the repository name", repoURL)
+	}
+	return host, repository, nil
+}
+
+type ClientOpts func(c *nativeOCIClient)
+
+// WithHTTPClient sets the HTTP client used to access the registry, instead of a client configured from the credentials
+func WithHTTPClient(httpClient *http.Client) ClientOpts {
+	return func(c *nativeOCIClient) {
+		c.httpClient = httpClient
+	}
+}
+
+func NewClient(repoURL string, creds Creds, proxy string, opts ...ClientOpts) Client {
+	c := &nativeOCIClient{
+		repoURL: repoURL,
+		creds:   creds,
+		proxy:   proxy,
+	}
+	for i := range opts {
+		opts[i](c)
+	}
+	return c
+}
+
+var _ Client = &nativeOCIClient{}
+
+type nativeOCIClient struct {
+	repoURL    string
+	creds      Creds
+	proxy      string
+	httpClient *http.Client
+
+	lock  sync.Mutex
+	token string
+}
+
+func (c *nativeOCIClient) ResolveRevision(ctx context.Context, revision string) (string, error) {
+	if revision == "" {
+		revision = "latest"
+	}
+	if IsDigest(revision) {
+		return revision, nil
+	}
+	_, digest, err := c.getManifest(ctx, revision)
+	if err != nil {
+		return "", err
+	}
+	return digest, nil
+}
+
+func (c *nativeOCIClient) Extract(ctx context.Context, digest string) (string, argoio.Closer, error) {
+	if !IsDigest(digest) {
+		return "", nil, fmt.Errorf("invalid digest '%s'", digest)
+	}
+	start := time.Now()
+	manifest, _, err := c.getManifest(ctx, digest)
+	if err != nil {
+		return "", nil, err
+	}
+	tempDir, err := files.CreateTempDir(os.TempDir())
+	if err != nil {
+		return "", nil, err
+	}
+	closer := argoio.NewCloser(func() error {
+		return os.RemoveAll(tempDir)
+	})
+	extractedSize := int64(0)
+	for _, layer := range manifest.Layers {
+		if err := c.extractLayer(ctx, tempDir, layer, maxExtractedSize-extractedSize); err != nil {
+			argoio.Close(closer)
+			return "", nil, fmt.Errorf("error extracting layer %s: %w", layer.Digest, err)
+		}
+		// the size of the extracted files is limited across all the layers
+		extractedSize, err = dirSize(tempDir)
+		if err != nil {
+			argoio.Close(closer)
+			return "", nil, err
+		}
+	}
+	log.WithFields(log.Fields{"repo": c.repoURL, "digest": digest, "seconds": time.Since(start).Seconds()}).Info("took to extract OCI artifact")
+	return tempDir, closer, nil
+}
+
+func (c *nativeOCIClient) TestRepository(ctx context.Context) error {
+	_, err := c.ResolveRevision(ctx, "")
+	var respErr *responseError
+	// an empty repository or a repository without a latest tag is accessible
+	if errors.As(err, &respErr) && respErr.statusCode == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
+
+// extractLayer extracts the given layer into the given directory, without exceeding the given size. Compressed
+// tarballs are unpacked, other layers are written to the file named by their title annotation.
+func (c *nativeOCIClient) extractLayer(ctx context.Context, dir string, layer Descriptor, maxSize int64) error {
+	if layer.Size > maxSize {
+		return fmt.Errorf("size of layer exceeds the remaining %d bytes of the maximum artifact size", maxSize)
+	}
+	title := layer.Annotations[annotationTitle]
+	target := dir
+	if title != "" {
+		target = filepath.Join(dir, title)
+		if !files.Inbound(target, dir) || target == dir {
+			return fmt.Errorf("illegal file name: %s", title)
+		}
+	}
+	if strings.HasSuffix(layer.MediaType, "tar+gzip") || strings.HasSuffix(layer.MediaType, ".tar.gzip") {
+		if title != "" && layer.Annotations[annotationUnpack] != "true" {
+			target = dir
+		}
+		// the tarball is downloaded to a temporary file, so that it is only unpacked once its digest is verified
+		tgzFile, err := os.CreateTemp("", "oci-layer-")
+		if err != nil {
+			return err
+		}
+		defer tgzstream.CloseAndDelete(tgzFile)
+		if err := c.downloadBlob(ctx, layer, tgzFile, maxSize); err != nil {
+			return err
+		}
+		if _, err := tgzFile.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		return files.Untgz(target, tgzFile, maxSize)
+	}
+	if title == "" {
+		return fmt.Errorf("unsupported layer media type '%s' without file name", layer.MediaType)
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	// a file with an invalid digest is removed along with the rest of the artifact
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	err = c.downloadBlob(ctx, layer, f, maxSize)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// dirSize returns the total size of the files of the given directory
+func dirSize(dir string) (int64, error) {
+	size := int64(0)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
+
+// getManifest returns the manifest with the given reference and its digest
+func (c *nativeOCIClient) getManifest(ctx context.Context, reference string) (*Manifest, string, error) {
+	data, err := c.get(ctx, "manifests/"+reference, strings.Join([]string{MediaTypeImageManifest, MediaTypeDockerManifest}, ", "), maxManifestSize)
+	if err != nil {
+		return nil, "", err
+	}
+	digest := "sha256:" + sha256Hex(data)
+	if IsDigest(reference) && digest != reference {
+		return nil, "", fmt.Errorf("digest of manifest %s does not match: %s", reference, digest)
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, "", fmt.Errorf("error unmarshaling manifest %s: %w", reference, err)
+	}
+	return &manifest, digest, nil
+}
+
+// getBlob returns the content of the given blob, after checking its digest
+func (c *nativeOCIClient) getBlob(ctx context.Context, blob Descriptor, maxSize int64) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := c.downloadBlob(ctx, blob, &buf, maxSize); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// downloadBlob streams the content of the given blob to the given writer, and checks its digest once written. The
+// content written for a blob with an invalid digest must be discarded by the caller.
+func (c *nativeOCIClient) downloadBlob(ctx context.Context, blob Descriptor, w io.Writer, maxSize int64) error {
+	if !IsDigest(blob.Digest) {
+		return fmt.Errorf("unsupported digest '%s'", blob.Digest)
+	}
+	body, reqURL, err := c.open(ctx, "blobs/"+blob.Digest, "")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = body.Close() }()
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(w, hash), io.LimitReader(body, maxSize+1))
+	if err != nil {
+		return err
+	}
+	if size > maxSize {
+		return fmt.Errorf("content of %s exceeds the maximum size of %d bytes", reqURL, maxSize)
+	}
+	if digest := "sha256:" + hex.EncodeToString(hash.Sum(nil)); digest != blob.Digest {
+		return fmt.Errorf("digest of blob %s does not match: %s", blob.Digest, digest)
+	}
+	return nil
+}
+
+type responseError struct {
+	url        string
+	statusCode int
+	status     string
+}
+
+func (e *responseError) Error() string {
+	return fmt.Sprintf("failed to get %s: %s", e.url, e.status)
+}
+
+// get returns the content of the given path of the repository API of the registry
+func (c *nativeOCIClient) get(ctx context.Context, path string, accept string, maxSize int64) ([]byte, error) {
+	body, reqURL, err := c.open(ctx, path, accept)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = body.Close() }()
+	data, err := io.ReadAll(io.LimitReader(body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("content of %s exceeds the maximum size of %d bytes", reqURL, maxSize)
+	}
+	return data, nil
+}
+
+// open returns the body of the response to the given path of the repository API of the registry, along with the URL
+// of the request, authenticating when requested by the registry. The body must be closed by the caller.
+func (c *nativeOCIClient) open(ctx context.Context, path string, accept string) (io.ReadCloser, string, error) {
+	host, repository, err := ParseRepoURL(c.repoURL)
+	if err != nil {
+		return nil, "", err
+	}
+	httpClient, err := c.getHTTPClient()
+	if err != nil {
+		return nil, "", err
+	}
+	reqURL := fmt.Sprintf("https://%s/v2/%s/%s", host, repository, path)
+	do := func() (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		c.lock.Lock()
+		token := c.token
+		c.lock.Unlock()
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		} else if c.creds.Username != "" || c.creds.Password != "" {
+			req.SetBasicAuth(c.creds.Username, c.creds.Password)
+		}
+		return httpClient.Do(req)
+	}
+	resp, err := do()
+	if err != nil {
+		return nil, "", err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		_ = resp.Body.Close()
+		if err := c.authenticate(ctx, httpClient, challenge, repository); err != nil {
+			return nil, "", fmt.Errorf("error authenticating to %s: %w", host, err)
+		}
+		resp, err = do()
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, "", &responseError{url: reqURL, statusCode: resp.StatusCode, status: resp.Status}
+	}
+	return resp.Body, reqURL, nil
+}
+
+// authenticate retrieves a bearer token from the authorization service of the registry, as requested by the given
+// WWW-Authenticate challenge
+func (c *nativeOCIClient) authenticate(ctx context.Context, httpClient *http.Client, challenge string, repository string) error {
+	scheme, params := parseChallenge(challenge)
+	if !strings.EqualFold(scheme, "bearer") || params["realm"] == "" {
+		return fmt.Errorf("unsupported authentication challenge '%s'", challenge)
+	}
+	realm, err := url.Parse(params["realm"])
+	if err != nil {
+		return fmt.Errorf("invalid realm '%s': %w", params["realm"], err)
+	}
+	query := realm.Query()
+	if params["service"] != "" {
+		query.Set("service", params["service"])
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", repository)
+	}
+	query.Set("scope", scope)
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return err
+	}
+	if c.creds.Username != "" || c.creds.Password != "" {
+		req.SetBasicAuth(c.creds.Username, c.creds.Password)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New("failed to get token: " + resp.Status)
+	}
+	var tokenResp struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxManifestSize)).Decode(&tokenResp); err != nil {
+		return fmt.Errorf("error decoding token: %w", err)
+	}
+	token := tokenResp.Token
+	if token == "" {
+		token = tokenResp.AccessToken
+	}
+	if token == "" {
+		return errors.New("no token returned")
+	}
+	c.lock.Lock()
+	c.token = token
+	c.lock.Unlock()
+	return nil
+}
+
+// getHTTPClient returns the HTTP client of the registry, which is shared by the clients of the same repository with
+// the same credentials and proxy
+func (c *nativeOCIClient) getHTTPClient() (*http.Client, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.httpClient != nil {
+		return c.httpClient, nil
+	}
+	var caData []byte
+	if c.creds.CAPath != "" {
+		var err error
+		caData, err = os.ReadFile(c.creds.CAPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	key := httpClientKey(c.repoURL, c.proxy, c.creds, caData)
+
+	httpClientsLock.Lock()
+	defer httpClientsLock.Unlock()
+	httpClient, ok := httpClients[key]
+	if !ok {
+		tlsConf, err := newTLSConfig(c.creds, caData)
+		if err != nil {
+			return nil, err
+		}
+		httpClient = &http.Client{
+			Timeout: requestTimeout,
+			Transport: &http.Transport{
+				Proxy:               proxy.GetCallback(c.proxy),
+				TLSClientConfig:     tlsConf,
+				TLSHandshakeTimeout: 10 * time.Second,
+				IdleConnTimeout:     90 * time.Second,
+				MaxIdleConnsPerHost: 10,
+			},
+		}
+		httpClients[key] = httpClient
+	}
+	c.httpClient = httpClient
+	return httpClient, nil
+}
+
+// httpClientKey returns the key of the HTTP client of a repository in the httpClients cache
+func httpClientKey(repoURL string, proxy string, creds Creds, caData []byte) string {
+	h := sha256.New()
+	for _, data := range [][]byte{[]byte(repoURL), []byte(proxy), []byte(fmt.Sprint(creds.InsecureSkipVerify)), caData, creds.CertData, creds.KeyData} {
+		_, _ = fmt.Fprintf(h, "%d:", len(data))
+		_, _ = h.Write(data)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func newTLSConfig(creds Creds, caData []byte) (*tls.Config, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: creds.InsecureSkipVerify}
+
+	if len(caData) > 0 {
+		caCertPool := x509.NewCertPool()
+		caCertPool.AppendCertsFromPEM(caData)
+		tlsConfig.RootCAs = caCertPool
+	}
+
+	// If a client cert & key is provided then configure TLS config accordingly.
+	if len(creds.CertData) > 0 && len(creds.KeyData) > 0 {
+		cert, err := tls.X509KeyPair(creds.CertData, creds.KeyData)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// parseChallenge parses a WWW-Authenticate header, such as
+// Bearer realm="https://auth.example.com/token",service="registry.example.com",scope="repository:foo:pull"
+func parseChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := map[string]string{}
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, ", "), "=")
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		if key = strings.TrimSpace(key); key != "" {
+			params[strings.ToLower(key)] = value
+		}
+	}
+	return scheme, params
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,247 @@
+package oci
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRegistry is a minimal OCI registry serving the manifests and blobs of a single repository
+type fakeRegistry struct {
+	manifests map[string][]byte
+	blobs     map[string][]byte
+	token     string
+}
+
+func newFakeRegistry() *fakeRegistry {
+	return &fakeRegistry{manifests: map[string][]byte{}, blobs: map[string][]byte{}}
+}
+
+func (r *fakeRegistry) addBlob(data []byte) string {
+	digest := "sha256:" + sha256Hex(data)
+	r.blobs[digest] = data
+	return digest
+}
+
+func (r *fakeRegistry) addManifest(t *testing.T, tag string, layers ...Descriptor) string {
+	data, err := json.Marshal(Manifest{SchemaVersion: 2, MediaType: MediaTypeImageManifest, Layers: layers})
+	require.NoError(t, err)
+	digest := "sha256:" + sha256Hex(data)
+	r.manifests[digest] = data
+	if tag != "" {
+		r.manifests[tag] = data
+	}
+	return digest
+}
+
+func (r *fakeRegistry) start(t *testing.T) (*httptest.Server, string) {
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/token" {
+			username, password, ok := req.BasicAuth()
+			if !ok || username != "user" || password != "pass" || req.URL.Query().Get("scope") != "repository:org/manifests:pull" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"token":"` + r.token + `"}`))
+			return
+		}
+		if r.token != "" && req.Header.Get("Authorization") != "Bearer "+r.token {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="`+server.URL+`/token",service="registry"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var data []byte
+		if reference := strings.TrimPrefix(req.URL.Path, "/v2/org/manifests/manifests/"); reference != req.URL.Path {
+			data = r.manifests[reference]
+		} else if digest := strings.TrimPrefix(req.URL.Path, "/v2/org/manifests/blobs/"); digest != req.URL.Path {
+			data = r.blobs[digest]
+		}
+		if data == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(server.Close)
+	return server, Prefix + strings.TrimPrefix(server.URL, "https://") + "/org/manifests"
+}
+
+func tgz(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+	return buf.Bytes()
+}
+
+func TestParseRepoURL(t *testing.T) {
+	host, repository, err := ParseRepoURL("oci://registry.example.com:5000/org/manifests/")
+	require.NoError(t, err)
+	assert.Equal(t, "registry.example.com:5000", host)
+	assert.Equal(t, "org/manifests", repository)
+
+	_, _, err = ParseRepoURL("oci://registry.example.com")
+	assert.Error(t, err)
+	_, _, err = ParseRepoURL("https://registry.example.com/org/manifests")
+	assert.Error(t, err)
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.example.com/token",service="registry.example.com",scope="repository:org/manifests:pull,push"`)
+	assert.Equal(t, "Bearer", scheme)
+	assert.Equal(t, map[string]string{
+		"realm":   "https://auth.example.com/token",
+		"service": "registry.example.com",
+		"scope":   "repository:org/manifests:pull,push",
+	}, params)
+}
+
+func TestClient(t *testing.T) {
+	registry := newFakeRegistry()
+	registry.token = "secret-token"
+	tarball := tgz(t, map[string]string{"deployment.yaml": "kind: Deployment", "overlays/kustomization.yaml": "resources: []"})
+	file := []byte("kind: ConfigMap")
+	digest := registry.addManifest(t, "v1.0.0",
+		Descriptor{MediaType: "application/vnd.cncf.flux.content.v1.tar+gzip", Digest: registry.addBlob(tarball), Size: int64(len(tarball))},
+		Descriptor{MediaType: "application/yaml", Digest: registry.addBlob(file), Size: int64(len(file)), Annotations: map[string]string{annotationTitle: "configmap.yaml"}},
+	)
+	_, repoURL := registry.start(t)
+	client := NewClient(repoURL, Creds{Username: "user", Password: "pass", InsecureSkipVerify: true}, "")
+
+	t.Run("ResolveRevision", func(t *testing.T) {
+		resolved, err := client.ResolveRevision(context.Background(), "v1.0.0")
+		require.NoError(t, err)
+		assert.Equal(t, digest, resolved)
+
+		resolved, err = client.ResolveRevision(context.Background(), digest)
+		require.NoError(t, err)
+		assert.Equal(t, digest, resolved)
+
+		_, err = client.ResolveRevision(context.Background(), "v2.0.0")
+		assert.ErrorContains(t, err, "404")
+	})
+	t.Run("Extract", func(t *testing.T) {
+		dir, closer, err := client.Extract(context.Background(), digest)
+		require.NoError(t, err)
+		defer closer.Close()
+		data, err := os.ReadFile(filepath.Join(dir, "deployment.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "kind: Deployment", string(data))
+		data, err = os.ReadFile(filepath.Join(dir, "overlays", "kustomization.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "resources: []", string(data))
+		data, err = os.ReadFile(filepath.Join(dir, "configmap.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "kind: ConfigMap", string(data))
+
+		require.NoError(t, closer.Close())
+		_, err = os.Stat(dir)
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("TestRepository", func(t *testing.T) {
+		assert.NoError(t, client.TestRepository(context.Background()))
+	})
+	t.Run("InvalidCredentials", func(t *testing.T) {
+		client := NewClient(repoURL, Creds{Username: "user", Password: "wrong", InsecureSkipVerify: true}, "")
+		_, err := client.ResolveRevision(context.Background(), "v1.0.0")
+		assert.ErrorContains(t, err, "error authenticating")
+		assert.Error(t, client.TestRepository(context.Background()))
+	})
+}
+
+func TestClient_Extract_InvalidLayers(t *testing.T) {
+	registry := newFakeRegistry()
+	file := []byte("kind: ConfigMap")
+	corrupted := registry.addManifest(t, "", Descriptor{MediaType: "application/yaml", Digest: "sha256:" + sha256Hex([]byte("other")), Annotations: map[string]string{annotationTitle: "configmap.yaml"}})
+	registry.blobs["sha256:"+sha256Hex([]byte("other"))] = file
+	traversal := registry.addManifest(t, "", Descriptor{MediaType: "application/yaml", Digest: registry.addBlob(file), Annotations: map[string]string{annotationTitle: "../configmap.yaml"}})
+	untitled := registry.addManifest(t, "", Descriptor{MediaType: "application/yaml", Digest: registry.addBlob(file)})
+	_, repoURL := registry.start(t)
+	client := NewClient(repoURL, Creds{InsecureSkipVerify: true}, "")
+
+	_, _, err := client.Extract(context.Background(), corrupted)
+	assert.ErrorContains(t, err, "does not match")
+	_, _, err = client.Extract(context.Background(), traversal)
+	assert.ErrorContains(t, err, "illegal file name")
+	_, _, err = client.Extract(context.Background(), untitled)
+	assert.ErrorContains(t, err, "unsupported layer media type")
+	_, _, err = client.Extract(context.Background(), "latest")
+	assert.ErrorContains(t, err, "invalid digest")
+}
+
+func TestClient_HTTPClient(t *testing.T) {
+	registry := newFakeRegistry()
+	digest := registry.addManifest(t, "latest")
+	_, repoURL := registry.start(t)
+	newClient := func(creds Creds) *nativeOCIClient {
+		return NewClient(repoURL, creds, "").(*nativeOCIClient)
+	}
+
+	httpClient, err := newClient(Creds{InsecureSkipVerify: true}).getHTTPClient()
+	require.NoError(t, err)
+	assert.Equal(t, requestTimeout, httpClient.Timeout)
+	sameHTTPClient, err := newClient(Creds{Username: "user", InsecureSkipVerify: true}).getHTTPClient()
+	require.NoError(t, err)
+	assert.Same(t, httpClient, sameHTTPClient)
+	otherHTTPClient, err := newClient(Creds{}).getHTTPClient()
+	require.NoError(t, err)
+	assert.NotSame(t, httpClient, otherHTTPClient)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = newClient(Creds{InsecureSkipVerify: true}).ResolveRevision(ctx, "latest")
+	assert.ErrorIs(t, err, context.Canceled)
+	resolved, err := newClient(Creds{InsecureSkipVerify: true}).ResolveRevision(context.Background(), "latest")
+	require.NoError(t, err)
+	assert.Equal(t, digest, resolved)
+}
+
+func TestClient_Extract_MaxSize(t *testing.T) {
+	defer func(size int64) { maxExtractedSize = size }(maxExtractedSize)
+	maxExtractedSize = 100
+
+	registry := newFakeRegistry()
+	file := []byte(strings.Repeat("a", 60))
+	otherFile := []byte(strings.Repeat("b", 60))
+	layers := registry.addManifest(t, "",
+		Descriptor{MediaType: "application/yaml", Digest: registry.addBlob(file), Size: int64(len(file)), Annotations: map[string]string{annotationTitle: "a.yaml"}},
+		Descriptor{MediaType: "application/yaml", Digest: registry.addBlob(otherFile), Size: int64(len(otherFile)), Annotations: map[string]string{annotationTitle: "b.yaml"}},
+	)
+	// the size of the layer is not declared in the manifest
+	undeclared := registry.addManifest(t, "",
+		Descriptor{MediaType: "application/yaml", Digest: registry.addBlob(file), Annotations: map[string]string{annotationTitle: "a.yaml"}},
+		Descriptor{MediaType: "application/yaml", Digest: registry.addBlob(otherFile), Annotations: map[string]string{annotationTitle: "b.yaml"}},
+	)
+	tarball := tgz(t, map[string]string{"b.yaml": string(otherFile)})
+	tarballs := registry.addManifest(t, "",
+		Descriptor{MediaType: "application/yaml", Digest: registry.addBlob(file), Annotations: map[string]string{annotationTitle: "a.yaml"}},
+		Descriptor{MediaType: "application/vnd.cncf.flux.content.v1.tar+gzip", Digest: registry.addBlob(tarball)},
+	)
+	_, repoURL := registry.start(t)
+	client := NewClient(repoURL, Creds{InsecureSkipVerify: true}, "")
+
+	_, _, err := client.Extract(context.Background(), layers)
+	assert.ErrorContains(t, err, "exceeds the remaining 40 bytes")
+	_, _, err = client.Extract(context.Background(), undeclared)
+	assert.ErrorContains(t, err, "exceeds the maximum size of 40 bytes")
+	_, _, err = client.Extract(context.Background(), tarballs)
+	assert.ErrorContains(t, err, "exceeds the maximum size of 40 bytes")
+}
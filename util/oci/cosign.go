@@ -0,0 +1,145 @@
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	// annotationCosignSignature is the annotation of the layers of a cosign signature manifest holding the base64
+	// encoded signature of the layer
+	annotationCosignSignature = "dev.cosignproject.cosign/signature"
+	// cosignSignatureType is the type of the simple signing payloads signed by cosign
+	cosignSignatureType = "cosign container image signature"
+	// maxSignaturePayloadSize is the maximum size of a simple signing payload
+	maxSignaturePayloadSize = 1024 * 1024
+)
+
+// Signature is a cosign signature of an artifact
+type Signature struct {
+	// Payload is the simple signing payload, which contains the digest of the signed artifact
+	Payload []byte `json:"payload"`
+	// Signature is the base64 encoded signature of the payload
+	Signature string `json:"signature"`
+}
+
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// SignatureTag returns the tag under which cosign stores the signatures of the artifact with the given digest
+func SignatureTag(digest string) string {
+	return strings.Replace(digest, ":", "-", 1) + ".sig"
+}
+
+func (c *nativeOCIClient) GetSignatures(ctx context.Context, digest string) ([]Signature, error) {
+	if !IsDigest(digest) {
+		return nil, fmt.Errorf("invalid digest '%s'", digest)
+	}
+	manifest, _, err := c.getManifest(ctx, SignatureTag(digest))
+	if err != nil {
+		var respErr *responseError
+		if errors.As(err, &respErr) && respErr.statusCode == http.StatusNotFound {
+			// the artifact is not signed
+			return nil, nil
+		}
+		return nil, err
+	}
+	var signatures []Signature
+	for _, layer := range manifest.Layers {
+		signature, ok := layer.Annotations[annotationCosignSignature]
+		if !ok || layer.Size > maxSignaturePayloadSize {
+			continue
+		}
+		payload, err := c.getBlob(ctx, layer, maxSignaturePayloadSize)
+		if err != nil {
+			return nil, fmt.Errorf("error getting signature payload %s: %w", layer.Digest, err)
+		}
+		signatures = append(signatures, Signature{Payload: payload, Signature: signature})
+	}
+	return signatures, nil
+}
+
+// ParsePublicKey parses a PEM encoded ECDSA, RSA or Ed25519 public key, as generated by cosign generate-key-pair
+func ParsePublicKey(publicKey string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(publicKey)))
+	if block == nil {
+		return nil, errors.New("no PEM encoded public key found")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	switch key.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", key)
+	}
+}
+
+// VerifySignatures verifies that one of the given signatures is a signature of the artifact with the given digest made
+// with one of the given PEM encoded public keys, and returns the index of the first public key with which a signature
+// was verified
+func VerifySignatures(digest string, signatures []Signature, publicKeys []string) (int, error) {
+	if len(signatures) == 0 {
+		return -1, fmt.Errorf("artifact %s is not signed", digest)
+	}
+	keys := make([]crypto.PublicKey, len(publicKeys))
+	for i := range publicKeys {
+		key, err := ParsePublicKey(publicKeys[i])
+		if err != nil {
+			return -1, fmt.Errorf("invalid public key: %w", err)
+		}
+		keys[i] = key
+	}
+	for _, signature := range signatures {
+		var payload simpleSigningPayload
+		if err := json.Unmarshal(signature.Payload, &payload); err != nil {
+			continue
+		}
+		if payload.Critical.Type != cosignSignatureType || payload.Critical.Image.DockerManifestDigest != digest {
+			continue
+		}
+		sig, err := base64.StdEncoding.DecodeString(signature.Signature)
+		if err != nil {
+			continue
+		}
+		for i := range keys {
+			if verifySignature(keys[i], signature.Payload, sig) {
+				return i, nil
+			}
+		}
+	}
+	return -1, fmt.Errorf("no signature of artifact %s could be verified with the allowed public keys", digest)
+}
+
+func verifySignature(key crypto.PublicKey, payload []byte, sig []byte) bool {
+	digest := sha256.Sum256(payload)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(k, digest[:], sig)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, payload, sig)
+	}
+	return false
+}
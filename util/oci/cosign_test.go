@@ -0,0 +1,98 @@
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCosignKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func sign(t *testing.T, key crypto.Signer, digest string) Signature {
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"registry.example.com/org/manifests"},"image":{"docker-manifest-digest":"%s"},"type":"cosign container image signature"},"optional":null}`, digest))
+	hash := sha256.Sum256(payload)
+	sig, err := key.Sign(rand.Reader, hash[:], crypto.SHA256)
+	require.NoError(t, err)
+	return Signature{Payload: payload, Signature: base64.StdEncoding.EncodeToString(sig)}
+}
+
+func TestSignatureTag(t *testing.T) {
+	assert.Equal(t, "sha256-abc.sig", SignatureTag("sha256:abc"))
+}
+
+func TestParsePublicKey(t *testing.T) {
+	_, publicKey := newCosignKey(t)
+	key, err := ParsePublicKey(publicKey)
+	require.NoError(t, err)
+	assert.IsType(t, &ecdsa.PublicKey{}, key)
+
+	_, err = ParsePublicKey("not a key")
+	assert.EqualError(t, err, "no PEM encoded public key found")
+}
+
+func TestVerifySignatures(t *testing.T) {
+	digest := "sha256:" + sha256Hex([]byte("manifest"))
+	otherDigest := "sha256:" + sha256Hex([]byte("other"))
+	key, publicKey := newCosignKey(t)
+	otherKey, otherPublicKey := newCosignKey(t)
+
+	index, err := VerifySignatures(digest, []Signature{sign(t, otherKey, digest)}, []string{publicKey, otherPublicKey})
+	require.NoError(t, err)
+	assert.Equal(t, 1, index)
+
+	_, err = VerifySignatures(digest, []Signature{sign(t, otherKey, digest)}, []string{publicKey})
+	assert.ErrorContains(t, err, "could be verified")
+
+	_, err = VerifySignatures(digest, []Signature{sign(t, key, otherDigest)}, []string{publicKey})
+	assert.ErrorContains(t, err, "could be verified")
+
+	_, err = VerifySignatures(digest, nil, []string{publicKey})
+	assert.ErrorContains(t, err, "is not signed")
+
+	_, err = VerifySignatures(digest, []Signature{sign(t, key, digest)}, []string{"invalid"})
+	assert.ErrorContains(t, err, "invalid public key")
+}
+
+func TestClient_GetSignatures(t *testing.T) {
+	registry := newFakeRegistry()
+	file := []byte("kind: ConfigMap")
+	digest := registry.addManifest(t, "v1.0.0", Descriptor{MediaType: "application/yaml", Digest: registry.addBlob(file), Annotations: map[string]string{annotationTitle: "configmap.yaml"}})
+	unsigned := registry.addManifest(t, "v2.0.0", Descriptor{MediaType: "application/yaml", Digest: registry.addBlob([]byte("kind: Secret")), Annotations: map[string]string{annotationTitle: "secret.yaml"}})
+	key, publicKey := newCosignKey(t)
+	signature := sign(t, key, digest)
+	registry.addManifest(t, SignatureTag(digest), Descriptor{
+		MediaType:   "application/vnd.dev.cosign.simplesigning.v1+json",
+		Digest:      registry.addBlob(signature.Payload),
+		Size:        int64(len(signature.Payload)),
+		Annotations: map[string]string{annotationCosignSignature: signature.Signature},
+	})
+	_, repoURL := registry.start(t)
+	client := NewClient(repoURL, Creds{InsecureSkipVerify: true}, "")
+
+	signatures, err := client.GetSignatures(context.Background(), digest)
+	require.NoError(t, err)
+	assert.Equal(t, []Signature{signature}, signatures)
+	_, err = VerifySignatures(digest, signatures, []string{publicKey})
+	assert.NoError(t, err)
+
+	signatures, err = client.GetSignatures(context.Background(), unsigned)
+	require.NoError(t, err)
+	assert.Empty(t, signatures)
+}
@@ -252,57 +252,39 @@ func Test_getAppRefreshPrefix(t *testing.T) {
 	}
 }
 
-func TestAppRevisionHasChanged(t *testing.T) {
-	assert.True(t, appRevisionHasChanged(&v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{
-		Source: v1alpha1.ApplicationSource{},
-	}}, "master", true))
-
-	assert.False(t, appRevisionHasChanged(&v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{
-		Source: v1alpha1.ApplicationSource{},
-	}}, "master", false))
-
-	assert.False(t, appRevisionHasChanged(&v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{
-		Source: v1alpha1.ApplicationSource{
-			TargetRevision: "dev",
-		},
-	}}, "master", true))
-
-	assert.True(t, appRevisionHasChanged(&v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{
-		Source: v1alpha1.ApplicationSource{
-			TargetRevision: "dev",
-		},
-	}}, "dev", false))
-
-	assert.False(t, appRevisionHasChanged(&v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{
-		Source: v1alpha1.ApplicationSource{
-			TargetRevision: "refs/heads/dev",
-		},
-	}}, "master", true))
-
-	assert.True(t, appRevisionHasChanged(&v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{
-		Source: v1alpha1.ApplicationSource{
-			TargetRevision: "refs/heads/dev",
-		},
-	}}, "dev", false))
-	assert.True(t, appRevisionHasChanged(&v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{
-		Source: v1alpha1.ApplicationSource{
-			TargetRevision: "env/test",
-		},
-	}}, "env/test", false))
-	assert.True(t, appRevisionHasChanged(&v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{
-		Source: v1alpha1.ApplicationSource{
-			TargetRevision: "refs/heads/env/test",
-		},
-	}}, "env/test", false))
+func TestSourceRevisionHasChanged(t *testing.T) {
+	assert.True(t, sourceRevisionHasChanged(v1alpha1.ApplicationSource{}, "master", true))
+
+	assert.False(t, sourceRevisionHasChanged(v1alpha1.ApplicationSource{}, "master", false))
+
+	assert.False(t, sourceRevisionHasChanged(v1alpha1.ApplicationSource{
+		TargetRevision: "dev",
+	}, "master", true))
+
+	assert.True(t, sourceRevisionHasChanged(v1alpha1.ApplicationSource{
+		TargetRevision: "dev",
+	}, "dev", false))
+
+	assert.False(t, sourceRevisionHasChanged(v1alpha1.ApplicationSource{
+		TargetRevision: "refs/heads/dev",
+	}, "master", true))
+
+	assert.True(t, sourceRevisionHasChanged(v1alpha1.ApplicationSource{
+		TargetRevision: "refs/heads/dev",
+	}, "dev", false))
+	assert.True(t, sourceRevisionHasChanged(v1alpha1.ApplicationSource{
+		TargetRevision: "env/test",
+	}, "env/test", false))
+	assert.True(t, sourceRevisionHasChanged(v1alpha1.ApplicationSource{
+		TargetRevision: "refs/heads/env/test",
+	}, "env/test", false))
 }
 
-func Test_affectedRevisionInfo_appRevisionHasChanged(t *testing.T) {
-	appWithRevision := func(targetRevision string) *v1alpha1.Application {
-		return &v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{
-			Source: v1alpha1.ApplicationSource{
-				TargetRevision: targetRevision,
-			},
-		}}
+func Test_affectedRevisionInfo_sourceRevisionHasChanged(t *testing.T) {
+	sourceWithRevision := func(targetRevision string) v1alpha1.ApplicationSource {
+		return v1alpha1.ApplicationSource{
+			TargetRevision: targetRevision,
+		}
 	}
 
 	githubPushPayload := func(branchName string) github.PushPayload {
@@ -417,8 +399,8 @@ func Test_affectedRevisionInfo_appRevisionHasChanged(t *testing.T) {
 		t.Run(testCopy.name, func(t *testing.T) {
 			t.Parallel()
 			_, revisionFromHook, _, _, _ := affectedRevisionInfo(testCopy.hookPayload)
-			if got := appRevisionHasChanged(appWithRevision(testCopy.targetRevision), revisionFromHook, false); got != testCopy.hasChanged {
-				t.Errorf("appRevisionHasChanged() = %v, want %v", got, testCopy.hasChanged)
+			if got := sourceRevisionHasChanged(sourceWithRevision(testCopy.targetRevision), revisionFromHook, false); got != testCopy.hasChanged {
+				t.Errorf("sourceRevisionHasChanged() = %v, want %v", got, testCopy.hasChanged)
 			}
 		})
 	}